@@ -1,6 +1,7 @@
 package v1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -9,7 +10,7 @@ import (
 // ---------------------------------------------------
 func (in *GCPKubernetesCluster) DeepCopyInto(out *GCPKubernetesCluster) {
 	out.TypeMeta = in.TypeMeta
-	out.ObjectMeta = in.ObjectMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = GCPKubernetesClusterSpec{
 		ClusterName:           in.Spec.ClusterName,
 		InitialNodeCount:      in.Spec.InitialNodeCount,
@@ -18,13 +19,41 @@ func (in *GCPKubernetesCluster) DeepCopyInto(out *GCPKubernetesCluster) {
 		ClusterIpv4Cidr:       in.Spec.ClusterIpv4Cidr,
 		Description:           in.Spec.Description,
 		InitialClusterVersion: in.Spec.InitialClusterVersion,
+		ServicesIpv4Cidr:      in.Spec.ServicesIpv4Cidr,
+		MasterIpv4Cidr:        in.Spec.MasterIpv4Cidr,
 		Network:               in.Spec.Network,
 		NodePools:             in.Spec.NodePools,
 		Subnetwork:            in.Spec.Subnetwork,
 	}
+	if in.Spec.NetworkRef != nil {
+		ref := *in.Spec.NetworkRef
+		out.Spec.NetworkRef = &ref
+	}
+	if in.Spec.SubnetworkRef != nil {
+		ref := *in.Spec.SubnetworkRef
+		out.Spec.SubnetworkRef = &ref
+	}
+	if in.Spec.ClusterIpv4CidrFrom != nil {
+		claim := *in.Spec.ClusterIpv4CidrFrom
+		out.Spec.ClusterIpv4CidrFrom = &claim
+	}
+	if in.Spec.ServicesIpv4CidrFrom != nil {
+		claim := *in.Spec.ServicesIpv4CidrFrom
+		out.Spec.ServicesIpv4CidrFrom = &claim
+	}
+	if in.Spec.MasterIpv4CidrFrom != nil {
+		claim := *in.Spec.MasterIpv4CidrFrom
+		out.Spec.MasterIpv4CidrFrom = &claim
+	}
 	out.Status = GCPKubernetesClusterStatus{
 		Phase: in.Status.Phase,
 	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
 }
 
 func (in *GCPKubernetesCluster) DeepCopyObject() runtime.Object {
@@ -54,9 +83,104 @@ func (in *GCPKubernetesClusterList) DeepCopyObject() runtime.Object {
 // ---------------------------------------------------
 func (in *GCPInstance) DeepCopyInto(out *GCPInstance) {
 	out.TypeMeta = in.TypeMeta
-	out.ObjectMeta = in.ObjectMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = GCPInstanceSpec{
-		Name: in.Spec.Name,
+		Name:            in.Spec.Name,
+		Zone:            in.Spec.Zone,
+		MachineType:     in.Spec.MachineType,
+		AllowDisruption: in.Spec.AllowDisruption,
+		SourceImage:     in.Spec.SourceImage,
+		Network:         in.Spec.Network,
+	}
+	if in.Spec.Metadata != nil {
+		out.Spec.Metadata = make([]MetadataItem, len(in.Spec.Metadata))
+		for i := range in.Spec.Metadata {
+			in.Spec.Metadata[i].DeepCopyInto(&out.Spec.Metadata[i])
+		}
+	}
+	if in.Spec.Disks != nil {
+		out.Spec.Disks = make([]AttachedDiskRef, len(in.Spec.Disks))
+		copy(out.Spec.Disks, in.Spec.Disks)
+	}
+	if in.Spec.SSH != nil {
+		out.Spec.SSH = &SSHAccess{}
+		in.Spec.SSH.DeepCopyInto(out.Spec.SSH)
+	}
+	if in.Spec.AddressRef != nil {
+		out.Spec.AddressRef = &ResourceRef{Name: in.Spec.AddressRef.Name}
+	}
+	if in.Spec.NetworkRef != nil {
+		ref := *in.Spec.NetworkRef
+		out.Spec.NetworkRef = &ref
+	}
+	if in.Spec.SubnetworkRef != nil {
+		ref := *in.Spec.SubnetworkRef
+		out.Spec.SubnetworkRef = &ref
+	}
+	out.Status = GCPInstanceStatus{
+		Phase:       in.Status.Phase,
+		InstanceID:  in.Status.InstanceID,
+		Zone:        in.Status.Zone,
+		MachineType: in.Status.MachineType,
+		CPUPlatform: in.Status.CPUPlatform,
+		InternalIP:  in.Status.InternalIP,
+		ExternalIP:  in.Status.ExternalIP,
+		OSLoginRole: in.Status.OSLoginRole,
+	}
+	if in.Status.CreationTimestamp != nil {
+		out.Status.CreationTimestamp = in.Status.CreationTimestamp.DeepCopy()
+	}
+	if in.Status.LastStartTime != nil {
+		out.Status.LastStartTime = in.Status.LastStartTime.DeepCopy()
+	}
+	if in.Status.LastStopTime != nil {
+		out.Status.LastStopTime = in.Status.LastStopTime.DeepCopy()
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+	if in.Status.AttachedDisks != nil {
+		out.Status.AttachedDisks = make([]string, len(in.Status.AttachedDisks))
+		copy(out.Status.AttachedDisks, in.Status.AttachedDisks)
+	}
+	out.Status.MetadataKeys = deepCopyStrings(in.Status.MetadataKeys)
+	out.Status.SSHKeys = deepCopyStrings(in.Status.SSHKeys)
+	out.Status.OSLoginPrincipals = deepCopyStrings(in.Status.OSLoginPrincipals)
+}
+
+func (in *SSHAccess) DeepCopyInto(out *SSHAccess) {
+	if in.Keys != nil {
+		out.Keys = make([]SSHKey, len(in.Keys))
+		for i := range in.Keys {
+			out.Keys[i].Username = in.Keys[i].Username
+			in.Keys[i].SecretKeyRef.DeepCopyInto(&out.Keys[i].SecretKeyRef)
+		}
+	}
+	if in.OSLogin != nil {
+		out.OSLogin = &OSLogin{
+			Admin: in.OSLogin.Admin,
+		}
+		if in.OSLogin.Principals != nil {
+			out.OSLogin.Principals = make([]string, len(in.OSLogin.Principals))
+			copy(out.OSLogin.Principals, in.OSLogin.Principals)
+		}
+	}
+}
+
+func (in *MetadataItem) DeepCopyInto(out *MetadataItem) {
+	out.Key = in.Key
+	out.Value = in.Value
+	if in.ValueFrom != nil {
+		out.ValueFrom = &MetadataValueSource{}
+		if in.ValueFrom.ConfigMapKeyRef != nil {
+			out.ValueFrom.ConfigMapKeyRef = in.ValueFrom.ConfigMapKeyRef.DeepCopy()
+		}
+		if in.ValueFrom.SecretKeyRef != nil {
+			out.ValueFrom.SecretKeyRef = in.ValueFrom.SecretKeyRef.DeepCopy()
+		}
 	}
 }
 
@@ -87,10 +211,42 @@ func (in *GCPInstanceList) DeepCopyObject() runtime.Object {
 // ---------------------------------------------------
 func (in *GCPNetwork) DeepCopyInto(out *GCPNetwork) {
 	out.TypeMeta = in.TypeMeta
-	out.ObjectMeta = in.ObjectMeta
-	out.Spec = GCPNetworkSpec{
-		Name:                  in.Spec.Name,
-		AutoCreateSubnetworks: in.Spec.AutoCreateSubnetworks,
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	if in.Spec.InternalIPv6 != nil {
+		ipv6 := *in.Spec.InternalIPv6
+		out.Spec.InternalIPv6 = &ipv6
+	}
+	if in.Spec.Peerings != nil {
+		out.Spec.Peerings = make([]NetworkPeering, len(in.Spec.Peerings))
+		for i := range in.Spec.Peerings {
+			out.Spec.Peerings[i] = in.Spec.Peerings[i]
+			if in.Spec.Peerings[i].PeerNetworkRef != nil {
+				ref := *in.Spec.Peerings[i].PeerNetworkRef
+				out.Spec.Peerings[i].PeerNetworkRef = &ref
+			}
+		}
+	}
+	out.Status = GCPNetworkStatus{
+		Phase:             in.Status.Phase,
+		SelfLink:          in.Status.SelfLink,
+		GatewayIPv4:       in.Status.GatewayIPv4,
+		MTU:               in.Status.MTU,
+		InternalIPv6Range: in.Status.InternalIPv6Range,
+	}
+	if in.Status.Subnetworks != nil {
+		out.Status.Subnetworks = make([]string, len(in.Status.Subnetworks))
+		copy(out.Status.Subnetworks, in.Status.Subnetworks)
+	}
+	if in.Status.Peerings != nil {
+		out.Status.Peerings = make([]NetworkPeeringStatus, len(in.Status.Peerings))
+		copy(out.Status.Peerings, in.Status.Peerings)
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
 	}
 }
 
@@ -115,3 +271,1371 @@ func (in *GCPNetworkList) DeepCopyObject() runtime.Object {
 
 	return &out
 }
+
+// ---------------------------------------------------
+// GCPDisk
+// ---------------------------------------------------
+func (in *GCPDisk) DeepCopyInto(out *GCPDisk) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = GCPDiskSpec{
+		Name:           in.Spec.Name,
+		Zone:           in.Spec.Zone,
+		SizeGb:         in.Spec.SizeGb,
+		Type:           in.Spec.Type,
+		SourceImage:    in.Spec.SourceImage,
+		SourceSnapshot: in.Spec.SourceSnapshot,
+	}
+	if in.Spec.Labels != nil {
+		out.Spec.Labels = make(map[string]string, len(in.Spec.Labels))
+		for k, v := range in.Spec.Labels {
+			out.Spec.Labels[k] = v
+		}
+	}
+	out.Status = GCPDiskStatus{
+		Phase:    in.Status.Phase,
+		SelfLink: in.Status.SelfLink,
+		SizeGb:   in.Status.SizeGb,
+	}
+	if in.Status.Users != nil {
+		out.Status.Users = make([]string, len(in.Status.Users))
+		copy(out.Status.Users, in.Status.Users)
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPDisk) DeepCopyObject() runtime.Object {
+	out := GCPDisk{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPDiskList) DeepCopyObject() runtime.Object {
+	out := GCPDiskList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPDisk, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPSnapshot
+// ---------------------------------------------------
+func (in *GCPSnapshot) DeepCopyInto(out *GCPSnapshot) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = GCPSnapshotSpec{
+		Name: in.Spec.Name,
+	}
+	in.Spec.Source.DeepCopyInto(&out.Spec.Source)
+	if in.Spec.StorageLocations != nil {
+		out.Spec.StorageLocations = make([]string, len(in.Spec.StorageLocations))
+		copy(out.Spec.StorageLocations, in.Spec.StorageLocations)
+	}
+	if in.Spec.Labels != nil {
+		out.Spec.Labels = make(map[string]string, len(in.Spec.Labels))
+		for k, v := range in.Spec.Labels {
+			out.Spec.Labels[k] = v
+		}
+	}
+	out.Status = GCPSnapshotStatus{
+		Phase:        in.Status.Phase,
+		SelfLink:     in.Status.SelfLink,
+		SourceDisk:   in.Status.SourceDisk,
+		DiskSizeGb:   in.Status.DiskSizeGb,
+		StorageBytes: in.Status.StorageBytes,
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *SnapshotSource) DeepCopyInto(out *SnapshotSource) {
+	out.DiskRef = nil
+	out.InstanceRef = nil
+	if in.DiskRef != nil {
+		out.DiskRef = &ResourceRef{Name: in.DiskRef.Name}
+	}
+	if in.InstanceRef != nil {
+		out.InstanceRef = &ResourceRef{Name: in.InstanceRef.Name}
+	}
+}
+
+func (in *GCPSnapshot) DeepCopyObject() runtime.Object {
+	out := GCPSnapshot{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPSnapshotList) DeepCopyObject() runtime.Object {
+	out := GCPSnapshotList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPSnapshot, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPSnapshotSchedule
+// ---------------------------------------------------
+func (in *GCPSnapshotSchedule) DeepCopyInto(out *GCPSnapshotSchedule) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = GCPSnapshotScheduleSpec{
+		Schedule:       in.Spec.Schedule,
+		RetentionCount: in.Spec.RetentionCount,
+		Suspend:        in.Spec.Suspend,
+	}
+	in.Spec.Template.Source.DeepCopyInto(&out.Spec.Template.Source)
+	if in.Spec.Template.StorageLocations != nil {
+		out.Spec.Template.StorageLocations = make([]string, len(in.Spec.Template.StorageLocations))
+		copy(out.Spec.Template.StorageLocations, in.Spec.Template.StorageLocations)
+	}
+	if in.Spec.Template.Labels != nil {
+		out.Spec.Template.Labels = make(map[string]string, len(in.Spec.Template.Labels))
+		for k, v := range in.Spec.Template.Labels {
+			out.Spec.Template.Labels[k] = v
+		}
+	}
+	out.Status = GCPSnapshotScheduleStatus{}
+	if in.Status.LastScheduleTime != nil {
+		out.Status.LastScheduleTime = in.Status.LastScheduleTime.DeepCopy()
+	}
+	if in.Status.NextScheduleTime != nil {
+		out.Status.NextScheduleTime = in.Status.NextScheduleTime.DeepCopy()
+	}
+	if in.Status.Snapshots != nil {
+		out.Status.Snapshots = make([]string, len(in.Status.Snapshots))
+		copy(out.Status.Snapshots, in.Status.Snapshots)
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPSnapshotSchedule) DeepCopyObject() runtime.Object {
+	out := GCPSnapshotSchedule{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPSnapshotScheduleList) DeepCopyObject() runtime.Object {
+	out := GCPSnapshotScheduleList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPSnapshotSchedule, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPInstanceTemplate
+// ---------------------------------------------------
+func (in *GCPInstanceTemplate) DeepCopyInto(out *GCPInstanceTemplate) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = GCPInstanceTemplateSpec{
+		Name:        in.Spec.Name,
+		MachineType: in.Spec.MachineType,
+		SourceImage: in.Spec.SourceImage,
+		DiskSizeGb:  in.Spec.DiskSizeGb,
+		DiskType:    in.Spec.DiskType,
+		Network:     in.Spec.Network,
+	}
+	if in.Spec.Metadata != nil {
+		out.Spec.Metadata = make([]MetadataItem, len(in.Spec.Metadata))
+		for i := range in.Spec.Metadata {
+			in.Spec.Metadata[i].DeepCopyInto(&out.Spec.Metadata[i])
+		}
+	}
+	if in.Spec.Tags != nil {
+		out.Spec.Tags = make([]string, len(in.Spec.Tags))
+		copy(out.Spec.Tags, in.Spec.Tags)
+	}
+	if in.Spec.Labels != nil {
+		out.Spec.Labels = make(map[string]string, len(in.Spec.Labels))
+		for k, v := range in.Spec.Labels {
+			out.Spec.Labels[k] = v
+		}
+	}
+	out.Status = GCPInstanceTemplateStatus{
+		CurrentTemplate: in.Status.CurrentTemplate,
+		SelfLink:        in.Status.SelfLink,
+	}
+	if in.Status.Templates != nil {
+		out.Status.Templates = make([]string, len(in.Status.Templates))
+		copy(out.Status.Templates, in.Status.Templates)
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPInstanceTemplate) DeepCopyObject() runtime.Object {
+	out := GCPInstanceTemplate{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPInstanceTemplateList) DeepCopyObject() runtime.Object {
+	out := GCPInstanceTemplateList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPInstanceTemplate, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPManagedInstanceGroup
+// ---------------------------------------------------
+func (in *GCPManagedInstanceGroup) DeepCopyInto(out *GCPManagedInstanceGroup) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = GCPManagedInstanceGroupSpec{
+		Name:             in.Spec.Name,
+		Zone:             in.Spec.Zone,
+		BaseInstanceName: in.Spec.BaseInstanceName,
+		TemplateRef:      in.Spec.TemplateRef,
+		TargetSize:       in.Spec.TargetSize,
+	}
+	if in.Spec.AutoHealing != nil {
+		autoHealing := *in.Spec.AutoHealing
+		out.Spec.AutoHealing = &autoHealing
+	}
+	if in.Spec.UpdatePolicy != nil {
+		out.Spec.UpdatePolicy = &UpdatePolicy{
+			Type:              in.Spec.UpdatePolicy.Type,
+			MinimalAction:     in.Spec.UpdatePolicy.MinimalAction,
+			ReplacementMethod: in.Spec.UpdatePolicy.ReplacementMethod,
+		}
+		if in.Spec.UpdatePolicy.MaxSurge != nil {
+			maxSurge := *in.Spec.UpdatePolicy.MaxSurge
+			out.Spec.UpdatePolicy.MaxSurge = &maxSurge
+		}
+		if in.Spec.UpdatePolicy.MaxUnavailable != nil {
+			maxUnavailable := *in.Spec.UpdatePolicy.MaxUnavailable
+			out.Spec.UpdatePolicy.MaxUnavailable = &maxUnavailable
+		}
+	}
+	out.Status = GCPManagedInstanceGroupStatus{
+		CurrentTemplate: in.Status.CurrentTemplate,
+		TargetTemplate:  in.Status.TargetTemplate,
+		TargetSize:      in.Status.TargetSize,
+		Stable:          in.Status.Stable,
+		InstanceGroup:   in.Status.InstanceGroup,
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPManagedInstanceGroup) DeepCopyObject() runtime.Object {
+	out := GCPManagedInstanceGroup{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPManagedInstanceGroupList) DeepCopyObject() runtime.Object {
+	out := GCPManagedInstanceGroupList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPManagedInstanceGroup, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPAddress
+// ---------------------------------------------------
+func (in *GCPAddress) DeepCopyInto(out *GCPAddress) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = GCPAddressStatus{
+		Phase:    in.Status.Phase,
+		Address:  in.Status.Address,
+		SelfLink: in.Status.SelfLink,
+	}
+	if in.Status.Users != nil {
+		out.Status.Users = make([]string, len(in.Status.Users))
+		copy(out.Status.Users, in.Status.Users)
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPAddress) DeepCopyObject() runtime.Object {
+	out := GCPAddress{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPAddressList) DeepCopyObject() runtime.Object {
+	out := GCPAddressList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPAddress, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPSubnetwork
+// ---------------------------------------------------
+func (in *GCPSubnetwork) DeepCopyInto(out *GCPSubnetwork) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	if in.Spec.SecondaryRanges != nil {
+		out.Spec.SecondaryRanges = make([]SubnetworkSecondaryRange, len(in.Spec.SecondaryRanges))
+		copy(out.Spec.SecondaryRanges, in.Spec.SecondaryRanges)
+	}
+	if in.Spec.IPCidrRangeFrom != nil {
+		claim := *in.Spec.IPCidrRangeFrom
+		out.Spec.IPCidrRangeFrom = &claim
+	}
+	if in.Spec.FlowLogs != nil {
+		flowLogs := *in.Spec.FlowLogs
+		out.Spec.FlowLogs = &flowLogs
+	}
+	out.Status = GCPSubnetworkStatus{
+		Phase:          in.Status.Phase,
+		SelfLink:       in.Status.SelfLink,
+		GatewayAddress: in.Status.GatewayAddress,
+		IPCidrRange:    in.Status.IPCidrRange,
+	}
+	if in.Status.SecondaryRanges != nil {
+		out.Status.SecondaryRanges = make([]SubnetworkSecondaryRange, len(in.Status.SecondaryRanges))
+		copy(out.Status.SecondaryRanges, in.Status.SecondaryRanges)
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPSubnetwork) DeepCopyObject() runtime.Object {
+	out := GCPSubnetwork{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPSubnetworkList) DeepCopyObject() runtime.Object {
+	out := GCPSubnetworkList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPSubnetwork, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPFirewallRule
+// ---------------------------------------------------
+func (in *GCPFirewallRule) DeepCopyInto(out *GCPFirewallRule) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Spec.Allowed = deepCopyFirewallRuleProtocols(in.Spec.Allowed)
+	out.Spec.Denied = deepCopyFirewallRuleProtocols(in.Spec.Denied)
+	out.Spec.SourceRanges = deepCopyStrings(in.Spec.SourceRanges)
+	out.Spec.DestinationRanges = deepCopyStrings(in.Spec.DestinationRanges)
+	out.Spec.SourceTags = deepCopyStrings(in.Spec.SourceTags)
+	out.Spec.TargetTags = deepCopyStrings(in.Spec.TargetTags)
+	out.Spec.TargetServiceAccounts = deepCopyStrings(in.Spec.TargetServiceAccounts)
+	out.Status = GCPFirewallRuleStatus{
+		SelfLink: in.Status.SelfLink,
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPFirewallRule) DeepCopyObject() runtime.Object {
+	out := GCPFirewallRule{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPFirewallRuleList) DeepCopyObject() runtime.Object {
+	out := GCPFirewallRuleList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPFirewallRule, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPRouter
+// ---------------------------------------------------
+func (in *GCPRouter) DeepCopyInto(out *GCPRouter) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	if in.Spec.BGP != nil {
+		bgp := *in.Spec.BGP
+		out.Spec.BGP = &bgp
+	}
+	if in.Spec.NATs != nil {
+		out.Spec.NATs = make([]RouterNAT, len(in.Spec.NATs))
+		for i := range in.Spec.NATs {
+			nat := in.Spec.NATs[i]
+			if nat.AddressRefs != nil {
+				nat.AddressRefs = make([]ResourceRef, len(in.Spec.NATs[i].AddressRefs))
+				copy(nat.AddressRefs, in.Spec.NATs[i].AddressRefs)
+			}
+			if nat.Subnetworks != nil {
+				nat.Subnetworks = make([]NATSubnetwork, len(in.Spec.NATs[i].Subnetworks))
+				copy(nat.Subnetworks, in.Spec.NATs[i].Subnetworks)
+			}
+			if nat.Logging != nil {
+				logging := *in.Spec.NATs[i].Logging
+				nat.Logging = &logging
+			}
+			out.Spec.NATs[i] = nat
+		}
+	}
+	out.Status = GCPRouterStatus{
+		SelfLink: in.Status.SelfLink,
+		NATs:     deepCopyStrings(in.Status.NATs),
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPRouter) DeepCopyObject() runtime.Object {
+	out := GCPRouter{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPRouterList) DeepCopyObject() runtime.Object {
+	out := GCPRouterList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPRouter, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPRoute
+// ---------------------------------------------------
+func (in *GCPRoute) DeepCopyInto(out *GCPRoute) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Spec.Tags = deepCopyStrings(in.Spec.Tags)
+	if in.Spec.NextHop.InstanceRef != nil {
+		ref := *in.Spec.NextHop.InstanceRef
+		out.Spec.NextHop.InstanceRef = &ref
+	}
+	out.Status = GCPRouteStatus{
+		SelfLink: in.Status.SelfLink,
+		NextHop:  in.Status.NextHop,
+		Warnings: deepCopyStrings(in.Status.Warnings),
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPRoute) DeepCopyObject() runtime.Object {
+	out := GCPRoute{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPRouteList) DeepCopyObject() runtime.Object {
+	out := GCPRouteList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPRoute, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// IPPool
+// ---------------------------------------------------
+func (in *IPPool) DeepCopyInto(out *IPPool) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = IPPoolSpec{
+		CIDRs:    deepCopyStrings(in.Spec.CIDRs),
+		Reserved: deepCopyStrings(in.Spec.Reserved),
+	}
+	out.Status = IPPoolStatus{}
+	if in.Status.Allocations != nil {
+		out.Status.Allocations = make([]IPPoolAllocation, len(in.Status.Allocations))
+		copy(out.Status.Allocations, in.Status.Allocations)
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *IPPool) DeepCopyObject() runtime.Object {
+	out := IPPool{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *IPPoolList) DeepCopyObject() runtime.Object {
+	out := IPPoolList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]IPPool, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPDNSZone
+// ---------------------------------------------------
+func (in *GCPDNSZone) DeepCopyInto(out *GCPDNSZone) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	if in.Spec.NetworkRefs != nil {
+		out.Spec.NetworkRefs = make([]NamespacedResourceRef, len(in.Spec.NetworkRefs))
+		copy(out.Spec.NetworkRefs, in.Spec.NetworkRefs)
+	}
+	out.Status = GCPDNSZoneStatus{
+		ID:          in.Status.ID,
+		NameServers: deepCopyStrings(in.Status.NameServers),
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPDNSZone) DeepCopyObject() runtime.Object {
+	out := GCPDNSZone{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPDNSZoneList) DeepCopyObject() runtime.Object {
+	out := GCPDNSZoneList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPDNSZone, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPDNSRecordSet
+// ---------------------------------------------------
+func (in *GCPDNSRecordSet) DeepCopyInto(out *GCPDNSRecordSet) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Spec.Rrdatas = deepCopyStrings(in.Spec.Rrdatas)
+	if in.Spec.RrdatasFrom != nil {
+		out.Spec.RrdatasFrom = make([]RecordSetSource, len(in.Spec.RrdatasFrom))
+		for i, source := range in.Spec.RrdatasFrom {
+			out.Spec.RrdatasFrom[i] = RecordSetSource{AddressType: source.AddressType}
+			if source.InstanceRef != nil {
+				ref := *source.InstanceRef
+				out.Spec.RrdatasFrom[i].InstanceRef = &ref
+			}
+			if source.AddressRef != nil {
+				ref := *source.AddressRef
+				out.Spec.RrdatasFrom[i].AddressRef = &ref
+			}
+		}
+	}
+	out.Status = GCPDNSRecordSetStatus{
+		Rrdatas: deepCopyStrings(in.Status.Rrdatas),
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPDNSRecordSet) DeepCopyObject() runtime.Object {
+	out := GCPDNSRecordSet{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPDNSRecordSetList) DeepCopyObject() runtime.Object {
+	out := GCPDNSRecordSetList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPDNSRecordSet, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPStorageBucket
+// ---------------------------------------------------
+func (in *GCPStorageBucket) DeepCopyInto(out *GCPStorageBucket) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	if in.Spec.LifecycleRules != nil {
+		out.Spec.LifecycleRules = make([]BucketLifecycleRule, len(in.Spec.LifecycleRules))
+		for i, rule := range in.Spec.LifecycleRules {
+			out.Spec.LifecycleRules[i] = rule
+			if rule.Condition.Age != nil {
+				age := *rule.Condition.Age
+				out.Spec.LifecycleRules[i].Condition.Age = &age
+			}
+			if rule.Condition.IsLive != nil {
+				isLive := *rule.Condition.IsLive
+				out.Spec.LifecycleRules[i].Condition.IsLive = &isLive
+			}
+			out.Spec.LifecycleRules[i].Condition.MatchesStorageClass = deepCopyStrings(rule.Condition.MatchesStorageClass)
+			out.Spec.LifecycleRules[i].Condition.MatchesPrefix = deepCopyStrings(rule.Condition.MatchesPrefix)
+			out.Spec.LifecycleRules[i].Condition.MatchesSuffix = deepCopyStrings(rule.Condition.MatchesSuffix)
+		}
+	}
+	if in.Spec.RetentionPolicy != nil {
+		policy := *in.Spec.RetentionPolicy
+		out.Spec.RetentionPolicy = &policy
+	}
+	if in.Spec.Labels != nil {
+		out.Spec.Labels = make(map[string]string, len(in.Spec.Labels))
+		for k, v := range in.Spec.Labels {
+			out.Spec.Labels[k] = v
+		}
+	}
+	if in.Spec.IAMBindings != nil {
+		out.Spec.IAMBindings = make([]BucketIAMBinding, len(in.Spec.IAMBindings))
+		for i, binding := range in.Spec.IAMBindings {
+			out.Spec.IAMBindings[i] = BucketIAMBinding{
+				Role:    binding.Role,
+				Members: deepCopyStrings(binding.Members),
+			}
+		}
+	}
+	out.Status = GCPStorageBucketStatus{
+		SelfLink: in.Status.SelfLink,
+		URL:      in.Status.URL,
+	}
+	if in.Status.IAMBindings != nil {
+		out.Status.IAMBindings = make([]BucketIAMBinding, len(in.Status.IAMBindings))
+		for i, binding := range in.Status.IAMBindings {
+			out.Status.IAMBindings[i] = BucketIAMBinding{
+				Role:    binding.Role,
+				Members: deepCopyStrings(binding.Members),
+			}
+		}
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPStorageBucket) DeepCopyObject() runtime.Object {
+	out := GCPStorageBucket{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPStorageBucketList) DeepCopyObject() runtime.Object {
+	out := GCPStorageBucketList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPStorageBucket, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPSQLInstance
+// ---------------------------------------------------
+func (in *GCPSQLInstance) DeepCopyInto(out *GCPSQLInstance) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	if in.Spec.Backup != nil {
+		backup := *in.Spec.Backup
+		out.Spec.Backup = &backup
+	}
+	if in.Spec.NetworkRef != nil {
+		ref := *in.Spec.NetworkRef
+		out.Spec.NetworkRef = &ref
+	}
+	if in.Spec.Flags != nil {
+		out.Spec.Flags = make([]SQLInstanceFlag, len(in.Spec.Flags))
+		copy(out.Spec.Flags, in.Spec.Flags)
+	}
+	out.Status = GCPSQLInstanceStatus{
+		Phase:          in.Status.Phase,
+		SelfLink:       in.Status.SelfLink,
+		ConnectionName: in.Status.ConnectionName,
+		PrivateIP:      in.Status.PrivateIP,
+		PublicIP:       in.Status.PublicIP,
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPSQLInstance) DeepCopyObject() runtime.Object {
+	out := GCPSQLInstance{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPSQLInstanceList) DeepCopyObject() runtime.Object {
+	out := GCPSQLInstanceList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPSQLInstance, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPSQLDatabase
+// ---------------------------------------------------
+func (in *GCPSQLDatabase) DeepCopyInto(out *GCPSQLDatabase) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = GCPSQLDatabaseStatus{
+		SelfLink: in.Status.SelfLink,
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPSQLDatabase) DeepCopyObject() runtime.Object {
+	out := GCPSQLDatabase{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPSQLDatabaseList) DeepCopyObject() runtime.Object {
+	out := GCPSQLDatabaseList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPSQLDatabase, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPSQLUser
+// ---------------------------------------------------
+func (in *GCPSQLUser) DeepCopyInto(out *GCPSQLUser) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = GCPSQLUserStatus{
+		SecretName:            in.Status.SecretName,
+		SecretResourceVersion: in.Status.SecretResourceVersion,
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPSQLUser) DeepCopyObject() runtime.Object {
+	out := GCPSQLUser{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPSQLUserList) DeepCopyObject() runtime.Object {
+	out := GCPSQLUserList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPSQLUser, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPPubSubTopic
+// ---------------------------------------------------
+func (in *GCPPubSubTopic) DeepCopyInto(out *GCPPubSubTopic) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	if in.Spec.Labels != nil {
+		out.Spec.Labels = make(map[string]string, len(in.Spec.Labels))
+		for k, v := range in.Spec.Labels {
+			out.Spec.Labels[k] = v
+		}
+	}
+	out.Status = GCPPubSubTopicStatus{
+		Path: in.Status.Path,
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPPubSubTopic) DeepCopyObject() runtime.Object {
+	out := GCPPubSubTopic{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPPubSubTopicList) DeepCopyObject() runtime.Object {
+	out := GCPPubSubTopicList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPPubSubTopic, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPPubSubSubscription
+// ---------------------------------------------------
+func (in *GCPPubSubSubscription) DeepCopyInto(out *GCPPubSubSubscription) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	if in.Spec.DeadLetterPolicy != nil {
+		policy := *in.Spec.DeadLetterPolicy
+		out.Spec.DeadLetterPolicy = &policy
+	}
+	if in.Spec.RetryPolicy != nil {
+		policy := *in.Spec.RetryPolicy
+		out.Spec.RetryPolicy = &policy
+	}
+	if in.Spec.PushConfig != nil {
+		pushConfig := *in.Spec.PushConfig
+		if in.Spec.PushConfig.Attributes != nil {
+			pushConfig.Attributes = make(map[string]string, len(in.Spec.PushConfig.Attributes))
+			for k, v := range in.Spec.PushConfig.Attributes {
+				pushConfig.Attributes[k] = v
+			}
+		}
+		if in.Spec.PushConfig.OIDCToken != nil {
+			token := *in.Spec.PushConfig.OIDCToken
+			pushConfig.OIDCToken = &token
+		}
+		out.Spec.PushConfig = &pushConfig
+	}
+	if in.Spec.Labels != nil {
+		out.Spec.Labels = make(map[string]string, len(in.Spec.Labels))
+		for k, v := range in.Spec.Labels {
+			out.Spec.Labels[k] = v
+		}
+	}
+	out.Status = GCPPubSubSubscriptionStatus{
+		Path:  in.Status.Path,
+		State: in.Status.State,
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPPubSubSubscription) DeepCopyObject() runtime.Object {
+	out := GCPPubSubSubscription{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPPubSubSubscriptionList) DeepCopyObject() runtime.Object {
+	out := GCPPubSubSubscriptionList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPPubSubSubscription, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPServiceAccount
+// ---------------------------------------------------
+func (in *GCPServiceAccount) DeepCopyInto(out *GCPServiceAccount) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = GCPServiceAccountStatus{
+		Email:    in.Status.Email,
+		UniqueID: in.Status.UniqueID,
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPServiceAccount) DeepCopyObject() runtime.Object {
+	out := GCPServiceAccount{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPServiceAccountList) DeepCopyObject() runtime.Object {
+	out := GCPServiceAccountList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPServiceAccount, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPServiceAccountKey
+// ---------------------------------------------------
+func (in *GCPServiceAccountKey) DeepCopyInto(out *GCPServiceAccountKey) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = GCPServiceAccountKeyStatus{
+		KeyID:      in.Status.KeyID,
+		SecretName: in.Status.SecretName,
+	}
+	if in.Status.KeyCreationTime != nil {
+		out.Status.KeyCreationTime = in.Status.KeyCreationTime.DeepCopy()
+	}
+	if in.Status.NextRotationTime != nil {
+		out.Status.NextRotationTime = in.Status.NextRotationTime.DeepCopy()
+	}
+	if in.Status.RetiredKeys != nil {
+		out.Status.RetiredKeys = make([]RetiredServiceAccountKey, len(in.Status.RetiredKeys))
+		for i, key := range in.Status.RetiredKeys {
+			out.Status.RetiredKeys[i] = RetiredServiceAccountKey{
+				KeyID:       key.KeyID,
+				RetiredTime: *key.RetiredTime.DeepCopy(),
+			}
+		}
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPServiceAccountKey) DeepCopyObject() runtime.Object {
+	out := GCPServiceAccountKey{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPServiceAccountKeyList) DeepCopyObject() runtime.Object {
+	out := GCPServiceAccountKeyList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPServiceAccountKey, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPIAMPolicyMember
+// ---------------------------------------------------
+func (in *GCPIAMPolicyMember) DeepCopyInto(out *GCPIAMPolicyMember) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	if in.Spec.ServiceAccountRef != nil {
+		ref := *in.Spec.ServiceAccountRef
+		out.Spec.ServiceAccountRef = &ref
+	}
+	if in.Spec.Condition != nil {
+		condition := *in.Spec.Condition
+		out.Spec.Condition = &condition
+	}
+	out.Status = GCPIAMPolicyMemberStatus{
+		Resource: in.Status.Resource,
+		Member:   in.Status.Member,
+		Granted:  in.Status.Granted,
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPIAMPolicyMember) DeepCopyObject() runtime.Object {
+	out := GCPIAMPolicyMember{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPIAMPolicyMemberList) DeepCopyObject() runtime.Object {
+	out := GCPIAMPolicyMemberList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPIAMPolicyMember, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPArtifactRepository
+// ---------------------------------------------------
+func (in *GCPArtifactRepository) DeepCopyInto(out *GCPArtifactRepository) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	if in.Spec.CleanupPolicies != nil {
+		out.Spec.CleanupPolicies = make([]RepositoryCleanupPolicy, len(in.Spec.CleanupPolicies))
+		for i, policy := range in.Spec.CleanupPolicies {
+			out.Spec.CleanupPolicies[i] = policy
+			if policy.Condition != nil {
+				condition := *policy.Condition
+				condition.TagPrefixes = deepCopyStrings(policy.Condition.TagPrefixes)
+				condition.VersionNamePrefixes = deepCopyStrings(policy.Condition.VersionNamePrefixes)
+				condition.PackageNamePrefixes = deepCopyStrings(policy.Condition.PackageNamePrefixes)
+				if policy.Condition.OlderThan != nil {
+					olderThan := *policy.Condition.OlderThan
+					condition.OlderThan = &olderThan
+				}
+				if policy.Condition.NewerThan != nil {
+					newerThan := *policy.Condition.NewerThan
+					condition.NewerThan = &newerThan
+				}
+				out.Spec.CleanupPolicies[i].Condition = &condition
+			}
+			if policy.MostRecentVersions != nil {
+				out.Spec.CleanupPolicies[i].MostRecentVersions = &RepositoryCleanupMostRecentVersions{
+					KeepCount:           policy.MostRecentVersions.KeepCount,
+					PackageNamePrefixes: deepCopyStrings(policy.MostRecentVersions.PackageNamePrefixes),
+				}
+			}
+		}
+	}
+	if in.Spec.Labels != nil {
+		out.Spec.Labels = make(map[string]string, len(in.Spec.Labels))
+		for k, v := range in.Spec.Labels {
+			out.Spec.Labels[k] = v
+		}
+	}
+	out.Spec.Readers = deepCopyStrings(in.Spec.Readers)
+	out.Spec.Writers = deepCopyStrings(in.Spec.Writers)
+	out.Status = GCPArtifactRepositoryStatus{
+		Path:    in.Status.Path,
+		URL:     in.Status.URL,
+		Readers: deepCopyStrings(in.Status.Readers),
+		Writers: deepCopyStrings(in.Status.Writers),
+	}
+}
+
+func (in *GCPArtifactRepository) DeepCopyObject() runtime.Object {
+	out := GCPArtifactRepository{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPArtifactRepositoryList) DeepCopyObject() runtime.Object {
+	out := GCPArtifactRepositoryList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPArtifactRepository, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+// ---------------------------------------------------
+// GCPRedisInstance
+// ---------------------------------------------------
+func (in *GCPRedisInstance) DeepCopyInto(out *GCPRedisInstance) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	if in.Spec.NetworkRef != nil {
+		ref := *in.Spec.NetworkRef
+		out.Spec.NetworkRef = &ref
+	}
+	if in.Spec.Labels != nil {
+		out.Spec.Labels = make(map[string]string, len(in.Spec.Labels))
+		for k, v := range in.Spec.Labels {
+			out.Spec.Labels[k] = v
+		}
+	}
+	out.Status = GCPRedisInstanceStatus{
+		State:      in.Status.State,
+		Host:       in.Status.Host,
+		Port:       in.Status.Port,
+		SecretName: in.Status.SecretName,
+	}
+	if in.Status.Conditions != nil {
+		out.Status.Conditions = make([]metav1.Condition, len(in.Status.Conditions))
+		for i := range in.Status.Conditions {
+			in.Status.Conditions[i].DeepCopyInto(&out.Status.Conditions[i])
+		}
+	}
+}
+
+func (in *GCPRedisInstance) DeepCopyObject() runtime.Object {
+	out := GCPRedisInstance{}
+	in.DeepCopyInto(&out)
+
+	return &out
+}
+
+func (in *GCPRedisInstanceList) DeepCopyObject() runtime.Object {
+	out := GCPRedisInstanceList{}
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+
+	if in.Items != nil {
+		out.Items = make([]GCPRedisInstance, len(in.Items))
+		for i := range in.Items {
+			in.Items[i].DeepCopyInto(&out.Items[i])
+		}
+	}
+
+	return &out
+}
+
+func deepCopyFirewallRuleProtocols(in []FirewallRuleProtocol) []FirewallRuleProtocol {
+	if in == nil {
+		return nil
+	}
+	out := make([]FirewallRuleProtocol, len(in))
+	for i := range in {
+		out[i] = FirewallRuleProtocol{
+			Protocol: in[i].Protocol,
+			Ports:    deepCopyStrings(in[i].Ports),
+		}
+	}
+	return out
+}
+
+func deepCopyStrings(in []string) []string {
+	if in == nil {
+		return nil
+	}
+	out := make([]string, len(in))
+	copy(out, in)
+	return out
+}
@@ -0,0 +1,107 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPAddressList contains a list of GCPAddress
+// +kubebuilder:object:root=true
+type GCPAddressList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPAddresses
+	Items []GCPAddress `json:"items"`
+}
+
+// GCPAddress is the Schema for the gcpaddresses API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpaddresses,shortName=ga,singular=gcpaddress
+// +kubebuilder:printcolumn:name="Address",type=string,JSONPath=".status.address"
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=".spec.addressType"
+// +kubebuilder:printcolumn:name="Region",type=string,JSONPath=".spec.region"
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=".status.phase"
+type GCPAddress struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPAddress
+	Spec GCPAddressSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPAddress
+	Status GCPAddressStatus `json:"status"`
+}
+
+// GCPAddressSpec defines the desired state of GCPAddress. Reserved addresses cannot be modified.
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="addresses are immutable"
+// +kubebuilder:validation:XValidation:rule="self.addressType == 'EXTERNAL' || has(self.region) || has(self.network)",message="global internal addresses require a network"
+type GCPAddressSpec struct {
+	// +kubebuilder:validation:Required
+	// Name is the name of the GCP address
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// Region of the address. Global addresses, e.g. for global load balancers, leave it empty.
+	Region string `json:"region,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=EXTERNAL;INTERNAL
+	// +kubebuilder:default=EXTERNAL
+	// AddressType is EXTERNAL for a public IP or INTERNAL for a private IP of a VPC
+	AddressType string `json:"addressType,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Address is the IP to reserve. If unset, GCP picks an available IP.
+	Address string `json:"address,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=PREMIUM;STANDARD
+	// NetworkTier of an external address. Defaults to PREMIUM.
+	NetworkTier string `json:"networkTier,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Subnetwork is the URL of the subnetwork of a regional internal address
+	Subnetwork string `json:"subnetwork,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Network is the URL of the network of a global internal address
+	Network string `json:"network,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Purpose of an internal address, e.g. GCE_ENDPOINT, VPC_PEERING or PRIVATE_SERVICE_CONNECT
+	Purpose string `json:"purpose,omitempty"`
+	// +kubebuilder:validation:Optional
+	// PrefixLength of an internal address range reserved for VPC_PEERING
+	PrefixLength int64 `json:"prefixLength,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Description of the address
+	Description string `json:"description,omitempty"`
+}
+
+type AddressStatus string
+
+const (
+	AddressStatusReserving AddressStatus = "RESERVING"
+	AddressStatusReserved  AddressStatus = "RESERVED"
+	AddressStatusInUse     AddressStatus = "IN_USE"
+)
+
+const (
+	// AddressConditionDeletionBlocked reports whether the deletion of the address waits for its users to release it
+	AddressConditionDeletionBlocked = "DeletionBlocked"
+)
+
+// GCPAddressStatus defines the observed state of GCPAddress
+type GCPAddressStatus struct {
+	// +kubebuilder:validation:Optional
+	// Phase is the current state of the GCP address
+	Phase AddressStatus `json:"phase,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Address is the reserved IP
+	Address string `json:"address,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SelfLink is the URL of the GCP address
+	SelfLink string `json:"selfLink,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Users are the URLs of the resources using the address
+	Users []string `json:"users,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the GCP address
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
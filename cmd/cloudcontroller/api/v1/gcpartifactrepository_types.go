@@ -0,0 +1,157 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPArtifactRepositoryList contains a list of GCPArtifactRepository
+// +kubebuilder:object:root=true
+type GCPArtifactRepositoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPArtifactRepositories
+	Items []GCPArtifactRepository `json:"items"`
+}
+
+// GCPArtifactRepository is the Schema for the gcpartifactrepositories API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpartifactrepositories,shortName=gar,singular=gcpartifactrepository
+// +kubebuilder:printcolumn:name="Format",type=string,JSONPath=".spec.format"
+// +kubebuilder:printcolumn:name="Location",type=string,JSONPath=".spec.location"
+// +kubebuilder:printcolumn:name="URL",type=string,JSONPath=".status.url"
+type GCPArtifactRepository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPArtifactRepository
+	Spec GCPArtifactRepositorySpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPArtifactRepository
+	Status GCPArtifactRepositoryStatus `json:"status"`
+}
+
+// GCPArtifactRepositorySpec defines the desired state of GCPArtifactRepository
+// +kubebuilder:validation:XValidation:rule="!has(self.immutableTags) || !self.immutableTags || self.format == 'DOCKER'",message="immutableTags is only supported by DOCKER repositories"
+type GCPArtifactRepositorySpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z]([a-z0-9-]{0,61}[a-z0-9])?$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	// Name of the repository in GCP
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	// Location of the repository, a region such as us-central1 or a multi-region such as europe
+	Location string `json:"location"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=DOCKER;MAVEN;NPM;PYTHON
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="format is immutable"
+	// Format of the packages stored in the repository
+	Format string `json:"format"`
+	// +kubebuilder:validation:Optional
+	// Description of the repository
+	Description string `json:"description,omitempty"`
+	// +kubebuilder:validation:Optional
+	// ImmutableTags prevents tags from being moved to another image or deleted
+	ImmutableTags bool `json:"immutableTags,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=map
+	// +listMapKey=id
+	// CleanupPolicies delete or keep package versions. Versions matching a Keep policy are never deleted.
+	CleanupPolicies []RepositoryCleanupPolicy `json:"cleanupPolicies,omitempty"`
+	// +kubebuilder:validation:Optional
+	// CleanupPolicyDryRun only reports the versions the cleanup policies would delete
+	CleanupPolicyDryRun bool `json:"cleanupPolicyDryRun,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Labels of the repository
+	Labels map[string]string `json:"labels,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="kmsKeyName is immutable"
+	// KMSKeyName is the Cloud KMS key encrypting the repository. Google-managed keys if unset.
+	KMSKeyName string `json:"kmsKeyName,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Readers are granted roles/artifactregistry.reader on the repository, e.g.
+	// serviceAccount:node@my-project.iam.gserviceaccount.com. Readers removed from the list are revoked, members granted
+	// outside of the repository are left untouched.
+	Readers []string `json:"readers,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Writers are granted roles/artifactregistry.writer on the repository, e.g.
+	// serviceAccount:ci@my-project.iam.gserviceaccount.com. Writers removed from the list are revoked, members granted
+	// outside of the repository are left untouched.
+	Writers []string `json:"writers,omitempty"`
+}
+
+// RepositoryCleanupPolicy deletes or keeps the package versions matching its condition or being among the most
+// recent versions
+// +kubebuilder:validation:XValidation:rule="has(self.condition) != has(self.mostRecentVersions)",message="exactly one of condition and mostRecentVersions must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.mostRecentVersions) || self.action == 'KEEP'",message="mostRecentVersions is only supported by KEEP policies"
+type RepositoryCleanupPolicy struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z0-9-]{1,128}$`
+	// ID of the policy
+	ID string `json:"id"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=DELETE;KEEP
+	// Action applied to the matching versions
+	Action string `json:"action"`
+	// +kubebuilder:validation:Optional
+	// Condition the versions must meet, all set fields must match
+	Condition *RepositoryCleanupCondition `json:"condition,omitempty"`
+	// +kubebuilder:validation:Optional
+	// MostRecentVersions matches the most recent versions of the packages
+	MostRecentVersions *RepositoryCleanupMostRecentVersions `json:"mostRecentVersions,omitempty"`
+}
+
+// RepositoryCleanupCondition selects package versions by tag, name and age
+type RepositoryCleanupCondition struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=TAGGED;UNTAGGED;ANY
+	// TagState of the versions
+	TagState string `json:"tagState,omitempty"`
+	// +kubebuilder:validation:Optional
+	// TagPrefixes match the versions with a tag starting with one of the prefixes
+	TagPrefixes []string `json:"tagPrefixes,omitempty"`
+	// +kubebuilder:validation:Optional
+	// VersionNamePrefixes match the versions with a name starting with one of the prefixes
+	VersionNamePrefixes []string `json:"versionNamePrefixes,omitempty"`
+	// +kubebuilder:validation:Optional
+	// PackageNamePrefixes match the versions of the packages with a name starting with one of the prefixes
+	PackageNamePrefixes []string `json:"packageNamePrefixes,omitempty"`
+	// +kubebuilder:validation:Optional
+	// OlderThan matches the versions uploaded longer ago than the duration, e.g. 720h
+	OlderThan *metav1.Duration `json:"olderThan,omitempty"`
+	// +kubebuilder:validation:Optional
+	// NewerThan matches the versions uploaded within the duration
+	NewerThan *metav1.Duration `json:"newerThan,omitempty"`
+}
+
+// RepositoryCleanupMostRecentVersions matches the most recent versions of the packages
+type RepositoryCleanupMostRecentVersions struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// KeepCount is the number of most recent versions kept per package
+	KeepCount int64 `json:"keepCount"`
+	// +kubebuilder:validation:Optional
+	// PackageNamePrefixes restrict the policy to the packages with a name starting with one of the prefixes
+	PackageNamePrefixes []string `json:"packageNamePrefixes,omitempty"`
+}
+
+// GCPArtifactRepositoryStatus defines the observed state of GCPArtifactRepository
+type GCPArtifactRepositoryStatus struct {
+	// +kubebuilder:validation:Optional
+	// Path is the resource name of the repository, e.g. projects/my-project/locations/us-central1/repositories/my-repo
+	Path string `json:"path,omitempty"`
+	// +kubebuilder:validation:Optional
+	// URL is the address packages are pushed to and pulled from, e.g. us-central1-docker.pkg.dev/my-project/my-repo
+	URL string `json:"url,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Readers are the members granted roles/artifactregistry.reader by the operator
+	Readers []string `json:"readers,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Writers are the members granted roles/artifactregistry.writer by the operator
+	Writers []string `json:"writers,omitempty"`
+}
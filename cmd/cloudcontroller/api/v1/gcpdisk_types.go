@@ -0,0 +1,97 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPDiskList contains a list of GCPDisk
+// +kubebuilder:object:root=true
+type GCPDiskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPDisks
+	Items []GCPDisk `json:"items"`
+}
+
+// GCPDisk is the Schema for the gcpdisks API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpdisks,shortName=gd,singular=gcpdisk
+// +kubebuilder:printcolumn:name="Size",type=integer,JSONPath=".status.sizeGb"
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=".status.phase"
+type GCPDisk struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPDisk
+	Spec GCPDiskSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPDisk
+	Status GCPDiskStatus `json:"status"`
+}
+
+// GCPDiskSpec defines the desired state of GCPDisk
+// +kubebuilder:validation:XValidation:rule="!(has(self.sourceImage) && has(self.sourceSnapshot))",message="sourceImage and sourceSnapshot are mutually exclusive"
+type GCPDiskSpec struct {
+	// +kubebuilder:validation:Required
+	// Name is the name of the GCP disk
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// Zone in which the GCP disk resides
+	Zone string `json:"zone"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:XValidation:rule="self >= oldSelf",message="sizeGb cannot be decreased"
+	// SizeGb is the size of the disk in GB. It can be increased while the disk is in use, but not decreased.
+	SizeGb int64 `json:"sizeGb"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=pd-balanced
+	// Type of the disk, e.g. pd-standard, pd-balanced or pd-ssd
+	Type string `json:"type,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SourceImage is the image used to initialize the disk, e.g. projects/debian-cloud/global/images/family/debian-12
+	SourceImage string `json:"sourceImage,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SourceSnapshot is the snapshot used to initialize the disk, e.g. global/snapshots/my-snapshot
+	SourceSnapshot string `json:"sourceSnapshot,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Labels applied to the disk
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+type DiskStatus string
+
+const (
+	DiskStatusCreating  DiskStatus = "CREATING"
+	DiskStatusRestoring DiskStatus = "RESTORING"
+	DiskStatusReady     DiskStatus = "READY"
+	DiskStatusFailed    DiskStatus = "FAILED"
+	DiskStatusDeleting  DiskStatus = "DELETING"
+)
+
+const (
+	// DiskConditionSizeSynced reports whether the disk has the size of the spec
+	DiskConditionSizeSynced = "SizeSynced"
+)
+
+// GCPDiskStatus defines the observed state of GCPDisk
+type GCPDiskStatus struct {
+	// +kubebuilder:validation:Optional
+	// Phase is the current state of the GCP disk
+	Phase DiskStatus `json:"phase,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SelfLink is the URL of the GCP disk
+	SelfLink string `json:"selfLink,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SizeGb is the current size of the disk in GB
+	SizeGb int64 `json:"sizeGb,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Users are the instances the disk is attached to
+	Users []string `json:"users,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the changes applied to the GCP disk
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
@@ -0,0 +1,102 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPDNSRecordSetList contains a list of GCPDNSRecordSet
+// +kubebuilder:object:root=true
+type GCPDNSRecordSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPDNSRecordSets
+	Items []GCPDNSRecordSet `json:"items"`
+}
+
+// GCPDNSRecordSet is the Schema for the gcpdnsrecordsets API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpdnsrecordsets,shortName=gdrs,singular=gcpdnsrecordset
+// +kubebuilder:printcolumn:name="Zone",type=string,JSONPath=".spec.zoneRef.name"
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=".spec.name"
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Data",type=string,JSONPath=".status.rrdatas"
+type GCPDNSRecordSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPDNSRecordSet
+	Spec GCPDNSRecordSetSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPDNSRecordSet
+	Status GCPDNSRecordSetStatus `json:"status"`
+}
+
+// GCPDNSRecordSetSpec defines the desired state of GCPDNSRecordSet
+// +kubebuilder:validation:XValidation:rule="has(self.rrdatas) != has(self.rrdatasFrom)",message="exactly one of rrdatas and rrdatasFrom is required"
+// +kubebuilder:validation:XValidation:rule="!has(self.rrdatasFrom) || self.type == 'A'",message="rrdatasFrom requires an A record set"
+type GCPDNSRecordSetSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="zoneRef is immutable"
+	// ZoneRef references the GCPDNSZone the record set belongs to
+	ZoneRef ResourceRef `json:"zoneRef"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	// +kubebuilder:validation:XValidation:rule="self.endsWith('.')",message="name must be fully qualified, ending with a dot"
+	// Name is the DNS name of the record set, e.g. www.example.com.
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=A;AAAA;CAA;CNAME;MX;NS;PTR;SRV;TXT
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	// Type of the record set
+	Type string `json:"type"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=300
+	// TTL is the number of seconds resolvers may cache the record set
+	TTL int64 `json:"ttl,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=1
+	// Rrdatas is the data of the record set, e.g. the IPs of an A record set
+	Rrdatas []string `json:"rrdatas,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=1
+	// RrdatasFrom takes the IPs of an A record set from other objects, the record set follows them when they change
+	RrdatasFrom []RecordSetSource `json:"rrdatasFrom,omitempty"`
+}
+
+// RecordSetSource references an object providing an IP of the record set
+// +kubebuilder:validation:XValidation:rule="has(self.instanceRef) != has(self.addressRef)",message="exactly one of instanceRef and addressRef is required"
+type RecordSetSource struct {
+	// +kubebuilder:validation:Optional
+	// InstanceRef references a GCPInstance whose IP is used
+	InstanceRef *ResourceRef `json:"instanceRef,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=INTERNAL;EXTERNAL
+	// +kubebuilder:default=INTERNAL
+	// AddressType selects the INTERNAL or EXTERNAL IP of the instance
+	AddressType string `json:"addressType,omitempty"`
+	// +kubebuilder:validation:Optional
+	// AddressRef references a GCPAddress whose reserved IP is used
+	AddressRef *ResourceRef `json:"addressRef,omitempty"`
+}
+
+const (
+	// RecordSetConditionZoneReady reports whether the referenced GCPDNSZone is ready
+	RecordSetConditionZoneReady = "ZoneReady"
+	// RecordSetConditionSourcesReady reports whether the objects of rrdatasFrom provide an IP
+	RecordSetConditionSourcesReady = "SourcesReady"
+)
+
+// GCPDNSRecordSetStatus defines the observed state of GCPDNSRecordSet
+type GCPDNSRecordSetStatus struct {
+	// +kubebuilder:validation:Optional
+	// Rrdatas is the data of the record set in Cloud DNS
+	Rrdatas []string `json:"rrdatas,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the record set
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
@@ -0,0 +1,87 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPDNSZoneList contains a list of GCPDNSZone
+// +kubebuilder:object:root=true
+type GCPDNSZoneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPDNSZones
+	Items []GCPDNSZone `json:"items"`
+}
+
+// GCPDNSZone is the Schema for the gcpdnszones API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpdnszones,shortName=gdz,singular=gcpdnszone
+// +kubebuilder:printcolumn:name="DNS Name",type=string,JSONPath=".spec.dnsName"
+// +kubebuilder:printcolumn:name="Visibility",type=string,JSONPath=".spec.visibility"
+// +kubebuilder:printcolumn:name="Name Servers",type=string,JSONPath=".status.nameServers"
+type GCPDNSZone struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPDNSZone
+	Spec GCPDNSZoneSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPDNSZone
+	Status GCPDNSZoneStatus `json:"status"`
+}
+
+// GCPDNSZoneSpec defines the desired state of GCPDNSZone
+// +kubebuilder:validation:XValidation:rule="self.visibility == 'private' || !has(self.networkRefs)",message="networkRefs are only allowed for private zones"
+type GCPDNSZoneSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	// Name is the name of the Cloud DNS managed zone
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="dnsName is immutable"
+	// +kubebuilder:validation:XValidation:rule="self.endsWith('.')",message="dnsName must be fully qualified, ending with a dot"
+	// DNSName is the DNS suffix served by the zone, e.g. example.com.
+	DNSName string `json:"dnsName"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=public;private
+	// +kubebuilder:default=public
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="visibility is immutable"
+	// Visibility is public for a zone served on the internet or private for a zone only resolvable from its networks
+	Visibility string `json:"visibility,omitempty"`
+	// +kubebuilder:validation:Optional
+	// NetworkRefs references the GCPNetworks a private zone is visible from
+	NetworkRefs []NamespacedResourceRef `json:"networkRefs,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="Managed by cloudcontroller"
+	// Description of the zone
+	Description string `json:"description,omitempty"`
+}
+
+const (
+	DNSZoneVisibilityPublic  = "public"
+	DNSZoneVisibilityPrivate = "private"
+)
+
+const (
+	// DNSZoneConditionNetworksReady reports whether the GCPNetworks the private zone is visible from are ready
+	DNSZoneConditionNetworksReady = "NetworksReady"
+	// DNSZoneConditionDeletionBlocked reports whether the deletion of the zone waits for its record sets to be deleted
+	DNSZoneConditionDeletionBlocked = "DeletionBlocked"
+)
+
+// GCPDNSZoneStatus defines the observed state of GCPDNSZone
+type GCPDNSZoneStatus struct {
+	// +kubebuilder:validation:Optional
+	// ID is the identifier of the managed zone assigned by GCP
+	ID string `json:"id,omitempty"`
+	// +kubebuilder:validation:Optional
+	// NameServers are the name servers to delegate the DNS name of a public zone to
+	NameServers []string `json:"nameServers,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the managed zone
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
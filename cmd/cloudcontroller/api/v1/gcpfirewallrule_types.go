@@ -0,0 +1,121 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPFirewallRuleList contains a list of GCPFirewallRule
+// +kubebuilder:object:root=true
+type GCPFirewallRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPFirewallRules
+	Items []GCPFirewallRule `json:"items"`
+}
+
+// GCPFirewallRule is the Schema for the gcpfirewallrules API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpfirewallrules,shortName=gfw,singular=gcpfirewallrule
+// +kubebuilder:printcolumn:name="Network",type=string,JSONPath=".spec.networkRef.name"
+// +kubebuilder:printcolumn:name="Direction",type=string,JSONPath=".spec.direction"
+// +kubebuilder:printcolumn:name="Priority",type=integer,JSONPath=".spec.priority"
+// +kubebuilder:printcolumn:name="Disabled",type=boolean,JSONPath=".spec.disabled"
+type GCPFirewallRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPFirewallRule
+	Spec GCPFirewallRuleSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPFirewallRule
+	Status GCPFirewallRuleStatus `json:"status"`
+}
+
+// GCPFirewallRuleSpec defines the desired state of GCPFirewallRule
+// +kubebuilder:validation:XValidation:rule="has(self.allowed) != has(self.denied)",message="exactly one of allowed or denied is required"
+// +kubebuilder:validation:XValidation:rule="self.direction == 'INGRESS' || (!has(self.sourceRanges) && !has(self.sourceTags))",message="egress rules cannot have sources"
+// +kubebuilder:validation:XValidation:rule="self.direction == 'EGRESS' || !has(self.destinationRanges)",message="ingress rules cannot have destination ranges"
+// +kubebuilder:validation:XValidation:rule="!has(self.targetTags) || !has(self.targetServiceAccounts)",message="targetTags and targetServiceAccounts are mutually exclusive"
+type GCPFirewallRuleSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	// Name is the name of the GCP firewall rule
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="networkRef is immutable"
+	// NetworkRef references the GCPNetwork the rule applies to
+	NetworkRef ResourceRef `json:"networkRef"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=INGRESS;EGRESS
+	// +kubebuilder:default=INGRESS
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="direction is immutable"
+	// Direction of the traffic the rule applies to
+	Direction string `json:"direction,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=1000
+	// Priority of the rule, lower values take precedence
+	Priority int64 `json:"priority,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=1
+	// Allowed are the protocols and ports the rule allows
+	Allowed []FirewallRuleProtocol `json:"allowed,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=1
+	// Denied are the protocols and ports the rule denies
+	Denied []FirewallRuleProtocol `json:"denied,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SourceRanges of an ingress rule. Ingress rules without source apply to 0.0.0.0/0.
+	SourceRanges []string `json:"sourceRanges,omitempty"`
+	// +kubebuilder:validation:Optional
+	// DestinationRanges of an egress rule. Egress rules without destination apply to 0.0.0.0/0.
+	DestinationRanges []string `json:"destinationRanges,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SourceTags are the network tags of the instances the ingress traffic originates from
+	SourceTags []string `json:"sourceTags,omitempty"`
+	// +kubebuilder:validation:Optional
+	// TargetTags are the network tags of the instances the rule applies to. All instances if unset.
+	TargetTags []string `json:"targetTags,omitempty"`
+	// +kubebuilder:validation:Optional
+	// TargetServiceAccounts are the service accounts of the instances the rule applies to
+	TargetServiceAccounts []string `json:"targetServiceAccounts,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Logging enables the firewall rules logging
+	Logging bool `json:"logging,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Disabled keeps the rule without enforcing it
+	Disabled bool `json:"disabled,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Description of the rule
+	Description string `json:"description,omitempty"`
+}
+
+// FirewallRuleProtocol is a protocol and its ports matched by the rule
+type FirewallRuleProtocol struct {
+	// +kubebuilder:validation:Required
+	// Protocol is a protocol name, e.g. tcp, udp, icmp or all, or an IP protocol number
+	Protocol string `json:"protocol"`
+	// +kubebuilder:validation:Optional
+	// Ports are ports or port ranges, e.g. 443 or 8000-8080, of tcp, udp and sctp rules. All ports if unset.
+	Ports []string `json:"ports,omitempty"`
+}
+
+const (
+	// FirewallRuleConditionNetworkReady reports whether the referenced GCPNetwork is ready
+	FirewallRuleConditionNetworkReady = "NetworkReady"
+)
+
+// GCPFirewallRuleStatus defines the observed state of GCPFirewallRule
+type GCPFirewallRuleStatus struct {
+	// +kubebuilder:validation:Optional
+	// SelfLink is the URL of the GCP firewall rule
+	SelfLink string `json:"selfLink,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the GCP firewall rule
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
@@ -0,0 +1,115 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPIAMPolicyMemberList contains a list of GCPIAMPolicyMember
+// +kubebuilder:object:root=true
+type GCPIAMPolicyMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPIAMPolicyMembers
+	Items []GCPIAMPolicyMember `json:"items"`
+}
+
+// GCPIAMPolicyMember is the Schema for the gcpiampolicymembers API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpiampolicymembers,shortName=gipm,singular=gcpiampolicymember
+// +kubebuilder:printcolumn:name="Resource",type=string,JSONPath=".status.resource"
+// +kubebuilder:printcolumn:name="Role",type=string,JSONPath=".spec.role"
+// +kubebuilder:printcolumn:name="Member",type=string,JSONPath=".status.member"
+type GCPIAMPolicyMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+	// Spec defines the desired state of GCPIAMPolicyMember
+	Spec GCPIAMPolicyMemberSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPIAMPolicyMember
+	Status GCPIAMPolicyMemberStatus `json:"status"`
+}
+
+// GCPIAMPolicyMemberSpec defines the desired state of GCPIAMPolicyMember. The member is added to the binding of the
+// role and condition in the IAM policy of the resource, the other members and bindings of the policy are left
+// untouched. A member already bound, e.g. by the iamBindings of a GCPStorageBucket, is left bound on deletion. If
+// the bucket revokes it later, the GCPIAMPolicyMember grants it again and takes it over.
+// +kubebuilder:validation:XValidation:rule="has(self.member) != has(self.serviceAccountRef)",message="exactly one of member and serviceAccountRef must be set"
+type GCPIAMPolicyMemberSpec struct {
+	// +kubebuilder:validation:Required
+	// Resource the role is granted on
+	Resource IAMPolicyResource `json:"resource"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^(user|serviceAccount|group|domain|principal|principalSet):.+$|^(allUsers|allAuthenticatedUsers)$`
+	// Member granted the role, e.g. user:jane@example.com, group:team@example.com or
+	// serviceAccount:app@my-project.iam.gserviceaccount.com
+	Member string `json:"member,omitempty"`
+	// +kubebuilder:validation:Optional
+	// ServiceAccountRef grants the role to the referenced GCPServiceAccount
+	ServiceAccountRef *ResourceRef `json:"serviceAccountRef,omitempty"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^(roles|projects/[^/]+/roles|organizations/[^/]+/roles)/[a-zA-Z0-9_.]+$`
+	// Role granted, e.g. roles/storage.objectViewer or projects/my-project/roles/myRole
+	Role string `json:"role"`
+	// +kubebuilder:validation:Optional
+	// Condition restricts the grant, the member is bound to the role unconditionally if unset
+	Condition *IAMCondition `json:"condition,omitempty"`
+}
+
+const (
+	IAMPolicyResourceProject           = "Project"
+	IAMPolicyResourceGCPStorageBucket  = "GCPStorageBucket"
+	IAMPolicyResourceGCPServiceAccount = "GCPServiceAccount"
+	IAMPolicyResourceGCPPubSubTopic    = "GCPPubSubTopic"
+)
+
+// IAMPolicyResource references the resource holding the IAM policy
+// +kubebuilder:validation:XValidation:rule="self.kind == 'Project' ? !has(self.name) : has(self.name)",message="name must be set for every kind but Project"
+type IAMPolicyResource struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Project;GCPStorageBucket;GCPServiceAccount;GCPPubSubTopic
+	// Kind of the resource. Project is the project the operator manages, the other kinds are benzaiten.io objects.
+	Kind string `json:"kind"`
+	// +kubebuilder:validation:Optional
+	// Name of the referenced object in the namespace of the GCPIAMPolicyMember
+	Name string `json:"name,omitempty"`
+}
+
+// IAMCondition is a CEL expression the request must satisfy for the grant to apply
+type IAMCondition struct {
+	// +kubebuilder:validation:Required
+	// Title of the condition, conditions of the same role are told apart by title and expression
+	Title string `json:"title"`
+	// +kubebuilder:validation:Optional
+	// Description of the condition
+	Description string `json:"description,omitempty"`
+	// +kubebuilder:validation:Required
+	// Expression in CEL, e.g. request.time < timestamp("2025-01-01T00:00:00Z")
+	Expression string `json:"expression"`
+}
+
+const (
+	// IAMPolicyMemberConditionReferencesReady reports whether the referenced resource and service account exist
+	IAMPolicyMemberConditionReferencesReady = "ReferencesReady"
+)
+
+// GCPIAMPolicyMemberStatus defines the observed state of GCPIAMPolicyMember
+type GCPIAMPolicyMemberStatus struct {
+	// +kubebuilder:validation:Optional
+	// Resource is the resource name of the resource holding the policy, e.g. projects/my-project
+	Resource string `json:"resource,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Member bound to the role
+	Member string `json:"member,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Granted reports whether the member was bound by the GCPIAMPolicyMember, only then it is removed on deletion
+	Granted bool `json:"granted,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the binding
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
@@ -4,6 +4,7 @@
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -23,6 +24,10 @@ type GCPInstanceList struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Namespaced,path=gcpinstances,shortName=gi,singular=gcpinstance
 // +kubebuilder:printcolumn:name="Status",type=string,JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Internal IP",type=string,JSONPath=".status.internalIP"
+// +kubebuilder:printcolumn:name="External IP",type=string,JSONPath=".status.externalIP"
+// +kubebuilder:printcolumn:name="Machine Type",type=string,JSONPath=".status.machineType",priority=1
+// +kubebuilder:printcolumn:name="Zone",type=string,JSONPath=".status.zone",priority=1
 type GCPInstance struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -36,15 +41,195 @@ type GCPInstance struct {
 }
 
 // GCPInstanceSpec defines the desired state of GCPInstance
+// +kubebuilder:validation:XValidation:rule="!(has(self.network) && has(self.networkRef))",message="network and networkRef are mutually exclusive"
 type GCPInstanceSpec struct {
 	// +kubebuilder:validation:Required
 	// Name is the name of the GCP instance
 	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// Zone in which the GCP instance resides
+	Zone string `json:"zone"`
+	// +kubebuilder:validation:Required
+	// MachineType is the name of the Google Compute Engine machine type, e.g. e2-medium.
+	// Changing it resizes the instance, which requires AllowDisruption.
+	MachineType string `json:"machineType"`
+	// +kubebuilder:validation:Optional
+	// AllowDisruption permits the controller to stop and restart the instance to apply changes, e.g. a machine type resize
+	AllowDisruption bool `json:"allowDisruption,omitempty"`
+	// +kubebuilder:validation:Required
+	// SourceImage is the image used to initialize the boot disk, e.g. projects/debian-cloud/global/images/family/debian-12
+	SourceImage string `json:"sourceImage"`
+	// +kubebuilder:validation:Optional
+	// Network is the name or self link of the network the instance is connected to. Defaults to the default network.
+	Network string `json:"network,omitempty"`
+	// +kubebuilder:validation:Optional
+	// NetworkRef references the GCPNetwork the instance is connected to. The instance waits for it to be ready.
+	NetworkRef *NamespacedResourceRef `json:"networkRef,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SubnetworkRef references the GCPSubnetwork the instance is connected to. It must reside in the region of the
+	// instance. The instance waits for it to be ready.
+	SubnetworkRef *NamespacedResourceRef `json:"subnetworkRef,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Metadata is the list of metadata entries set on the instance, e.g. startup-script
+	Metadata []MetadataItem `json:"metadata,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Disks is the list of GCPDisks attached to the instance in addition to its boot disk
+	Disks []AttachedDiskRef `json:"disks,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SSH defines who may log into the instance. If unset, the OS Login roles granted by the controller are revoked
+	// and the other SSH access is left untouched.
+	SSH *SSHAccess `json:"ssh,omitempty"`
+	// +kubebuilder:validation:Optional
+	// AddressRef references a GCPAddress in the region of the instance. An EXTERNAL address becomes the external IP,
+	// an INTERNAL address the internal IP of the instance. The address outlives the instance.
+	// Swapping the external IP of a running instance requires AllowDisruption.
+	AddressRef *ResourceRef `json:"addressRef,omitempty"`
+}
+
+// AttachedDiskRef defines a GCPDisk attached to the instance
+type AttachedDiskRef struct {
+	// +kubebuilder:validation:Required
+	// DiskRef references the GCPDisk to attach. The disk must reside in the zone of the instance.
+	DiskRef ResourceRef `json:"diskRef"`
+	// +kubebuilder:validation:Optional
+	// DeviceName exposed to the guest OS under /dev/disk/by-id/google-*. Defaults to the disk name.
+	DeviceName string `json:"deviceName,omitempty"`
+	// +kubebuilder:validation:Optional
+	// ReadOnly attaches the disk in read-only mode
+	ReadOnly bool `json:"readOnly,omitempty"`
+}
+
+// SSHAccess defines the SSH access to an instance, either with public keys or with OS Login
+// +kubebuilder:validation:XValidation:rule="!(has(self.keys) && has(self.osLogin))",message="keys and osLogin are mutually exclusive"
+type SSHAccess struct {
+	// +kubebuilder:validation:Optional
+	// Keys is the list of public keys merged into the ssh-keys metadata of the instance. The keys added outside the
+	// spec, e.g. by gcloud compute ssh, are kept.
+	Keys []SSHKey `json:"keys,omitempty"`
+	// +kubebuilder:validation:Optional
+	// OSLogin enables OS Login on the instance and grants login access to its principals
+	OSLogin *OSLogin `json:"osLogin,omitempty"`
+}
+
+// SSHKey defines the public keys of a user. Removing the Secret or its key revokes the access.
+type SSHKey struct {
+	// +kubebuilder:validation:Required
+	// Username is the user the keys log in as
+	Username string `json:"username"`
+	// +kubebuilder:validation:Required
+	// SecretKeyRef selects the Secret key holding the public keys, one per line
+	SecretKeyRef corev1.SecretKeySelector `json:"secretKeyRef"`
+}
+
+// OSLogin defines the principals allowed to log into the instance through OS Login
+type OSLogin struct {
+	// +kubebuilder:validation:Optional
+	// Principals granted login access, e.g. user:jane@example.com or group:devs@example.com
+	Principals []string `json:"principals,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Admin grants administrator access instead of standard user access
+	Admin bool `json:"admin,omitempty"`
+}
+
+// MetadataItem defines a single instance metadata entry
+type MetadataItem struct {
+	// +kubebuilder:validation:Required
+	// Key of the metadata entry
+	Key string `json:"key"`
+	// +kubebuilder:validation:Optional
+	// Value of the metadata entry. Ignored if ValueFrom is set.
+	Value string `json:"value,omitempty"`
+	// +kubebuilder:validation:Optional
+	// ValueFrom sources the value of the metadata entry from a ConfigMap or Secret key
+	ValueFrom *MetadataValueSource `json:"valueFrom,omitempty"`
+}
+
+// MetadataValueSource defines the source of a metadata value. Exactly one of its fields must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.configMapKeyRef) != has(self.secretKeyRef)",message="exactly one of configMapKeyRef or secretKeyRef must be set"
+type MetadataValueSource struct {
+	// +kubebuilder:validation:Optional
+	// ConfigMapKeyRef selects a key of a ConfigMap in the namespace of the GCPInstance
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SecretKeyRef selects a key of a Secret in the namespace of the GCPInstance
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
 }
 
 // GCPInstanceStatus defines the observed state of GCPInstance
 type GCPInstanceStatus struct {
 	// +kubebuilder:validation:Optional
 	// Phase is the current state of the GCP instance
-	Phase string `json:"phase"`
+	Phase InstanceStatus `json:"phase"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the changes applied to the GCP instance
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// +kubebuilder:validation:Optional
+	// AttachedDisks are the self links of the GCPDisks attached by the controller
+	AttachedDisks []string `json:"attachedDisks,omitempty"`
+	// +kubebuilder:validation:Optional
+	// MetadataKeys are the metadata keys set by the controller. Keys dropped from the spec are removed from the
+	// instance, keys set by other tooling are left untouched.
+	MetadataKeys []string `json:"metadataKeys,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SSHKeys are the lines of the ssh-keys metadata set by the controller. The other lines, e.g. added by gcloud
+	// compute ssh, are kept.
+	SSHKeys []string `json:"sshKeys,omitempty"`
+	// +kubebuilder:validation:Optional
+	// OSLoginRole is the OS Login role granted by the controller on the instance
+	OSLoginRole string `json:"osLoginRole,omitempty"`
+	// +kubebuilder:validation:Optional
+	// OSLoginPrincipals are the principals granted the OS Login role by the controller. Members granted the role
+	// outside the controller are left untouched.
+	OSLoginPrincipals []string `json:"osLoginPrincipals,omitempty"`
+	// +kubebuilder:validation:Optional
+	// InstanceID is the unique identifier of the GCP instance
+	InstanceID string `json:"instanceID,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Zone in which the GCP instance runs
+	Zone string `json:"zone,omitempty"`
+	// +kubebuilder:validation:Optional
+	// MachineType is the machine type the GCP instance runs with
+	MachineType string `json:"machineType,omitempty"`
+	// +kubebuilder:validation:Optional
+	// CPUPlatform is the CPU platform of the GCP instance, e.g. Intel Broadwell
+	CPUPlatform string `json:"cpuPlatform,omitempty"`
+	// +kubebuilder:validation:Optional
+	// InternalIP is the internal IP of the primary network interface
+	InternalIP string `json:"internalIP,omitempty"`
+	// +kubebuilder:validation:Optional
+	// ExternalIP is the external IP of the primary network interface, if any
+	ExternalIP string `json:"externalIP,omitempty"`
+	// +kubebuilder:validation:Optional
+	// CreationTimestamp is the time the GCP instance was created
+	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
+	// +kubebuilder:validation:Optional
+	// LastStartTime is the time the GCP instance was last started
+	LastStartTime *metav1.Time `json:"lastStartTime,omitempty"`
+	// +kubebuilder:validation:Optional
+	// LastStopTime is the time the GCP instance was last stopped
+	LastStopTime *metav1.Time `json:"lastStopTime,omitempty"`
 }
+
+const (
+	// InstanceConditionMachineTypeSynced reports whether the instance runs with the machine type of the spec
+	InstanceConditionMachineTypeSynced = "MachineTypeSynced"
+	// InstanceConditionDisksAttached reports whether the GCPDisks of the spec are attached to the instance
+	InstanceConditionDisksAttached = "DisksAttached"
+	// InstanceConditionAddressBound reports whether the instance uses the IP of the referenced GCPAddress
+	InstanceConditionAddressBound = "AddressBound"
+	// InstanceConditionReferencesResolved reports whether the referenced GCPNetwork and GCPSubnetwork are ready
+	InstanceConditionReferencesResolved = "ReferencesResolved"
+)
+
+type InstanceStatus string
+
+const (
+	InstanceStatusProvisioning InstanceStatus = "PROVISIONING"
+	InstanceStatusStaging      InstanceStatus = "STAGING"
+	InstanceStatusRunning      InstanceStatus = "RUNNING"
+	InstanceStatusStopping     InstanceStatus = "STOPPING"
+	InstanceStatusStopped      InstanceStatus = "STOPPED"
+	InstanceStatusSuspended    InstanceStatus = "SUSPENDED"
+	InstanceStatusTerminated   InstanceStatus = "TERMINATED"
+	InstanceStatusError        InstanceStatus = "ERROR"
+)
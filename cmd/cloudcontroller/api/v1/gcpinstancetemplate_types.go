@@ -0,0 +1,88 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPInstanceTemplateList contains a list of GCPInstanceTemplate
+// +kubebuilder:object:root=true
+type GCPInstanceTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPInstanceTemplates
+	Items []GCPInstanceTemplate `json:"items"`
+}
+
+// GCPInstanceTemplate is the Schema for the gcpinstancetemplates API.
+// GCP instance templates are immutable, every change of the spec creates a new template version.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpinstancetemplates,shortName=git,singular=gcpinstancetemplate
+// +kubebuilder:printcolumn:name="Template",type=string,JSONPath=".status.currentTemplate"
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+type GCPInstanceTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPInstanceTemplate
+	Spec GCPInstanceTemplateSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPInstanceTemplate
+	Status GCPInstanceTemplateStatus `json:"status"`
+}
+
+// GCPInstanceTemplateSpec defines the desired state of GCPInstanceTemplate
+type GCPInstanceTemplateSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=54
+	// Name is the name prefix of the GCP instance templates, each version is suffixed with a hash of its properties
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// MachineType is the name of the Google Compute Engine machine type, e.g. e2-medium
+	MachineType string `json:"machineType"`
+	// +kubebuilder:validation:Required
+	// SourceImage is the image used to initialize the boot disk, e.g. projects/debian-cloud/global/images/family/debian-12
+	SourceImage string `json:"sourceImage"`
+	// +kubebuilder:validation:Optional
+	// DiskSizeGb is the size of the boot disk in GB. Defaults to the size of the image.
+	DiskSizeGb int64 `json:"diskSizeGb,omitempty"`
+	// +kubebuilder:validation:Optional
+	// DiskType is the type of the boot disk, e.g. pd-standard, pd-balanced or pd-ssd
+	DiskType string `json:"diskType,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Network is the name or self link of the network the instances are connected to. Defaults to the default network.
+	Network string `json:"network,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Metadata is the list of metadata entries set on the instances, e.g. startup-script
+	Metadata []MetadataItem `json:"metadata,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Tags are the network tags applied to the instances
+	Tags []string `json:"tags,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Labels applied to the instances
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+const (
+	// InstanceTemplateConditionReady reports whether the template version of the current spec exists
+	InstanceTemplateConditionReady = "Ready"
+)
+
+// GCPInstanceTemplateStatus defines the observed state of GCPInstanceTemplate
+type GCPInstanceTemplateStatus struct {
+	// +kubebuilder:validation:Optional
+	// CurrentTemplate is the name of the GCP instance template matching the spec
+	CurrentTemplate string `json:"currentTemplate,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SelfLink is the URL of the current GCP instance template
+	SelfLink string `json:"selfLink,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Templates are the names of all GCP instance template versions which still exist, including the current one
+	Templates []string `json:"templates,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the GCP instance template
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
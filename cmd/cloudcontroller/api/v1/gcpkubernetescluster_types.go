@@ -13,7 +13,8 @@ type GCPKubernetesClusterList struct {
 	Items []GCPKubernetesCluster `json:"items"`
 }
 
-// GCPKubernetesCluster is the Schema for the gcpkubernetesclusters API.
+// GCPKubernetesCluster is the Schema for the gcpkubernetesclusters API. Deleting it deletes the GKE cluster, the
+// blocks allocated from IPPools are released once the GKE cluster is gone.
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Namespaced,path=gcpkubernetesclusters,shortName=gkc,singular=gcpkubernetescluster
@@ -26,6 +27,11 @@ type GCPKubernetesCluster struct {
 	Status GCPKubernetesClusterStatus `json:"status,omitempty"`
 }
 
+// +kubebuilder:validation:XValidation:rule="!(has(self.network) && has(self.networkRef))",message="network and networkRef are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!(has(self.subnetwork) && has(self.subnetworkRef))",message="subnetwork and subnetworkRef are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!(has(self.clusterIpv4Cidr) && has(self.clusterIpv4CidrFrom))",message="clusterIpv4Cidr and clusterIpv4CidrFrom are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!(has(self.servicesIpv4Cidr) && has(self.servicesIpv4CidrFrom))",message="servicesIpv4Cidr and servicesIpv4CidrFrom are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!(has(self.masterIpv4Cidr) && has(self.masterIpv4CidrFrom))",message="masterIpv4Cidr and masterIpv4CidrFrom are mutually exclusive"
 type GCPKubernetesClusterSpec struct {
 	// ClusterName of the GCP Kubernetes cluster.
 	// +kubebuilder:validation:Required
@@ -42,6 +48,28 @@ type GCPKubernetesClusterSpec struct {
 	// ClusterIpv4Cidr defines the IP address range of the container pods in this cluster.
 	// +kubebuilder:validation:Optional
 	ClusterIpv4Cidr string `json:"clusterIpv4Cidr,omitempty"`
+	// ClusterIpv4CidrFrom allocates the IP address range of the container pods from an IPPool. A claim added after the
+	// cluster was created is not allocated, the cluster must be recreated.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="clusterIpv4CidrFrom is immutable"
+	ClusterIpv4CidrFrom *IPPoolClaim `json:"clusterIpv4CidrFrom,omitempty"`
+	// ServicesIpv4Cidr defines the IP address range of the services in this cluster.
+	// +kubebuilder:validation:Optional
+	ServicesIpv4Cidr string `json:"servicesIpv4Cidr,omitempty"`
+	// ServicesIpv4CidrFrom allocates the IP address range of the services from an IPPool. A claim added after the
+	// cluster was created is not allocated, the cluster must be recreated.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="servicesIpv4CidrFrom is immutable"
+	ServicesIpv4CidrFrom *IPPoolClaim `json:"servicesIpv4CidrFrom,omitempty"`
+	// MasterIpv4Cidr defines the /28 IP address range of the control plane and makes the nodes private.
+	// +kubebuilder:validation:Optional
+	MasterIpv4Cidr string `json:"masterIpv4Cidr,omitempty"`
+	// MasterIpv4CidrFrom allocates the IP address range of the control plane from an IPPool and makes the nodes private.
+	// A claim added after the cluster was created is not allocated, the cluster must be recreated.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="masterIpv4CidrFrom is immutable"
+	// +kubebuilder:validation:XValidation:rule="self.prefixLength == 28",message="the control plane range must be a /28"
+	MasterIpv4CidrFrom *IPPoolClaim `json:"masterIpv4CidrFrom,omitempty"`
 	// Description of this cluster.
 	// +kubebuilder:validation:Optional
 	Description string `json:"description,omitempty"`
@@ -51,12 +79,18 @@ type GCPKubernetesClusterSpec struct {
 	// Network of the Google Compute Engine network which the cluster is connected.
 	// +kubebuilder:validation:Optional
 	Network string `json:"network,omitempty"`
+	// NetworkRef references the GCPNetwork the cluster is connected to. The cluster waits for it to be ready.
+	// +kubebuilder:validation:Optional
+	NetworkRef *NamespacedResourceRef `json:"networkRef,omitempty"`
 	// NodePools associated with this cluster.
 	// +kubebuilder:validation:Optional
 	NodePools []*NodePool `json:"nodePools,omitempty"`
 	// Subnetwork of the Google Compute Engine subnetwork connected.
 	// +kubebuilder:validation:Optional
 	Subnetwork string `json:"subnetwork,omitempty"`
+	// SubnetworkRef references the GCPSubnetwork the cluster is connected to. The cluster waits for it to be ready.
+	// +kubebuilder:validation:Optional
+	SubnetworkRef *NamespacedResourceRef `json:"subnetworkRef,omitempty"`
 }
 
 type NodePool struct {
@@ -104,8 +138,18 @@ const (
 	ClusterStatusDegraded     ClusterStatus = "DEGRADED"
 )
 
+const (
+	// ClusterConditionReferencesResolved reports whether the referenced GCPNetwork and GCPSubnetwork are ready
+	ClusterConditionReferencesResolved = "ReferencesResolved"
+	// ClusterConditionRangesAllocated reports whether the ranges claimed from IPPools are allocated
+	ClusterConditionRangesAllocated = "RangesAllocated"
+)
+
 type GCPKubernetesClusterStatus struct {
 	// Phase is the current state of the GCP Kubernetes cluster
 	// +kubebuilder:validation:Optional
 	Phase ClusterStatus `json:"phase,omitempty"`
+	// Conditions describe the state of the GCP Kubernetes cluster
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
@@ -0,0 +1,129 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPManagedInstanceGroupList contains a list of GCPManagedInstanceGroup
+// +kubebuilder:object:root=true
+type GCPManagedInstanceGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPManagedInstanceGroups
+	Items []GCPManagedInstanceGroup `json:"items"`
+}
+
+// GCPManagedInstanceGroup is the Schema for the gcpmanagedinstancegroups API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpmanagedinstancegroups,shortName=gmig,singular=gcpmanagedinstancegroup
+// +kubebuilder:printcolumn:name="Target Size",type=integer,JSONPath=".spec.targetSize"
+// +kubebuilder:printcolumn:name="Current Template",type=string,JSONPath=".status.currentTemplate"
+// +kubebuilder:printcolumn:name="Target Template",type=string,JSONPath=".status.targetTemplate"
+// +kubebuilder:printcolumn:name="Stable",type=boolean,JSONPath=".status.stable"
+type GCPManagedInstanceGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPManagedInstanceGroup
+	Spec GCPManagedInstanceGroupSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPManagedInstanceGroup
+	Status GCPManagedInstanceGroupStatus `json:"status"`
+}
+
+// GCPManagedInstanceGroupSpec defines the desired state of GCPManagedInstanceGroup
+type GCPManagedInstanceGroupSpec struct {
+	// +kubebuilder:validation:Required
+	// Name is the name of the GCP managed instance group
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// Zone in which the GCP managed instance group resides
+	Zone string `json:"zone"`
+	// +kubebuilder:validation:Optional
+	// BaseInstanceName is the prefix of the instance names. Defaults to the name of the group.
+	BaseInstanceName string `json:"baseInstanceName,omitempty"`
+	// +kubebuilder:validation:Required
+	// TemplateRef references the GCPInstanceTemplate the instances are created from
+	TemplateRef ResourceRef `json:"templateRef"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	// TargetSize is the number of instances of the group
+	TargetSize int64 `json:"targetSize"`
+	// +kubebuilder:validation:Optional
+	// AutoHealing recreates the instances failing the health check
+	AutoHealing *AutoHealingPolicy `json:"autoHealing,omitempty"`
+	// +kubebuilder:validation:Optional
+	// UpdatePolicy defines how template changes are rolled out. Defaults to a proactive rolling update replacing the instances.
+	UpdatePolicy *UpdatePolicy `json:"updatePolicy,omitempty"`
+}
+
+// AutoHealingPolicy defines the health check of the managed instance group
+type AutoHealingPolicy struct {
+	// +kubebuilder:validation:Required
+	// HealthCheck is the URL of the health check, e.g. global/healthChecks/my-health-check
+	HealthCheck string `json:"healthCheck"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// InitialDelaySec is the time given to a new instance to start before it is health checked
+	InitialDelaySec int64 `json:"initialDelaySec,omitempty"`
+}
+
+// UpdatePolicy defines the rolling update of the managed instance group
+type UpdatePolicy struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=PROACTIVE;OPPORTUNISTIC
+	// +kubebuilder:default=PROACTIVE
+	// Type is PROACTIVE to roll out template changes immediately or OPPORTUNISTIC to apply them to new instances only
+	Type string `json:"type,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=REPLACE;RESTART;REFRESH
+	// +kubebuilder:default=REPLACE
+	// MinimalAction is the least disruptive action performed on an instance to update it
+	MinimalAction string `json:"minimalAction,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=SUBSTITUTE;RECREATE
+	// +kubebuilder:default=SUBSTITUTE
+	// ReplacementMethod is SUBSTITUTE to create instances with new names or RECREATE to keep the instance names
+	ReplacementMethod string `json:"replacementMethod,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// MaxSurge is the number of instances created above the target size during the update
+	MaxSurge *int64 `json:"maxSurge,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// MaxUnavailable is the number of instances which may be unavailable during the update
+	MaxUnavailable *int64 `json:"maxUnavailable,omitempty"`
+}
+
+const (
+	// ManagedInstanceGroupConditionTemplateReady reports whether the referenced GCPInstanceTemplate is ready
+	ManagedInstanceGroupConditionTemplateReady = "TemplateReady"
+	// ManagedInstanceGroupConditionUpdated reports whether all instances run the target template
+	ManagedInstanceGroupConditionUpdated = "Updated"
+)
+
+// GCPManagedInstanceGroupStatus defines the observed state of GCPManagedInstanceGroup
+type GCPManagedInstanceGroupStatus struct {
+	// +kubebuilder:validation:Optional
+	// CurrentTemplate is the name of the GCP instance template all instances run
+	CurrentTemplate string `json:"currentTemplate,omitempty"`
+	// +kubebuilder:validation:Optional
+	// TargetTemplate is the name of the GCP instance template the instances are updated to
+	TargetTemplate string `json:"targetTemplate,omitempty"`
+	// +kubebuilder:validation:Optional
+	// TargetSize is the number of instances the group is scaled to
+	TargetSize int64 `json:"targetSize,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Stable is true when no instance of the group is being created, deleted or updated
+	Stable bool `json:"stable,omitempty"`
+	// +kubebuilder:validation:Optional
+	// InstanceGroup is the URL of the instance group of the managed instance group
+	InstanceGroup string `json:"instanceGroup,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the GCP managed instance group
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
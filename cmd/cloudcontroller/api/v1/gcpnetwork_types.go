@@ -22,6 +22,10 @@ type GCPNetworkList struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Namespaced,path=gcpnetworks,shortName=gn,singular=gcpnetwork
+// +kubebuilder:printcolumn:name="Auto Subnetworks",type=boolean,JSONPath=".spec.autoCreateSubnetworks"
+// +kubebuilder:printcolumn:name="Routing Mode",type=string,JSONPath=".spec.routingMode"
+// +kubebuilder:printcolumn:name="MTU",type=integer,JSONPath=".status.mtu"
+// +kubebuilder:printcolumn:name="Gateway",type=string,JSONPath=".status.gatewayIPv4"
 // +kubebuilder:printcolumn:name="Status",type=string,JSONPath=".status.phase"
 type GCPNetwork struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -36,18 +40,141 @@ type GCPNetwork struct {
 }
 
 // GCPNetworkSpec defines the desired state of GCPNetwork
+// +kubebuilder:validation:XValidation:rule="has(self.internalIpv6) == has(oldSelf.internalIpv6)",message="internalIpv6 is immutable"
 type GCPNetworkSpec struct {
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
 	// Name is the name of the GCP network
 	Name string `json:"name"`
 	// +kubebuilder:validation:Required
-	// AutoCreateSubnetworks
+	// +kubebuilder:validation:XValidation:rule="oldSelf || !self",message="a custom mode network cannot be switched to auto mode"
+	// AutoCreateSubnetworks creates a subnetwork in each region. An auto mode network can be switched to custom mode
+	// but not the other way around.
 	AutoCreateSubnetworks bool `json:"autoCreateSubnetworks"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=REGIONAL;GLOBAL
+	// +kubebuilder:default=REGIONAL
+	// RoutingMode is REGIONAL to let the Cloud Routers advertise the subnetworks of their region only or GLOBAL to
+	// advertise the subnetworks of all regions
+	RoutingMode string `json:"routingMode,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1300
+	// +kubebuilder:validation:Maximum=8896
+	// MTU is the maximum transmission unit of the network in bytes, 1460 if unset
+	MTU int64 `json:"mtu,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Description of the network
+	Description string `json:"description,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="internalIpv6 is immutable"
+	// InternalIPv6 enables the internal IPv6 ranges of the network
+	InternalIPv6 *NetworkInternalIPv6 `json:"internalIpv6,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=AFTER_CLASSIC_FIREWALL;BEFORE_CLASSIC_FIREWALL
+	// +kubebuilder:default=AFTER_CLASSIC_FIREWALL
+	// FirewallPolicyEnforcementOrder sets whether the network firewall policies are evaluated after or before the
+	// firewall rules
+	FirewallPolicyEnforcementOrder string `json:"firewallPolicyEnforcementOrder,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// Peerings connect the network to other VPC networks
+	Peerings []NetworkPeering `json:"peerings,omitempty"`
+}
+
+// NetworkInternalIPv6 defines the internal IPv6 ranges of the network
+type NetworkInternalIPv6 struct {
+	// +kubebuilder:validation:Optional
+	// Range is the /48 ULA range of the network, e.g. fd20:0:0::/48. GCP allocates one if unset.
+	Range string `json:"range,omitempty"`
+}
+
+// NetworkPeering defines a VPC network peering. The peer network must define the peering back to become ACTIVE.
+// +kubebuilder:validation:XValidation:rule="has(self.peerNetworkRef) != has(self.peerNetwork)",message="exactly one of peerNetworkRef or peerNetwork is required"
+type NetworkPeering struct {
+	// +kubebuilder:validation:Required
+	// Name of the peering
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// PeerNetworkRef references the GCPNetwork to peer with
+	PeerNetworkRef *ResourceRef `json:"peerNetworkRef,omitempty"`
+	// +kubebuilder:validation:Optional
+	// PeerNetwork is the URL of the network to peer with, e.g. projects/other-project/global/networks/other-network
+	PeerNetwork string `json:"peerNetwork,omitempty"`
+	// +kubebuilder:validation:Optional
+	// ExportCustomRoutes exports the custom routes of the network to the peer network
+	ExportCustomRoutes bool `json:"exportCustomRoutes,omitempty"`
+	// +kubebuilder:validation:Optional
+	// ImportCustomRoutes imports the custom routes of the peer network
+	ImportCustomRoutes bool `json:"importCustomRoutes,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=true
+	// ExportSubnetRoutesWithPublicIP exports the subnet routes with privately used public IP ranges
+	ExportSubnetRoutesWithPublicIP bool `json:"exportSubnetRoutesWithPublicIp"`
+	// +kubebuilder:validation:Optional
+	// ImportSubnetRoutesWithPublicIP imports the subnet routes with privately used public IP ranges of the peer network
+	ImportSubnetRoutesWithPublicIP bool `json:"importSubnetRoutesWithPublicIp,omitempty"`
 }
 
+type NetworkStatus string
+
+const (
+	NetworkStatusReady    NetworkStatus = "READY"
+	NetworkStatusDeleting NetworkStatus = "DELETING"
+)
+
+const (
+	// NetworkConditionDeletionBlocked reports whether the deletion of the network waits for its dependents to be deleted
+	NetworkConditionDeletionBlocked = "DeletionBlocked"
+	// NetworkConditionSynced reports whether the GCP network matches the spec
+	NetworkConditionSynced = "Synced"
+	// NetworkConditionPeeringsReady reports whether the peer networks are ready and the peerings match the spec
+	NetworkConditionPeeringsReady = "PeeringsReady"
+)
+
 // GCPNetworkStatus defines the observed state of GCPNetwork
 type GCPNetworkStatus struct {
 	// +kubebuilder:validation:Optional
-	// Phase is the current state of the GCP cluster
-	Phase string `json:"phase"`
+	// Phase is the current state of the GCP network
+	Phase NetworkStatus `json:"phase"`
+	// +kubebuilder:validation:Optional
+	// SelfLink is the URL of the GCP network
+	SelfLink string `json:"selfLink,omitempty"`
+	// +kubebuilder:validation:Optional
+	// GatewayIPv4 is the gateway address of a legacy network
+	GatewayIPv4 string `json:"gatewayIPv4,omitempty"`
+	// +kubebuilder:validation:Optional
+	// MTU is the maximum transmission unit of the GCP network
+	MTU int64 `json:"mtu,omitempty"`
+	// +kubebuilder:validation:Optional
+	// InternalIPv6Range is the internal IPv6 range of the GCP network
+	InternalIPv6Range string `json:"internalIpv6Range,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Subnetworks are the URLs of the subnetworks of the network
+	Subnetworks []string `json:"subnetworks,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Peerings are the peerings of the GCP network, including the ones not managed by the operator
+	Peerings []NetworkPeeringStatus `json:"peerings,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the GCP network
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// NetworkPeeringStatus defines the observed state of a VPC network peering
+type NetworkPeeringStatus struct {
+	// +kubebuilder:validation:Required
+	// Name of the peering
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// PeerNetwork is the URL of the peer network
+	PeerNetwork string `json:"peerNetwork,omitempty"`
+	// +kubebuilder:validation:Optional
+	// State of the peering, ACTIVE once both networks define it or INACTIVE
+	State string `json:"state,omitempty"`
+	// +kubebuilder:validation:Optional
+	// StateDetails explains the state of the peering
+	StateDetails string `json:"stateDetails,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Managed is true when the peering is defined in the spec
+	Managed bool `json:"managed,omitempty"`
 }
@@ -0,0 +1,155 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPPubSubSubscriptionList contains a list of GCPPubSubSubscription
+// +kubebuilder:object:root=true
+type GCPPubSubSubscriptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPPubSubSubscriptions
+	Items []GCPPubSubSubscription `json:"items"`
+}
+
+// GCPPubSubSubscription is the Schema for the gcppubsubsubscriptions API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcppubsubsubscriptions,shortName=gpss,singular=gcppubsubsubscription
+// +kubebuilder:printcolumn:name="Topic",type=string,JSONPath=".spec.topicRef.name"
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=".spec.name"
+// +kubebuilder:printcolumn:name="Push Endpoint",type=string,JSONPath=".spec.pushConfig.endpoint"
+type GCPPubSubSubscription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPPubSubSubscription
+	Spec GCPPubSubSubscriptionSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPPubSubSubscription
+	Status GCPPubSubSubscriptionStatus `json:"status"`
+}
+
+// GCPPubSubSubscriptionSpec defines the desired state of GCPPubSubSubscription
+// +kubebuilder:validation:XValidation:rule="!has(self.pushConfig) || !has(self.enableExactlyOnceDelivery) || !self.enableExactlyOnceDelivery",message="exactly-once delivery is only supported by pull subscriptions"
+type GCPPubSubSubscriptionSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	// Name is the name of the Pub/Sub subscription
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="topicRef is immutable"
+	// TopicRef references the GCPPubSubTopic the subscription receives the messages of
+	TopicRef ResourceRef `json:"topicRef"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=600
+	// +kubebuilder:default=10
+	// AckDeadlineSeconds is the time a subscriber has to acknowledge a message before it is redelivered
+	AckDeadlineSeconds int64 `json:"ackDeadlineSeconds,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+s$`
+	// MessageRetentionDuration keeps the unacknowledged messages, e.g. 86400s. Between 10 minutes and 7 days.
+	// Defaults to 7 days.
+	MessageRetentionDuration string `json:"messageRetentionDuration,omitempty"`
+	// +kubebuilder:validation:Optional
+	// RetainAckedMessages keeps the acknowledged messages for the retention duration, so they can be replayed
+	RetainAckedMessages bool `json:"retainAckedMessages,omitempty"`
+	// +kubebuilder:validation:Optional
+	// DeadLetterPolicy forwards the messages failing to be delivered to a dead-letter topic. The Pub/Sub service
+	// account must be allowed to publish to the dead-letter topic and to subscribe to this subscription.
+	DeadLetterPolicy *SubscriptionDeadLetterPolicy `json:"deadLetterPolicy,omitempty"`
+	// +kubebuilder:validation:Optional
+	// RetryPolicy delays the redelivery of the messages. Messages are redelivered immediately if unset.
+	RetryPolicy *SubscriptionRetryPolicy `json:"retryPolicy,omitempty"`
+	// +kubebuilder:validation:Optional
+	// PushConfig makes Pub/Sub push the messages to an endpoint. The subscription is a pull subscription if unset.
+	PushConfig *SubscriptionPushConfig `json:"pushConfig,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="filter is immutable"
+	// Filter only delivers the messages matching the filter, e.g. attributes.type = "order"
+	Filter string `json:"filter,omitempty"`
+	// +kubebuilder:validation:Optional
+	// EnableExactlyOnceDelivery guarantees acknowledged messages are not redelivered
+	EnableExactlyOnceDelivery bool `json:"enableExactlyOnceDelivery,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="enableMessageOrdering is immutable"
+	// EnableMessageOrdering delivers the messages sharing an ordering key in the order they were published
+	EnableMessageOrdering bool `json:"enableMessageOrdering,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Labels of the subscription
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// SubscriptionDeadLetterPolicy defines where undeliverable messages are forwarded
+type SubscriptionDeadLetterPolicy struct {
+	// +kubebuilder:validation:Required
+	// TopicRef references the GCPPubSubTopic the undeliverable messages are forwarded to
+	TopicRef ResourceRef `json:"topicRef"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=5
+	// MaxDeliveryAttempts is the number of delivery attempts before a message is forwarded
+	MaxDeliveryAttempts int64 `json:"maxDeliveryAttempts,omitempty"`
+}
+
+// SubscriptionRetryPolicy defines the exponential backoff of the redeliveries
+type SubscriptionRetryPolicy struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+s$`
+	// +kubebuilder:default="10s"
+	// MinimumBackoff is the delay before the first redelivery, between 0s and 600s
+	MinimumBackoff string `json:"minimumBackoff,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+s$`
+	// +kubebuilder:default="600s"
+	// MaximumBackoff is the maximum delay between redeliveries, between 0s and 600s
+	MaximumBackoff string `json:"maximumBackoff,omitempty"`
+}
+
+// SubscriptionPushConfig defines the endpoint the messages are pushed to
+type SubscriptionPushConfig struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https://`
+	// Endpoint is the URL the messages are pushed to, e.g. https://example.com/push
+	Endpoint string `json:"endpoint"`
+	// +kubebuilder:validation:Optional
+	// Attributes of the push endpoint, e.g. x-goog-version
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// +kubebuilder:validation:Optional
+	// OIDCToken authenticates the push requests with an OIDC token of the service account
+	OIDCToken *SubscriptionOIDCToken `json:"oidcToken,omitempty"`
+}
+
+// SubscriptionOIDCToken defines the OIDC token attached to the push requests
+type SubscriptionOIDCToken struct {
+	// +kubebuilder:validation:Required
+	// ServiceAccountEmail is the service account the token is generated for
+	ServiceAccountEmail string `json:"serviceAccountEmail"`
+	// +kubebuilder:validation:Optional
+	// Audience of the token. Defaults to the push endpoint.
+	Audience string `json:"audience,omitempty"`
+}
+
+const (
+	// SubscriptionConditionTopicsReady reports whether the referenced topics exist
+	SubscriptionConditionTopicsReady = "TopicsReady"
+)
+
+// GCPPubSubSubscriptionStatus defines the observed state of GCPPubSubSubscription
+type GCPPubSubSubscriptionStatus struct {
+	// +kubebuilder:validation:Optional
+	// Path is the resource name of the subscription, e.g. projects/my-project/subscriptions/my-subscription
+	Path string `json:"path,omitempty"`
+	// +kubebuilder:validation:Optional
+	// State of the subscription, ACTIVE or RESOURCE_ERROR
+	State string `json:"state,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the subscription
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
@@ -0,0 +1,70 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPPubSubTopicList contains a list of GCPPubSubTopic
+// +kubebuilder:object:root=true
+type GCPPubSubTopicList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPPubSubTopics
+	Items []GCPPubSubTopic `json:"items"`
+}
+
+// GCPPubSubTopic is the Schema for the gcppubsubtopics API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcppubsubtopics,shortName=gpst,singular=gcppubsubtopic
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=".spec.name"
+// +kubebuilder:printcolumn:name="Retention",type=string,JSONPath=".spec.messageRetentionDuration"
+type GCPPubSubTopic struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPPubSubTopic
+	Spec GCPPubSubTopicSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPPubSubTopic
+	Status GCPPubSubTopicStatus `json:"status"`
+}
+
+// GCPPubSubTopicSpec defines the desired state of GCPPubSubTopic
+type GCPPubSubTopicSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	// Name is the name of the Pub/Sub topic
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+s$`
+	// MessageRetentionDuration keeps the published messages, acknowledged or not, for replay, e.g. 86400s. Between
+	// 10 minutes and 31 days. Messages are not retained by the topic if unset.
+	MessageRetentionDuration string `json:"messageRetentionDuration,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Labels of the topic
+	Labels map[string]string `json:"labels,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="kmsKeyName is immutable"
+	// KMSKeyName is the Cloud KMS key encrypting the messages, e.g.
+	// projects/my-project/locations/us-central1/keyRings/my-ring/cryptoKeys/my-key. Google-managed keys if unset.
+	KMSKeyName string `json:"kmsKeyName,omitempty"`
+}
+
+const (
+	// TopicConditionDeletionBlocked reports whether the deletion of the topic waits for its subscriptions to be deleted
+	TopicConditionDeletionBlocked = "DeletionBlocked"
+)
+
+// GCPPubSubTopicStatus defines the observed state of GCPPubSubTopic
+type GCPPubSubTopicStatus struct {
+	// +kubebuilder:validation:Optional
+	// Path is the resource name of the topic, e.g. projects/my-project/topics/my-topic
+	Path string `json:"path,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the topic
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
@@ -0,0 +1,124 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPRedisInstanceList contains a list of GCPRedisInstance
+// +kubebuilder:object:root=true
+type GCPRedisInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPRedisInstances
+	Items []GCPRedisInstance `json:"items"`
+}
+
+// GCPRedisInstance is the Schema for the gcpredisinstances API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpredisinstances,shortName=gredis,singular=gcpredisinstance
+// +kubebuilder:printcolumn:name="Tier",type=string,JSONPath=".spec.tier"
+// +kubebuilder:printcolumn:name="Memory",type=integer,JSONPath=".spec.memorySizeGb"
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="Host",type=string,JSONPath=".status.host"
+type GCPRedisInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPRedisInstance
+	Spec GCPRedisInstanceSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPRedisInstance
+	Status GCPRedisInstanceStatus `json:"status"`
+}
+
+// GCPRedisInstanceSpec defines the desired state of GCPRedisInstance
+// +kubebuilder:validation:XValidation:rule="(has(self.tls) && self.tls) == (has(oldSelf.tls) && oldSelf.tls)",message="tls is immutable"
+type GCPRedisInstanceSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z]([a-z0-9-]{0,38}[a-z0-9])?$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	// Name of the Memorystore for Redis instance
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	// Region of the instance
+	Region string `json:"region"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=BASIC;STANDARD_HA
+	// +kubebuilder:default=BASIC
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="tier is immutable"
+	// Tier is STANDARD_HA for an instance replicated to a standby in another zone
+	Tier string `json:"tier,omitempty"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=300
+	// MemorySizeGb is the memory of the instance. Changes are applied in place, BASIC instances are flushed.
+	MemorySizeGb int64 `json:"memorySizeGb"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="redisVersion is immutable"
+	// RedisVersion is the version of Redis, e.g. REDIS_7_2. Defaults to the latest version supported by GCP.
+	RedisVersion string `json:"redisVersion,omitempty"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="networkRef is immutable"
+	// NetworkRef references the GCPNetwork the instance is reachable from. The instance waits for it to be ready.
+	NetworkRef *NamespacedResourceRef `json:"networkRef"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=DIRECT_PEERING;PRIVATE_SERVICE_ACCESS
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="connectMode is immutable"
+	// ConnectMode is PRIVATE_SERVICE_ACCESS for a network with private services access configured. Defaults to
+	// DIRECT_PEERING.
+	ConnectMode string `json:"connectMode,omitempty"`
+	// +kubebuilder:validation:Optional
+	// AuthEnabled requires clients to authenticate with the AUTH string published in the Secret
+	AuthEnabled bool `json:"authEnabled,omitempty"`
+	// +kubebuilder:validation:Optional
+	// TLS encrypts the traffic to the instance. The CA certificate of the instance is published in the Secret.
+	TLS bool `json:"tls,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Labels of the instance
+	Labels map[string]string `json:"labels,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="secretName is immutable"
+	// SecretName is the name of the Secret holding the connection details. Defaults to <name>-redis, name being
+	// the name of the GCPRedisInstance.
+	SecretName string `json:"secretName,omitempty"`
+}
+
+type RedisInstanceState string
+
+const (
+	RedisInstanceStateCreating    RedisInstanceState = "CREATING"
+	RedisInstanceStateReady       RedisInstanceState = "READY"
+	RedisInstanceStateUpdating    RedisInstanceState = "UPDATING"
+	RedisInstanceStateDeleting    RedisInstanceState = "DELETING"
+	RedisInstanceStateRepairing   RedisInstanceState = "REPAIRING"
+	RedisInstanceStateMaintenance RedisInstanceState = "MAINTENANCE"
+)
+
+const (
+	// RedisInstanceConditionReferencesResolved reports whether the referenced GCPNetwork is ready
+	RedisInstanceConditionReferencesResolved = "ReferencesResolved"
+)
+
+// GCPRedisInstanceStatus defines the observed state of GCPRedisInstance
+type GCPRedisInstanceStatus struct {
+	// +kubebuilder:validation:Optional
+	// State is the current state of the Memorystore for Redis instance
+	State RedisInstanceState `json:"state,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Host is the IP of the instance in the referenced network
+	Host string `json:"host,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Port of the instance
+	Port int64 `json:"port,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SecretName is the name of the Secret holding the connection details
+	SecretName string `json:"secretName,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the instance
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
@@ -0,0 +1,107 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPRouteList contains a list of GCPRoute
+// +kubebuilder:object:root=true
+type GCPRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPRoutes
+	Items []GCPRoute `json:"items"`
+}
+
+// GCPRoute is the Schema for the gcproutes API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcproutes,shortName=groute,singular=gcproute
+// +kubebuilder:printcolumn:name="Network",type=string,JSONPath=".spec.networkRef.name"
+// +kubebuilder:printcolumn:name="Destination",type=string,JSONPath=".spec.destRange"
+// +kubebuilder:printcolumn:name="Priority",type=integer,JSONPath=".spec.priority"
+// +kubebuilder:printcolumn:name="Next Hop",type=string,JSONPath=".status.nextHop"
+type GCPRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPRoute
+	Spec GCPRouteSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPRoute
+	Status GCPRouteStatus `json:"status"`
+}
+
+// GCPRouteSpec defines the desired state of GCPRoute. GCP routes are immutable, changing the spec replaces the route.
+type GCPRouteSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	// Name is the name of the GCP route
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="networkRef is immutable"
+	// NetworkRef references the GCPNetwork the route belongs to
+	NetworkRef ResourceRef `json:"networkRef"`
+	// +kubebuilder:validation:Required
+	// DestRange is the destination range of the traffic, e.g. 0.0.0.0/0
+	DestRange string `json:"destRange"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=1000
+	// Priority of the route, lower values take precedence among routes with the same destination
+	Priority int64 `json:"priority,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Tags are the network tags of the instances the route applies to. All instances if unset.
+	Tags []string `json:"tags,omitempty"`
+	// +kubebuilder:validation:Required
+	// NextHop is where the matching traffic is sent to
+	NextHop RouteNextHop `json:"nextHop"`
+	// +kubebuilder:validation:Optional
+	// Description of the route
+	Description string `json:"description,omitempty"`
+}
+
+// RouteNextHop defines the next hop of the route
+// +kubebuilder:validation:XValidation:rule="[has(self.gateway), has(self.instanceRef), has(self.ip), has(self.ilb)].filter(x, x).size() == 1",message="exactly one next hop is required"
+type RouteNextHop struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=default-internet-gateway
+	// Gateway is the internet gateway
+	Gateway string `json:"gateway,omitempty"`
+	// +kubebuilder:validation:Optional
+	// InstanceRef references the GCPInstance forwarding the traffic, e.g. an appliance VM
+	InstanceRef *ResourceRef `json:"instanceRef,omitempty"`
+	// +kubebuilder:validation:Optional
+	// IP is the internal IP of the instance forwarding the traffic
+	IP string `json:"ip,omitempty"`
+	// +kubebuilder:validation:Optional
+	// ILB is the URL or IP of the forwarding rule of an internal passthrough load balancer
+	ILB string `json:"ilb,omitempty"`
+}
+
+const (
+	// RouteConditionNetworkReady reports whether the referenced GCPNetwork is ready
+	RouteConditionNetworkReady = "NetworkReady"
+	// RouteConditionNextHopReady reports whether the GCPInstance of the next hop is ready
+	RouteConditionNextHopReady = "NextHopReady"
+)
+
+// GCPRouteStatus defines the observed state of GCPRoute
+type GCPRouteStatus struct {
+	// +kubebuilder:validation:Optional
+	// SelfLink is the URL of the GCP route
+	SelfLink string `json:"selfLink,omitempty"`
+	// +kubebuilder:validation:Optional
+	// NextHop is the next hop of the GCP route
+	NextHop string `json:"nextHop,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Warnings reported by GCP, e.g. when the next hop instance is not running
+	Warnings []string `json:"warnings,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the GCP route
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
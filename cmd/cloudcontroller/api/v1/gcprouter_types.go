@@ -0,0 +1,152 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPRouterList contains a list of GCPRouter
+// +kubebuilder:object:root=true
+type GCPRouterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPRouters
+	Items []GCPRouter `json:"items"`
+}
+
+// GCPRouter is the Schema for the gcprouters API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcprouters,shortName=grt,singular=gcprouter
+// +kubebuilder:printcolumn:name="Region",type=string,JSONPath=".spec.region"
+// +kubebuilder:printcolumn:name="Network",type=string,JSONPath=".spec.networkRef.name"
+// +kubebuilder:printcolumn:name="ASN",type=integer,JSONPath=".spec.bgp.asn"
+type GCPRouter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPRouter
+	Spec GCPRouterSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPRouter
+	Status GCPRouterStatus `json:"status"`
+}
+
+// GCPRouterSpec defines the desired state of GCPRouter
+type GCPRouterSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	// Name is the name of the GCP Cloud Router
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	// Region in which the GCP Cloud Router resides
+	Region string `json:"region"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="networkRef is immutable"
+	// NetworkRef references the GCPNetwork the router belongs to
+	NetworkRef ResourceRef `json:"networkRef"`
+	// +kubebuilder:validation:Optional
+	// BGP configures the BGP speaker of the router. Removing it leaves the BGP speaker of the GCP router in place.
+	BGP *RouterBGP `json:"bgp,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// NATs are the Cloud NAT gateways of the router
+	NATs []RouterNAT `json:"nats,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Description of the router
+	Description string `json:"description,omitempty"`
+}
+
+// RouterBGP defines the BGP speaker of the router
+type RouterBGP struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// ASN is the private ASN of the router, e.g. 64512
+	ASN int64 `json:"asn"`
+}
+
+// RouterNAT defines a Cloud NAT gateway
+// +kubebuilder:validation:XValidation:rule="self.ipAllocation == 'AUTO_ONLY' ? !has(self.addressRefs) : has(self.addressRefs)",message="addressRefs are required for and only allowed with MANUAL_ONLY ip allocation"
+// +kubebuilder:validation:XValidation:rule="self.subnetworkSelection == 'LIST_OF_SUBNETWORKS' ? has(self.subnetworks) : !has(self.subnetworks)",message="subnetworks are required for and only allowed with LIST_OF_SUBNETWORKS selection"
+type RouterNAT struct {
+	// +kubebuilder:validation:Required
+	// Name of the NAT gateway
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=AUTO_ONLY;MANUAL_ONLY
+	// +kubebuilder:default=AUTO_ONLY
+	// IPAllocation is AUTO_ONLY to let GCP allocate the NAT IPs or MANUAL_ONLY to use the addressRefs
+	IPAllocation string `json:"ipAllocation,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=1
+	// AddressRefs reference the GCPAddresses used as NAT IPs. They must be external addresses of the router region.
+	AddressRefs []ResourceRef `json:"addressRefs,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ALL_SUBNETWORKS_ALL_IP_RANGES;ALL_SUBNETWORKS_ALL_PRIMARY_IP_RANGES;LIST_OF_SUBNETWORKS
+	// +kubebuilder:default=ALL_SUBNETWORKS_ALL_IP_RANGES
+	// SubnetworkSelection selects the subnetworks whose traffic is translated
+	SubnetworkSelection string `json:"subnetworkSelection,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=1
+	// Subnetworks whose traffic is translated with LIST_OF_SUBNETWORKS selection
+	Subnetworks []NATSubnetwork `json:"subnetworks,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Logging enables the NAT logs
+	Logging *NATLogging `json:"logging,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// MinPortsPerVM is the minimum number of ports allocated to a VM
+	MinPortsPerVM int64 `json:"minPortsPerVm,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// MaxPortsPerVM is the maximum number of ports allocated to a VM with dynamic port allocation
+	MaxPortsPerVM int64 `json:"maxPortsPerVm,omitempty"`
+	// +kubebuilder:validation:Optional
+	// DynamicPortAllocation allocates ports to the VMs depending on their usage
+	DynamicPortAllocation bool `json:"dynamicPortAllocation,omitempty"`
+}
+
+// NATSubnetwork selects the ranges of a subnetwork translated by the NAT gateway
+type NATSubnetwork struct {
+	// +kubebuilder:validation:Required
+	// SubnetworkRef references the GCPSubnetwork
+	SubnetworkRef ResourceRef `json:"subnetworkRef"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ALL_IP_RANGES;PRIMARY_IP_RANGE
+	// +kubebuilder:default=ALL_IP_RANGES
+	// SourceIPRanges are the ranges of the subnetwork which are translated
+	SourceIPRanges string `json:"sourceIpRanges,omitempty"`
+}
+
+// NATLogging defines the NAT logs
+type NATLogging struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ERRORS_ONLY;TRANSLATIONS_ONLY;ALL
+	// +kubebuilder:default=ALL
+	// Filter selects the logged connections
+	Filter string `json:"filter,omitempty"`
+}
+
+const (
+	// RouterConditionNetworkReady reports whether the referenced GCPNetwork is ready
+	RouterConditionNetworkReady = "NetworkReady"
+	// RouterConditionNATReady reports whether the GCPAddresses and GCPSubnetworks referenced by the NATs are ready
+	RouterConditionNATReady = "NATReady"
+)
+
+// GCPRouterStatus defines the observed state of GCPRouter
+type GCPRouterStatus struct {
+	// +kubebuilder:validation:Optional
+	// SelfLink is the URL of the GCP Cloud Router
+	SelfLink string `json:"selfLink,omitempty"`
+	// +kubebuilder:validation:Optional
+	// NATs are the names of the NAT gateways of the router
+	NATs []string `json:"nats,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the GCP Cloud Router
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
@@ -0,0 +1,69 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPServiceAccountList contains a list of GCPServiceAccount
+// +kubebuilder:object:root=true
+type GCPServiceAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPServiceAccounts
+	Items []GCPServiceAccount `json:"items"`
+}
+
+// GCPServiceAccount is the Schema for the gcpserviceaccounts API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpserviceaccounts,shortName=gsa,singular=gcpserviceaccount
+// +kubebuilder:printcolumn:name="Email",type=string,JSONPath=".status.email"
+type GCPServiceAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPServiceAccount
+	Spec GCPServiceAccountSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPServiceAccount
+	Status GCPServiceAccountStatus `json:"status"`
+}
+
+// GCPServiceAccountSpec defines the desired state of GCPServiceAccount
+type GCPServiceAccountSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z][a-z0-9-]{4,28}[a-z0-9]$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="accountID is immutable"
+	// AccountID is the first part of the service account email, e.g. app for app@my-project.iam.gserviceaccount.com
+	AccountID string `json:"accountID"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=100
+	// DisplayName of the service account
+	DisplayName string `json:"displayName,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=256
+	// Description of the service account
+	Description string `json:"description,omitempty"`
+}
+
+const (
+	// ServiceAccountConditionDeletionBlocked reports whether the deletion of the service account waits for its keys
+	// to be deleted
+	ServiceAccountConditionDeletionBlocked = "DeletionBlocked"
+)
+
+// GCPServiceAccountStatus defines the observed state of GCPServiceAccount
+type GCPServiceAccountStatus struct {
+	// +kubebuilder:validation:Optional
+	// Email of the service account
+	Email string `json:"email,omitempty"`
+	// +kubebuilder:validation:Optional
+	// UniqueID is the numeric ID of the service account
+	UniqueID string `json:"uniqueID,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the service account
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
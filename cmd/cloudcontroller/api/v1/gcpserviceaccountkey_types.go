@@ -0,0 +1,100 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPServiceAccountKeyList contains a list of GCPServiceAccountKey
+// +kubebuilder:object:root=true
+type GCPServiceAccountKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPServiceAccountKeys
+	Items []GCPServiceAccountKey `json:"items"`
+}
+
+// GCPServiceAccountKey is the Schema for the gcpserviceaccountkeys API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpserviceaccountkeys,shortName=gsak,singular=gcpserviceaccountkey
+// +kubebuilder:printcolumn:name="Service Account",type=string,JSONPath=".spec.serviceAccountRef.name"
+// +kubebuilder:printcolumn:name="Key",type=string,JSONPath=".status.keyID"
+// +kubebuilder:printcolumn:name="Next Rotation",type=date,JSONPath=".status.nextRotationTime"
+type GCPServiceAccountKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPServiceAccountKey
+	Spec GCPServiceAccountKeySpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPServiceAccountKey
+	Status GCPServiceAccountKeyStatus `json:"status"`
+}
+
+// GCPServiceAccountKeySpec defines the desired state of GCPServiceAccountKey. The JSON credentials file of the key is
+// stored in a Secret, deleting the Secret or the data key creates a new key.
+type GCPServiceAccountKeySpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="serviceAccountRef is immutable"
+	// ServiceAccountRef references the GCPServiceAccount the key belongs to
+	ServiceAccountRef ResourceRef `json:"serviceAccountRef"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="secretName is immutable"
+	// SecretName is the name of the Secret holding the key. Defaults to <name>-key, name being the name of the
+	// GCPServiceAccountKey.
+	SecretName string `json:"secretName,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="key.json"
+	// SecretKey is the data key of the Secret the JSON credentials file is stored under
+	SecretKey string `json:"secretKey,omitempty"`
+	// +kubebuilder:validation:Optional
+	// RotationSchedule is a cron expression in UTC at which the key is replaced by a new key, e.g. "0 3 1 * *" for
+	// monthly rotations. The key is never rotated if unset.
+	RotationSchedule string `json:"rotationSchedule,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="24h"
+	// GracePeriod is the time a replaced key stays valid, so workloads can pick up the new key
+	GracePeriod metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// RetiredServiceAccountKey is a replaced key waiting for its grace period to end
+type RetiredServiceAccountKey struct {
+	// +kubebuilder:validation:Required
+	// KeyID of the replaced key
+	KeyID string `json:"keyID"`
+	// +kubebuilder:validation:Required
+	// RetiredTime is the time the key was replaced
+	RetiredTime metav1.Time `json:"retiredTime"`
+}
+
+const (
+	// ServiceAccountKeyConditionServiceAccountReady reports whether the referenced GCPServiceAccount exists
+	ServiceAccountKeyConditionServiceAccountReady = "ServiceAccountReady"
+	// ServiceAccountKeyConditionScheduleValid reports whether the cron expression of the rotation schedule can be parsed
+	ServiceAccountKeyConditionScheduleValid = "ScheduleValid"
+)
+
+// GCPServiceAccountKeyStatus defines the observed state of GCPServiceAccountKey
+type GCPServiceAccountKeyStatus struct {
+	// +kubebuilder:validation:Optional
+	// KeyID is the ID of the key stored in the Secret
+	KeyID string `json:"keyID,omitempty"`
+	// +kubebuilder:validation:Optional
+	// KeyCreationTime is the time the key stored in the Secret was created
+	KeyCreationTime *metav1.Time `json:"keyCreationTime,omitempty"`
+	// +kubebuilder:validation:Optional
+	// NextRotationTime is the time the key will be replaced
+	NextRotationTime *metav1.Time `json:"nextRotationTime,omitempty"`
+	// +kubebuilder:validation:Optional
+	// RetiredKeys are the replaced keys deleted once their grace period ends
+	RetiredKeys []RetiredServiceAccountKey `json:"retiredKeys,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SecretName is the name of the Secret holding the key
+	SecretName string `json:"secretName,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the key
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
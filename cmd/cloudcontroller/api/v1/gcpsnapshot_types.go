@@ -0,0 +1,101 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPSnapshotList contains a list of GCPSnapshot
+// +kubebuilder:object:root=true
+type GCPSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPSnapshots
+	Items []GCPSnapshot `json:"items"`
+}
+
+// GCPSnapshot is the Schema for the gcpsnapshots API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpsnapshots,shortName=gsn,singular=gcpsnapshot
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Storage",type=integer,JSONPath=".status.storageBytes"
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"
+type GCPSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPSnapshot
+	Spec GCPSnapshotSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPSnapshot
+	Status GCPSnapshotStatus `json:"status"`
+}
+
+// GCPSnapshotSpec defines the desired state of GCPSnapshot
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="snapshots are immutable"
+type GCPSnapshotSpec struct {
+	// +kubebuilder:validation:Required
+	// Name is the name of the GCP snapshot
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// Source is the disk the snapshot is taken from
+	Source SnapshotSource `json:"source"`
+	// +kubebuilder:validation:Optional
+	// StorageLocations is the Cloud Storage location of the snapshot, e.g. eu or us-central1
+	StorageLocations []string `json:"storageLocations,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Labels applied to the snapshot
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// SnapshotSource references the disk a snapshot is taken from
+// +kubebuilder:validation:XValidation:rule="has(self.diskRef) != has(self.instanceRef)",message="exactly one of diskRef or instanceRef must be set"
+type SnapshotSource struct {
+	// +kubebuilder:validation:Optional
+	// DiskRef references a GCPDisk
+	DiskRef *ResourceRef `json:"diskRef,omitempty"`
+	// +kubebuilder:validation:Optional
+	// InstanceRef references a GCPInstance whose boot disk is snapshotted
+	InstanceRef *ResourceRef `json:"instanceRef,omitempty"`
+}
+
+type SnapshotStatus string
+
+const (
+	SnapshotStatusCreating  SnapshotStatus = "CREATING"
+	SnapshotStatusUploading SnapshotStatus = "UPLOADING"
+	SnapshotStatusReady     SnapshotStatus = "READY"
+	SnapshotStatusFailed    SnapshotStatus = "FAILED"
+	SnapshotStatusDeleting  SnapshotStatus = "DELETING"
+)
+
+const (
+	// SnapshotConditionReady reports whether the snapshot is uploaded and can be restored
+	SnapshotConditionReady = "Ready"
+)
+
+// GCPSnapshotStatus defines the observed state of GCPSnapshot
+type GCPSnapshotStatus struct {
+	// +kubebuilder:validation:Optional
+	// Phase is the current state of the GCP snapshot
+	Phase SnapshotStatus `json:"phase,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SelfLink is the URL of the GCP snapshot, usable as sourceSnapshot of a GCPDisk
+	SelfLink string `json:"selfLink,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SourceDisk is the URL of the disk the snapshot was taken from
+	SourceDisk string `json:"sourceDisk,omitempty"`
+	// +kubebuilder:validation:Optional
+	// DiskSizeGb is the size of the source disk in GB
+	DiskSizeGb int64 `json:"diskSizeGb,omitempty"`
+	// +kubebuilder:validation:Optional
+	// StorageBytes is the size of the snapshot in Cloud Storage
+	StorageBytes int64 `json:"storageBytes,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the GCP snapshot
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
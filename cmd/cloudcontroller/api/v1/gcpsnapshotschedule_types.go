@@ -0,0 +1,90 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPSnapshotScheduleList contains a list of GCPSnapshotSchedule
+// +kubebuilder:object:root=true
+type GCPSnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPSnapshotSchedules
+	Items []GCPSnapshotSchedule `json:"items"`
+}
+
+// GCPSnapshotSchedule is the Schema for the gcpsnapshotschedules API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpsnapshotschedules,shortName=gss,singular=gcpsnapshotschedule
+// +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=".spec.schedule"
+// +kubebuilder:printcolumn:name="Retention",type=integer,JSONPath=".spec.retentionCount"
+// +kubebuilder:printcolumn:name="Last Snapshot",type=date,JSONPath=".status.lastScheduleTime"
+type GCPSnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPSnapshotSchedule
+	Spec GCPSnapshotScheduleSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPSnapshotSchedule
+	Status GCPSnapshotScheduleStatus `json:"status"`
+}
+
+// GCPSnapshotScheduleSpec defines the desired state of GCPSnapshotSchedule
+type GCPSnapshotScheduleSpec struct {
+	// +kubebuilder:validation:Required
+	// Schedule is a cron expression in UTC, e.g. "0 2 * * *" for nightly snapshots
+	Schedule string `json:"schedule"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// RetentionCount is the number of ready snapshots kept, older ready snapshots are deleted. Failed snapshots
+	// never count towards it, only the most recent failed snapshot is kept.
+	RetentionCount int32 `json:"retentionCount"`
+	// +kubebuilder:validation:Optional
+	// Suspend stops the creation of new snapshots, existing snapshots are kept
+	Suspend bool `json:"suspend,omitempty"`
+	// +kubebuilder:validation:Required
+	// Template describes the snapshots created by the schedule
+	Template SnapshotTemplate `json:"template"`
+}
+
+// SnapshotTemplate describes the GCPSnapshots created by a GCPSnapshotSchedule
+type SnapshotTemplate struct {
+	// +kubebuilder:validation:Required
+	// Source is the disk the snapshots are taken from
+	Source SnapshotSource `json:"source"`
+	// +kubebuilder:validation:Optional
+	// StorageLocations is the Cloud Storage location of the snapshots, e.g. eu or us-central1
+	StorageLocations []string `json:"storageLocations,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Labels applied to the snapshots
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+const (
+	// SnapshotScheduleLabel is set on the GCPSnapshots created by a GCPSnapshotSchedule
+	SnapshotScheduleLabel = "benzaiten.io/snapshot-schedule"
+
+	// SnapshotScheduleConditionValid reports whether the cron expression of the schedule can be parsed
+	SnapshotScheduleConditionValid = "ScheduleValid"
+)
+
+// GCPSnapshotScheduleStatus defines the observed state of GCPSnapshotSchedule
+type GCPSnapshotScheduleStatus struct {
+	// +kubebuilder:validation:Optional
+	// LastScheduleTime is the time the last snapshot was scheduled
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// +kubebuilder:validation:Optional
+	// NextScheduleTime is the time the next snapshot will be scheduled
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Snapshots are the names of the retained GCPSnapshots, oldest first
+	Snapshots []string `json:"snapshots,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the schedule
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
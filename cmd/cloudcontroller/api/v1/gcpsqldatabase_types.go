@@ -0,0 +1,66 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPSQLDatabaseList contains a list of GCPSQLDatabase
+// +kubebuilder:object:root=true
+type GCPSQLDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPSQLDatabases
+	Items []GCPSQLDatabase `json:"items"`
+}
+
+// GCPSQLDatabase is the Schema for the gcpsqldatabases API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpsqldatabases,shortName=gsqldb,singular=gcpsqldatabase
+// +kubebuilder:printcolumn:name="Instance",type=string,JSONPath=".spec.instanceRef.name"
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=".spec.name"
+type GCPSQLDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPSQLDatabase
+	Spec GCPSQLDatabaseSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPSQLDatabase
+	Status GCPSQLDatabaseStatus `json:"status"`
+}
+
+// GCPSQLDatabaseSpec defines the desired state of GCPSQLDatabase
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="databases are immutable"
+type GCPSQLDatabaseSpec struct {
+	// +kubebuilder:validation:Required
+	// InstanceRef references the GCPSQLInstance the database is created in
+	InstanceRef ResourceRef `json:"instanceRef"`
+	// +kubebuilder:validation:Required
+	// Name is the name of the database
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// Charset of the database, e.g. UTF8 or utf8mb4. Defaults to the engine default.
+	Charset string `json:"charset,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Collation of the database, e.g. en_US.UTF8. Defaults to the engine default.
+	Collation string `json:"collation,omitempty"`
+}
+
+const (
+	// SQLDatabaseConditionInstanceReady reports whether the referenced GCPSQLInstance is running
+	SQLDatabaseConditionInstanceReady = "InstanceReady"
+)
+
+// GCPSQLDatabaseStatus defines the observed state of GCPSQLDatabase
+type GCPSQLDatabaseStatus struct {
+	// +kubebuilder:validation:Optional
+	// SelfLink is the URL of the database
+	SelfLink string `json:"selfLink,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the database
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
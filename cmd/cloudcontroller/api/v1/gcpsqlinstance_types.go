@@ -0,0 +1,150 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPSQLInstanceList contains a list of GCPSQLInstance
+// +kubebuilder:object:root=true
+type GCPSQLInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPSQLInstances
+	Items []GCPSQLInstance `json:"items"`
+}
+
+// GCPSQLInstance is the Schema for the gcpsqlinstances API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpsqlinstances,shortName=gsql,singular=gcpsqlinstance
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=".spec.databaseVersion"
+// +kubebuilder:printcolumn:name="Tier",type=string,JSONPath=".spec.tier"
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Private IP",type=string,JSONPath=".status.privateIP"
+type GCPSQLInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPSQLInstance
+	Spec GCPSQLInstanceSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPSQLInstance
+	Status GCPSQLInstanceStatus `json:"status"`
+}
+
+// GCPSQLInstanceSpec defines the desired state of GCPSQLInstance
+// +kubebuilder:validation:XValidation:rule="self.publicIP || has(self.networkRef)",message="an instance without a public IP requires a networkRef"
+type GCPSQLInstanceSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	// Name is the name of the Cloud SQL instance. Names of deleted instances cannot be reused for a week.
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="databaseVersion is immutable"
+	// DatabaseVersion is the database engine and major version, e.g. POSTGRES_16 or MYSQL_8_0
+	DatabaseVersion string `json:"databaseVersion"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	// Region of the instance
+	Region string `json:"region"`
+	// +kubebuilder:validation:Required
+	// Tier is the machine type of the instance, e.g. db-custom-2-7680. Changing it restarts the instance.
+	Tier string `json:"tier"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ZONAL;REGIONAL
+	// +kubebuilder:default=ZONAL
+	// AvailabilityType is REGIONAL for a highly available instance with a standby in another zone
+	AvailabilityType string `json:"availabilityType,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:XValidation:rule="self >= oldSelf",message="diskSizeGb cannot be decreased"
+	// DiskSizeGb is the size of the data disk. Defaults to 10GB, the disk grows automatically.
+	DiskSizeGb int64 `json:"diskSizeGb,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Backup configures the automated backups of the instance
+	Backup *SQLInstanceBackup `json:"backup,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="networkRef is immutable"
+	// NetworkRef references the GCPNetwork the instance gets a private IP in. The network needs private services
+	// access configured. The instance waits for it to be ready.
+	NetworkRef *NamespacedResourceRef `json:"networkRef,omitempty"`
+	// +kubebuilder:validation:Optional
+	// PublicIP assigns a public IP to the instance
+	PublicIP bool `json:"publicIP,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Flags are the database flags of the instance, e.g. max_connections. Some flags restart the instance.
+	Flags []SQLInstanceFlag `json:"flags,omitempty"`
+	// +kubebuilder:validation:Optional
+	// DeletionProtection prevents the deletion of the instance until it is disabled
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+}
+
+// SQLInstanceBackup defines the automated backups of the instance
+type SQLInstanceBackup struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=true
+	// Enabled enables the daily automated backups
+	Enabled bool `json:"enabled"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):00$`
+	// StartTime is the UTC hour the backup window starts at, e.g. 03:00
+	StartTime string `json:"startTime,omitempty"`
+	// +kubebuilder:validation:Optional
+	// PointInTimeRecovery keeps the transaction logs to restore the instance at any point in time
+	PointInTimeRecovery bool `json:"pointInTimeRecovery,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=365
+	// RetainedBackups is the number of automated backups kept. Defaults to 7.
+	RetainedBackups int64 `json:"retainedBackups,omitempty"`
+}
+
+// SQLInstanceFlag defines a database flag
+type SQLInstanceFlag struct {
+	// +kubebuilder:validation:Required
+	// Name of the flag
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// Value of the flag, empty for flags without value
+	Value string `json:"value,omitempty"`
+}
+
+type SQLInstanceStatus string
+
+const (
+	SQLInstanceStatusPendingCreate SQLInstanceStatus = "PENDING_CREATE"
+	SQLInstanceStatusRunnable      SQLInstanceStatus = "RUNNABLE"
+	SQLInstanceStatusSuspended     SQLInstanceStatus = "SUSPENDED"
+	SQLInstanceStatusMaintenance   SQLInstanceStatus = "MAINTENANCE"
+	SQLInstanceStatusFailed        SQLInstanceStatus = "FAILED"
+)
+
+const (
+	// SQLInstanceConditionReferencesResolved reports whether the referenced GCPNetwork is ready
+	SQLInstanceConditionReferencesResolved = "ReferencesResolved"
+)
+
+// GCPSQLInstanceStatus defines the observed state of GCPSQLInstance
+type GCPSQLInstanceStatus struct {
+	// +kubebuilder:validation:Optional
+	// Phase is the current state of the Cloud SQL instance
+	Phase SQLInstanceStatus `json:"phase,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SelfLink is the URL of the Cloud SQL instance
+	SelfLink string `json:"selfLink,omitempty"`
+	// +kubebuilder:validation:Optional
+	// ConnectionName is the project:region:instance name used by the Cloud SQL connectors
+	ConnectionName string `json:"connectionName,omitempty"`
+	// +kubebuilder:validation:Optional
+	// PrivateIP is the IP of the instance in the referenced network
+	PrivateIP string `json:"privateIP,omitempty"`
+	// +kubebuilder:validation:Optional
+	// PublicIP is the public IP of the instance, if any
+	PublicIP string `json:"publicIP,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the Cloud SQL instance
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
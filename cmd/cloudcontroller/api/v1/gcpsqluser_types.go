@@ -0,0 +1,76 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPSQLUserList contains a list of GCPSQLUser
+// +kubebuilder:object:root=true
+type GCPSQLUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPSQLUsers
+	Items []GCPSQLUser `json:"items"`
+}
+
+// GCPSQLUser is the Schema for the gcpsqlusers API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpsqlusers,shortName=gsqluser,singular=gcpsqluser
+// +kubebuilder:printcolumn:name="Instance",type=string,JSONPath=".spec.instanceRef.name"
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=".spec.name"
+// +kubebuilder:printcolumn:name="Secret",type=string,JSONPath=".status.secretName"
+type GCPSQLUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPSQLUser
+	Spec GCPSQLUserSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPSQLUser
+	Status GCPSQLUserStatus `json:"status"`
+}
+
+// GCPSQLUserSpec defines the desired state of GCPSQLUser. The password is generated by the operator and stored in
+// the credentials Secret. Deleting the Secret rotates the password.
+type GCPSQLUserSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="instanceRef is immutable"
+	// InstanceRef references the GCPSQLInstance the user is created in
+	InstanceRef ResourceRef `json:"instanceRef"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	// Name is the name of the database user
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="host is immutable"
+	// Host the MySQL user connects from, e.g. % for any host. Ignored by other engines.
+	Host string `json:"host,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="secretName is immutable"
+	// SecretName is the name of the Secret holding the credentials. Defaults to <name>-credentials, name being the
+	// name of the GCPSQLUser.
+	SecretName string `json:"secretName,omitempty"`
+}
+
+const (
+	// SQLUserConditionInstanceReady reports whether the referenced GCPSQLInstance is running
+	SQLUserConditionInstanceReady = "InstanceReady"
+)
+
+// GCPSQLUserStatus defines the observed state of GCPSQLUser
+type GCPSQLUserStatus struct {
+	// +kubebuilder:validation:Optional
+	// SecretName is the name of the Secret holding the credentials
+	SecretName string `json:"secretName,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SecretResourceVersion is the resource version of the Secret whose password is set in Cloud SQL, the password
+	// of a changed Secret is applied
+	SecretResourceVersion string `json:"secretResourceVersion,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the user
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
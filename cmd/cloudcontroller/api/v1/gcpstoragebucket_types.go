@@ -0,0 +1,175 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPStorageBucketList contains a list of GCPStorageBucket
+// +kubebuilder:object:root=true
+type GCPStorageBucketList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPStorageBuckets
+	Items []GCPStorageBucket `json:"items"`
+}
+
+// GCPStorageBucket is the Schema for the gcpstoragebuckets API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpstoragebuckets,shortName=gsb,singular=gcpstoragebucket
+// +kubebuilder:printcolumn:name="Location",type=string,JSONPath=".spec.location"
+// +kubebuilder:printcolumn:name="Storage Class",type=string,JSONPath=".spec.storageClass"
+// +kubebuilder:printcolumn:name="URL",type=string,JSONPath=".status.url"
+type GCPStorageBucket struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPStorageBucket
+	Spec GCPStorageBucketSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPStorageBucket
+	Status GCPStorageBucketStatus `json:"status"`
+}
+
+// GCPStorageBucketSpec defines the desired state of GCPStorageBucket
+type GCPStorageBucketSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	// Name is the globally unique name of the GCS bucket
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="location is immutable"
+	// Location of the bucket, a region, dual-region or multi-region, e.g. us-central1 or EU
+	Location string `json:"location"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=STANDARD;NEARLINE;COLDLINE;ARCHIVE
+	// +kubebuilder:default=STANDARD
+	// StorageClass is the default storage class of the objects of the bucket
+	StorageClass string `json:"storageClass,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Versioning keeps the noncurrent versions of overwritten and deleted objects
+	Versioning bool `json:"versioning,omitempty"`
+	// +kubebuilder:validation:Optional
+	// LifecycleRules delete objects or change their storage class once they meet the rule conditions
+	LifecycleRules []BucketLifecycleRule `json:"lifecycleRules,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=true
+	// UniformBucketLevelAccess disables object ACLs, access is granted by IAM only
+	UniformBucketLevelAccess bool `json:"uniformBucketLevelAccess"`
+	// +kubebuilder:validation:Optional
+	// RetentionPolicy prevents the deletion or overwrite of objects younger than the retention period
+	RetentionPolicy *BucketRetentionPolicy `json:"retentionPolicy,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Labels of the bucket
+	Labels map[string]string `json:"labels,omitempty"`
+	// +kubebuilder:validation:Optional
+	// KMSKeyName is the Cloud KMS key encrypting the objects of the bucket, e.g.
+	// projects/my-project/locations/us-central1/keyRings/my-ring/cryptoKeys/my-key. Google-managed keys if unset.
+	KMSKeyName string `json:"kmsKeyName,omitempty"`
+	// +kubebuilder:validation:Optional
+	// IAMBindings grant roles on the bucket. Members removed from the bindings are revoked, members granted outside
+	// of the bucket are left untouched.
+	IAMBindings []BucketIAMBinding `json:"iamBindings,omitempty"`
+	// +kubebuilder:validation:Optional
+	// ForceDestroy deletes the objects of the bucket along with it. Without it, deleting a non-empty bucket is blocked.
+	ForceDestroy bool `json:"forceDestroy,omitempty"`
+}
+
+// BucketLifecycleRule defines an action applied to the objects meeting the condition
+type BucketLifecycleRule struct {
+	// +kubebuilder:validation:Required
+	// Action applied to the objects
+	Action BucketLifecycleAction `json:"action"`
+	// +kubebuilder:validation:Required
+	// Condition the objects must meet, all set fields must match
+	Condition BucketLifecycleCondition `json:"condition"`
+}
+
+// BucketLifecycleAction defines the action of a lifecycle rule
+// +kubebuilder:validation:XValidation:rule="self.type == 'SetStorageClass' || !has(self.storageClass)",message="storageClass is only allowed for SetStorageClass"
+// +kubebuilder:validation:XValidation:rule="self.type != 'SetStorageClass' || has(self.storageClass)",message="SetStorageClass requires a storageClass"
+type BucketLifecycleAction struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Delete;SetStorageClass;AbortIncompleteMultipartUpload
+	// Type of the action
+	Type string `json:"type"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=NEARLINE;COLDLINE;ARCHIVE
+	// StorageClass the objects are moved to
+	StorageClass string `json:"storageClass,omitempty"`
+}
+
+// BucketLifecycleCondition defines the condition of a lifecycle rule
+type BucketLifecycleCondition struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// Age of the objects in days
+	Age *int64 `json:"age,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Format=date
+	// CreatedBefore matches the objects created before the date, e.g. 2025-01-31
+	CreatedBefore string `json:"createdBefore,omitempty"`
+	// +kubebuilder:validation:Optional
+	// IsLive matches the live objects if true, the noncurrent versions if false
+	IsLive *bool `json:"isLive,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// NumNewerVersions matches the noncurrent versions having at least this many newer versions
+	NumNewerVersions int64 `json:"numNewerVersions,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// DaysSinceNoncurrentTime matches the versions noncurrent for at least this many days
+	DaysSinceNoncurrentTime int64 `json:"daysSinceNoncurrentTime,omitempty"`
+	// +kubebuilder:validation:Optional
+	// MatchesStorageClass matches the objects of the storage classes
+	MatchesStorageClass []string `json:"matchesStorageClass,omitempty"`
+	// +kubebuilder:validation:Optional
+	// MatchesPrefix matches the objects whose name starts with one of the prefixes
+	MatchesPrefix []string `json:"matchesPrefix,omitempty"`
+	// +kubebuilder:validation:Optional
+	// MatchesSuffix matches the objects whose name ends with one of the suffixes
+	MatchesSuffix []string `json:"matchesSuffix,omitempty"`
+}
+
+// BucketRetentionPolicy defines the retention policy of the bucket. The policy is never locked by the operator.
+type BucketRetentionPolicy struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// RetentionPeriod is the minimum age of the objects in seconds before they can be deleted or overwritten
+	RetentionPeriod int64 `json:"retentionPeriod"`
+}
+
+// BucketIAMBinding grants a role on the bucket to members
+type BucketIAMBinding struct {
+	// +kubebuilder:validation:Required
+	// Role granted, e.g. roles/storage.objectViewer
+	Role string `json:"role"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// Members granted the role, e.g. serviceAccount:app@my-project.iam.gserviceaccount.com or group:team@example.com
+	Members []string `json:"members"`
+}
+
+const (
+	// BucketConditionDeletionBlocked reports whether the deletion of the bucket waits for its objects to be deleted
+	BucketConditionDeletionBlocked = "DeletionBlocked"
+)
+
+// GCPStorageBucketStatus defines the observed state of GCPStorageBucket
+type GCPStorageBucketStatus struct {
+	// +kubebuilder:validation:Optional
+	// SelfLink is the URL of the GCS bucket
+	SelfLink string `json:"selfLink,omitempty"`
+	// +kubebuilder:validation:Optional
+	// URL is the gs:// URL of the bucket
+	URL string `json:"url,omitempty"`
+	// +kubebuilder:validation:Optional
+	// IAMBindings are the members of the bucket IAM policy granted by the operator
+	IAMBindings []BucketIAMBinding `json:"iamBindings,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the bucket
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
@@ -0,0 +1,152 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GCPSubnetworkList contains a list of GCPSubnetwork
+// +kubebuilder:object:root=true
+type GCPSubnetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of GCPSubnetworks
+	Items []GCPSubnetwork `json:"items"`
+}
+
+// GCPSubnetwork is the Schema for the gcpsubnetworks API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=gcpsubnetworks,shortName=gsub,singular=gcpsubnetwork
+// +kubebuilder:printcolumn:name="Region",type=string,JSONPath=".spec.region"
+// +kubebuilder:printcolumn:name="Range",type=string,JSONPath=".status.ipCidrRange"
+// +kubebuilder:printcolumn:name="Network",type=string,JSONPath=".spec.networkRef.name"
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=".status.phase"
+type GCPSubnetwork struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of GCPSubnetwork
+	Spec GCPSubnetworkSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of GCPSubnetwork
+	Status GCPSubnetworkStatus `json:"status"`
+}
+
+// GCPSubnetworkSpec defines the desired state of GCPSubnetwork
+// +kubebuilder:validation:XValidation:rule="has(self.ipCidrRange) != has(self.ipCidrRangeFrom)",message="exactly one of ipCidrRange or ipCidrRangeFrom is required"
+// +kubebuilder:validation:XValidation:rule="has(self.ipCidrRangeFrom) == has(oldSelf.ipCidrRangeFrom)",message="ipCidrRangeFrom cannot be added or removed"
+type GCPSubnetworkSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	// Name is the name of the GCP subnetwork
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="region is immutable"
+	// Region in which the GCP subnetwork resides
+	Region string `json:"region"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="networkRef is immutable"
+	// NetworkRef references the GCPNetwork the subnetwork belongs to
+	NetworkRef ResourceRef `json:"networkRef"`
+	// +kubebuilder:validation:Optional
+	// IPCidrRange is the primary range of the subnetwork, e.g. 10.0.0.0/24. It can only be expanded.
+	IPCidrRange string `json:"ipCidrRange,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ipCidrRangeFrom is immutable"
+	// IPCidrRangeFrom allocates the primary range of the subnetwork from an IPPool
+	IPCidrRangeFrom *IPPoolClaim `json:"ipCidrRangeFrom,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=rangeName
+	// SecondaryRanges are the alias IP ranges of the subnetwork, e.g. for GKE pods and services
+	SecondaryRanges []SubnetworkSecondaryRange `json:"secondaryRanges,omitempty"`
+	// +kubebuilder:validation:Optional
+	// PrivateIPGoogleAccess lets instances without external IP reach Google APIs
+	PrivateIPGoogleAccess bool `json:"privateIpGoogleAccess,omitempty"`
+	// +kubebuilder:validation:Optional
+	// FlowLogs enables the VPC flow logs of the subnetwork
+	FlowLogs *SubnetworkFlowLogs `json:"flowLogs,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=PRIVATE;REGIONAL_MANAGED_PROXY;GLOBAL_MANAGED_PROXY;PRIVATE_SERVICE_CONNECT
+	// +kubebuilder:default=PRIVATE
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="purpose is immutable"
+	// Purpose of the subnetwork
+	Purpose string `json:"purpose,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ACTIVE;BACKUP
+	// Role of a managed proxy subnetwork
+	Role string `json:"role,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Description of the subnetwork
+	Description string `json:"description,omitempty"`
+}
+
+// SubnetworkSecondaryRange is an alias IP range of the subnetwork
+type SubnetworkSecondaryRange struct {
+	// +kubebuilder:validation:Required
+	// RangeName is the name of the range, referenced e.g. by GKE clusters
+	RangeName string `json:"rangeName"`
+	// +kubebuilder:validation:Required
+	// IPCidrRange of the secondary range, e.g. 10.4.0.0/14
+	IPCidrRange string `json:"ipCidrRange"`
+}
+
+// SubnetworkFlowLogs defines the VPC flow logs of the subnetwork
+type SubnetworkFlowLogs struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=INTERVAL_5_SEC;INTERVAL_30_SEC;INTERVAL_1_MIN;INTERVAL_5_MIN;INTERVAL_10_MIN;INTERVAL_15_MIN
+	// +kubebuilder:default=INTERVAL_5_SEC
+	// AggregationInterval over which the flows are aggregated into a log entry
+	AggregationInterval string `json:"aggregationInterval,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	// +kubebuilder:default="0.5"
+	// FlowSampling is the share of the flows which are logged, from 0 to 1
+	FlowSampling string `json:"flowSampling,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=INCLUDE_ALL_METADATA;EXCLUDE_ALL_METADATA
+	// +kubebuilder:default=INCLUDE_ALL_METADATA
+	// Metadata added to the log entries
+	Metadata string `json:"metadata,omitempty"`
+}
+
+type SubnetworkStatus string
+
+const (
+	SubnetworkStatusReady    SubnetworkStatus = "READY"
+	SubnetworkStatusDraining SubnetworkStatus = "DRAINING"
+)
+
+const (
+	// SubnetworkConditionNetworkReady reports whether the referenced GCPNetwork is ready
+	SubnetworkConditionNetworkReady = "NetworkReady"
+	// SubnetworkConditionSynced reports whether the GCP subnetwork matches the spec
+	SubnetworkConditionSynced = "Synced"
+	// SubnetworkConditionRangeAllocated reports whether the primary range is allocated from the IPPool
+	SubnetworkConditionRangeAllocated = "RangeAllocated"
+)
+
+// GCPSubnetworkStatus defines the observed state of GCPSubnetwork
+type GCPSubnetworkStatus struct {
+	// +kubebuilder:validation:Optional
+	// Phase is the current state of the GCP subnetwork
+	Phase SubnetworkStatus `json:"phase,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SelfLink is the URL of the GCP subnetwork
+	SelfLink string `json:"selfLink,omitempty"`
+	// +kubebuilder:validation:Optional
+	// GatewayAddress is the gateway of the primary range
+	GatewayAddress string `json:"gatewayAddress,omitempty"`
+	// +kubebuilder:validation:Optional
+	// IPCidrRange is the current primary range of the subnetwork
+	IPCidrRange string `json:"ipCidrRange,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SecondaryRanges are the current secondary ranges of the subnetwork
+	SecondaryRanges []SubnetworkSecondaryRange `json:"secondaryRanges,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the GCP subnetwork
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
@@ -0,0 +1,91 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IPPoolList contains a list of IPPool
+// +kubebuilder:object:root=true
+type IPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Items is the list of IPPools
+	Items []IPPool `json:"items"`
+}
+
+// IPPool is the Schema for the ippools API. It hands out non-overlapping CIDR blocks to the objects claiming them.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,path=ippools,shortName=ipp,singular=ippool
+// +kubebuilder:printcolumn:name="CIDRs",type=string,JSONPath=".spec.cidrs"
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=".status.conditions[?(@.type=='Ready')].status"
+type IPPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// Spec defines the desired state of IPPool
+	Spec IPPoolSpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Status defines the observed state of IPPool
+	Status IPPoolStatus `json:"status"`
+}
+
+// IPPoolSpec defines the desired state of IPPool
+type IPPoolSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// CIDRs are the parent ranges the blocks are allocated from, e.g. 10.0.0.0/8
+	CIDRs []string `json:"cidrs"`
+	// +kubebuilder:validation:Optional
+	// Reserved ranges are never allocated, e.g. the ranges of networks not managed by the operator
+	Reserved []string `json:"reserved,omitempty"`
+}
+
+// IPPoolClaim requests a block of the given size from an IPPool
+type IPPoolClaim struct {
+	// +kubebuilder:validation:Required
+	// PoolRef references the IPPool the block is allocated from
+	PoolRef NamespacedResourceRef `json:"poolRef"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=128
+	// PrefixLength is the size of the block, e.g. 20 for a /20
+	PrefixLength int `json:"prefixLength"`
+}
+
+// IPPoolAllocation records a block allocated to an object
+type IPPoolAllocation struct {
+	// +kubebuilder:validation:Required
+	// CIDR of the allocated block
+	CIDR string `json:"cidr"`
+	// +kubebuilder:validation:Required
+	// Kind of the object the block is allocated to, e.g. GCPSubnetwork
+	Kind string `json:"kind"`
+	// +kubebuilder:validation:Required
+	// Namespace of the object the block is allocated to
+	Namespace string `json:"namespace"`
+	// +kubebuilder:validation:Required
+	// Name of the object the block is allocated to
+	Name string `json:"name"`
+	// +kubebuilder:validation:Required
+	// Range is the use of the block by the object, e.g. primary, pods, services or master
+	Range string `json:"range"`
+}
+
+const (
+	// IPPoolConditionReady reports whether the ranges of the pool are valid and contain its allocations
+	IPPoolConditionReady = "Ready"
+)
+
+// IPPoolStatus defines the observed state of IPPool
+type IPPoolStatus struct {
+	// +kubebuilder:validation:Optional
+	// Allocations are the blocks allocated from the pool
+	Allocations []IPPoolAllocation `json:"allocations,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Conditions describe the state of the pool
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ResourceRef references another benzaiten.io object in the same namespace
+type ResourceRef struct {
+	// +kubebuilder:validation:Required
+	// Name of the referenced object
+	Name string `json:"name"`
+}
+
+// NamespacedResourceRef references another benzaiten.io object, optionally in another namespace
+type NamespacedResourceRef struct {
+	// +kubebuilder:validation:Required
+	// Name of the referenced object
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// Namespace of the referenced object. Defaults to the namespace of the referencing object.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// NamespacedName returns the name of the referenced object, namespace being the one of the referencing object
+func (r NamespacedResourceRef) NamespacedName(namespace string) types.NamespacedName {
+	if r.Namespace != "" {
+		namespace = r.Namespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: r.Name}
+}
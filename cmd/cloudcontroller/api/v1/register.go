@@ -25,6 +25,54 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&GCPInstanceList{},
 		&GCPNetwork{},
 		&GCPNetworkList{},
+		&GCPDisk{},
+		&GCPDiskList{},
+		&GCPSnapshot{},
+		&GCPSnapshotList{},
+		&GCPSnapshotSchedule{},
+		&GCPSnapshotScheduleList{},
+		&GCPInstanceTemplate{},
+		&GCPInstanceTemplateList{},
+		&GCPManagedInstanceGroup{},
+		&GCPManagedInstanceGroupList{},
+		&GCPAddress{},
+		&GCPAddressList{},
+		&GCPSubnetwork{},
+		&GCPSubnetworkList{},
+		&GCPFirewallRule{},
+		&GCPFirewallRuleList{},
+		&GCPRouter{},
+		&GCPRouterList{},
+		&GCPRoute{},
+		&GCPRouteList{},
+		&IPPool{},
+		&IPPoolList{},
+		&GCPDNSZone{},
+		&GCPDNSZoneList{},
+		&GCPDNSRecordSet{},
+		&GCPDNSRecordSetList{},
+		&GCPStorageBucket{},
+		&GCPStorageBucketList{},
+		&GCPSQLInstance{},
+		&GCPSQLInstanceList{},
+		&GCPSQLDatabase{},
+		&GCPSQLDatabaseList{},
+		&GCPSQLUser{},
+		&GCPSQLUserList{},
+		&GCPPubSubTopic{},
+		&GCPPubSubTopicList{},
+		&GCPPubSubSubscription{},
+		&GCPPubSubSubscriptionList{},
+		&GCPServiceAccount{},
+		&GCPServiceAccountList{},
+		&GCPServiceAccountKey{},
+		&GCPServiceAccountKeyList{},
+		&GCPIAMPolicyMember{},
+		&GCPIAMPolicyMemberList{},
+		&GCPArtifactRepository{},
+		&GCPArtifactRepositoryList{},
+		&GCPRedisInstance{},
+		&GCPRedisInstanceList{},
 	)
 
 	metav1.AddToGroupVersion(scheme, SchemaGroupVersion)
@@ -2,10 +2,20 @@ package gcp
 
 import (
 	"context"
+	"fmt"
 	"github.com/go-logr/logr"
+	"google.golang.org/api/artifactregistry/v1"
+	"google.golang.org/api/cloudresourcemanager/v1"
 	"google.golang.org/api/compute/v1"
 	"google.golang.org/api/container/v1"
+	"google.golang.org/api/dns/v1"
+	"google.golang.org/api/iam/v1"
 	"google.golang.org/api/option"
+	"google.golang.org/api/pubsub/v1"
+	"google.golang.org/api/redis/v1"
+	"google.golang.org/api/sqladmin/v1"
+	"google.golang.org/api/storage/v1"
+	"strings"
 )
 
 type Config struct {
@@ -23,8 +33,16 @@ type Config struct {
 }
 
 type API struct {
-	Compute   ComputeService
-	Container ContainerService
+	Compute          ComputeService
+	Container        ContainerService
+	DNS              DNSService
+	Storage          StorageService
+	SQLAdmin         SQLAdminService
+	PubSub           PubSubService
+	IAM              IAMService
+	ResourceManager  ResourceManagerService
+	ArtifactRegistry ArtifactRegistryService
+	Redis            RedisService
 	Config
 }
 
@@ -50,6 +68,46 @@ func NewAPI(ctx context.Context, log logr.Logger, gcpSaFilePath string) (*API, e
 		return nil, err
 	}
 
+	dnsService, err := dns.NewService(ctx, option.WithCredentialsFile(gcpSaFilePath))
+	if err != nil {
+		return nil, err
+	}
+
+	storageService, err := storage.NewService(ctx, option.WithCredentialsFile(gcpSaFilePath))
+	if err != nil {
+		return nil, err
+	}
+
+	sqladminService, err := sqladmin.NewService(ctx, option.WithCredentialsFile(gcpSaFilePath))
+	if err != nil {
+		return nil, err
+	}
+
+	pubsubService, err := pubsub.NewService(ctx, option.WithCredentialsFile(gcpSaFilePath))
+	if err != nil {
+		return nil, err
+	}
+
+	iamService, err := iam.NewService(ctx, option.WithCredentialsFile(gcpSaFilePath))
+	if err != nil {
+		return nil, err
+	}
+
+	resourceManagerService, err := cloudresourcemanager.NewService(ctx, option.WithCredentialsFile(gcpSaFilePath))
+	if err != nil {
+		return nil, err
+	}
+
+	artifactRegistryService, err := artifactregistry.NewService(ctx, option.WithCredentialsFile(gcpSaFilePath))
+	if err != nil {
+		return nil, err
+	}
+
+	redisService, err := redis.NewService(ctx, option.WithCredentialsFile(gcpSaFilePath))
+	if err != nil {
+		return nil, err
+	}
+
 	return &API{
 		Compute: ComputeService{
 			Clients: ComputeClients{
@@ -59,6 +117,45 @@ func NewAPI(ctx context.Context, log logr.Logger, gcpSaFilePath string) (*API, e
 				Networks: &GCPNetworks{
 					NetworksService: computeService.Networks,
 				},
+				ZoneOperations: &GCPZoneOperations{
+					ZoneOperationsService: computeService.ZoneOperations,
+				},
+				Disks: &GCPDisks{
+					DisksService: computeService.Disks,
+				},
+				GlobalOperations: &GCPGlobalOperations{
+					GlobalOperationsService: computeService.GlobalOperations,
+				},
+				Snapshots: &GCPSnapshots{
+					SnapshotsService: computeService.Snapshots,
+				},
+				InstanceTemplates: &GCPInstanceTemplates{
+					InstanceTemplatesService: computeService.InstanceTemplates,
+				},
+				InstanceGroupManagers: &GCPInstanceGroupManagers{
+					InstanceGroupManagersService: computeService.InstanceGroupManagers,
+				},
+				RegionOperations: &GCPRegionOperations{
+					RegionOperationsService: computeService.RegionOperations,
+				},
+				Addresses: &GCPAddresses{
+					AddressesService: computeService.Addresses,
+				},
+				GlobalAddresses: &GCPGlobalAddresses{
+					GlobalAddressesService: computeService.GlobalAddresses,
+				},
+				Subnetworks: &GCPSubnetworks{
+					SubnetworksService: computeService.Subnetworks,
+				},
+				Firewalls: &GCPFirewalls{
+					FirewallsService: computeService.Firewalls,
+				},
+				Routers: &GCPRouters{
+					RoutersService: computeService.Routers,
+				},
+				Routes: &GCPRoutes{
+					RoutesService: computeService.Routes,
+				},
 			},
 		},
 		Container: ContainerService{
@@ -68,6 +165,92 @@ func NewAPI(ctx context.Context, log logr.Logger, gcpSaFilePath string) (*API, e
 				},
 			},
 		},
+		DNS: DNSService{
+			Clients: DNSClients{
+				ManagedZones: &GCPManagedZones{
+					ManagedZonesService: dnsService.ManagedZones,
+				},
+				ResourceRecordSets: &GCPResourceRecordSets{
+					ResourceRecordSetsService: dnsService.ResourceRecordSets,
+				},
+				ManagedZoneOperations: &GCPManagedZoneOperations{
+					ManagedZoneOperationsService: dnsService.ManagedZoneOperations,
+				},
+			},
+		},
+		Storage: StorageService{
+			Clients: StorageClients{
+				Buckets: &GCPBuckets{
+					BucketsService: storageService.Buckets,
+				},
+				Objects: &GCPObjects{
+					ObjectsService: storageService.Objects,
+				},
+			},
+		},
+		SQLAdmin: SQLAdminService{
+			Clients: SQLAdminClients{
+				Instances: &GCPSQLInstances{
+					InstancesService: sqladminService.Instances,
+				},
+				Databases: &GCPSQLDatabases{
+					DatabasesService: sqladminService.Databases,
+				},
+				Users: &GCPSQLUsers{
+					UsersService: sqladminService.Users,
+				},
+				Operations: &GCPSQLOperations{
+					OperationsService: sqladminService.Operations,
+				},
+			},
+		},
+		PubSub: PubSubService{
+			Clients: PubSubClients{
+				Topics: &GCPTopics{
+					TopicsService: pubsubService.Projects.Topics,
+				},
+				Subscriptions: &GCPSubscriptions{
+					SubscriptionsService: pubsubService.Projects.Subscriptions,
+				},
+			},
+		},
+		IAM: IAMService{
+			Clients: IAMClients{
+				ServiceAccounts: &GCPServiceAccounts{
+					ServiceAccountsService: iamService.Projects.ServiceAccounts,
+				},
+				ServiceAccountKeys: &GCPServiceAccountKeys{
+					KeysService: iamService.Projects.ServiceAccounts.Keys,
+				},
+			},
+		},
+		ResourceManager: ResourceManagerService{
+			Clients: ResourceManagerClients{
+				Projects: &GCPProjects{
+					ProjectsService: resourceManagerService.Projects,
+				},
+			},
+		},
+		ArtifactRegistry: ArtifactRegistryService{
+			Clients: ArtifactRegistryClients{
+				Repositories: &GCPRepositories{
+					RepositoriesService: artifactRegistryService.Projects.Locations.Repositories,
+				},
+				Operations: &GCPArtifactRegistryOperations{
+					OperationsService: artifactRegistryService.Projects.Locations.Operations,
+				},
+			},
+		},
+		Redis: RedisService{
+			Clients: RedisClients{
+				Instances: &GCPRedisInstances{
+					InstancesService: redisService.Projects.Locations.Instances,
+				},
+				Operations: &GCPRedisOperations{
+					OperationsService: redisService.Projects.Locations.Operations,
+				},
+			},
+		},
 		Config: config,
 	}, nil
 }
@@ -80,59 +263,67 @@ func (a *API) ListInstances(zone string) (*compute.InstanceList, error) {
 	return resp, nil
 }
 
-func (a *API) ListNetworks() (*compute.NetworkList, error) {
-	resp, err := a.Compute.Clients.Networks.List(a.ProjectId).Do()
+func (a *API) GetInstance(zone, name string) (*compute.Instance, error) {
+	resp, err := a.Compute.Clients.Instances.Get(a.ProjectId, zone, name).Do()
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
-func (a *API) GetNetwork(nid string) (*compute.Network, error) {
-	resp, err := a.Compute.Clients.Networks.Get(a.ProjectId, nid).Do()
+func (a *API) CreateInstance(zone string, instance *compute.Instance) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Instances.Insert(a.ProjectId, zone, instance).Do()
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
-func (a *API) CreateNetwork(network *compute.Network) (*compute.Operation, error) {
-	resp, err := a.Compute.Clients.Networks.Insert(a.ProjectId, network).Do()
+func (a *API) SetInstanceMetadata(zone, name string, metadata *compute.Metadata) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Instances.SetMetadata(a.ProjectId, zone, name, metadata).Do()
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
-func (a *API) DeleteNetwork(nid string) (*compute.Operation, error) {
-	resp, err := a.Compute.Clients.Networks.Delete(a.ProjectId, nid).Do()
+func (a *API) StopInstance(zone, name string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Instances.Stop(a.ProjectId, zone, name).Do()
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
-func (a *API) ListClusters(zone string) (*container.ListClustersResponse, error) {
-	resp, err := a.Container.Clients.Clusters.List(a.ProjectId, zone).Do()
+func (a *API) StartInstance(zone, name string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Instances.Start(a.ProjectId, zone, name).Do()
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
-func (a *API) GetCluster(zone, clusterName string) (*container.Cluster, error) {
-	resp, err := a.Container.Clients.Clusters.Get(a.ProjectId, zone, clusterName).Do()
+func (a *API) SetInstanceMachineType(zone, name, machineType string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Instances.SetMachineType(a.ProjectId, zone, name, &compute.InstancesSetMachineTypeRequest{
+		MachineType: fmt.Sprintf("zones/%s/machineTypes/%s", zone, machineType),
+	}).Do()
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
-func (a *API) CreateCluster(zone string, cluster *container.Cluster) (*container.Operation, error) {
-	resp, err := a.Container.Clients.Clusters.Create(a.ProjectId, zone, &container.CreateClusterRequest{
-		Cluster:   cluster,
-		Zone:      zone,
-		ProjectId: a.ProjectId,
+func (a *API) GetInstanceIamPolicy(zone, name string) (*compute.Policy, error) {
+	resp, err := a.Compute.Clients.Instances.GetIamPolicy(a.ProjectId, zone, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) SetInstanceIamPolicy(zone, name string, policy *compute.Policy) (*compute.Policy, error) {
+	resp, err := a.Compute.Clients.Instances.SetIamPolicy(a.ProjectId, zone, name, &compute.ZoneSetPolicyRequest{
+		Policy: policy,
 	}).Do()
 	if err != nil {
 		return nil, err
@@ -140,22 +331,1084 @@ func (a *API) CreateCluster(zone string, cluster *container.Cluster) (*container
 	return resp, nil
 }
 
-func (a *API) DeleteCluster(zone, clusterName string) (*container.Operation, error) {
-	resp, err := a.Container.Clients.Clusters.Delete(a.ProjectId, zone, clusterName).Do()
+func (a *API) AttachInstanceDisk(zone, name string, disk *compute.AttachedDisk) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Instances.AttachDisk(a.ProjectId, zone, name, disk).Do()
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
-func (a *API) UpdateCluster(zone, clusterName string, cu *ClusterUpdates) (*container.Operation, error) {
-	updateRequest := container.UpdateClusterRequest{
-		ClusterId: clusterName,
-		Zone:      zone,
-		ProjectId: a.ProjectId,
-		Update:    &container.ClusterUpdate{},
+func (a *API) DetachInstanceDisk(zone, name, deviceName string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Instances.DetachDisk(a.ProjectId, zone, name, deviceName).Do()
+	if err != nil {
+		return nil, err
 	}
-	resp, err := a.Container.Clients.Clusters.Update(a.ProjectId, zone, clusterName, &updateRequest).Do()
+	return resp, nil
+}
+
+func (a *API) AddInstanceAccessConfig(zone, name, networkInterface string, accessConfig *compute.AccessConfig) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Instances.AddAccessConfig(a.ProjectId, zone, name, networkInterface, accessConfig).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteInstanceAccessConfig(zone, name, accessConfig, networkInterface string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Instances.DeleteAccessConfig(a.ProjectId, zone, name, accessConfig, networkInterface).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) WaitZoneOperation(zone, operation string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.ZoneOperations.Wait(a.ProjectId, zone, operation).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetDisk(zone, name string) (*compute.Disk, error) {
+	resp, err := a.Compute.Clients.Disks.Get(a.ProjectId, zone, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateDisk(zone string, disk *compute.Disk) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Disks.Insert(a.ProjectId, zone, disk).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteDisk(zone, name string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Disks.Delete(a.ProjectId, zone, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) ResizeDisk(zone, name string, sizeGb int64) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Disks.Resize(a.ProjectId, zone, name, &compute.DisksResizeRequest{
+		SizeGb: sizeGb,
+	}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) SetDiskLabels(zone, name, fingerprint string, labels map[string]string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Disks.SetLabels(a.ProjectId, zone, name, &compute.ZoneSetLabelsRequest{
+		LabelFingerprint: fingerprint,
+		Labels:           labels,
+	}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) WaitGlobalOperation(operation string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.GlobalOperations.Wait(a.ProjectId, operation).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetSnapshot(name string) (*compute.Snapshot, error) {
+	resp, err := a.Compute.Clients.Snapshots.Get(a.ProjectId, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateSnapshot(snapshot *compute.Snapshot) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Snapshots.Insert(a.ProjectId, snapshot).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteSnapshot(name string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Snapshots.Delete(a.ProjectId, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetInstanceTemplate(name string) (*compute.InstanceTemplate, error) {
+	resp, err := a.Compute.Clients.InstanceTemplates.Get(a.ProjectId, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateInstanceTemplate(template *compute.InstanceTemplate) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.InstanceTemplates.Insert(a.ProjectId, template).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteInstanceTemplate(name string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.InstanceTemplates.Delete(a.ProjectId, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetInstanceGroupManager(zone, name string) (*compute.InstanceGroupManager, error) {
+	resp, err := a.Compute.Clients.InstanceGroupManagers.Get(a.ProjectId, zone, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateInstanceGroupManager(zone string, manager *compute.InstanceGroupManager) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.InstanceGroupManagers.Insert(a.ProjectId, zone, manager).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) PatchInstanceGroupManager(zone, name string, manager *compute.InstanceGroupManager) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.InstanceGroupManagers.Patch(a.ProjectId, zone, name, manager).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) ResizeInstanceGroupManager(zone, name string, size int64) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.InstanceGroupManagers.Resize(a.ProjectId, zone, name, size).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteInstanceGroupManager(zone, name string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.InstanceGroupManagers.Delete(a.ProjectId, zone, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) WaitRegionOperation(region, operation string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.RegionOperations.Wait(a.ProjectId, region, operation).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetAddress(region, name string) (*compute.Address, error) {
+	resp, err := a.Compute.Clients.Addresses.Get(a.ProjectId, region, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateAddress(region string, address *compute.Address) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Addresses.Insert(a.ProjectId, region, address).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteAddress(region, name string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Addresses.Delete(a.ProjectId, region, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetGlobalAddress(name string) (*compute.Address, error) {
+	resp, err := a.Compute.Clients.GlobalAddresses.Get(a.ProjectId, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateGlobalAddress(address *compute.Address) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.GlobalAddresses.Insert(a.ProjectId, address).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteGlobalAddress(name string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.GlobalAddresses.Delete(a.ProjectId, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetSubnetwork(region, name string) (*compute.Subnetwork, error) {
+	resp, err := a.Compute.Clients.Subnetworks.Get(a.ProjectId, region, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateSubnetwork(region string, subnetwork *compute.Subnetwork) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Subnetworks.Insert(a.ProjectId, region, subnetwork).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) PatchSubnetwork(region, name string, subnetwork *compute.Subnetwork) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Subnetworks.Patch(a.ProjectId, region, name, subnetwork).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) ExpandSubnetworkIpCidrRange(region, name, ipCidrRange string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Subnetworks.ExpandIpCidrRange(a.ProjectId, region, name, &compute.SubnetworksExpandIpCidrRangeRequest{IpCidrRange: ipCidrRange}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) SetSubnetworkPrivateIpGoogleAccess(region, name string, privateIpGoogleAccess bool) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Subnetworks.SetPrivateIpGoogleAccess(a.ProjectId, region, name, &compute.SubnetworksSetPrivateIpGoogleAccessRequest{PrivateIpGoogleAccess: privateIpGoogleAccess, ForceSendFields: []string{"PrivateIpGoogleAccess"}}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteSubnetwork(region, name string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Subnetworks.Delete(a.ProjectId, region, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetFirewall(name string) (*compute.Firewall, error) {
+	resp, err := a.Compute.Clients.Firewalls.Get(a.ProjectId, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateFirewall(firewall *compute.Firewall) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Firewalls.Insert(a.ProjectId, firewall).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) PatchFirewall(name string, firewall *compute.Firewall) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Firewalls.Patch(a.ProjectId, name, firewall).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteFirewall(name string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Firewalls.Delete(a.ProjectId, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetRouter(region, name string) (*compute.Router, error) {
+	resp, err := a.Compute.Clients.Routers.Get(a.ProjectId, region, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateRouter(region string, router *compute.Router) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Routers.Insert(a.ProjectId, region, router).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) PatchRouter(region, name string, router *compute.Router) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Routers.Patch(a.ProjectId, region, name, router).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteRouter(region, name string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Routers.Delete(a.ProjectId, region, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetRoute(name string) (*compute.Route, error) {
+	resp, err := a.Compute.Clients.Routes.Get(a.ProjectId, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateRoute(route *compute.Route) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Routes.Insert(a.ProjectId, route).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteRoute(name string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Routes.Delete(a.ProjectId, name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) ListNetworks() (*compute.NetworkList, error) {
+	resp, err := a.Compute.Clients.Networks.List(a.ProjectId).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetNetwork(nid string) (*compute.Network, error) {
+	resp, err := a.Compute.Clients.Networks.Get(a.ProjectId, nid).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateNetwork(network *compute.Network) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Networks.Insert(a.ProjectId, network).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteNetwork(nid string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Networks.Delete(a.ProjectId, nid).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) PatchNetwork(nid string, network *compute.Network) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Networks.Patch(a.ProjectId, nid, network).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) SwitchNetworkToCustomMode(nid string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Networks.SwitchToCustomMode(a.ProjectId, nid).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) AddNetworkPeering(network string, peering *compute.NetworkPeering) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Networks.AddPeering(a.ProjectId, network, &compute.NetworksAddPeeringRequest{NetworkPeering: peering}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) RemoveNetworkPeering(network, name string) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Networks.RemovePeering(a.ProjectId, network, &compute.NetworksRemovePeeringRequest{Name: name}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) UpdateNetworkPeering(network string, peering *compute.NetworkPeering) (*compute.Operation, error) {
+	resp, err := a.Compute.Clients.Networks.UpdatePeering(a.ProjectId, network, &compute.NetworksUpdatePeeringRequest{NetworkPeering: peering}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) ListClusters(zone string) (*container.ListClustersResponse, error) {
+	resp, err := a.Container.Clients.Clusters.List(a.ProjectId, zone).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetCluster(zone, clusterName string) (*container.Cluster, error) {
+	resp, err := a.Container.Clients.Clusters.Get(a.ProjectId, zone, clusterName).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateCluster(zone string, cluster *container.Cluster) (*container.Operation, error) {
+	resp, err := a.Container.Clients.Clusters.Create(a.ProjectId, zone, &container.CreateClusterRequest{
+		Cluster:   cluster,
+		Zone:      zone,
+		ProjectId: a.ProjectId,
+	}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteCluster(zone, clusterName string) (*container.Operation, error) {
+	resp, err := a.Container.Clients.Clusters.Delete(a.ProjectId, zone, clusterName).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) UpdateCluster(zone, clusterName string, cu *ClusterUpdates) (*container.Operation, error) {
+	updateRequest := container.UpdateClusterRequest{
+		ClusterId: clusterName,
+		Zone:      zone,
+		ProjectId: a.ProjectId,
+		Update:    &container.ClusterUpdate{},
+	}
+	resp, err := a.Container.Clients.Clusters.Update(a.ProjectId, zone, clusterName, &updateRequest).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetManagedZone(zoneName string) (*dns.ManagedZone, error) {
+	resp, err := a.DNS.Clients.ManagedZones.Get(a.ProjectId, zoneName).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateManagedZone(managedZone *dns.ManagedZone) (*dns.ManagedZone, error) {
+	resp, err := a.DNS.Clients.ManagedZones.Create(a.ProjectId, managedZone).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) PatchManagedZone(zoneName string, managedZone *dns.ManagedZone) (*dns.Operation, error) {
+	resp, err := a.DNS.Clients.ManagedZones.Patch(a.ProjectId, zoneName, managedZone).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetManagedZoneOperation(zoneName, operation string) (*dns.Operation, error) {
+	resp, err := a.DNS.Clients.ManagedZoneOperations.Get(a.ProjectId, zoneName, operation).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteManagedZone(zoneName string) error {
+	return a.DNS.Clients.ManagedZones.Delete(a.ProjectId, zoneName).Do()
+}
+
+func (a *API) GetResourceRecordSet(zoneName, name, rrsetType string) (*dns.ResourceRecordSet, error) {
+	resp, err := a.DNS.Clients.ResourceRecordSets.Get(a.ProjectId, zoneName, name, rrsetType).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateResourceRecordSet(zoneName string, rrset *dns.ResourceRecordSet) (*dns.ResourceRecordSet, error) {
+	resp, err := a.DNS.Clients.ResourceRecordSets.Create(a.ProjectId, zoneName, rrset).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) PatchResourceRecordSet(zoneName string, rrset *dns.ResourceRecordSet) (*dns.ResourceRecordSet, error) {
+	resp, err := a.DNS.Clients.ResourceRecordSets.Patch(a.ProjectId, zoneName, rrset.Name, rrset.Type, rrset).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteResourceRecordSet(zoneName, name, rrsetType string) error {
+	_, err := a.DNS.Clients.ResourceRecordSets.Delete(a.ProjectId, zoneName, name, rrsetType).Do()
+	return err
+}
+
+func (a *API) GetBucket(bucketName string) (*storage.Bucket, error) {
+	resp, err := a.Storage.Clients.Buckets.Get(bucketName).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateBucket(bucket *storage.Bucket) (*storage.Bucket, error) {
+	resp, err := a.Storage.Clients.Buckets.Insert(a.ProjectId, bucket).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) PatchBucket(bucketName string, bucket *storage.Bucket) (*storage.Bucket, error) {
+	resp, err := a.Storage.Clients.Buckets.Patch(bucketName, bucket).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteBucket(bucketName string) error {
+	return a.Storage.Clients.Buckets.Delete(bucketName).Do()
+}
+
+func (a *API) GetBucketIamPolicy(bucketName string) (*storage.Policy, error) {
+	resp, err := a.Storage.Clients.Buckets.GetIamPolicy(bucketName).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) SetBucketIamPolicy(bucketName string, policy *storage.Policy) (*storage.Policy, error) {
+	resp, err := a.Storage.Clients.Buckets.SetIamPolicy(bucketName, policy).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) ListObjects(bucketName, pageToken string) (*storage.Objects, error) {
+	resp, err := a.Storage.Clients.Objects.List(bucketName, pageToken).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteObject(bucketName, objectName string, generation int64) error {
+	return a.Storage.Clients.Objects.Delete(bucketName, objectName, generation).Do()
+}
+
+func (a *API) GetSQLInstance(instanceName string) (*sqladmin.DatabaseInstance, error) {
+	resp, err := a.SQLAdmin.Clients.Instances.Get(a.ProjectId, instanceName).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateSQLInstance(instance *sqladmin.DatabaseInstance) (*sqladmin.Operation, error) {
+	resp, err := a.SQLAdmin.Clients.Instances.Insert(a.ProjectId, instance).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) PatchSQLInstance(instanceName string, instance *sqladmin.DatabaseInstance) (*sqladmin.Operation, error) {
+	resp, err := a.SQLAdmin.Clients.Instances.Patch(a.ProjectId, instanceName, instance).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteSQLInstance(instanceName string) (*sqladmin.Operation, error) {
+	resp, err := a.SQLAdmin.Clients.Instances.Delete(a.ProjectId, instanceName).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetSQLOperation(operation string) (*sqladmin.Operation, error) {
+	resp, err := a.SQLAdmin.Clients.Operations.Get(a.ProjectId, operation).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetSQLDatabase(instanceName, databaseName string) (*sqladmin.Database, error) {
+	resp, err := a.SQLAdmin.Clients.Databases.Get(a.ProjectId, instanceName, databaseName).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateSQLDatabase(instanceName string, database *sqladmin.Database) (*sqladmin.Operation, error) {
+	resp, err := a.SQLAdmin.Clients.Databases.Insert(a.ProjectId, instanceName, database).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteSQLDatabase(instanceName, databaseName string) (*sqladmin.Operation, error) {
+	resp, err := a.SQLAdmin.Clients.Databases.Delete(a.ProjectId, instanceName, databaseName).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetSQLUser(instanceName, userName, host string) (*sqladmin.User, error) {
+	resp, err := a.SQLAdmin.Clients.Users.Get(a.ProjectId, instanceName, userName, host).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateSQLUser(instanceName string, user *sqladmin.User) (*sqladmin.Operation, error) {
+	resp, err := a.SQLAdmin.Clients.Users.Insert(a.ProjectId, instanceName, user).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) UpdateSQLUser(instanceName string, user *sqladmin.User) (*sqladmin.Operation, error) {
+	resp, err := a.SQLAdmin.Clients.Users.Update(a.ProjectId, instanceName, user).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteSQLUser(instanceName, userName, host string) (*sqladmin.Operation, error) {
+	resp, err := a.SQLAdmin.Clients.Users.Delete(a.ProjectId, instanceName, userName, host).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetTopic(topicName string) (*pubsub.Topic, error) {
+	resp, err := a.PubSub.Clients.Topics.Get(a.ProjectId, topicName).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateTopic(topicName string, topic *pubsub.Topic) (*pubsub.Topic, error) {
+	resp, err := a.PubSub.Clients.Topics.Create(a.ProjectId, topicName, topic).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PatchTopic updates the fields of the topic listed in the update mask
+func (a *API) PatchTopic(topicName string, topic *pubsub.Topic, updateMask []string) (*pubsub.Topic, error) {
+	topic.Name = TopicPath(a.ProjectId, topicName)
+	resp, err := a.PubSub.Clients.Topics.Patch(a.ProjectId, topicName, &pubsub.UpdateTopicRequest{
+		Topic:      topic,
+		UpdateMask: strings.Join(updateMask, ","),
+	}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteTopic(topicName string) error {
+	_, err := a.PubSub.Clients.Topics.Delete(a.ProjectId, topicName).Do()
+	return err
+}
+
+func (a *API) GetSubscription(subscriptionName string) (*pubsub.Subscription, error) {
+	resp, err := a.PubSub.Clients.Subscriptions.Get(a.ProjectId, subscriptionName).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateSubscription(subscriptionName string, subscription *pubsub.Subscription) (*pubsub.Subscription, error) {
+	resp, err := a.PubSub.Clients.Subscriptions.Create(a.ProjectId, subscriptionName, subscription).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PatchSubscription updates the fields of the subscription listed in the update mask
+func (a *API) PatchSubscription(subscriptionName string, subscription *pubsub.Subscription, updateMask []string) (*pubsub.Subscription, error) {
+	subscription.Name = SubscriptionPath(a.ProjectId, subscriptionName)
+	resp, err := a.PubSub.Clients.Subscriptions.Patch(a.ProjectId, subscriptionName, &pubsub.UpdateSubscriptionRequest{
+		Subscription: subscription,
+		UpdateMask:   strings.Join(updateMask, ","),
+	}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteSubscription(subscriptionName string) error {
+	_, err := a.PubSub.Clients.Subscriptions.Delete(a.ProjectId, subscriptionName).Do()
+	return err
+}
+
+// ServiceAccountEmail returns the email of the service account of the project
+func (a *API) ServiceAccountEmail(accountID string) string {
+	return fmt.Sprintf("%s@%s.iam.gserviceaccount.com", accountID, a.ProjectId)
+}
+
+func (a *API) GetServiceAccount(accountID string) (*iam.ServiceAccount, error) {
+	resp, err := a.IAM.Clients.ServiceAccounts.Get(a.ProjectId, a.ServiceAccountEmail(accountID)).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateServiceAccount(accountID string, sa *iam.ServiceAccount) (*iam.ServiceAccount, error) {
+	resp, err := a.IAM.Clients.ServiceAccounts.Create(a.ProjectId, &iam.CreateServiceAccountRequest{
+		AccountId:      accountID,
+		ServiceAccount: sa,
+	}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PatchServiceAccount updates the fields of the service account listed in the update mask
+func (a *API) PatchServiceAccount(accountID string, sa *iam.ServiceAccount, updateMask []string) (*iam.ServiceAccount, error) {
+	resp, err := a.IAM.Clients.ServiceAccounts.Patch(a.ProjectId, a.ServiceAccountEmail(accountID), &iam.PatchServiceAccountRequest{
+		ServiceAccount: sa,
+		UpdateMask:     strings.Join(updateMask, ","),
+	}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteServiceAccount(accountID string) error {
+	_, err := a.IAM.Clients.ServiceAccounts.Delete(a.ProjectId, a.ServiceAccountEmail(accountID)).Do()
+	return err
+}
+
+func (a *API) GetServiceAccountKey(accountID, keyID string) (*iam.ServiceAccountKey, error) {
+	resp, err := a.IAM.Clients.ServiceAccountKeys.Get(a.ProjectId, a.ServiceAccountEmail(accountID), keyID).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateServiceAccountKey creates a key of the service account, the private key data is the base64 encoded JSON
+// credentials file
+func (a *API) CreateServiceAccountKey(accountID string) (*iam.ServiceAccountKey, error) {
+	resp, err := a.IAM.Clients.ServiceAccountKeys.Create(a.ProjectId, a.ServiceAccountEmail(accountID), &iam.CreateServiceAccountKeyRequest{
+		PrivateKeyType: "TYPE_GOOGLE_CREDENTIALS_FILE",
+	}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteServiceAccountKey(accountID, keyID string) error {
+	_, err := a.IAM.Clients.ServiceAccountKeys.Delete(a.ProjectId, a.ServiceAccountEmail(accountID), keyID).Do()
+	return err
+}
+
+func (a *API) GetServiceAccountIamPolicy(accountID string) (*iam.Policy, error) {
+	resp, err := a.IAM.Clients.ServiceAccounts.GetIamPolicy(a.ProjectId, a.ServiceAccountEmail(accountID)).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) SetServiceAccountIamPolicy(accountID string, policy *iam.Policy) (*iam.Policy, error) {
+	resp, err := a.IAM.Clients.ServiceAccounts.SetIamPolicy(a.ProjectId, a.ServiceAccountEmail(accountID), &iam.SetIamPolicyRequest{
+		Policy: policy,
+	}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetTopicIamPolicy(topicName string) (*pubsub.Policy, error) {
+	resp, err := a.PubSub.Clients.Topics.GetIamPolicy(a.ProjectId, topicName).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) SetTopicIamPolicy(topicName string, policy *pubsub.Policy) (*pubsub.Policy, error) {
+	resp, err := a.PubSub.Clients.Topics.SetIamPolicy(a.ProjectId, topicName, &pubsub.SetIamPolicyRequest{
+		Policy: policy,
+	}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetProjectIamPolicy() (*cloudresourcemanager.Policy, error) {
+	resp, err := a.ResourceManager.Clients.Projects.GetIamPolicy(a.ProjectId).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetProjectIamPolicy replaces the bindings of the project IAM policy, the audit configs are left untouched
+func (a *API) SetProjectIamPolicy(policy *cloudresourcemanager.Policy) (*cloudresourcemanager.Policy, error) {
+	resp, err := a.ResourceManager.Clients.Projects.SetIamPolicy(a.ProjectId, &cloudresourcemanager.SetIamPolicyRequest{
+		Policy:     policy,
+		UpdateMask: "bindings,etag",
+	}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// iamPolicyVersion is the version IAM policies are requested in. Version 3 is the only version holding conditional
+// bindings, a policy read in a lower version and written back loses their conditions.
+const iamPolicyVersion = 3
+
+// IAMResourceKind is a kind of GCP resource holding an IAM policy
+type IAMResourceKind string
+
+const (
+	IAMResourceProject        IAMResourceKind = "Project"
+	IAMResourceBucket         IAMResourceKind = "Bucket"
+	IAMResourceServiceAccount IAMResourceKind = "ServiceAccount"
+	IAMResourceTopic          IAMResourceKind = "Topic"
+)
+
+// GetIamPolicy returns the IAM policy of the named resource of the kind, the name is ignored for the project. The
+// policies of every kind are returned as IAM policies.
+func (a *API) GetIamPolicy(kind IAMResourceKind, name string) (*iam.Policy, error) {
+	var resp any
+	var err error
+	switch kind {
+	case IAMResourceProject:
+		resp, err = a.GetProjectIamPolicy()
+	case IAMResourceBucket:
+		resp, err = a.GetBucketIamPolicy(name)
+	case IAMResourceServiceAccount:
+		return a.GetServiceAccountIamPolicy(name)
+	case IAMResourceTopic:
+		resp, err = a.GetTopicIamPolicy(name)
+	default:
+		return nil, fmt.Errorf("unsupported IAM resource kind %s", kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &iam.Policy{}
+	err = convertIamPolicy(resp, policy)
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// SetIamPolicy replaces the IAM policy of the named resource of the kind. The write is rejected if the etag of the
+// policy no longer matches the current policy.
+func (a *API) SetIamPolicy(kind IAMResourceKind, name string, policy *iam.Policy) error {
+	var err error
+	switch kind {
+	case IAMResourceProject:
+		p := &cloudresourcemanager.Policy{}
+		if err = convertIamPolicy(policy, p); err == nil {
+			_, err = a.SetProjectIamPolicy(p)
+		}
+	case IAMResourceBucket:
+		p := &storage.Policy{}
+		if err = convertIamPolicy(policy, p); err == nil {
+			_, err = a.SetBucketIamPolicy(name, p)
+		}
+	case IAMResourceServiceAccount:
+		_, err = a.SetServiceAccountIamPolicy(name, policy)
+	case IAMResourceTopic:
+		p := &pubsub.Policy{}
+		if err = convertIamPolicy(policy, p); err == nil {
+			_, err = a.SetTopicIamPolicy(name, p)
+		}
+	default:
+		err = fmt.Errorf("unsupported IAM resource kind %s", kind)
+	}
+	return err
+}
+
+func (a *API) GetRepository(location, repositoryName string) (*artifactregistry.Repository, error) {
+	resp, err := a.ArtifactRegistry.Clients.Repositories.Get(a.ProjectId, location, repositoryName).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateRepository(location, repositoryName string, repository *artifactregistry.Repository) (*artifactregistry.Operation, error) {
+	resp, err := a.ArtifactRegistry.Clients.Repositories.Create(a.ProjectId, location, repositoryName, repository).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PatchRepository updates the fields of the repository listed in the update mask
+func (a *API) PatchRepository(location, repositoryName string, repository *artifactregistry.Repository, updateMask []string) (*artifactregistry.Repository, error) {
+	resp, err := a.ArtifactRegistry.Clients.Repositories.Patch(a.ProjectId, location, repositoryName, repository, strings.Join(updateMask, ",")).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteRepository(location, repositoryName string) (*artifactregistry.Operation, error) {
+	resp, err := a.ArtifactRegistry.Clients.Repositories.Delete(a.ProjectId, location, repositoryName).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetRepositoryIamPolicy(location, repositoryName string) (*artifactregistry.Policy, error) {
+	resp, err := a.ArtifactRegistry.Clients.Repositories.GetIamPolicy(a.ProjectId, location, repositoryName).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) SetRepositoryIamPolicy(location, repositoryName string, policy *artifactregistry.Policy) (*artifactregistry.Policy, error) {
+	resp, err := a.ArtifactRegistry.Clients.Repositories.SetIamPolicy(a.ProjectId, location, repositoryName, &artifactregistry.SetIamPolicyRequest{
+		Policy: policy,
+	}).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetArtifactRegistryOperation returns the long-running operation, operation being its resource name
+func (a *API) GetArtifactRegistryOperation(operation string) (*artifactregistry.Operation, error) {
+	resp, err := a.ArtifactRegistry.Clients.Operations.Get(operation).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) GetRedisInstance(region, instanceName string) (*redis.Instance, error) {
+	resp, err := a.Redis.Clients.Instances.Get(a.ProjectId, region, instanceName).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) CreateRedisInstance(region, instanceName string, instance *redis.Instance) (*redis.Operation, error) {
+	resp, err := a.Redis.Clients.Instances.Create(a.ProjectId, region, instanceName, instance).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PatchRedisInstance updates the fields of the instance listed in the update mask
+func (a *API) PatchRedisInstance(region, instanceName string, instance *redis.Instance, updateMask []string) (*redis.Operation, error) {
+	resp, err := a.Redis.Clients.Instances.Patch(a.ProjectId, region, instanceName, instance, strings.Join(updateMask, ",")).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (a *API) DeleteRedisInstance(region, instanceName string) (*redis.Operation, error) {
+	resp, err := a.Redis.Clients.Instances.Delete(a.ProjectId, region, instanceName).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetRedisAuthString returns the AUTH string of an instance with auth enabled
+func (a *API) GetRedisAuthString(region, instanceName string) (string, error) {
+	resp, err := a.Redis.Clients.Instances.GetAuthString(a.ProjectId, region, instanceName).Do()
+	if err != nil {
+		return "", err
+	}
+	return resp.AuthString, nil
+}
+
+// GetRedisOperation returns the long-running operation, operation being its resource name
+func (a *API) GetRedisOperation(operation string) (*redis.Operation, error) {
+	resp, err := a.Redis.Clients.Operations.Get(operation).Do()
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,15 @@
 package gcp
 
 import (
+	"google.golang.org/api/artifactregistry/v1"
+	"google.golang.org/api/cloudresourcemanager/v1"
 	"google.golang.org/api/container/v1"
+	"google.golang.org/api/dns/v1"
+	"google.golang.org/api/iam/v1"
+	"google.golang.org/api/pubsub/v1"
+	"google.golang.org/api/redis/v1"
+	"google.golang.org/api/sqladmin/v1"
+	"google.golang.org/api/storage/v1"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -465,3 +473,1618 @@ func TestGetCluster(t *testing.T) {
 		t.Errorf("Expected cluster %v, got %v", expectedCluster, cluster)
 	}
 }
+
+func TestGetInstance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockInstancesInterface := NewMockInstancesInterface(ctrl)
+	mockGetInstancesInterface := NewMockGetInstancesInterface(ctrl)
+
+	// Set up expectations
+	expectedInstance := &compute.Instance{
+		Name:   "test-instance",
+		Status: "RUNNING",
+	}
+
+	// Expect the Get method to be called with the correct parameters and return the mock GetInstancesInterface
+	mockInstancesInterface.EXPECT().
+		Get(projectID, zone, "test-instance").
+		Return(mockGetInstancesInterface)
+
+	// Expect the Do method to be called and return the expected instance
+	mockGetInstancesInterface.EXPECT().
+		Do().
+		Return(expectedInstance, nil)
+
+	// Create the API instance with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Instances: mockInstancesInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	instance, err := api.GetInstance(zone, "test-instance")
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("GetInstance returned an error: %v", err)
+	}
+
+	if instance != expectedInstance {
+		t.Errorf("Expected instance %v, got %v", expectedInstance, instance)
+	}
+}
+
+func TestCreateInstance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockInstancesInterface := NewMockInstancesInterface(ctrl)
+	mockCreateInstancesInterface := NewMockCreateInstancesInterface(ctrl)
+
+	// Set up expectations
+	expectedOperation := &compute.Operation{
+		Name: "test-operation",
+	}
+
+	// Expect the Insert method to be called with the correct parameters and return the mock CreateInstancesInterface
+	mockInstancesInterface.EXPECT().
+		Insert(projectID, zone, gomock.Any()).
+		Return(mockCreateInstancesInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockCreateInstancesInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API instance with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Instances: mockInstancesInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	operation, err := api.CreateInstance(zone, &compute.Instance{
+		Name: "test-instance",
+	})
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("CreateInstance returned an error: %v", err)
+	}
+
+	if operation != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, operation)
+	}
+}
+
+func TestSetInstanceMetadata(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockInstancesInterface := NewMockInstancesInterface(ctrl)
+	mockSetMetadataInstancesInterface := NewMockSetMetadataInstancesInterface(ctrl)
+
+	// Set up expectations
+	expectedOperation := &compute.Operation{
+		Name: "test-operation",
+	}
+	script := "#!/bin/bash"
+	metadata := &compute.Metadata{
+		Fingerprint: "test-fingerprint",
+		Items: []*compute.MetadataItems{
+			{
+				Key:   "startup-script",
+				Value: &script,
+			},
+		},
+	}
+
+	// Expect the SetMetadata method to be called with the correct parameters and return the mock SetMetadataInstancesInterface
+	mockInstancesInterface.EXPECT().
+		SetMetadata(projectID, zone, "test-instance", metadata).
+		Return(mockSetMetadataInstancesInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockSetMetadataInstancesInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API instance with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Instances: mockInstancesInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	operation, err := api.SetInstanceMetadata(zone, "test-instance", metadata)
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("SetInstanceMetadata returned an error: %v", err)
+	}
+
+	if operation != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, operation)
+	}
+}
+
+func TestSetInstanceIamPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockInstancesInterface := NewMockInstancesInterface(ctrl)
+	mockSetIamPolicyInstancesInterface := NewMockSetIamPolicyInstancesInterface(ctrl)
+
+	// Set up expectations
+	policy := &compute.Policy{
+		Etag: "test-etag",
+		Bindings: []*compute.Binding{
+			{
+				Role:    "roles/compute.osLogin",
+				Members: []string{"user:jane@example.com"},
+			},
+		},
+	}
+
+	// Expect the SetIamPolicy method to be called with the policy wrapped in the request
+	mockInstancesInterface.EXPECT().
+		SetIamPolicy(projectID, zone, "test-instance", &compute.ZoneSetPolicyRequest{Policy: policy}).
+		Return(mockSetIamPolicyInstancesInterface)
+
+	// Expect the Do method to be called and return the updated policy
+	mockSetIamPolicyInstancesInterface.EXPECT().
+		Do().
+		Return(policy, nil)
+
+	// Create the API instance with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Instances: mockInstancesInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	updated, err := api.SetInstanceIamPolicy(zone, "test-instance", policy)
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("SetInstanceIamPolicy returned an error: %v", err)
+	}
+
+	if updated != policy {
+		t.Errorf("Expected policy %v, got %v", policy, updated)
+	}
+}
+
+func TestSetInstanceMachineType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockInstancesInterface := NewMockInstancesInterface(ctrl)
+	mockSetMachineTypeInstancesInterface := NewMockSetMachineTypeInstancesInterface(ctrl)
+
+	// Set up expectations
+	expectedOperation := &compute.Operation{
+		Name: "test-operation",
+	}
+
+	// Expect the SetMachineType method to be called with the zonal machine type
+	mockInstancesInterface.EXPECT().
+		SetMachineType(projectID, zone, "test-instance", &compute.InstancesSetMachineTypeRequest{
+			MachineType: "zones/" + zone + "/machineTypes/e2-standard-4",
+		}).
+		Return(mockSetMachineTypeInstancesInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockSetMachineTypeInstancesInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API instance with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Instances: mockInstancesInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	operation, err := api.SetInstanceMachineType(zone, "test-instance", "e2-standard-4")
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("SetInstanceMachineType returned an error: %v", err)
+	}
+
+	if operation != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, operation)
+	}
+}
+
+func TestGetDisk(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockDisksInterface := NewMockDisksInterface(ctrl)
+	mockGetDisksInterface := NewMockGetDisksInterface(ctrl)
+
+	// Set up expectations
+	expectedDisk := &compute.Disk{
+		Name:   "test-disk",
+		SizeGb: 10,
+	}
+
+	// Expect the Get method to be called with the correct parameters and return the mock GetDisksInterface
+	mockDisksInterface.EXPECT().
+		Get(projectID, zone, "test-disk").
+		Return(mockGetDisksInterface)
+
+	// Expect the Do method to be called and return the expected disk
+	mockGetDisksInterface.EXPECT().
+		Do().
+		Return(expectedDisk, nil)
+
+	// Create the API disk with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Disks: mockDisksInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	disk, err := api.GetDisk(zone, "test-disk")
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("GetDisk returned an error: %v", err)
+	}
+
+	if disk != expectedDisk {
+		t.Errorf("Expected disk %v, got %v", expectedDisk, disk)
+	}
+}
+
+func TestResizeDisk(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockDisksInterface := NewMockDisksInterface(ctrl)
+	mockResizeDisksInterface := NewMockResizeDisksInterface(ctrl)
+
+	// Set up expectations
+	expectedOperation := &compute.Operation{
+		Name: "test-operation",
+	}
+
+	// Expect the Resize method to be called with the new size
+	mockDisksInterface.EXPECT().
+		Resize(projectID, zone, "test-disk", &compute.DisksResizeRequest{SizeGb: 20}).
+		Return(mockResizeDisksInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockResizeDisksInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API disk with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Disks: mockDisksInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	operation, err := api.ResizeDisk(zone, "test-disk", 20)
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("ResizeDisk returned an error: %v", err)
+	}
+
+	if operation != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, operation)
+	}
+}
+
+func TestGetSnapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockSnapshotsInterface := NewMockSnapshotsInterface(ctrl)
+	mockGetSnapshotsInterface := NewMockGetSnapshotsInterface(ctrl)
+
+	// Set up expectations
+	expectedSnapshot := &compute.Snapshot{
+		Name:         "test-snapshot",
+		Status:       "READY",
+		StorageBytes: 1024,
+	}
+
+	// Expect the Get method to be called with the correct parameters and return the mock GetSnapshotsInterface
+	mockSnapshotsInterface.EXPECT().
+		Get(projectID, "test-snapshot").
+		Return(mockGetSnapshotsInterface)
+
+	// Expect the Do method to be called and return the expected snapshot
+	mockGetSnapshotsInterface.EXPECT().
+		Do().
+		Return(expectedSnapshot, nil)
+
+	// Create the API snapshot with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Snapshots: mockSnapshotsInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	snapshot, err := api.GetSnapshot("test-snapshot")
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("GetSnapshot returned an error: %v", err)
+	}
+
+	if snapshot != expectedSnapshot {
+		t.Errorf("Expected snapshot %v, got %v", expectedSnapshot, snapshot)
+	}
+}
+
+func TestCreateSnapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockSnapshotsInterface := NewMockSnapshotsInterface(ctrl)
+	mockCreateSnapshotsInterface := NewMockCreateSnapshotsInterface(ctrl)
+
+	// Set up expectations
+	snapshot := &compute.Snapshot{
+		Name:       "test-snapshot",
+		SourceDisk: "zones/test-zone/disks/test-disk",
+	}
+	expectedOperation := &compute.Operation{
+		Name: "test-operation",
+	}
+
+	// Expect the Insert method to be called with the snapshot
+	mockSnapshotsInterface.EXPECT().
+		Insert(projectID, snapshot).
+		Return(mockCreateSnapshotsInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockCreateSnapshotsInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API snapshot with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Snapshots: mockSnapshotsInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	op, err := api.CreateSnapshot(snapshot)
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("CreateSnapshot returned an error: %v", err)
+	}
+
+	if op != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, op)
+	}
+}
+
+func TestCreateInstanceTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockInstanceTemplatesInterface := NewMockInstanceTemplatesInterface(ctrl)
+	mockCreateInstanceTemplatesInterface := NewMockCreateInstanceTemplatesInterface(ctrl)
+
+	// Set up expectations
+	template := &compute.InstanceTemplate{
+		Name: "test-template-0a1b2c3d",
+		Properties: &compute.InstanceProperties{
+			MachineType: "e2-medium",
+		},
+	}
+	expectedOperation := &compute.Operation{
+		Name: "test-operation",
+	}
+
+	// Expect the Insert method to be called with the template
+	mockInstanceTemplatesInterface.EXPECT().
+		Insert(projectID, template).
+		Return(mockCreateInstanceTemplatesInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockCreateInstanceTemplatesInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API instance template with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				InstanceTemplates: mockInstanceTemplatesInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	op, err := api.CreateInstanceTemplate(template)
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("CreateInstanceTemplate returned an error: %v", err)
+	}
+
+	if op != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, op)
+	}
+}
+
+func TestResizeInstanceGroupManager(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockInstanceGroupManagersInterface := NewMockInstanceGroupManagersInterface(ctrl)
+	mockResizeInstanceGroupManagersInterface := NewMockResizeInstanceGroupManagersInterface(ctrl)
+
+	// Set up expectations
+	expectedOperation := &compute.Operation{
+		Name: "test-operation",
+	}
+
+	// Expect the Resize method to be called with the new size
+	mockInstanceGroupManagersInterface.EXPECT().
+		Resize(projectID, zone, "test-mig", int64(3)).
+		Return(mockResizeInstanceGroupManagersInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockResizeInstanceGroupManagersInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API instance group manager with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				InstanceGroupManagers: mockInstanceGroupManagersInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	op, err := api.ResizeInstanceGroupManager(zone, "test-mig", 3)
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("ResizeInstanceGroupManager returned an error: %v", err)
+	}
+
+	if op != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, op)
+	}
+}
+
+func TestGetAddress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockAddressesInterface := NewMockAddressesInterface(ctrl)
+	mockGetAddressesInterface := NewMockGetAddressesInterface(ctrl)
+
+	// Set up expectations
+	expectedAddress := &compute.Address{
+		Name:    "test-address",
+		Address: "34.1.2.3",
+		Status:  "RESERVED",
+	}
+
+	// Expect the Get method to be called with the correct parameters and return the mock GetAddressesInterface
+	mockAddressesInterface.EXPECT().
+		Get(projectID, "test-region", "test-address").
+		Return(mockGetAddressesInterface)
+
+	// Expect the Do method to be called and return the expected address
+	mockGetAddressesInterface.EXPECT().
+		Do().
+		Return(expectedAddress, nil)
+
+	// Create the API address with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Addresses: mockAddressesInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	address, err := api.GetAddress("test-region", "test-address")
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("GetAddress returned an error: %v", err)
+	}
+
+	if address != expectedAddress {
+		t.Errorf("Expected address %v, got %v", expectedAddress, address)
+	}
+}
+
+func TestCreateGlobalAddress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockGlobalAddressesInterface := NewMockGlobalAddressesInterface(ctrl)
+	mockCreateGlobalAddressesInterface := NewMockCreateGlobalAddressesInterface(ctrl)
+
+	// Set up expectations
+	address := &compute.Address{
+		Name:        "test-address",
+		AddressType: "EXTERNAL",
+	}
+	expectedOperation := &compute.Operation{
+		Name: "test-operation",
+	}
+
+	// Expect the Insert method to be called with the address
+	mockGlobalAddressesInterface.EXPECT().
+		Insert(projectID, address).
+		Return(mockCreateGlobalAddressesInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockCreateGlobalAddressesInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API global address with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				GlobalAddresses: mockGlobalAddressesInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	op, err := api.CreateGlobalAddress(address)
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("CreateGlobalAddress returned an error: %v", err)
+	}
+
+	if op != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, op)
+	}
+}
+
+func TestGetSubnetwork(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockSubnetworksInterface := NewMockSubnetworksInterface(ctrl)
+	mockGetSubnetworksInterface := NewMockGetSubnetworksInterface(ctrl)
+
+	// Set up expectations
+	expectedSubnetwork := &compute.Subnetwork{
+		Name:        "test-subnetwork",
+		IpCidrRange: "10.0.0.0/24",
+		State:       "READY",
+	}
+
+	// Expect the Get method to be called with the correct parameters and return the mock GetSubnetworksInterface
+	mockSubnetworksInterface.EXPECT().
+		Get(projectID, "test-region", "test-subnetwork").
+		Return(mockGetSubnetworksInterface)
+
+	// Expect the Do method to be called and return the expected subnetwork
+	mockGetSubnetworksInterface.EXPECT().
+		Do().
+		Return(expectedSubnetwork, nil)
+
+	// Create the API subnetwork with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Subnetworks: mockSubnetworksInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	subnetwork, err := api.GetSubnetwork("test-region", "test-subnetwork")
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("GetSubnetwork returned an error: %v", err)
+	}
+
+	if subnetwork != expectedSubnetwork {
+		t.Errorf("Expected subnetwork %v, got %v", expectedSubnetwork, subnetwork)
+	}
+}
+
+func TestExpandSubnetworkIpCidrRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockSubnetworksInterface := NewMockSubnetworksInterface(ctrl)
+	mockExpandIpCidrRangeSubnetworksInterface := NewMockExpandIpCidrRangeSubnetworksInterface(ctrl)
+
+	// Set up expectations
+	req := &compute.SubnetworksExpandIpCidrRangeRequest{
+		IpCidrRange: "10.0.0.0/20",
+	}
+	expectedOperation := &compute.Operation{
+		Name: "test-operation",
+	}
+
+	// Expect the ExpandIpCidrRange method to be called with the new range
+	mockSubnetworksInterface.EXPECT().
+		ExpandIpCidrRange(projectID, "test-region", "test-subnetwork", req).
+		Return(mockExpandIpCidrRangeSubnetworksInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockExpandIpCidrRangeSubnetworksInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API subnetwork with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Subnetworks: mockSubnetworksInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	op, err := api.ExpandSubnetworkIpCidrRange("test-region", "test-subnetwork", "10.0.0.0/20")
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("ExpandSubnetworkIpCidrRange returned an error: %v", err)
+	}
+
+	if op != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, op)
+	}
+}
+
+func TestGetFirewall(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockFirewallsInterface := NewMockFirewallsInterface(ctrl)
+	mockGetFirewallsInterface := NewMockGetFirewallsInterface(ctrl)
+
+	// Set up expectations
+	expectedFirewall := &compute.Firewall{
+		Name:      "test-firewall",
+		Direction: "INGRESS",
+		Priority:  1000,
+	}
+
+	// Expect the Get method to be called with the correct parameters and return the mock GetFirewallsInterface
+	mockFirewallsInterface.EXPECT().
+		Get(projectID, "test-firewall").
+		Return(mockGetFirewallsInterface)
+
+	// Expect the Do method to be called and return the expected firewall
+	mockGetFirewallsInterface.EXPECT().
+		Do().
+		Return(expectedFirewall, nil)
+
+	// Create the API firewall with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Firewalls: mockFirewallsInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	firewall, err := api.GetFirewall("test-firewall")
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("GetFirewall returned an error: %v", err)
+	}
+
+	if firewall != expectedFirewall {
+		t.Errorf("Expected firewall %v, got %v", expectedFirewall, firewall)
+	}
+}
+
+func TestPatchFirewall(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockFirewallsInterface := NewMockFirewallsInterface(ctrl)
+	mockPatchFirewallsInterface := NewMockPatchFirewallsInterface(ctrl)
+
+	// Set up expectations
+	firewall := &compute.Firewall{
+		Name:     "test-firewall",
+		Disabled: true,
+	}
+	expectedOperation := &compute.Operation{
+		Name: "test-operation",
+	}
+
+	// Expect the Patch method to be called with the firewall
+	mockFirewallsInterface.EXPECT().
+		Patch(projectID, "test-firewall", firewall).
+		Return(mockPatchFirewallsInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockPatchFirewallsInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API firewall with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Firewalls: mockFirewallsInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	op, err := api.PatchFirewall("test-firewall", firewall)
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("PatchFirewall returned an error: %v", err)
+	}
+
+	if op != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, op)
+	}
+}
+
+func TestGetRouter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockRoutersInterface := NewMockRoutersInterface(ctrl)
+	mockGetRoutersInterface := NewMockGetRoutersInterface(ctrl)
+
+	// Set up expectations
+	expectedRouter := &compute.Router{
+		Name: "test-router",
+		Bgp: &compute.RouterBgp{
+			Asn: 64512,
+		},
+	}
+
+	// Expect the Get method to be called with the correct parameters and return the mock GetRoutersInterface
+	mockRoutersInterface.EXPECT().
+		Get(projectID, "test-region", "test-router").
+		Return(mockGetRoutersInterface)
+
+	// Expect the Do method to be called and return the expected router
+	mockGetRoutersInterface.EXPECT().
+		Do().
+		Return(expectedRouter, nil)
+
+	// Create the API router with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Routers: mockRoutersInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	router, err := api.GetRouter("test-region", "test-router")
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("GetRouter returned an error: %v", err)
+	}
+
+	if router != expectedRouter {
+		t.Errorf("Expected router %v, got %v", expectedRouter, router)
+	}
+}
+
+func TestPatchRouter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockRoutersInterface := NewMockRoutersInterface(ctrl)
+	mockPatchRoutersInterface := NewMockPatchRoutersInterface(ctrl)
+
+	// Set up expectations
+	router := &compute.Router{
+		Nats: []*compute.RouterNat{
+			{
+				Name:                "test-nat",
+				NatIpAllocateOption: "AUTO_ONLY",
+			},
+		},
+	}
+	expectedOperation := &compute.Operation{
+		Name: "test-operation",
+	}
+
+	// Expect the Patch method to be called with the router
+	mockRoutersInterface.EXPECT().
+		Patch(projectID, "test-region", "test-router", router).
+		Return(mockPatchRoutersInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockPatchRoutersInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API router with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Routers: mockRoutersInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	op, err := api.PatchRouter("test-region", "test-router", router)
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("PatchRouter returned an error: %v", err)
+	}
+
+	if op != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, op)
+	}
+}
+
+func TestAddNetworkPeering(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockNetworksInterface := NewMockNetworksInterface(ctrl)
+	mockAddPeeringNetworksInterface := NewMockAddPeeringNetworksInterface(ctrl)
+
+	// Set up expectations
+	peering := &compute.NetworkPeering{
+		Name:                 "test-peering",
+		Network:              "projects/other-project/global/networks/other-network",
+		ExchangeSubnetRoutes: true,
+	}
+	expectedOperation := &compute.Operation{
+		Name: "test-operation",
+	}
+
+	// Expect the AddPeering method to be called with the peering
+	mockNetworksInterface.EXPECT().
+		AddPeering(projectID, networkID, &compute.NetworksAddPeeringRequest{NetworkPeering: peering}).
+		Return(mockAddPeeringNetworksInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockAddPeeringNetworksInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API network with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Networks: mockNetworksInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	op, err := api.AddNetworkPeering(networkID, peering)
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("AddNetworkPeering returned an error: %v", err)
+	}
+
+	if op != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, op)
+	}
+}
+
+func TestRemoveNetworkPeering(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockNetworksInterface := NewMockNetworksInterface(ctrl)
+	mockRemovePeeringNetworksInterface := NewMockRemovePeeringNetworksInterface(ctrl)
+
+	// Set up expectations
+	expectedOperation := &compute.Operation{
+		Name: "test-operation",
+	}
+
+	// Expect the RemovePeering method to be called with the peering name
+	mockNetworksInterface.EXPECT().
+		RemovePeering(projectID, networkID, &compute.NetworksRemovePeeringRequest{Name: "test-peering"}).
+		Return(mockRemovePeeringNetworksInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockRemovePeeringNetworksInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API network with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Networks: mockNetworksInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	op, err := api.RemoveNetworkPeering(networkID, "test-peering")
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("RemoveNetworkPeering returned an error: %v", err)
+	}
+
+	if op != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, op)
+	}
+}
+
+func TestCreateRoute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockRoutesInterface := NewMockRoutesInterface(ctrl)
+	mockCreateRoutesInterface := NewMockCreateRoutesInterface(ctrl)
+
+	// Set up expectations
+	route := &compute.Route{
+		Name:           "test-route",
+		Network:        "global/networks/test-network",
+		DestRange:      "0.0.0.0/0",
+		NextHopGateway: "global/gateways/default-internet-gateway",
+	}
+	expectedOperation := &compute.Operation{
+		Name: "test-operation",
+	}
+
+	// Expect the Insert method to be called with the route
+	mockRoutesInterface.EXPECT().
+		Insert(projectID, route).
+		Return(mockCreateRoutesInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockCreateRoutesInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API route with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Routes: mockRoutesInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	op, err := api.CreateRoute(route)
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("CreateRoute returned an error: %v", err)
+	}
+
+	if op != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, op)
+	}
+}
+
+func TestSwitchNetworkToCustomMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockNetworksInterface := NewMockNetworksInterface(ctrl)
+	mockSwitchToCustomModeNetworksInterface := NewMockSwitchToCustomModeNetworksInterface(ctrl)
+
+	// Set up expectations
+	expectedOperation := &compute.Operation{
+		Name: "test-operation",
+	}
+
+	// Expect the SwitchToCustomMode method to be called with the network
+	mockNetworksInterface.EXPECT().
+		SwitchToCustomMode(projectID, networkID).
+		Return(mockSwitchToCustomModeNetworksInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockSwitchToCustomModeNetworksInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API network with the mock
+	api := &API{
+		Compute: ComputeService{
+			Clients: ComputeClients{
+				Networks: mockNetworksInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	op, err := api.SwitchNetworkToCustomMode(networkID)
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("SwitchNetworkToCustomMode returned an error: %v", err)
+	}
+
+	if op != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, op)
+	}
+}
+
+func TestPatchResourceRecordSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockResourceRecordSetsInterface := NewMockResourceRecordSetsInterface(ctrl)
+	mockPatchResourceRecordSetsInterface := NewMockPatchResourceRecordSetsInterface(ctrl)
+
+	// Set up expectations
+	rrset := &dns.ResourceRecordSet{
+		Name:    "vm.example.com.",
+		Type:    "A",
+		Ttl:     300,
+		Rrdatas: []string{"10.0.0.2"},
+	}
+
+	// Expect the Patch method to be called with the name and type of the record set
+	mockResourceRecordSetsInterface.EXPECT().
+		Patch(projectID, "test-zone", "vm.example.com.", "A", rrset).
+		Return(mockPatchResourceRecordSetsInterface)
+
+	// Expect the Do method to be called and return the patched record set
+	mockPatchResourceRecordSetsInterface.EXPECT().
+		Do().
+		Return(rrset, nil)
+
+	// Create the API DNS with the mock
+	api := &API{
+		DNS: DNSService{
+			Clients: DNSClients{
+				ResourceRecordSets: mockResourceRecordSetsInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	resp, err := api.PatchResourceRecordSet("test-zone", rrset)
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("PatchResourceRecordSet returned an error: %v", err)
+	}
+
+	if resp != rrset {
+		t.Errorf("Expected record set %v, got %v", rrset, resp)
+	}
+}
+
+func TestListObjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockObjectsInterface := NewMockObjectsInterface(ctrl)
+	mockListObjectsInterface := NewMockListObjectsInterface(ctrl)
+
+	// Set up expectations
+	expectedObjects := &storage.Objects{
+		Items: []*storage.Object{
+			{
+				Name:       "test-object",
+				Generation: 1,
+			},
+		},
+		NextPageToken: "test-token",
+	}
+
+	// Expect the List method to be called with the bucket and page token
+	mockObjectsInterface.EXPECT().
+		List("test-bucket", "").
+		Return(mockListObjectsInterface)
+
+	// Expect the Do method to be called and return the expected objects
+	mockListObjectsInterface.EXPECT().
+		Do().
+		Return(expectedObjects, nil)
+
+	// Create the API storage with the mock
+	api := &API{
+		Storage: StorageService{
+			Clients: StorageClients{
+				Objects: mockObjectsInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	objects, err := api.ListObjects("test-bucket", "")
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("ListObjects returned an error: %v", err)
+	}
+
+	if objects != expectedObjects {
+		t.Errorf("Expected objects %v, got %v", expectedObjects, objects)
+	}
+}
+
+func TestUpdateSQLUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockUsersInterface := NewMockSQLUsersInterface(ctrl)
+	mockUpdateUsersInterface := NewMockUpdateSQLUsersInterface(ctrl)
+
+	// Set up expectations
+	user := &sqladmin.User{
+		Name:     "test-user",
+		Host:     "%",
+		Password: "test-password",
+	}
+	expectedOperation := &sqladmin.Operation{
+		Name:   "test-operation",
+		Status: "PENDING",
+	}
+
+	// Expect the Update method to be called with the project, instance and user
+	mockUsersInterface.EXPECT().
+		Update(projectID, "test-instance", user).
+		Return(mockUpdateUsersInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockUpdateUsersInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API sql admin with the mock
+	api := &API{
+		SQLAdmin: SQLAdminService{
+			Clients: SQLAdminClients{
+				Users: mockUsersInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	operation, err := api.UpdateSQLUser("test-instance", user)
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("UpdateSQLUser returned an error: %v", err)
+	}
+
+	if operation != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, operation)
+	}
+}
+
+func TestPatchSubscription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockSubscriptionsInterface := NewMockSubscriptionsInterface(ctrl)
+	mockPatchSubscriptionsInterface := NewMockPatchSubscriptionsInterface(ctrl)
+
+	// Set up expectations
+	subscription := &pubsub.Subscription{
+		AckDeadlineSeconds: 60,
+	}
+	expectedSubscription := &pubsub.Subscription{
+		Name:               "projects/test-project/subscriptions/test-subscription",
+		AckDeadlineSeconds: 60,
+	}
+
+	// Expect the Patch method to be called with the named subscription and the joined update mask
+	mockSubscriptionsInterface.EXPECT().
+		Patch(projectID, "test-subscription", &pubsub.UpdateSubscriptionRequest{
+			Subscription: &pubsub.Subscription{
+				Name:               "projects/test-project/subscriptions/test-subscription",
+				AckDeadlineSeconds: 60,
+			},
+			UpdateMask: "ackDeadlineSeconds,labels",
+		}).
+		Return(mockPatchSubscriptionsInterface)
+
+	// Expect the Do method to be called and return the expected subscription
+	mockPatchSubscriptionsInterface.EXPECT().
+		Do().
+		Return(expectedSubscription, nil)
+
+	// Create the API pubsub with the mock
+	api := &API{
+		PubSub: PubSubService{
+			Clients: PubSubClients{
+				Subscriptions: mockSubscriptionsInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	result, err := api.PatchSubscription("test-subscription", subscription, []string{"ackDeadlineSeconds", "labels"})
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("PatchSubscription returned an error: %v", err)
+	}
+
+	if result != expectedSubscription {
+		t.Errorf("Expected subscription %v, got %v", expectedSubscription, result)
+	}
+}
+
+func TestCreateServiceAccountKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockServiceAccountKeysInterface := NewMockServiceAccountKeysInterface(ctrl)
+	mockCreateServiceAccountKeysInterface := NewMockCreateServiceAccountKeysInterface(ctrl)
+
+	// Set up expectations
+	expectedKey := &iam.ServiceAccountKey{
+		Name:           "projects/test-project/serviceAccounts/test-account@test-project.iam.gserviceaccount.com/keys/abc123",
+		PrivateKeyData: "e30=",
+	}
+
+	// Expect the Create method to be called with the service account email and a JSON credentials file key type
+	mockServiceAccountKeysInterface.EXPECT().
+		Create(projectID, "test-account@test-project.iam.gserviceaccount.com", &iam.CreateServiceAccountKeyRequest{
+			PrivateKeyType: "TYPE_GOOGLE_CREDENTIALS_FILE",
+		}).
+		Return(mockCreateServiceAccountKeysInterface)
+
+	// Expect the Do method to be called and return the expected key
+	mockCreateServiceAccountKeysInterface.EXPECT().
+		Do().
+		Return(expectedKey, nil)
+
+	// Create the API iam with the mock
+	api := &API{
+		IAM: IAMService{
+			Clients: IAMClients{
+				ServiceAccountKeys: mockServiceAccountKeysInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	result, err := api.CreateServiceAccountKey("test-account")
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("CreateServiceAccountKey returned an error: %v", err)
+	}
+
+	if result != expectedKey {
+		t.Errorf("Expected key %v, got %v", expectedKey, result)
+	}
+}
+
+func TestSetIamPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockProjectsInterface := NewMockProjectsInterface(ctrl)
+	mockSetIamPolicyProjectsInterface := NewMockSetIamPolicyProjectsInterface(ctrl)
+
+	// Set up expectations
+	policy := &iam.Policy{
+		Etag:    "BwXhqDZd6eY=",
+		Version: 3,
+		Bindings: []*iam.Binding{
+			{
+				Role:      "roles/viewer",
+				Members:   []string{"user:jane@example.com"},
+				Condition: &iam.Expr{Title: "expires", Expression: `request.time < timestamp("2025-01-01T00:00:00Z")`},
+			},
+		},
+	}
+
+	// Expect the SetIamPolicy method to be called with the policy converted to a project policy, leaving the audit
+	// configs untouched
+	mockProjectsInterface.EXPECT().
+		SetIamPolicy(projectID, &cloudresourcemanager.SetIamPolicyRequest{
+			Policy: &cloudresourcemanager.Policy{
+				Etag:    "BwXhqDZd6eY=",
+				Version: 3,
+				Bindings: []*cloudresourcemanager.Binding{
+					{
+						Role:      "roles/viewer",
+						Members:   []string{"user:jane@example.com"},
+						Condition: &cloudresourcemanager.Expr{Title: "expires", Expression: `request.time < timestamp("2025-01-01T00:00:00Z")`},
+					},
+				},
+			},
+			UpdateMask: "bindings,etag",
+		}).
+		Return(mockSetIamPolicyProjectsInterface)
+
+	// Expect the Do method to be called and return the written policy
+	mockSetIamPolicyProjectsInterface.EXPECT().
+		Do().
+		Return(&cloudresourcemanager.Policy{}, nil)
+
+	// Create the API resource manager with the mock
+	api := &API{
+		ResourceManager: ResourceManagerService{
+			Clients: ResourceManagerClients{
+				Projects: mockProjectsInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	err := api.SetIamPolicy(IAMResourceProject, "", policy)
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("SetIamPolicy returned an error: %v", err)
+	}
+}
+
+func TestCreateRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockRepositoriesInterface := NewMockRepositoriesInterface(ctrl)
+	mockCreateRepositoriesInterface := NewMockCreateRepositoriesInterface(ctrl)
+
+	// Set up expectations
+	repository := &artifactregistry.Repository{
+		Format:       "DOCKER",
+		DockerConfig: &artifactregistry.DockerRepositoryConfig{ImmutableTags: true},
+	}
+	expectedOperation := &artifactregistry.Operation{
+		Name: "projects/test-project/locations/us-central1/operations/test-operation",
+	}
+
+	// Expect the Create method to be called with the location and repository ID
+	mockRepositoriesInterface.EXPECT().
+		Create(projectID, "us-central1", "test-repository", repository).
+		Return(mockCreateRepositoriesInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockCreateRepositoriesInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API artifact registry with the mock
+	api := &API{
+		ArtifactRegistry: ArtifactRegistryService{
+			Clients: ArtifactRegistryClients{
+				Repositories: mockRepositoriesInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	result, err := api.CreateRepository("us-central1", "test-repository", repository)
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("CreateRepository returned an error: %v", err)
+	}
+
+	if result != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, result)
+	}
+}
+
+func TestPatchRedisInstance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Create mocks
+	mockRedisInstancesInterface := NewMockRedisInstancesInterface(ctrl)
+	mockPatchRedisInstancesInterface := NewMockPatchRedisInstancesInterface(ctrl)
+
+	// Set up expectations
+	instance := &redis.Instance{
+		MemorySizeGb: 5,
+		AuthEnabled:  true,
+	}
+	expectedOperation := &redis.Operation{
+		Name: "projects/test-project/locations/us-central1/operations/test-operation",
+	}
+
+	// Expect the Patch method to be called with the joined update mask
+	mockRedisInstancesInterface.EXPECT().
+		Patch(projectID, "us-central1", "test-instance", instance, "memorySizeGb,authEnabled").
+		Return(mockPatchRedisInstancesInterface)
+
+	// Expect the Do method to be called and return the expected operation
+	mockPatchRedisInstancesInterface.EXPECT().
+		Do().
+		Return(expectedOperation, nil)
+
+	// Create the API redis with the mock
+	api := &API{
+		Redis: RedisService{
+			Clients: RedisClients{
+				Instances: mockRedisInstancesInterface,
+			},
+		},
+		Config: Config{
+			ProjectId: projectID,
+		},
+	}
+
+	// Call the function under test
+	result, err := api.PatchRedisInstance("us-central1", "test-instance", instance, []string{"memorySizeGb", "authEnabled"})
+
+	// Verify the results
+	if err != nil {
+		t.Fatalf("PatchRedisInstance returned an error: %v", err)
+	}
+
+	if result != expectedOperation {
+		t.Errorf("Expected operation %v, got %v", expectedOperation, result)
+	}
+}
@@ -0,0 +1,189 @@
+package gcp
+
+import (
+	"fmt"
+	"google.golang.org/api/artifactregistry/v1"
+	"google.golang.org/api/googleapi"
+)
+
+//===============================================================================================
+// TYPES AND INTERFACES
+//===============================================================================================
+
+// Services
+type (
+	ArtifactRegistryService struct {
+		Clients ArtifactRegistryClients
+	}
+)
+
+// Clients
+type (
+	ArtifactRegistryClients struct {
+		Repositories RepositoriesInterface
+		Operations   ArtifactRegistryOperationsInterface
+	}
+)
+
+// Resources
+type (
+	// artifact registry resources
+	GCPRepositories struct {
+		RepositoriesService *artifactregistry.ProjectsLocationsRepositoriesService
+	}
+	GCPArtifactRegistryOperations struct {
+		OperationsService *artifactregistry.ProjectsLocationsOperationsService
+	}
+)
+
+// Interfaces
+type (
+	// artifact registry interfaces
+	//// repositories
+	RepositoriesInterface interface {
+		Get(project, location, repository string) GetRepositoriesInterface
+		Create(project, location, repository string, repositoryResource *artifactregistry.Repository) CreateRepositoriesInterface
+		Patch(project, location, repository string, repositoryResource *artifactregistry.Repository, updateMask string) PatchRepositoriesInterface
+		Delete(project, location, repository string) DeleteRepositoriesInterface
+		GetIamPolicy(project, location, repository string) GetIamPolicyRepositoriesInterface
+		SetIamPolicy(project, location, repository string, request *artifactregistry.SetIamPolicyRequest) SetIamPolicyRepositoriesInterface
+	}
+	//// operations
+	ArtifactRegistryOperationsInterface interface {
+		Get(operation string) GetArtifactRegistryOperationsInterface
+	}
+)
+
+// Requests
+type (
+	// artifact registry do interfaces
+	//// repositories
+	GetRepositoriesInterface interface {
+		Do(opts ...googleapi.CallOption) (*artifactregistry.Repository, error)
+	}
+	CreateRepositoriesInterface interface {
+		Do(opts ...googleapi.CallOption) (*artifactregistry.Operation, error)
+	}
+	PatchRepositoriesInterface interface {
+		Do(opts ...googleapi.CallOption) (*artifactregistry.Repository, error)
+	}
+	DeleteRepositoriesInterface interface {
+		Do(opts ...googleapi.CallOption) (*artifactregistry.Operation, error)
+	}
+	GetIamPolicyRepositoriesInterface interface {
+		Do(opts ...googleapi.CallOption) (*artifactregistry.Policy, error)
+	}
+	SetIamPolicyRepositoriesInterface interface {
+		Do(opts ...googleapi.CallOption) (*artifactregistry.Policy, error)
+	}
+	//// operations
+	GetArtifactRegistryOperationsInterface interface {
+		Do(opts ...googleapi.CallOption) (*artifactregistry.Operation, error)
+	}
+)
+
+// Executor requests
+type (
+	// artifact registry google calls
+	//// repositories
+	GetRepositoriesRequest struct {
+		googleCall *artifactregistry.ProjectsLocationsRepositoriesGetCall
+	}
+	CreateRepositoriesRequest struct {
+		googleCall *artifactregistry.ProjectsLocationsRepositoriesCreateCall
+	}
+	PatchRepositoriesRequest struct {
+		googleCall *artifactregistry.ProjectsLocationsRepositoriesPatchCall
+	}
+	DeleteRepositoriesRequest struct {
+		googleCall *artifactregistry.ProjectsLocationsRepositoriesDeleteCall
+	}
+	GetIamPolicyRepositoriesRequest struct {
+		googleCall *artifactregistry.ProjectsLocationsRepositoriesGetIamPolicyCall
+	}
+	SetIamPolicyRepositoriesRequest struct {
+		googleCall *artifactregistry.ProjectsLocationsRepositoriesSetIamPolicyCall
+	}
+	//// operations
+	GetArtifactRegistryOperationsRequest struct {
+		googleCall *artifactregistry.ProjectsLocationsOperationsGetCall
+	}
+)
+
+// ===============================================================================================
+// FUNCTIONS
+// ===============================================================================================
+// Verbs
+// // Artifact Registry
+// ///// Repositories
+func (r *GCPRepositories) Get(projectID, location, repository string) GetRepositoriesInterface {
+	return &GetRepositoriesRequest{
+		googleCall: r.RepositoriesService.Get(RepositoryPath(projectID, location, repository)),
+	}
+}
+func (r *GCPRepositories) Create(projectID, location, repository string, repositoryResource *artifactregistry.Repository) CreateRepositoriesInterface {
+	return &CreateRepositoriesRequest{
+		googleCall: r.RepositoriesService.Create(fmt.Sprintf("projects/%s/locations/%s", projectID, location), repositoryResource).RepositoryId(repository),
+	}
+}
+func (r *GCPRepositories) Patch(projectID, location, repository string, repositoryResource *artifactregistry.Repository, updateMask string) PatchRepositoriesInterface {
+	return &PatchRepositoriesRequest{
+		googleCall: r.RepositoriesService.Patch(RepositoryPath(projectID, location, repository), repositoryResource).UpdateMask(updateMask),
+	}
+}
+func (r *GCPRepositories) Delete(projectID, location, repository string) DeleteRepositoriesInterface {
+	return &DeleteRepositoriesRequest{
+		googleCall: r.RepositoriesService.Delete(RepositoryPath(projectID, location, repository)),
+	}
+}
+
+func (r *GCPRepositories) GetIamPolicy(projectID, location, repository string) GetIamPolicyRepositoriesInterface {
+	return &GetIamPolicyRepositoriesRequest{
+		googleCall: r.RepositoriesService.GetIamPolicy(RepositoryPath(projectID, location, repository)).OptionsRequestedPolicyVersion(iamPolicyVersion),
+	}
+}
+func (r *GCPRepositories) SetIamPolicy(projectID, location, repository string, request *artifactregistry.SetIamPolicyRequest) SetIamPolicyRepositoriesInterface {
+	return &SetIamPolicyRepositoriesRequest{
+		googleCall: r.RepositoriesService.SetIamPolicy(RepositoryPath(projectID, location, repository), request),
+	}
+}
+
+// ///// Operations
+func (o *GCPArtifactRegistryOperations) Get(operation string) GetArtifactRegistryOperationsInterface {
+	return &GetArtifactRegistryOperationsRequest{
+		googleCall: o.OperationsService.Get(operation),
+	}
+}
+
+// RepositoryPath returns the resource name of the repository, e.g.
+// projects/my-project/locations/us-central1/repositories/my-repo
+func RepositoryPath(projectID, location, repository string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/repositories/%s", projectID, location, repository)
+}
+
+// Execs
+// // Artifact Registry
+// //// Repositories
+func (lc *GetRepositoriesRequest) Do(opts ...googleapi.CallOption) (*artifactregistry.Repository, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateRepositoriesRequest) Do(opts ...googleapi.CallOption) (*artifactregistry.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *PatchRepositoriesRequest) Do(opts ...googleapi.CallOption) (*artifactregistry.Repository, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteRepositoriesRequest) Do(opts ...googleapi.CallOption) (*artifactregistry.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *GetIamPolicyRepositoriesRequest) Do(opts ...googleapi.CallOption) (*artifactregistry.Policy, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *SetIamPolicyRepositoriesRequest) Do(opts ...googleapi.CallOption) (*artifactregistry.Policy, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Operations
+func (lc *GetArtifactRegistryOperationsRequest) Do(opts ...googleapi.CallOption) (*artifactregistry.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
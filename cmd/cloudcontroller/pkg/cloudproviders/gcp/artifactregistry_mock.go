@@ -0,0 +1,451 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/cloudproviders/gcp/artifactregistry_client.go
+
+// Package gcp is a generated GoMock package.
+package gcp
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "google.golang.org/api/artifactregistry/v1"
+	googleapi "google.golang.org/api/googleapi"
+)
+
+// MockRepositoriesInterface is a mock of RepositoriesInterface interface.
+type MockRepositoriesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoriesInterfaceMockRecorder
+}
+
+// MockRepositoriesInterfaceMockRecorder is the mock recorder for MockRepositoriesInterface.
+type MockRepositoriesInterfaceMockRecorder struct {
+	mock *MockRepositoriesInterface
+}
+
+// NewMockRepositoriesInterface creates a new mock instance.
+func NewMockRepositoriesInterface(ctrl *gomock.Controller) *MockRepositoriesInterface {
+	mock := &MockRepositoriesInterface{ctrl: ctrl}
+	mock.recorder = &MockRepositoriesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepositoriesInterface) EXPECT() *MockRepositoriesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRepositoriesInterface) Create(project, location, repository string, repositoryResource *v1.Repository) CreateRepositoriesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", project, location, repository, repositoryResource)
+	ret0, _ := ret[0].(CreateRepositoriesInterface)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoriesInterfaceMockRecorder) Create(project, location, repository, repositoryResource interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepositoriesInterface)(nil).Create), project, location, repository, repositoryResource)
+}
+
+// Delete mocks base method.
+func (m *MockRepositoriesInterface) Delete(project, location, repository string) DeleteRepositoriesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, location, repository)
+	ret0, _ := ret[0].(DeleteRepositoriesInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRepositoriesInterfaceMockRecorder) Delete(project, location, repository interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepositoriesInterface)(nil).Delete), project, location, repository)
+}
+
+// Get mocks base method.
+func (m *MockRepositoriesInterface) Get(project, location, repository string) GetRepositoriesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, location, repository)
+	ret0, _ := ret[0].(GetRepositoriesInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRepositoriesInterfaceMockRecorder) Get(project, location, repository interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRepositoriesInterface)(nil).Get), project, location, repository)
+}
+
+// GetIamPolicy mocks base method.
+func (m *MockRepositoriesInterface) GetIamPolicy(project, location, repository string) GetIamPolicyRepositoriesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIamPolicy", project, location, repository)
+	ret0, _ := ret[0].(GetIamPolicyRepositoriesInterface)
+	return ret0
+}
+
+// GetIamPolicy indicates an expected call of GetIamPolicy.
+func (mr *MockRepositoriesInterfaceMockRecorder) GetIamPolicy(project, location, repository interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIamPolicy", reflect.TypeOf((*MockRepositoriesInterface)(nil).GetIamPolicy), project, location, repository)
+}
+
+// Patch mocks base method.
+func (m *MockRepositoriesInterface) Patch(project, location, repository string, repositoryResource *v1.Repository, updateMask string) PatchRepositoriesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", project, location, repository, repositoryResource, updateMask)
+	ret0, _ := ret[0].(PatchRepositoriesInterface)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockRepositoriesInterfaceMockRecorder) Patch(project, location, repository, repositoryResource, updateMask interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockRepositoriesInterface)(nil).Patch), project, location, repository, repositoryResource, updateMask)
+}
+
+// SetIamPolicy mocks base method.
+func (m *MockRepositoriesInterface) SetIamPolicy(project, location, repository string, request *v1.SetIamPolicyRequest) SetIamPolicyRepositoriesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIamPolicy", project, location, repository, request)
+	ret0, _ := ret[0].(SetIamPolicyRepositoriesInterface)
+	return ret0
+}
+
+// SetIamPolicy indicates an expected call of SetIamPolicy.
+func (mr *MockRepositoriesInterfaceMockRecorder) SetIamPolicy(project, location, repository, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIamPolicy", reflect.TypeOf((*MockRepositoriesInterface)(nil).SetIamPolicy), project, location, repository, request)
+}
+
+// MockArtifactRegistryOperationsInterface is a mock of ArtifactRegistryOperationsInterface interface.
+type MockArtifactRegistryOperationsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockArtifactRegistryOperationsInterfaceMockRecorder
+}
+
+// MockArtifactRegistryOperationsInterfaceMockRecorder is the mock recorder for MockArtifactRegistryOperationsInterface.
+type MockArtifactRegistryOperationsInterfaceMockRecorder struct {
+	mock *MockArtifactRegistryOperationsInterface
+}
+
+// NewMockArtifactRegistryOperationsInterface creates a new mock instance.
+func NewMockArtifactRegistryOperationsInterface(ctrl *gomock.Controller) *MockArtifactRegistryOperationsInterface {
+	mock := &MockArtifactRegistryOperationsInterface{ctrl: ctrl}
+	mock.recorder = &MockArtifactRegistryOperationsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArtifactRegistryOperationsInterface) EXPECT() *MockArtifactRegistryOperationsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockArtifactRegistryOperationsInterface) Get(operation string) GetArtifactRegistryOperationsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", operation)
+	ret0, _ := ret[0].(GetArtifactRegistryOperationsInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockArtifactRegistryOperationsInterfaceMockRecorder) Get(operation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockArtifactRegistryOperationsInterface)(nil).Get), operation)
+}
+
+// MockGetRepositoriesInterface is a mock of GetRepositoriesInterface interface.
+type MockGetRepositoriesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetRepositoriesInterfaceMockRecorder
+}
+
+// MockGetRepositoriesInterfaceMockRecorder is the mock recorder for MockGetRepositoriesInterface.
+type MockGetRepositoriesInterfaceMockRecorder struct {
+	mock *MockGetRepositoriesInterface
+}
+
+// NewMockGetRepositoriesInterface creates a new mock instance.
+func NewMockGetRepositoriesInterface(ctrl *gomock.Controller) *MockGetRepositoriesInterface {
+	mock := &MockGetRepositoriesInterface{ctrl: ctrl}
+	mock.recorder = &MockGetRepositoriesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetRepositoriesInterface) EXPECT() *MockGetRepositoriesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetRepositoriesInterface) Do(opts ...googleapi.CallOption) (*v1.Repository, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetRepositoriesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetRepositoriesInterface)(nil).Do), opts...)
+}
+
+// MockCreateRepositoriesInterface is a mock of CreateRepositoriesInterface interface.
+type MockCreateRepositoriesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateRepositoriesInterfaceMockRecorder
+}
+
+// MockCreateRepositoriesInterfaceMockRecorder is the mock recorder for MockCreateRepositoriesInterface.
+type MockCreateRepositoriesInterfaceMockRecorder struct {
+	mock *MockCreateRepositoriesInterface
+}
+
+// NewMockCreateRepositoriesInterface creates a new mock instance.
+func NewMockCreateRepositoriesInterface(ctrl *gomock.Controller) *MockCreateRepositoriesInterface {
+	mock := &MockCreateRepositoriesInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateRepositoriesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateRepositoriesInterface) EXPECT() *MockCreateRepositoriesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateRepositoriesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateRepositoriesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateRepositoriesInterface)(nil).Do), opts...)
+}
+
+// MockPatchRepositoriesInterface is a mock of PatchRepositoriesInterface interface.
+type MockPatchRepositoriesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPatchRepositoriesInterfaceMockRecorder
+}
+
+// MockPatchRepositoriesInterfaceMockRecorder is the mock recorder for MockPatchRepositoriesInterface.
+type MockPatchRepositoriesInterfaceMockRecorder struct {
+	mock *MockPatchRepositoriesInterface
+}
+
+// NewMockPatchRepositoriesInterface creates a new mock instance.
+func NewMockPatchRepositoriesInterface(ctrl *gomock.Controller) *MockPatchRepositoriesInterface {
+	mock := &MockPatchRepositoriesInterface{ctrl: ctrl}
+	mock.recorder = &MockPatchRepositoriesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPatchRepositoriesInterface) EXPECT() *MockPatchRepositoriesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPatchRepositoriesInterface) Do(opts ...googleapi.CallOption) (*v1.Repository, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPatchRepositoriesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPatchRepositoriesInterface)(nil).Do), opts...)
+}
+
+// MockDeleteRepositoriesInterface is a mock of DeleteRepositoriesInterface interface.
+type MockDeleteRepositoriesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteRepositoriesInterfaceMockRecorder
+}
+
+// MockDeleteRepositoriesInterfaceMockRecorder is the mock recorder for MockDeleteRepositoriesInterface.
+type MockDeleteRepositoriesInterfaceMockRecorder struct {
+	mock *MockDeleteRepositoriesInterface
+}
+
+// NewMockDeleteRepositoriesInterface creates a new mock instance.
+func NewMockDeleteRepositoriesInterface(ctrl *gomock.Controller) *MockDeleteRepositoriesInterface {
+	mock := &MockDeleteRepositoriesInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteRepositoriesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteRepositoriesInterface) EXPECT() *MockDeleteRepositoriesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteRepositoriesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteRepositoriesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteRepositoriesInterface)(nil).Do), opts...)
+}
+
+// MockGetIamPolicyRepositoriesInterface is a mock of GetIamPolicyRepositoriesInterface interface.
+type MockGetIamPolicyRepositoriesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetIamPolicyRepositoriesInterfaceMockRecorder
+}
+
+// MockGetIamPolicyRepositoriesInterfaceMockRecorder is the mock recorder for MockGetIamPolicyRepositoriesInterface.
+type MockGetIamPolicyRepositoriesInterfaceMockRecorder struct {
+	mock *MockGetIamPolicyRepositoriesInterface
+}
+
+// NewMockGetIamPolicyRepositoriesInterface creates a new mock instance.
+func NewMockGetIamPolicyRepositoriesInterface(ctrl *gomock.Controller) *MockGetIamPolicyRepositoriesInterface {
+	mock := &MockGetIamPolicyRepositoriesInterface{ctrl: ctrl}
+	mock.recorder = &MockGetIamPolicyRepositoriesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetIamPolicyRepositoriesInterface) EXPECT() *MockGetIamPolicyRepositoriesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetIamPolicyRepositoriesInterface) Do(opts ...googleapi.CallOption) (*v1.Policy, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetIamPolicyRepositoriesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetIamPolicyRepositoriesInterface)(nil).Do), opts...)
+}
+
+// MockSetIamPolicyRepositoriesInterface is a mock of SetIamPolicyRepositoriesInterface interface.
+type MockSetIamPolicyRepositoriesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetIamPolicyRepositoriesInterfaceMockRecorder
+}
+
+// MockSetIamPolicyRepositoriesInterfaceMockRecorder is the mock recorder for MockSetIamPolicyRepositoriesInterface.
+type MockSetIamPolicyRepositoriesInterfaceMockRecorder struct {
+	mock *MockSetIamPolicyRepositoriesInterface
+}
+
+// NewMockSetIamPolicyRepositoriesInterface creates a new mock instance.
+func NewMockSetIamPolicyRepositoriesInterface(ctrl *gomock.Controller) *MockSetIamPolicyRepositoriesInterface {
+	mock := &MockSetIamPolicyRepositoriesInterface{ctrl: ctrl}
+	mock.recorder = &MockSetIamPolicyRepositoriesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetIamPolicyRepositoriesInterface) EXPECT() *MockSetIamPolicyRepositoriesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSetIamPolicyRepositoriesInterface) Do(opts ...googleapi.CallOption) (*v1.Policy, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSetIamPolicyRepositoriesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSetIamPolicyRepositoriesInterface)(nil).Do), opts...)
+}
+
+// MockGetArtifactRegistryOperationsInterface is a mock of GetArtifactRegistryOperationsInterface interface.
+type MockGetArtifactRegistryOperationsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetArtifactRegistryOperationsInterfaceMockRecorder
+}
+
+// MockGetArtifactRegistryOperationsInterfaceMockRecorder is the mock recorder for MockGetArtifactRegistryOperationsInterface.
+type MockGetArtifactRegistryOperationsInterfaceMockRecorder struct {
+	mock *MockGetArtifactRegistryOperationsInterface
+}
+
+// NewMockGetArtifactRegistryOperationsInterface creates a new mock instance.
+func NewMockGetArtifactRegistryOperationsInterface(ctrl *gomock.Controller) *MockGetArtifactRegistryOperationsInterface {
+	mock := &MockGetArtifactRegistryOperationsInterface{ctrl: ctrl}
+	mock.recorder = &MockGetArtifactRegistryOperationsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetArtifactRegistryOperationsInterface) EXPECT() *MockGetArtifactRegistryOperationsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetArtifactRegistryOperationsInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetArtifactRegistryOperationsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetArtifactRegistryOperationsInterface)(nil).Do), opts...)
+}
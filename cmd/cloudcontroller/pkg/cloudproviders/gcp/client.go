@@ -3,6 +3,7 @@ package gcp
 import (
 	"google.golang.org/api/compute/v1"
 	"google.golang.org/api/container/v1"
+	"google.golang.org/api/dns/v1"
 	"google.golang.org/api/googleapi"
 )
 
@@ -18,17 +19,38 @@ type (
 	ComputeService struct {
 		Clients ComputeClients
 	}
+	DNSService struct {
+		Clients DNSClients
+	}
 )
 
 // Clients
 type (
 	ComputeClients struct {
-		Instances InstancesInterface
-		Networks  NetworksInterface
+		Instances             InstancesInterface
+		Networks              NetworksInterface
+		ZoneOperations        ZoneOperationsInterface
+		Disks                 DisksInterface
+		GlobalOperations      GlobalOperationsInterface
+		Snapshots             SnapshotsInterface
+		InstanceTemplates     InstanceTemplatesInterface
+		InstanceGroupManagers InstanceGroupManagersInterface
+		RegionOperations      RegionOperationsInterface
+		Addresses             AddressesInterface
+		GlobalAddresses       GlobalAddressesInterface
+		Subnetworks           SubnetworksInterface
+		Firewalls             FirewallsInterface
+		Routers               RoutersInterface
+		Routes                RoutesInterface
 	}
 	ContainerClients struct {
 		Clusters ClustersInterface
 	}
+	DNSClients struct {
+		ManagedZones          ManagedZonesInterface
+		ResourceRecordSets    ResourceRecordSetsInterface
+		ManagedZoneOperations ManagedZoneOperationsInterface
+	}
 )
 
 // Resources
@@ -40,11 +62,61 @@ type (
 	GCPNetworks struct {
 		NetworksService *compute.NetworksService
 	}
+	GCPZoneOperations struct {
+		ZoneOperationsService *compute.ZoneOperationsService
+	}
+	GCPDisks struct {
+		DisksService *compute.DisksService
+	}
+	GCPGlobalOperations struct {
+		GlobalOperationsService *compute.GlobalOperationsService
+	}
+	GCPSnapshots struct {
+		SnapshotsService *compute.SnapshotsService
+	}
+	GCPInstanceTemplates struct {
+		InstanceTemplatesService *compute.InstanceTemplatesService
+	}
+	GCPInstanceGroupManagers struct {
+		InstanceGroupManagersService *compute.InstanceGroupManagersService
+	}
+	GCPRegionOperations struct {
+		RegionOperationsService *compute.RegionOperationsService
+	}
+	GCPAddresses struct {
+		AddressesService *compute.AddressesService
+	}
+	GCPGlobalAddresses struct {
+		GlobalAddressesService *compute.GlobalAddressesService
+	}
+	GCPSubnetworks struct {
+		SubnetworksService *compute.SubnetworksService
+	}
+	GCPFirewalls struct {
+		FirewallsService *compute.FirewallsService
+	}
+	GCPRouters struct {
+		RoutersService *compute.RoutersService
+	}
+	GCPRoutes struct {
+		RoutesService *compute.RoutesService
+	}
 
 	// container resources
 	GCPKubernetesClusters struct {
 		ClustersService *container.ProjectsZonesClustersService
 	}
+
+	// dns resources
+	GCPManagedZones struct {
+		ManagedZonesService *dns.ManagedZonesService
+	}
+	GCPResourceRecordSets struct {
+		ResourceRecordSetsService *dns.ResourceRecordSetsService
+	}
+	GCPManagedZoneOperations struct {
+		ManagedZoneOperationsService *dns.ManagedZoneOperationsService
+	}
 )
 
 // Interfaces
@@ -53,6 +125,18 @@ type (
 	//// instances
 	InstancesInterface interface {
 		List(project, zone string) ListInstancesInterface
+		Get(project, zone, instance string) GetInstancesInterface
+		Insert(project, zone string, instance *compute.Instance) CreateInstancesInterface
+		SetMetadata(project, zone, instance string, metadata *compute.Metadata) SetMetadataInstancesInterface
+		GetIamPolicy(project, zone, instance string) GetIamPolicyInstancesInterface
+		SetIamPolicy(project, zone, instance string, request *compute.ZoneSetPolicyRequest) SetIamPolicyInstancesInterface
+		Stop(project, zone, instance string) StopInstancesInterface
+		Start(project, zone, instance string) StartInstancesInterface
+		SetMachineType(project, zone, instance string, request *compute.InstancesSetMachineTypeRequest) SetMachineTypeInstancesInterface
+		AttachDisk(project, zone, instance string, disk *compute.AttachedDisk) AttachDiskInstancesInterface
+		DetachDisk(project, zone, instance, deviceName string) DetachDiskInstancesInterface
+		AddAccessConfig(project, zone, instance, networkInterface string, accessConfig *compute.AccessConfig) AddAccessConfigInstancesInterface
+		DeleteAccessConfig(project, zone, instance, accessConfig, networkInterface string) DeleteAccessConfigInstancesInterface
 	}
 	//// networks
 	NetworksInterface interface {
@@ -60,6 +144,92 @@ type (
 		Get(project, network string) GetNetworksInterface
 		Insert(project string, network *compute.Network) CreateNetworksInterface
 		Delete(project, network string) DeleteNetworksInterface
+		AddPeering(project, network string, req *compute.NetworksAddPeeringRequest) AddPeeringNetworksInterface
+		RemovePeering(project, network string, req *compute.NetworksRemovePeeringRequest) RemovePeeringNetworksInterface
+		UpdatePeering(project, network string, req *compute.NetworksUpdatePeeringRequest) UpdatePeeringNetworksInterface
+		Patch(project, network string, networkResource *compute.Network) PatchNetworksInterface
+		SwitchToCustomMode(project, network string) SwitchToCustomModeNetworksInterface
+	}
+	//// zone operations
+	ZoneOperationsInterface interface {
+		Wait(project, zone, operation string) WaitZoneOperationsInterface
+	}
+	//// disks
+	DisksInterface interface {
+		Get(project, zone, disk string) GetDisksInterface
+		Insert(project, zone string, disk *compute.Disk) CreateDisksInterface
+		Delete(project, zone, disk string) DeleteDisksInterface
+		Resize(project, zone, disk string, request *compute.DisksResizeRequest) ResizeDisksInterface
+		SetLabels(project, zone, disk string, request *compute.ZoneSetLabelsRequest) SetLabelsDisksInterface
+	}
+	//// global operations
+	GlobalOperationsInterface interface {
+		Wait(project, operation string) WaitGlobalOperationsInterface
+	}
+	//// snapshots
+	SnapshotsInterface interface {
+		Get(project, snapshot string) GetSnapshotsInterface
+		Insert(project string, snapshot *compute.Snapshot) CreateSnapshotsInterface
+		Delete(project, snapshot string) DeleteSnapshotsInterface
+	}
+	//// instance templates
+	InstanceTemplatesInterface interface {
+		Get(project, instanceTemplate string) GetInstanceTemplatesInterface
+		Insert(project string, instanceTemplate *compute.InstanceTemplate) CreateInstanceTemplatesInterface
+		Delete(project, instanceTemplate string) DeleteInstanceTemplatesInterface
+	}
+	//// instance group managers
+	InstanceGroupManagersInterface interface {
+		Get(project, zone, instanceGroupManager string) GetInstanceGroupManagersInterface
+		Insert(project, zone string, instanceGroupManager *compute.InstanceGroupManager) CreateInstanceGroupManagersInterface
+		Patch(project, zone, instanceGroupManager string, request *compute.InstanceGroupManager) PatchInstanceGroupManagersInterface
+		Resize(project, zone, instanceGroupManager string, size int64) ResizeInstanceGroupManagersInterface
+		Delete(project, zone, instanceGroupManager string) DeleteInstanceGroupManagersInterface
+	}
+	//// region operations
+	RegionOperationsInterface interface {
+		Wait(project, region, operation string) WaitRegionOperationsInterface
+	}
+	//// addresses
+	AddressesInterface interface {
+		Get(project, region, address string) GetAddressesInterface
+		Insert(project, region string, address *compute.Address) CreateAddressesInterface
+		Delete(project, region, address string) DeleteAddressesInterface
+	}
+	//// global addresses
+	GlobalAddressesInterface interface {
+		Get(project, address string) GetGlobalAddressesInterface
+		Insert(project string, address *compute.Address) CreateGlobalAddressesInterface
+		Delete(project, address string) DeleteGlobalAddressesInterface
+	}
+	//// subnetworks
+	SubnetworksInterface interface {
+		Get(project, region, subnetwork string) GetSubnetworksInterface
+		Insert(project, region string, subnetwork *compute.Subnetwork) CreateSubnetworksInterface
+		Patch(project, region, subnetwork string, subnetworkResource *compute.Subnetwork) PatchSubnetworksInterface
+		ExpandIpCidrRange(project, region, subnetwork string, req *compute.SubnetworksExpandIpCidrRangeRequest) ExpandIpCidrRangeSubnetworksInterface
+		SetPrivateIpGoogleAccess(project, region, subnetwork string, req *compute.SubnetworksSetPrivateIpGoogleAccessRequest) SetPrivateIpGoogleAccessSubnetworksInterface
+		Delete(project, region, subnetwork string) DeleteSubnetworksInterface
+	}
+	//// firewalls
+	FirewallsInterface interface {
+		Get(project, firewall string) GetFirewallsInterface
+		Insert(project string, firewall *compute.Firewall) CreateFirewallsInterface
+		Patch(project, firewall string, firewallResource *compute.Firewall) PatchFirewallsInterface
+		Delete(project, firewall string) DeleteFirewallsInterface
+	}
+	//// routers
+	RoutersInterface interface {
+		Get(project, region, router string) GetRoutersInterface
+		Insert(project, region string, router *compute.Router) CreateRoutersInterface
+		Patch(project, region, router string, routerResource *compute.Router) PatchRoutersInterface
+		Delete(project, region, router string) DeleteRoutersInterface
+	}
+	//// routes
+	RoutesInterface interface {
+		Get(project, route string) GetRoutesInterface
+		Insert(project string, route *compute.Route) CreateRoutesInterface
+		Delete(project, route string) DeleteRoutesInterface
 	}
 
 	// container interfaces
@@ -71,6 +241,26 @@ type (
 		Delete(project, zone, cluster string) DeleteClustersInterface
 		Update(project, zone, cluster string, update *container.UpdateClusterRequest) UpdateClustersInterface
 	}
+
+	// dns interfaces
+	//// managed zones
+	ManagedZonesInterface interface {
+		Get(project, managedZone string) GetManagedZonesInterface
+		Create(project string, managedZone *dns.ManagedZone) CreateManagedZonesInterface
+		Patch(project, managedZone string, managedZoneResource *dns.ManagedZone) PatchManagedZonesInterface
+		Delete(project, managedZone string) DeleteManagedZonesInterface
+	}
+	//// resource record sets
+	ResourceRecordSetsInterface interface {
+		Get(project, managedZone, name, rrsetType string) GetResourceRecordSetsInterface
+		Create(project, managedZone string, rrset *dns.ResourceRecordSet) CreateResourceRecordSetsInterface
+		Patch(project, managedZone, name, rrsetType string, rrset *dns.ResourceRecordSet) PatchResourceRecordSetsInterface
+		Delete(project, managedZone, name, rrsetType string) DeleteResourceRecordSetsInterface
+	}
+	//// managed zone operations
+	ManagedZoneOperationsInterface interface {
+		Get(project, managedZone, operation string) GetManagedZoneOperationsInterface
+	}
 )
 
 // Requests
@@ -80,6 +270,42 @@ type (
 	ListInstancesInterface interface {
 		Do(opts ...googleapi.CallOption) (*compute.InstanceList, error)
 	}
+	GetInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Instance, error)
+	}
+	CreateInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	SetMetadataInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	GetIamPolicyInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Policy, error)
+	}
+	SetIamPolicyInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Policy, error)
+	}
+	StopInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	StartInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	SetMachineTypeInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	AttachDiskInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	DetachDiskInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	AddAccessConfigInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	DeleteAccessConfigInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
 	//// networks
 	ListNetworksInterface interface {
 		Do(opts ...googleapi.CallOption) (*compute.NetworkList, error)
@@ -93,6 +319,160 @@ type (
 	DeleteNetworksInterface interface {
 		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
 	}
+	AddPeeringNetworksInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	RemovePeeringNetworksInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	UpdatePeeringNetworksInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	PatchNetworksInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	SwitchToCustomModeNetworksInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	//// zone operations
+	WaitZoneOperationsInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	//// disks
+	GetDisksInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Disk, error)
+	}
+	CreateDisksInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	DeleteDisksInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	ResizeDisksInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	SetLabelsDisksInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	//// global operations
+	WaitGlobalOperationsInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	//// snapshots
+	GetSnapshotsInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Snapshot, error)
+	}
+	CreateSnapshotsInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	DeleteSnapshotsInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	//// instance templates
+	GetInstanceTemplatesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.InstanceTemplate, error)
+	}
+	CreateInstanceTemplatesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	DeleteInstanceTemplatesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	//// instance group managers
+	GetInstanceGroupManagersInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.InstanceGroupManager, error)
+	}
+	CreateInstanceGroupManagersInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	PatchInstanceGroupManagersInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	ResizeInstanceGroupManagersInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	DeleteInstanceGroupManagersInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	//// region operations
+	WaitRegionOperationsInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	//// addresses
+	GetAddressesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Address, error)
+	}
+	CreateAddressesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	DeleteAddressesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	//// global addresses
+	GetGlobalAddressesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Address, error)
+	}
+	CreateGlobalAddressesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	DeleteGlobalAddressesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	//// subnetworks
+	GetSubnetworksInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Subnetwork, error)
+	}
+	CreateSubnetworksInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	PatchSubnetworksInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	ExpandIpCidrRangeSubnetworksInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	SetPrivateIpGoogleAccessSubnetworksInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	DeleteSubnetworksInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	//// firewalls
+	GetFirewallsInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Firewall, error)
+	}
+	CreateFirewallsInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	PatchFirewallsInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	DeleteFirewallsInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	//// routers
+	GetRoutersInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Router, error)
+	}
+	CreateRoutersInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	PatchRoutersInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	DeleteRoutersInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	//// routes
+	GetRoutesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Route, error)
+	}
+	CreateRoutesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
+	DeleteRoutesInterface interface {
+		Do(opts ...googleapi.CallOption) (*compute.Operation, error)
+	}
 
 	// container interfaces
 	//// kubernetes clusters
@@ -111,6 +491,38 @@ type (
 	UpdateClustersInterface interface {
 		Do(opts ...googleapi.CallOption) (*container.Operation, error)
 	}
+
+	// dns do interfaces
+	//// managed zones
+	GetManagedZonesInterface interface {
+		Do(opts ...googleapi.CallOption) (*dns.ManagedZone, error)
+	}
+	CreateManagedZonesInterface interface {
+		Do(opts ...googleapi.CallOption) (*dns.ManagedZone, error)
+	}
+	PatchManagedZonesInterface interface {
+		Do(opts ...googleapi.CallOption) (*dns.Operation, error)
+	}
+	DeleteManagedZonesInterface interface {
+		Do(opts ...googleapi.CallOption) error
+	}
+	//// resource record sets
+	GetResourceRecordSetsInterface interface {
+		Do(opts ...googleapi.CallOption) (*dns.ResourceRecordSet, error)
+	}
+	CreateResourceRecordSetsInterface interface {
+		Do(opts ...googleapi.CallOption) (*dns.ResourceRecordSet, error)
+	}
+	PatchResourceRecordSetsInterface interface {
+		Do(opts ...googleapi.CallOption) (*dns.ResourceRecordSet, error)
+	}
+	DeleteResourceRecordSetsInterface interface {
+		Do(opts ...googleapi.CallOption) (*dns.ResourceRecordSetsDeleteResponse, error)
+	}
+	//// managed zone operations
+	GetManagedZoneOperationsInterface interface {
+		Do(opts ...googleapi.CallOption) (*dns.Operation, error)
+	}
 )
 
 // Executor requests
@@ -120,6 +532,42 @@ type (
 	ListInstancesRequest struct {
 		googleCall *compute.InstancesListCall
 	}
+	GetInstancesRequest struct {
+		googleCall *compute.InstancesGetCall
+	}
+	CreateInstancesRequest struct {
+		googleCall *compute.InstancesInsertCall
+	}
+	SetMetadataInstancesRequest struct {
+		googleCall *compute.InstancesSetMetadataCall
+	}
+	GetIamPolicyInstancesRequest struct {
+		googleCall *compute.InstancesGetIamPolicyCall
+	}
+	SetIamPolicyInstancesRequest struct {
+		googleCall *compute.InstancesSetIamPolicyCall
+	}
+	StopInstancesRequest struct {
+		googleCall *compute.InstancesStopCall
+	}
+	StartInstancesRequest struct {
+		googleCall *compute.InstancesStartCall
+	}
+	SetMachineTypeInstancesRequest struct {
+		googleCall *compute.InstancesSetMachineTypeCall
+	}
+	AttachDiskInstancesRequest struct {
+		googleCall *compute.InstancesAttachDiskCall
+	}
+	DetachDiskInstancesRequest struct {
+		googleCall *compute.InstancesDetachDiskCall
+	}
+	AddAccessConfigInstancesRequest struct {
+		googleCall *compute.InstancesAddAccessConfigCall
+	}
+	DeleteAccessConfigInstancesRequest struct {
+		googleCall *compute.InstancesDeleteAccessConfigCall
+	}
 	//// networks
 	ListNetworksRequest struct {
 		googleCall *compute.NetworksListCall
@@ -133,6 +581,160 @@ type (
 	DeleteNetworksRequest struct {
 		googleCall *compute.NetworksDeleteCall
 	}
+	AddPeeringNetworksRequest struct {
+		googleCall *compute.NetworksAddPeeringCall
+	}
+	RemovePeeringNetworksRequest struct {
+		googleCall *compute.NetworksRemovePeeringCall
+	}
+	UpdatePeeringNetworksRequest struct {
+		googleCall *compute.NetworksUpdatePeeringCall
+	}
+	PatchNetworksRequest struct {
+		googleCall *compute.NetworksPatchCall
+	}
+	SwitchToCustomModeNetworksRequest struct {
+		googleCall *compute.NetworksSwitchToCustomModeCall
+	}
+	//// zone operations
+	WaitZoneOperationsRequest struct {
+		googleCall *compute.ZoneOperationsWaitCall
+	}
+	//// disks
+	GetDisksRequest struct {
+		googleCall *compute.DisksGetCall
+	}
+	CreateDisksRequest struct {
+		googleCall *compute.DisksInsertCall
+	}
+	DeleteDisksRequest struct {
+		googleCall *compute.DisksDeleteCall
+	}
+	ResizeDisksRequest struct {
+		googleCall *compute.DisksResizeCall
+	}
+	SetLabelsDisksRequest struct {
+		googleCall *compute.DisksSetLabelsCall
+	}
+	//// global operations
+	WaitGlobalOperationsRequest struct {
+		googleCall *compute.GlobalOperationsWaitCall
+	}
+	//// snapshots
+	GetSnapshotsRequest struct {
+		googleCall *compute.SnapshotsGetCall
+	}
+	CreateSnapshotsRequest struct {
+		googleCall *compute.SnapshotsInsertCall
+	}
+	DeleteSnapshotsRequest struct {
+		googleCall *compute.SnapshotsDeleteCall
+	}
+	//// instance templates
+	GetInstanceTemplatesRequest struct {
+		googleCall *compute.InstanceTemplatesGetCall
+	}
+	CreateInstanceTemplatesRequest struct {
+		googleCall *compute.InstanceTemplatesInsertCall
+	}
+	DeleteInstanceTemplatesRequest struct {
+		googleCall *compute.InstanceTemplatesDeleteCall
+	}
+	//// instance group managers
+	GetInstanceGroupManagersRequest struct {
+		googleCall *compute.InstanceGroupManagersGetCall
+	}
+	CreateInstanceGroupManagersRequest struct {
+		googleCall *compute.InstanceGroupManagersInsertCall
+	}
+	PatchInstanceGroupManagersRequest struct {
+		googleCall *compute.InstanceGroupManagersPatchCall
+	}
+	ResizeInstanceGroupManagersRequest struct {
+		googleCall *compute.InstanceGroupManagersResizeCall
+	}
+	DeleteInstanceGroupManagersRequest struct {
+		googleCall *compute.InstanceGroupManagersDeleteCall
+	}
+	//// region operations
+	WaitRegionOperationsRequest struct {
+		googleCall *compute.RegionOperationsWaitCall
+	}
+	//// addresses
+	GetAddressesRequest struct {
+		googleCall *compute.AddressesGetCall
+	}
+	CreateAddressesRequest struct {
+		googleCall *compute.AddressesInsertCall
+	}
+	DeleteAddressesRequest struct {
+		googleCall *compute.AddressesDeleteCall
+	}
+	//// global addresses
+	GetGlobalAddressesRequest struct {
+		googleCall *compute.GlobalAddressesGetCall
+	}
+	CreateGlobalAddressesRequest struct {
+		googleCall *compute.GlobalAddressesInsertCall
+	}
+	DeleteGlobalAddressesRequest struct {
+		googleCall *compute.GlobalAddressesDeleteCall
+	}
+	//// subnetworks
+	GetSubnetworksRequest struct {
+		googleCall *compute.SubnetworksGetCall
+	}
+	CreateSubnetworksRequest struct {
+		googleCall *compute.SubnetworksInsertCall
+	}
+	PatchSubnetworksRequest struct {
+		googleCall *compute.SubnetworksPatchCall
+	}
+	ExpandIpCidrRangeSubnetworksRequest struct {
+		googleCall *compute.SubnetworksExpandIpCidrRangeCall
+	}
+	SetPrivateIpGoogleAccessSubnetworksRequest struct {
+		googleCall *compute.SubnetworksSetPrivateIpGoogleAccessCall
+	}
+	DeleteSubnetworksRequest struct {
+		googleCall *compute.SubnetworksDeleteCall
+	}
+	//// firewalls
+	GetFirewallsRequest struct {
+		googleCall *compute.FirewallsGetCall
+	}
+	CreateFirewallsRequest struct {
+		googleCall *compute.FirewallsInsertCall
+	}
+	PatchFirewallsRequest struct {
+		googleCall *compute.FirewallsPatchCall
+	}
+	DeleteFirewallsRequest struct {
+		googleCall *compute.FirewallsDeleteCall
+	}
+	//// routers
+	GetRoutersRequest struct {
+		googleCall *compute.RoutersGetCall
+	}
+	CreateRoutersRequest struct {
+		googleCall *compute.RoutersInsertCall
+	}
+	PatchRoutersRequest struct {
+		googleCall *compute.RoutersPatchCall
+	}
+	DeleteRoutersRequest struct {
+		googleCall *compute.RoutersDeleteCall
+	}
+	//// routes
+	GetRoutesRequest struct {
+		googleCall *compute.RoutesGetCall
+	}
+	CreateRoutesRequest struct {
+		googleCall *compute.RoutesInsertCall
+	}
+	DeleteRoutesRequest struct {
+		googleCall *compute.RoutesDeleteCall
+	}
 
 	// container google calls
 	//// kubernetes clusters
@@ -151,6 +753,38 @@ type (
 	UpdateClustersRequest struct {
 		googleCall *container.ProjectsZonesClustersUpdateCall
 	}
+
+	// dns google calls
+	//// managed zones
+	GetManagedZonesRequest struct {
+		googleCall *dns.ManagedZonesGetCall
+	}
+	CreateManagedZonesRequest struct {
+		googleCall *dns.ManagedZonesCreateCall
+	}
+	PatchManagedZonesRequest struct {
+		googleCall *dns.ManagedZonesPatchCall
+	}
+	DeleteManagedZonesRequest struct {
+		googleCall *dns.ManagedZonesDeleteCall
+	}
+	//// resource record sets
+	GetResourceRecordSetsRequest struct {
+		googleCall *dns.ResourceRecordSetsGetCall
+	}
+	CreateResourceRecordSetsRequest struct {
+		googleCall *dns.ResourceRecordSetsCreateCall
+	}
+	PatchResourceRecordSetsRequest struct {
+		googleCall *dns.ResourceRecordSetsPatchCall
+	}
+	DeleteResourceRecordSetsRequest struct {
+		googleCall *dns.ResourceRecordSetsDeleteCall
+	}
+	//// managed zone operations
+	GetManagedZoneOperationsRequest struct {
+		googleCall *dns.ManagedZoneOperationsGetCall
+	}
 )
 
 // ===============================================================================================
@@ -164,6 +798,66 @@ func (i *GCPInstances) List(projectID, zone string) ListInstancesInterface {
 		googleCall: i.InstancesService.List(projectID, zone),
 	}
 }
+func (i *GCPInstances) Get(projectID, zone, instance string) GetInstancesInterface {
+	return &GetInstancesRequest{
+		googleCall: i.InstancesService.Get(projectID, zone, instance),
+	}
+}
+func (i *GCPInstances) Insert(projectID, zone string, instance *compute.Instance) CreateInstancesInterface {
+	return &CreateInstancesRequest{
+		googleCall: i.InstancesService.Insert(projectID, zone, instance),
+	}
+}
+func (i *GCPInstances) SetMetadata(projectID, zone, instance string, metadata *compute.Metadata) SetMetadataInstancesInterface {
+	return &SetMetadataInstancesRequest{
+		googleCall: i.InstancesService.SetMetadata(projectID, zone, instance, metadata),
+	}
+}
+func (i *GCPInstances) GetIamPolicy(projectID, zone, instance string) GetIamPolicyInstancesInterface {
+	return &GetIamPolicyInstancesRequest{
+		googleCall: i.InstancesService.GetIamPolicy(projectID, zone, instance),
+	}
+}
+func (i *GCPInstances) SetIamPolicy(projectID, zone, instance string, request *compute.ZoneSetPolicyRequest) SetIamPolicyInstancesInterface {
+	return &SetIamPolicyInstancesRequest{
+		googleCall: i.InstancesService.SetIamPolicy(projectID, zone, instance, request),
+	}
+}
+func (i *GCPInstances) Stop(projectID, zone, instance string) StopInstancesInterface {
+	return &StopInstancesRequest{
+		googleCall: i.InstancesService.Stop(projectID, zone, instance),
+	}
+}
+func (i *GCPInstances) Start(projectID, zone, instance string) StartInstancesInterface {
+	return &StartInstancesRequest{
+		googleCall: i.InstancesService.Start(projectID, zone, instance),
+	}
+}
+func (i *GCPInstances) SetMachineType(projectID, zone, instance string, request *compute.InstancesSetMachineTypeRequest) SetMachineTypeInstancesInterface {
+	return &SetMachineTypeInstancesRequest{
+		googleCall: i.InstancesService.SetMachineType(projectID, zone, instance, request),
+	}
+}
+func (i *GCPInstances) AttachDisk(projectID, zone, instance string, disk *compute.AttachedDisk) AttachDiskInstancesInterface {
+	return &AttachDiskInstancesRequest{
+		googleCall: i.InstancesService.AttachDisk(projectID, zone, instance, disk),
+	}
+}
+func (i *GCPInstances) DetachDisk(projectID, zone, instance, deviceName string) DetachDiskInstancesInterface {
+	return &DetachDiskInstancesRequest{
+		googleCall: i.InstancesService.DetachDisk(projectID, zone, instance, deviceName),
+	}
+}
+func (i *GCPInstances) AddAccessConfig(projectID, zone, instance, networkInterface string, accessConfig *compute.AccessConfig) AddAccessConfigInstancesInterface {
+	return &AddAccessConfigInstancesRequest{
+		googleCall: i.InstancesService.AddAccessConfig(projectID, zone, instance, networkInterface, accessConfig),
+	}
+}
+func (i *GCPInstances) DeleteAccessConfig(projectID, zone, instance, accessConfig, networkInterface string) DeleteAccessConfigInstancesInterface {
+	return &DeleteAccessConfigInstancesRequest{
+		googleCall: i.InstancesService.DeleteAccessConfig(projectID, zone, instance, accessConfig, networkInterface),
+	}
+}
 
 // //// Networks
 func (n *GCPNetworks) List(projectID string) ListNetworksInterface {
@@ -186,22 +880,283 @@ func (n *GCPNetworks) Delete(projectID, network string) DeleteNetworksInterface
 		googleCall: n.NetworksService.Delete(projectID, network),
 	}
 }
-
-// // Container
-// ///// Clusters
-func (g *GCPKubernetesClusters) List(projectID, zone string) ListClustersInterface {
-	return &ListClustersRequest{
-		googleCall: g.ClustersService.List(projectID, zone),
+func (n *GCPNetworks) AddPeering(projectID, network string, req *compute.NetworksAddPeeringRequest) AddPeeringNetworksInterface {
+	return &AddPeeringNetworksRequest{
+		googleCall: n.NetworksService.AddPeering(projectID, network, req),
 	}
 }
-func (g *GCPKubernetesClusters) Get(projectID, zone, cluster string) GetClustersInterface {
-	return &GetClustersRequest{
-		googleCall: g.ClustersService.Get(projectID, zone, cluster),
+func (n *GCPNetworks) RemovePeering(projectID, network string, req *compute.NetworksRemovePeeringRequest) RemovePeeringNetworksInterface {
+	return &RemovePeeringNetworksRequest{
+		googleCall: n.NetworksService.RemovePeering(projectID, network, req),
 	}
 }
-func (g *GCPKubernetesClusters) Create(projectID, zone string, cluster *container.CreateClusterRequest) CreateClustersInterface {
-	return &CreateClustersRequest{
-		googleCall: g.ClustersService.Create(projectID, zone, cluster),
+func (n *GCPNetworks) UpdatePeering(projectID, network string, req *compute.NetworksUpdatePeeringRequest) UpdatePeeringNetworksInterface {
+	return &UpdatePeeringNetworksRequest{
+		googleCall: n.NetworksService.UpdatePeering(projectID, network, req),
+	}
+}
+func (n *GCPNetworks) Patch(projectID, network string, networkResource *compute.Network) PatchNetworksInterface {
+	return &PatchNetworksRequest{
+		googleCall: n.NetworksService.Patch(projectID, network, networkResource),
+	}
+}
+func (n *GCPNetworks) SwitchToCustomMode(projectID, network string) SwitchToCustomModeNetworksInterface {
+	return &SwitchToCustomModeNetworksRequest{
+		googleCall: n.NetworksService.SwitchToCustomMode(projectID, network),
+	}
+}
+
+// //// Zone Operations
+func (o *GCPZoneOperations) Wait(projectID, zone, operation string) WaitZoneOperationsInterface {
+	return &WaitZoneOperationsRequest{
+		googleCall: o.ZoneOperationsService.Wait(projectID, zone, operation),
+	}
+}
+
+// //// Disks
+func (d *GCPDisks) Get(projectID, zone, disk string) GetDisksInterface {
+	return &GetDisksRequest{
+		googleCall: d.DisksService.Get(projectID, zone, disk),
+	}
+}
+func (d *GCPDisks) Insert(projectID, zone string, disk *compute.Disk) CreateDisksInterface {
+	return &CreateDisksRequest{
+		googleCall: d.DisksService.Insert(projectID, zone, disk),
+	}
+}
+func (d *GCPDisks) Delete(projectID, zone, disk string) DeleteDisksInterface {
+	return &DeleteDisksRequest{
+		googleCall: d.DisksService.Delete(projectID, zone, disk),
+	}
+}
+func (d *GCPDisks) Resize(projectID, zone, disk string, request *compute.DisksResizeRequest) ResizeDisksInterface {
+	return &ResizeDisksRequest{
+		googleCall: d.DisksService.Resize(projectID, zone, disk, request),
+	}
+}
+func (d *GCPDisks) SetLabels(projectID, zone, disk string, request *compute.ZoneSetLabelsRequest) SetLabelsDisksInterface {
+	return &SetLabelsDisksRequest{
+		googleCall: d.DisksService.SetLabels(projectID, zone, disk, request),
+	}
+}
+
+// //// Global Operations
+func (o *GCPGlobalOperations) Wait(projectID, operation string) WaitGlobalOperationsInterface {
+	return &WaitGlobalOperationsRequest{
+		googleCall: o.GlobalOperationsService.Wait(projectID, operation),
+	}
+}
+
+// //// Snapshots
+func (sn *GCPSnapshots) Get(projectID, snapshot string) GetSnapshotsInterface {
+	return &GetSnapshotsRequest{
+		googleCall: sn.SnapshotsService.Get(projectID, snapshot),
+	}
+}
+func (sn *GCPSnapshots) Insert(projectID string, snapshot *compute.Snapshot) CreateSnapshotsInterface {
+	return &CreateSnapshotsRequest{
+		googleCall: sn.SnapshotsService.Insert(projectID, snapshot),
+	}
+}
+func (sn *GCPSnapshots) Delete(projectID, snapshot string) DeleteSnapshotsInterface {
+	return &DeleteSnapshotsRequest{
+		googleCall: sn.SnapshotsService.Delete(projectID, snapshot),
+	}
+}
+
+// //// Instance Templates
+func (t *GCPInstanceTemplates) Get(projectID, instanceTemplate string) GetInstanceTemplatesInterface {
+	return &GetInstanceTemplatesRequest{
+		googleCall: t.InstanceTemplatesService.Get(projectID, instanceTemplate),
+	}
+}
+func (t *GCPInstanceTemplates) Insert(projectID string, instanceTemplate *compute.InstanceTemplate) CreateInstanceTemplatesInterface {
+	return &CreateInstanceTemplatesRequest{
+		googleCall: t.InstanceTemplatesService.Insert(projectID, instanceTemplate),
+	}
+}
+func (t *GCPInstanceTemplates) Delete(projectID, instanceTemplate string) DeleteInstanceTemplatesInterface {
+	return &DeleteInstanceTemplatesRequest{
+		googleCall: t.InstanceTemplatesService.Delete(projectID, instanceTemplate),
+	}
+}
+
+// //// Instance Group Managers
+func (m *GCPInstanceGroupManagers) Get(projectID, zone, instanceGroupManager string) GetInstanceGroupManagersInterface {
+	return &GetInstanceGroupManagersRequest{
+		googleCall: m.InstanceGroupManagersService.Get(projectID, zone, instanceGroupManager),
+	}
+}
+func (m *GCPInstanceGroupManagers) Insert(projectID, zone string, instanceGroupManager *compute.InstanceGroupManager) CreateInstanceGroupManagersInterface {
+	return &CreateInstanceGroupManagersRequest{
+		googleCall: m.InstanceGroupManagersService.Insert(projectID, zone, instanceGroupManager),
+	}
+}
+func (m *GCPInstanceGroupManagers) Patch(projectID, zone, instanceGroupManager string, request *compute.InstanceGroupManager) PatchInstanceGroupManagersInterface {
+	return &PatchInstanceGroupManagersRequest{
+		googleCall: m.InstanceGroupManagersService.Patch(projectID, zone, instanceGroupManager, request),
+	}
+}
+func (m *GCPInstanceGroupManagers) Resize(projectID, zone, instanceGroupManager string, size int64) ResizeInstanceGroupManagersInterface {
+	return &ResizeInstanceGroupManagersRequest{
+		googleCall: m.InstanceGroupManagersService.Resize(projectID, zone, instanceGroupManager, size),
+	}
+}
+func (m *GCPInstanceGroupManagers) Delete(projectID, zone, instanceGroupManager string) DeleteInstanceGroupManagersInterface {
+	return &DeleteInstanceGroupManagersRequest{
+		googleCall: m.InstanceGroupManagersService.Delete(projectID, zone, instanceGroupManager),
+	}
+}
+
+// //// Region Operations
+func (o *GCPRegionOperations) Wait(projectID, region, operation string) WaitRegionOperationsInterface {
+	return &WaitRegionOperationsRequest{
+		googleCall: o.RegionOperationsService.Wait(projectID, region, operation),
+	}
+}
+
+// //// Addresses
+func (ad *GCPAddresses) Get(projectID, region, address string) GetAddressesInterface {
+	return &GetAddressesRequest{
+		googleCall: ad.AddressesService.Get(projectID, region, address),
+	}
+}
+func (ad *GCPAddresses) Insert(projectID, region string, address *compute.Address) CreateAddressesInterface {
+	return &CreateAddressesRequest{
+		googleCall: ad.AddressesService.Insert(projectID, region, address),
+	}
+}
+func (ad *GCPAddresses) Delete(projectID, region, address string) DeleteAddressesInterface {
+	return &DeleteAddressesRequest{
+		googleCall: ad.AddressesService.Delete(projectID, region, address),
+	}
+}
+
+// //// Global Addresses
+func (ad *GCPGlobalAddresses) Get(projectID, address string) GetGlobalAddressesInterface {
+	return &GetGlobalAddressesRequest{
+		googleCall: ad.GlobalAddressesService.Get(projectID, address),
+	}
+}
+func (ad *GCPGlobalAddresses) Insert(projectID string, address *compute.Address) CreateGlobalAddressesInterface {
+	return &CreateGlobalAddressesRequest{
+		googleCall: ad.GlobalAddressesService.Insert(projectID, address),
+	}
+}
+func (ad *GCPGlobalAddresses) Delete(projectID, address string) DeleteGlobalAddressesInterface {
+	return &DeleteGlobalAddressesRequest{
+		googleCall: ad.GlobalAddressesService.Delete(projectID, address),
+	}
+}
+
+// //// Subnetworks
+func (sn *GCPSubnetworks) Get(projectID, region, subnetwork string) GetSubnetworksInterface {
+	return &GetSubnetworksRequest{
+		googleCall: sn.SubnetworksService.Get(projectID, region, subnetwork),
+	}
+}
+func (sn *GCPSubnetworks) Insert(projectID, region string, subnetwork *compute.Subnetwork) CreateSubnetworksInterface {
+	return &CreateSubnetworksRequest{
+		googleCall: sn.SubnetworksService.Insert(projectID, region, subnetwork),
+	}
+}
+func (sn *GCPSubnetworks) Patch(projectID, region, subnetwork string, subnetworkResource *compute.Subnetwork) PatchSubnetworksInterface {
+	return &PatchSubnetworksRequest{
+		googleCall: sn.SubnetworksService.Patch(projectID, region, subnetwork, subnetworkResource),
+	}
+}
+func (sn *GCPSubnetworks) ExpandIpCidrRange(projectID, region, subnetwork string, req *compute.SubnetworksExpandIpCidrRangeRequest) ExpandIpCidrRangeSubnetworksInterface {
+	return &ExpandIpCidrRangeSubnetworksRequest{
+		googleCall: sn.SubnetworksService.ExpandIpCidrRange(projectID, region, subnetwork, req),
+	}
+}
+func (sn *GCPSubnetworks) SetPrivateIpGoogleAccess(projectID, region, subnetwork string, req *compute.SubnetworksSetPrivateIpGoogleAccessRequest) SetPrivateIpGoogleAccessSubnetworksInterface {
+	return &SetPrivateIpGoogleAccessSubnetworksRequest{
+		googleCall: sn.SubnetworksService.SetPrivateIpGoogleAccess(projectID, region, subnetwork, req),
+	}
+}
+func (sn *GCPSubnetworks) Delete(projectID, region, subnetwork string) DeleteSubnetworksInterface {
+	return &DeleteSubnetworksRequest{
+		googleCall: sn.SubnetworksService.Delete(projectID, region, subnetwork),
+	}
+}
+
+// //// Firewalls
+func (fw *GCPFirewalls) Get(projectID, firewall string) GetFirewallsInterface {
+	return &GetFirewallsRequest{
+		googleCall: fw.FirewallsService.Get(projectID, firewall),
+	}
+}
+func (fw *GCPFirewalls) Insert(projectID string, firewall *compute.Firewall) CreateFirewallsInterface {
+	return &CreateFirewallsRequest{
+		googleCall: fw.FirewallsService.Insert(projectID, firewall),
+	}
+}
+func (fw *GCPFirewalls) Patch(projectID, firewall string, firewallResource *compute.Firewall) PatchFirewallsInterface {
+	return &PatchFirewallsRequest{
+		googleCall: fw.FirewallsService.Patch(projectID, firewall, firewallResource),
+	}
+}
+func (fw *GCPFirewalls) Delete(projectID, firewall string) DeleteFirewallsInterface {
+	return &DeleteFirewallsRequest{
+		googleCall: fw.FirewallsService.Delete(projectID, firewall),
+	}
+}
+
+// //// Routers
+func (rt *GCPRouters) Get(projectID, region, router string) GetRoutersInterface {
+	return &GetRoutersRequest{
+		googleCall: rt.RoutersService.Get(projectID, region, router),
+	}
+}
+func (rt *GCPRouters) Insert(projectID, region string, router *compute.Router) CreateRoutersInterface {
+	return &CreateRoutersRequest{
+		googleCall: rt.RoutersService.Insert(projectID, region, router),
+	}
+}
+func (rt *GCPRouters) Patch(projectID, region, router string, routerResource *compute.Router) PatchRoutersInterface {
+	return &PatchRoutersRequest{
+		googleCall: rt.RoutersService.Patch(projectID, region, router, routerResource),
+	}
+}
+func (rt *GCPRouters) Delete(projectID, region, router string) DeleteRoutersInterface {
+	return &DeleteRoutersRequest{
+		googleCall: rt.RoutersService.Delete(projectID, region, router),
+	}
+}
+
+// //// Routes
+func (ro *GCPRoutes) Get(projectID, route string) GetRoutesInterface {
+	return &GetRoutesRequest{
+		googleCall: ro.RoutesService.Get(projectID, route),
+	}
+}
+func (ro *GCPRoutes) Insert(projectID string, route *compute.Route) CreateRoutesInterface {
+	return &CreateRoutesRequest{
+		googleCall: ro.RoutesService.Insert(projectID, route),
+	}
+}
+func (ro *GCPRoutes) Delete(projectID, route string) DeleteRoutesInterface {
+	return &DeleteRoutesRequest{
+		googleCall: ro.RoutesService.Delete(projectID, route),
+	}
+}
+
+// // Container
+// ///// Clusters
+func (g *GCPKubernetesClusters) List(projectID, zone string) ListClustersInterface {
+	return &ListClustersRequest{
+		googleCall: g.ClustersService.List(projectID, zone),
+	}
+}
+func (g *GCPKubernetesClusters) Get(projectID, zone, cluster string) GetClustersInterface {
+	return &GetClustersRequest{
+		googleCall: g.ClustersService.Get(projectID, zone, cluster),
+	}
+}
+func (g *GCPKubernetesClusters) Create(projectID, zone string, cluster *container.CreateClusterRequest) CreateClustersInterface {
+	return &CreateClustersRequest{
+		googleCall: g.ClustersService.Create(projectID, zone, cluster),
 	}
 }
 func (g *GCPKubernetesClusters) Delete(projectID, zone, cluster string) DeleteClustersInterface {
@@ -215,12 +1170,100 @@ func (g *GCPKubernetesClusters) Update(projectID, zone, cluster string, update *
 	}
 }
 
+// // DNS
+// ///// Managed zones
+func (mz *GCPManagedZones) Get(projectID, managedZone string) GetManagedZonesInterface {
+	return &GetManagedZonesRequest{
+		googleCall: mz.ManagedZonesService.Get(projectID, managedZone),
+	}
+}
+func (mz *GCPManagedZones) Create(projectID string, managedZone *dns.ManagedZone) CreateManagedZonesInterface {
+	return &CreateManagedZonesRequest{
+		googleCall: mz.ManagedZonesService.Create(projectID, managedZone),
+	}
+}
+func (mz *GCPManagedZones) Patch(projectID, managedZone string, managedZoneResource *dns.ManagedZone) PatchManagedZonesInterface {
+	return &PatchManagedZonesRequest{
+		googleCall: mz.ManagedZonesService.Patch(projectID, managedZone, managedZoneResource),
+	}
+}
+func (mz *GCPManagedZones) Delete(projectID, managedZone string) DeleteManagedZonesInterface {
+	return &DeleteManagedZonesRequest{
+		googleCall: mz.ManagedZonesService.Delete(projectID, managedZone),
+	}
+}
+
+// ///// Resource record sets
+func (rs *GCPResourceRecordSets) Get(projectID, managedZone, name, rrsetType string) GetResourceRecordSetsInterface {
+	return &GetResourceRecordSetsRequest{
+		googleCall: rs.ResourceRecordSetsService.Get(projectID, managedZone, name, rrsetType),
+	}
+}
+func (rs *GCPResourceRecordSets) Create(projectID, managedZone string, rrset *dns.ResourceRecordSet) CreateResourceRecordSetsInterface {
+	return &CreateResourceRecordSetsRequest{
+		googleCall: rs.ResourceRecordSetsService.Create(projectID, managedZone, rrset),
+	}
+}
+func (rs *GCPResourceRecordSets) Patch(projectID, managedZone, name, rrsetType string, rrset *dns.ResourceRecordSet) PatchResourceRecordSetsInterface {
+	return &PatchResourceRecordSetsRequest{
+		googleCall: rs.ResourceRecordSetsService.Patch(projectID, managedZone, name, rrsetType, rrset),
+	}
+}
+func (rs *GCPResourceRecordSets) Delete(projectID, managedZone, name, rrsetType string) DeleteResourceRecordSetsInterface {
+	return &DeleteResourceRecordSetsRequest{
+		googleCall: rs.ResourceRecordSetsService.Delete(projectID, managedZone, name, rrsetType),
+	}
+}
+
+// ///// Managed zone operations
+func (mo *GCPManagedZoneOperations) Get(projectID, managedZone, operation string) GetManagedZoneOperationsInterface {
+	return &GetManagedZoneOperationsRequest{
+		googleCall: mo.ManagedZoneOperationsService.Get(projectID, managedZone, operation),
+	}
+}
+
 // Execs
 // // Compute
 // //// Instances
 func (lc *ListInstancesRequest) Do(opts ...googleapi.CallOption) (*compute.InstanceList, error) {
 	return lc.googleCall.Do(opts...)
 }
+func (lc *GetInstancesRequest) Do(opts ...googleapi.CallOption) (*compute.Instance, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateInstancesRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *SetMetadataInstancesRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *GetIamPolicyInstancesRequest) Do(opts ...googleapi.CallOption) (*compute.Policy, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *SetIamPolicyInstancesRequest) Do(opts ...googleapi.CallOption) (*compute.Policy, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *StopInstancesRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *StartInstancesRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *SetMachineTypeInstancesRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *AttachDiskInstancesRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DetachDiskInstancesRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *AddAccessConfigInstancesRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteAccessConfigInstancesRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
 
 // //// Networks
 func (lc *ListNetworksRequest) Do(opts ...googleapi.CallOption) (*compute.NetworkList, error) {
@@ -235,6 +1278,173 @@ func (lc *CreateNetworksRequest) Do(opts ...googleapi.CallOption) (*compute.Oper
 func (lc *DeleteNetworksRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
 	return lc.googleCall.Do(opts...)
 }
+func (lc *AddPeeringNetworksRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *RemovePeeringNetworksRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *UpdatePeeringNetworksRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *PatchNetworksRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *SwitchToCustomModeNetworksRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Zone Operations
+func (lc *WaitZoneOperationsRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Disks
+func (lc *GetDisksRequest) Do(opts ...googleapi.CallOption) (*compute.Disk, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateDisksRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteDisksRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *ResizeDisksRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *SetLabelsDisksRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Global Operations
+func (lc *WaitGlobalOperationsRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Snapshots
+func (lc *GetSnapshotsRequest) Do(opts ...googleapi.CallOption) (*compute.Snapshot, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateSnapshotsRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteSnapshotsRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Instance Templates
+func (lc *GetInstanceTemplatesRequest) Do(opts ...googleapi.CallOption) (*compute.InstanceTemplate, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateInstanceTemplatesRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteInstanceTemplatesRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Instance Group Managers
+func (lc *GetInstanceGroupManagersRequest) Do(opts ...googleapi.CallOption) (*compute.InstanceGroupManager, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateInstanceGroupManagersRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *PatchInstanceGroupManagersRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *ResizeInstanceGroupManagersRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteInstanceGroupManagersRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Region Operations
+func (lc *WaitRegionOperationsRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Addresses
+func (lc *GetAddressesRequest) Do(opts ...googleapi.CallOption) (*compute.Address, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateAddressesRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteAddressesRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Global Addresses
+func (lc *GetGlobalAddressesRequest) Do(opts ...googleapi.CallOption) (*compute.Address, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateGlobalAddressesRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteGlobalAddressesRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Subnetworks
+func (lc *GetSubnetworksRequest) Do(opts ...googleapi.CallOption) (*compute.Subnetwork, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateSubnetworksRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *PatchSubnetworksRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *ExpandIpCidrRangeSubnetworksRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *SetPrivateIpGoogleAccessSubnetworksRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteSubnetworksRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Firewalls
+func (lc *GetFirewallsRequest) Do(opts ...googleapi.CallOption) (*compute.Firewall, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateFirewallsRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *PatchFirewallsRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteFirewallsRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Routers
+func (lc *GetRoutersRequest) Do(opts ...googleapi.CallOption) (*compute.Router, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateRoutersRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *PatchRoutersRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteRoutersRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Routes
+func (lc *GetRoutesRequest) Do(opts ...googleapi.CallOption) (*compute.Route, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateRoutesRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteRoutesRequest) Do(opts ...googleapi.CallOption) (*compute.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
 
 // // Container
 // //// Clusters
@@ -253,3 +1463,37 @@ func (lc *DeleteClustersRequest) Do(opts ...googleapi.CallOption) (*container.Op
 func (lc *UpdateClustersRequest) Do(opts ...googleapi.CallOption) (*container.Operation, error) {
 	return lc.googleCall.Do(opts...)
 }
+
+// // DNS
+// //// Managed zones
+func (lc *GetManagedZonesRequest) Do(opts ...googleapi.CallOption) (*dns.ManagedZone, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateManagedZonesRequest) Do(opts ...googleapi.CallOption) (*dns.ManagedZone, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *PatchManagedZonesRequest) Do(opts ...googleapi.CallOption) (*dns.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteManagedZonesRequest) Do(opts ...googleapi.CallOption) error {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Resource record sets
+func (lc *GetResourceRecordSetsRequest) Do(opts ...googleapi.CallOption) (*dns.ResourceRecordSet, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateResourceRecordSetsRequest) Do(opts ...googleapi.CallOption) (*dns.ResourceRecordSet, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *PatchResourceRecordSetsRequest) Do(opts ...googleapi.CallOption) (*dns.ResourceRecordSet, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteResourceRecordSetsRequest) Do(opts ...googleapi.CallOption) (*dns.ResourceRecordSetsDeleteResponse, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Managed zone operations
+func (lc *GetManagedZoneOperationsRequest) Do(opts ...googleapi.CallOption) (*dns.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
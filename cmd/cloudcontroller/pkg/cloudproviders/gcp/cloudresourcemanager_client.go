@@ -0,0 +1,101 @@
+package gcp
+
+import (
+	"fmt"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/googleapi"
+)
+
+//===============================================================================================
+// TYPES AND INTERFACES
+//===============================================================================================
+
+// Services
+type (
+	ResourceManagerService struct {
+		Clients ResourceManagerClients
+	}
+)
+
+// Clients
+type (
+	ResourceManagerClients struct {
+		Projects ProjectsInterface
+	}
+)
+
+// Resources
+type (
+	// cloud resource manager resources
+	GCPProjects struct {
+		ProjectsService *cloudresourcemanager.ProjectsService
+	}
+)
+
+// Interfaces
+type (
+	// cloud resource manager interfaces
+	//// projects
+	ProjectsInterface interface {
+		GetIamPolicy(project string) GetIamPolicyProjectsInterface
+		SetIamPolicy(project string, request *cloudresourcemanager.SetIamPolicyRequest) SetIamPolicyProjectsInterface
+	}
+)
+
+// Requests
+type (
+	// cloud resource manager do interfaces
+	//// projects
+	GetIamPolicyProjectsInterface interface {
+		Do(opts ...googleapi.CallOption) (*cloudresourcemanager.Policy, error)
+	}
+	SetIamPolicyProjectsInterface interface {
+		Do(opts ...googleapi.CallOption) (*cloudresourcemanager.Policy, error)
+	}
+)
+
+// Executor requests
+type (
+	// cloud resource manager google calls
+	//// projects
+	GetIamPolicyProjectsRequest struct {
+		googleCall *cloudresourcemanager.ProjectsGetIamPolicyCall
+	}
+	SetIamPolicyProjectsRequest struct {
+		googleCall *cloudresourcemanager.ProjectsSetIamPolicyCall
+	}
+)
+
+// ===============================================================================================
+// FUNCTIONS
+// ===============================================================================================
+// Verbs
+// // Cloud Resource Manager
+// ///// Projects
+func (p *GCPProjects) GetIamPolicy(projectID string) GetIamPolicyProjectsInterface {
+	return &GetIamPolicyProjectsRequest{
+		googleCall: p.ProjectsService.GetIamPolicy(projectID, &cloudresourcemanager.GetIamPolicyRequest{
+			Options: &cloudresourcemanager.GetPolicyOptions{RequestedPolicyVersion: iamPolicyVersion},
+		}),
+	}
+}
+func (p *GCPProjects) SetIamPolicy(projectID string, request *cloudresourcemanager.SetIamPolicyRequest) SetIamPolicyProjectsInterface {
+	return &SetIamPolicyProjectsRequest{
+		googleCall: p.ProjectsService.SetIamPolicy(projectID, request),
+	}
+}
+
+// ProjectPath returns the resource name of the project, e.g. projects/my-project
+func ProjectPath(projectID string) string {
+	return fmt.Sprintf("projects/%s", projectID)
+}
+
+// Execs
+// // Cloud Resource Manager
+// //// Projects
+func (lc *GetIamPolicyProjectsRequest) Do(opts ...googleapi.CallOption) (*cloudresourcemanager.Policy, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *SetIamPolicyProjectsRequest) Do(opts ...googleapi.CallOption) (*cloudresourcemanager.Policy, error) {
+	return lc.googleCall.Do(opts...)
+}
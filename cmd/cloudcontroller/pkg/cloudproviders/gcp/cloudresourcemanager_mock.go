@@ -0,0 +1,148 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/cloudproviders/gcp/cloudresourcemanager_client.go
+
+// Package gcp is a generated GoMock package.
+package gcp
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "google.golang.org/api/cloudresourcemanager/v1"
+	googleapi "google.golang.org/api/googleapi"
+)
+
+// MockProjectsInterface is a mock of ProjectsInterface interface.
+type MockProjectsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectsInterfaceMockRecorder
+}
+
+// MockProjectsInterfaceMockRecorder is the mock recorder for MockProjectsInterface.
+type MockProjectsInterfaceMockRecorder struct {
+	mock *MockProjectsInterface
+}
+
+// NewMockProjectsInterface creates a new mock instance.
+func NewMockProjectsInterface(ctrl *gomock.Controller) *MockProjectsInterface {
+	mock := &MockProjectsInterface{ctrl: ctrl}
+	mock.recorder = &MockProjectsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectsInterface) EXPECT() *MockProjectsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetIamPolicy mocks base method.
+func (m *MockProjectsInterface) GetIamPolicy(project string) GetIamPolicyProjectsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIamPolicy", project)
+	ret0, _ := ret[0].(GetIamPolicyProjectsInterface)
+	return ret0
+}
+
+// GetIamPolicy indicates an expected call of GetIamPolicy.
+func (mr *MockProjectsInterfaceMockRecorder) GetIamPolicy(project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIamPolicy", reflect.TypeOf((*MockProjectsInterface)(nil).GetIamPolicy), project)
+}
+
+// SetIamPolicy mocks base method.
+func (m *MockProjectsInterface) SetIamPolicy(project string, request *v1.SetIamPolicyRequest) SetIamPolicyProjectsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIamPolicy", project, request)
+	ret0, _ := ret[0].(SetIamPolicyProjectsInterface)
+	return ret0
+}
+
+// SetIamPolicy indicates an expected call of SetIamPolicy.
+func (mr *MockProjectsInterfaceMockRecorder) SetIamPolicy(project, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIamPolicy", reflect.TypeOf((*MockProjectsInterface)(nil).SetIamPolicy), project, request)
+}
+
+// MockGetIamPolicyProjectsInterface is a mock of GetIamPolicyProjectsInterface interface.
+type MockGetIamPolicyProjectsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetIamPolicyProjectsInterfaceMockRecorder
+}
+
+// MockGetIamPolicyProjectsInterfaceMockRecorder is the mock recorder for MockGetIamPolicyProjectsInterface.
+type MockGetIamPolicyProjectsInterfaceMockRecorder struct {
+	mock *MockGetIamPolicyProjectsInterface
+}
+
+// NewMockGetIamPolicyProjectsInterface creates a new mock instance.
+func NewMockGetIamPolicyProjectsInterface(ctrl *gomock.Controller) *MockGetIamPolicyProjectsInterface {
+	mock := &MockGetIamPolicyProjectsInterface{ctrl: ctrl}
+	mock.recorder = &MockGetIamPolicyProjectsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetIamPolicyProjectsInterface) EXPECT() *MockGetIamPolicyProjectsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetIamPolicyProjectsInterface) Do(opts ...googleapi.CallOption) (*v1.Policy, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetIamPolicyProjectsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetIamPolicyProjectsInterface)(nil).Do), opts...)
+}
+
+// MockSetIamPolicyProjectsInterface is a mock of SetIamPolicyProjectsInterface interface.
+type MockSetIamPolicyProjectsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetIamPolicyProjectsInterfaceMockRecorder
+}
+
+// MockSetIamPolicyProjectsInterfaceMockRecorder is the mock recorder for MockSetIamPolicyProjectsInterface.
+type MockSetIamPolicyProjectsInterfaceMockRecorder struct {
+	mock *MockSetIamPolicyProjectsInterface
+}
+
+// NewMockSetIamPolicyProjectsInterface creates a new mock instance.
+func NewMockSetIamPolicyProjectsInterface(ctrl *gomock.Controller) *MockSetIamPolicyProjectsInterface {
+	mock := &MockSetIamPolicyProjectsInterface{ctrl: ctrl}
+	mock.recorder = &MockSetIamPolicyProjectsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetIamPolicyProjectsInterface) EXPECT() *MockSetIamPolicyProjectsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSetIamPolicyProjectsInterface) Do(opts ...googleapi.CallOption) (*v1.Policy, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSetIamPolicyProjectsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSetIamPolicyProjectsInterface)(nil).Do), opts...)
+}
@@ -0,0 +1,224 @@
+package gcp
+
+import (
+	"fmt"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iam/v1"
+)
+
+//===============================================================================================
+// TYPES AND INTERFACES
+//===============================================================================================
+
+// Services
+type (
+	IAMService struct {
+		Clients IAMClients
+	}
+)
+
+// Clients
+type (
+	IAMClients struct {
+		ServiceAccounts    ServiceAccountsInterface
+		ServiceAccountKeys ServiceAccountKeysInterface
+	}
+)
+
+// Resources
+type (
+	// iam resources
+	GCPServiceAccounts struct {
+		ServiceAccountsService *iam.ProjectsServiceAccountsService
+	}
+	GCPServiceAccountKeys struct {
+		KeysService *iam.ProjectsServiceAccountsKeysService
+	}
+)
+
+// Interfaces
+type (
+	// iam interfaces
+	//// service accounts
+	ServiceAccountsInterface interface {
+		Get(project, email string) GetServiceAccountsInterface
+		Create(project string, request *iam.CreateServiceAccountRequest) CreateServiceAccountsInterface
+		Patch(project, email string, request *iam.PatchServiceAccountRequest) PatchServiceAccountsInterface
+		Delete(project, email string) DeleteServiceAccountsInterface
+		GetIamPolicy(project, email string) GetIamPolicyServiceAccountsInterface
+		SetIamPolicy(project, email string, request *iam.SetIamPolicyRequest) SetIamPolicyServiceAccountsInterface
+	}
+	//// service account keys
+	ServiceAccountKeysInterface interface {
+		Get(project, email, keyID string) GetServiceAccountKeysInterface
+		Create(project, email string, request *iam.CreateServiceAccountKeyRequest) CreateServiceAccountKeysInterface
+		Delete(project, email, keyID string) DeleteServiceAccountKeysInterface
+	}
+)
+
+// Requests
+type (
+	// iam do interfaces
+	//// service accounts
+	GetServiceAccountsInterface interface {
+		Do(opts ...googleapi.CallOption) (*iam.ServiceAccount, error)
+	}
+	CreateServiceAccountsInterface interface {
+		Do(opts ...googleapi.CallOption) (*iam.ServiceAccount, error)
+	}
+	PatchServiceAccountsInterface interface {
+		Do(opts ...googleapi.CallOption) (*iam.ServiceAccount, error)
+	}
+	DeleteServiceAccountsInterface interface {
+		Do(opts ...googleapi.CallOption) (*iam.Empty, error)
+	}
+	GetIamPolicyServiceAccountsInterface interface {
+		Do(opts ...googleapi.CallOption) (*iam.Policy, error)
+	}
+	SetIamPolicyServiceAccountsInterface interface {
+		Do(opts ...googleapi.CallOption) (*iam.Policy, error)
+	}
+	//// service account keys
+	GetServiceAccountKeysInterface interface {
+		Do(opts ...googleapi.CallOption) (*iam.ServiceAccountKey, error)
+	}
+	CreateServiceAccountKeysInterface interface {
+		Do(opts ...googleapi.CallOption) (*iam.ServiceAccountKey, error)
+	}
+	DeleteServiceAccountKeysInterface interface {
+		Do(opts ...googleapi.CallOption) (*iam.Empty, error)
+	}
+)
+
+// Executor requests
+type (
+	// iam google calls
+	//// service accounts
+	GetServiceAccountsRequest struct {
+		googleCall *iam.ProjectsServiceAccountsGetCall
+	}
+	CreateServiceAccountsRequest struct {
+		googleCall *iam.ProjectsServiceAccountsCreateCall
+	}
+	PatchServiceAccountsRequest struct {
+		googleCall *iam.ProjectsServiceAccountsPatchCall
+	}
+	DeleteServiceAccountsRequest struct {
+		googleCall *iam.ProjectsServiceAccountsDeleteCall
+	}
+	GetIamPolicyServiceAccountsRequest struct {
+		googleCall *iam.ProjectsServiceAccountsGetIamPolicyCall
+	}
+	SetIamPolicyServiceAccountsRequest struct {
+		googleCall *iam.ProjectsServiceAccountsSetIamPolicyCall
+	}
+	//// service account keys
+	GetServiceAccountKeysRequest struct {
+		googleCall *iam.ProjectsServiceAccountsKeysGetCall
+	}
+	CreateServiceAccountKeysRequest struct {
+		googleCall *iam.ProjectsServiceAccountsKeysCreateCall
+	}
+	DeleteServiceAccountKeysRequest struct {
+		googleCall *iam.ProjectsServiceAccountsKeysDeleteCall
+	}
+)
+
+// ===============================================================================================
+// FUNCTIONS
+// ===============================================================================================
+// Verbs
+// // IAM
+// ///// Service Accounts
+func (s *GCPServiceAccounts) Get(projectID, email string) GetServiceAccountsInterface {
+	return &GetServiceAccountsRequest{
+		googleCall: s.ServiceAccountsService.Get(ServiceAccountPath(projectID, email)),
+	}
+}
+func (s *GCPServiceAccounts) Create(projectID string, request *iam.CreateServiceAccountRequest) CreateServiceAccountsInterface {
+	return &CreateServiceAccountsRequest{
+		googleCall: s.ServiceAccountsService.Create(fmt.Sprintf("projects/%s", projectID), request),
+	}
+}
+func (s *GCPServiceAccounts) Patch(projectID, email string, request *iam.PatchServiceAccountRequest) PatchServiceAccountsInterface {
+	return &PatchServiceAccountsRequest{
+		googleCall: s.ServiceAccountsService.Patch(ServiceAccountPath(projectID, email), request),
+	}
+}
+func (s *GCPServiceAccounts) Delete(projectID, email string) DeleteServiceAccountsInterface {
+	return &DeleteServiceAccountsRequest{
+		googleCall: s.ServiceAccountsService.Delete(ServiceAccountPath(projectID, email)),
+	}
+}
+
+func (s *GCPServiceAccounts) GetIamPolicy(projectID, email string) GetIamPolicyServiceAccountsInterface {
+	return &GetIamPolicyServiceAccountsRequest{
+		googleCall: s.ServiceAccountsService.GetIamPolicy(ServiceAccountPath(projectID, email)).OptionsRequestedPolicyVersion(iamPolicyVersion),
+	}
+}
+func (s *GCPServiceAccounts) SetIamPolicy(projectID, email string, request *iam.SetIamPolicyRequest) SetIamPolicyServiceAccountsInterface {
+	return &SetIamPolicyServiceAccountsRequest{
+		googleCall: s.ServiceAccountsService.SetIamPolicy(ServiceAccountPath(projectID, email), request),
+	}
+}
+
+// ///// Service Account Keys
+func (k *GCPServiceAccountKeys) Get(projectID, email, keyID string) GetServiceAccountKeysInterface {
+	return &GetServiceAccountKeysRequest{
+		googleCall: k.KeysService.Get(ServiceAccountKeyPath(projectID, email, keyID)),
+	}
+}
+func (k *GCPServiceAccountKeys) Create(projectID, email string, request *iam.CreateServiceAccountKeyRequest) CreateServiceAccountKeysInterface {
+	return &CreateServiceAccountKeysRequest{
+		googleCall: k.KeysService.Create(ServiceAccountPath(projectID, email), request),
+	}
+}
+func (k *GCPServiceAccountKeys) Delete(projectID, email, keyID string) DeleteServiceAccountKeysInterface {
+	return &DeleteServiceAccountKeysRequest{
+		googleCall: k.KeysService.Delete(ServiceAccountKeyPath(projectID, email, keyID)),
+	}
+}
+
+// ServiceAccountPath returns the resource name of the service account, e.g.
+// projects/my-project/serviceAccounts/app@my-project.iam.gserviceaccount.com
+func ServiceAccountPath(projectID, email string) string {
+	return fmt.Sprintf("projects/%s/serviceAccounts/%s", projectID, email)
+}
+
+// ServiceAccountKeyPath returns the resource name of the service account key
+func ServiceAccountKeyPath(projectID, email, keyID string) string {
+	return fmt.Sprintf("%s/keys/%s", ServiceAccountPath(projectID, email), keyID)
+}
+
+// Execs
+// // IAM
+// //// Service Accounts
+func (lc *GetServiceAccountsRequest) Do(opts ...googleapi.CallOption) (*iam.ServiceAccount, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateServiceAccountsRequest) Do(opts ...googleapi.CallOption) (*iam.ServiceAccount, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *PatchServiceAccountsRequest) Do(opts ...googleapi.CallOption) (*iam.ServiceAccount, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteServiceAccountsRequest) Do(opts ...googleapi.CallOption) (*iam.Empty, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *GetIamPolicyServiceAccountsRequest) Do(opts ...googleapi.CallOption) (*iam.Policy, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *SetIamPolicyServiceAccountsRequest) Do(opts ...googleapi.CallOption) (*iam.Policy, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Service Account Keys
+func (lc *GetServiceAccountKeysRequest) Do(opts ...googleapi.CallOption) (*iam.ServiceAccountKey, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateServiceAccountKeysRequest) Do(opts ...googleapi.CallOption) (*iam.ServiceAccountKey, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteServiceAccountKeysRequest) Do(opts ...googleapi.CallOption) (*iam.Empty, error) {
+	return lc.googleCall.Do(opts...)
+}
@@ -0,0 +1,563 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/cloudproviders/gcp/iam_client.go
+
+// Package gcp is a generated GoMock package.
+package gcp
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	googleapi "google.golang.org/api/googleapi"
+	v1 "google.golang.org/api/iam/v1"
+)
+
+// MockServiceAccountsInterface is a mock of ServiceAccountsInterface interface.
+type MockServiceAccountsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceAccountsInterfaceMockRecorder
+}
+
+// MockServiceAccountsInterfaceMockRecorder is the mock recorder for MockServiceAccountsInterface.
+type MockServiceAccountsInterfaceMockRecorder struct {
+	mock *MockServiceAccountsInterface
+}
+
+// NewMockServiceAccountsInterface creates a new mock instance.
+func NewMockServiceAccountsInterface(ctrl *gomock.Controller) *MockServiceAccountsInterface {
+	mock := &MockServiceAccountsInterface{ctrl: ctrl}
+	mock.recorder = &MockServiceAccountsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceAccountsInterface) EXPECT() *MockServiceAccountsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockServiceAccountsInterface) Create(project string, request *v1.CreateServiceAccountRequest) CreateServiceAccountsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", project, request)
+	ret0, _ := ret[0].(CreateServiceAccountsInterface)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockServiceAccountsInterfaceMockRecorder) Create(project, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockServiceAccountsInterface)(nil).Create), project, request)
+}
+
+// Delete mocks base method.
+func (m *MockServiceAccountsInterface) Delete(project, email string) DeleteServiceAccountsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, email)
+	ret0, _ := ret[0].(DeleteServiceAccountsInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceAccountsInterfaceMockRecorder) Delete(project, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockServiceAccountsInterface)(nil).Delete), project, email)
+}
+
+// Get mocks base method.
+func (m *MockServiceAccountsInterface) Get(project, email string) GetServiceAccountsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, email)
+	ret0, _ := ret[0].(GetServiceAccountsInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockServiceAccountsInterfaceMockRecorder) Get(project, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockServiceAccountsInterface)(nil).Get), project, email)
+}
+
+// GetIamPolicy mocks base method.
+func (m *MockServiceAccountsInterface) GetIamPolicy(project, email string) GetIamPolicyServiceAccountsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIamPolicy", project, email)
+	ret0, _ := ret[0].(GetIamPolicyServiceAccountsInterface)
+	return ret0
+}
+
+// GetIamPolicy indicates an expected call of GetIamPolicy.
+func (mr *MockServiceAccountsInterfaceMockRecorder) GetIamPolicy(project, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIamPolicy", reflect.TypeOf((*MockServiceAccountsInterface)(nil).GetIamPolicy), project, email)
+}
+
+// Patch mocks base method.
+func (m *MockServiceAccountsInterface) Patch(project, email string, request *v1.PatchServiceAccountRequest) PatchServiceAccountsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", project, email, request)
+	ret0, _ := ret[0].(PatchServiceAccountsInterface)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockServiceAccountsInterfaceMockRecorder) Patch(project, email, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockServiceAccountsInterface)(nil).Patch), project, email, request)
+}
+
+// SetIamPolicy mocks base method.
+func (m *MockServiceAccountsInterface) SetIamPolicy(project, email string, request *v1.SetIamPolicyRequest) SetIamPolicyServiceAccountsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIamPolicy", project, email, request)
+	ret0, _ := ret[0].(SetIamPolicyServiceAccountsInterface)
+	return ret0
+}
+
+// SetIamPolicy indicates an expected call of SetIamPolicy.
+func (mr *MockServiceAccountsInterfaceMockRecorder) SetIamPolicy(project, email, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIamPolicy", reflect.TypeOf((*MockServiceAccountsInterface)(nil).SetIamPolicy), project, email, request)
+}
+
+// MockServiceAccountKeysInterface is a mock of ServiceAccountKeysInterface interface.
+type MockServiceAccountKeysInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceAccountKeysInterfaceMockRecorder
+}
+
+// MockServiceAccountKeysInterfaceMockRecorder is the mock recorder for MockServiceAccountKeysInterface.
+type MockServiceAccountKeysInterfaceMockRecorder struct {
+	mock *MockServiceAccountKeysInterface
+}
+
+// NewMockServiceAccountKeysInterface creates a new mock instance.
+func NewMockServiceAccountKeysInterface(ctrl *gomock.Controller) *MockServiceAccountKeysInterface {
+	mock := &MockServiceAccountKeysInterface{ctrl: ctrl}
+	mock.recorder = &MockServiceAccountKeysInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceAccountKeysInterface) EXPECT() *MockServiceAccountKeysInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockServiceAccountKeysInterface) Create(project, email string, request *v1.CreateServiceAccountKeyRequest) CreateServiceAccountKeysInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", project, email, request)
+	ret0, _ := ret[0].(CreateServiceAccountKeysInterface)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockServiceAccountKeysInterfaceMockRecorder) Create(project, email, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockServiceAccountKeysInterface)(nil).Create), project, email, request)
+}
+
+// Delete mocks base method.
+func (m *MockServiceAccountKeysInterface) Delete(project, email, keyID string) DeleteServiceAccountKeysInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, email, keyID)
+	ret0, _ := ret[0].(DeleteServiceAccountKeysInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceAccountKeysInterfaceMockRecorder) Delete(project, email, keyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockServiceAccountKeysInterface)(nil).Delete), project, email, keyID)
+}
+
+// Get mocks base method.
+func (m *MockServiceAccountKeysInterface) Get(project, email, keyID string) GetServiceAccountKeysInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, email, keyID)
+	ret0, _ := ret[0].(GetServiceAccountKeysInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockServiceAccountKeysInterfaceMockRecorder) Get(project, email, keyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockServiceAccountKeysInterface)(nil).Get), project, email, keyID)
+}
+
+// MockGetServiceAccountsInterface is a mock of GetServiceAccountsInterface interface.
+type MockGetServiceAccountsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetServiceAccountsInterfaceMockRecorder
+}
+
+// MockGetServiceAccountsInterfaceMockRecorder is the mock recorder for MockGetServiceAccountsInterface.
+type MockGetServiceAccountsInterfaceMockRecorder struct {
+	mock *MockGetServiceAccountsInterface
+}
+
+// NewMockGetServiceAccountsInterface creates a new mock instance.
+func NewMockGetServiceAccountsInterface(ctrl *gomock.Controller) *MockGetServiceAccountsInterface {
+	mock := &MockGetServiceAccountsInterface{ctrl: ctrl}
+	mock.recorder = &MockGetServiceAccountsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetServiceAccountsInterface) EXPECT() *MockGetServiceAccountsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetServiceAccountsInterface) Do(opts ...googleapi.CallOption) (*v1.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetServiceAccountsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetServiceAccountsInterface)(nil).Do), opts...)
+}
+
+// MockCreateServiceAccountsInterface is a mock of CreateServiceAccountsInterface interface.
+type MockCreateServiceAccountsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateServiceAccountsInterfaceMockRecorder
+}
+
+// MockCreateServiceAccountsInterfaceMockRecorder is the mock recorder for MockCreateServiceAccountsInterface.
+type MockCreateServiceAccountsInterfaceMockRecorder struct {
+	mock *MockCreateServiceAccountsInterface
+}
+
+// NewMockCreateServiceAccountsInterface creates a new mock instance.
+func NewMockCreateServiceAccountsInterface(ctrl *gomock.Controller) *MockCreateServiceAccountsInterface {
+	mock := &MockCreateServiceAccountsInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateServiceAccountsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateServiceAccountsInterface) EXPECT() *MockCreateServiceAccountsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateServiceAccountsInterface) Do(opts ...googleapi.CallOption) (*v1.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateServiceAccountsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateServiceAccountsInterface)(nil).Do), opts...)
+}
+
+// MockPatchServiceAccountsInterface is a mock of PatchServiceAccountsInterface interface.
+type MockPatchServiceAccountsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPatchServiceAccountsInterfaceMockRecorder
+}
+
+// MockPatchServiceAccountsInterfaceMockRecorder is the mock recorder for MockPatchServiceAccountsInterface.
+type MockPatchServiceAccountsInterfaceMockRecorder struct {
+	mock *MockPatchServiceAccountsInterface
+}
+
+// NewMockPatchServiceAccountsInterface creates a new mock instance.
+func NewMockPatchServiceAccountsInterface(ctrl *gomock.Controller) *MockPatchServiceAccountsInterface {
+	mock := &MockPatchServiceAccountsInterface{ctrl: ctrl}
+	mock.recorder = &MockPatchServiceAccountsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPatchServiceAccountsInterface) EXPECT() *MockPatchServiceAccountsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPatchServiceAccountsInterface) Do(opts ...googleapi.CallOption) (*v1.ServiceAccount, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.ServiceAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPatchServiceAccountsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPatchServiceAccountsInterface)(nil).Do), opts...)
+}
+
+// MockDeleteServiceAccountsInterface is a mock of DeleteServiceAccountsInterface interface.
+type MockDeleteServiceAccountsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteServiceAccountsInterfaceMockRecorder
+}
+
+// MockDeleteServiceAccountsInterfaceMockRecorder is the mock recorder for MockDeleteServiceAccountsInterface.
+type MockDeleteServiceAccountsInterfaceMockRecorder struct {
+	mock *MockDeleteServiceAccountsInterface
+}
+
+// NewMockDeleteServiceAccountsInterface creates a new mock instance.
+func NewMockDeleteServiceAccountsInterface(ctrl *gomock.Controller) *MockDeleteServiceAccountsInterface {
+	mock := &MockDeleteServiceAccountsInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteServiceAccountsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteServiceAccountsInterface) EXPECT() *MockDeleteServiceAccountsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteServiceAccountsInterface) Do(opts ...googleapi.CallOption) (*v1.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteServiceAccountsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteServiceAccountsInterface)(nil).Do), opts...)
+}
+
+// MockGetIamPolicyServiceAccountsInterface is a mock of GetIamPolicyServiceAccountsInterface interface.
+type MockGetIamPolicyServiceAccountsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetIamPolicyServiceAccountsInterfaceMockRecorder
+}
+
+// MockGetIamPolicyServiceAccountsInterfaceMockRecorder is the mock recorder for MockGetIamPolicyServiceAccountsInterface.
+type MockGetIamPolicyServiceAccountsInterfaceMockRecorder struct {
+	mock *MockGetIamPolicyServiceAccountsInterface
+}
+
+// NewMockGetIamPolicyServiceAccountsInterface creates a new mock instance.
+func NewMockGetIamPolicyServiceAccountsInterface(ctrl *gomock.Controller) *MockGetIamPolicyServiceAccountsInterface {
+	mock := &MockGetIamPolicyServiceAccountsInterface{ctrl: ctrl}
+	mock.recorder = &MockGetIamPolicyServiceAccountsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetIamPolicyServiceAccountsInterface) EXPECT() *MockGetIamPolicyServiceAccountsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetIamPolicyServiceAccountsInterface) Do(opts ...googleapi.CallOption) (*v1.Policy, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetIamPolicyServiceAccountsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetIamPolicyServiceAccountsInterface)(nil).Do), opts...)
+}
+
+// MockSetIamPolicyServiceAccountsInterface is a mock of SetIamPolicyServiceAccountsInterface interface.
+type MockSetIamPolicyServiceAccountsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetIamPolicyServiceAccountsInterfaceMockRecorder
+}
+
+// MockSetIamPolicyServiceAccountsInterfaceMockRecorder is the mock recorder for MockSetIamPolicyServiceAccountsInterface.
+type MockSetIamPolicyServiceAccountsInterfaceMockRecorder struct {
+	mock *MockSetIamPolicyServiceAccountsInterface
+}
+
+// NewMockSetIamPolicyServiceAccountsInterface creates a new mock instance.
+func NewMockSetIamPolicyServiceAccountsInterface(ctrl *gomock.Controller) *MockSetIamPolicyServiceAccountsInterface {
+	mock := &MockSetIamPolicyServiceAccountsInterface{ctrl: ctrl}
+	mock.recorder = &MockSetIamPolicyServiceAccountsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetIamPolicyServiceAccountsInterface) EXPECT() *MockSetIamPolicyServiceAccountsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSetIamPolicyServiceAccountsInterface) Do(opts ...googleapi.CallOption) (*v1.Policy, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSetIamPolicyServiceAccountsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSetIamPolicyServiceAccountsInterface)(nil).Do), opts...)
+}
+
+// MockGetServiceAccountKeysInterface is a mock of GetServiceAccountKeysInterface interface.
+type MockGetServiceAccountKeysInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetServiceAccountKeysInterfaceMockRecorder
+}
+
+// MockGetServiceAccountKeysInterfaceMockRecorder is the mock recorder for MockGetServiceAccountKeysInterface.
+type MockGetServiceAccountKeysInterfaceMockRecorder struct {
+	mock *MockGetServiceAccountKeysInterface
+}
+
+// NewMockGetServiceAccountKeysInterface creates a new mock instance.
+func NewMockGetServiceAccountKeysInterface(ctrl *gomock.Controller) *MockGetServiceAccountKeysInterface {
+	mock := &MockGetServiceAccountKeysInterface{ctrl: ctrl}
+	mock.recorder = &MockGetServiceAccountKeysInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetServiceAccountKeysInterface) EXPECT() *MockGetServiceAccountKeysInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetServiceAccountKeysInterface) Do(opts ...googleapi.CallOption) (*v1.ServiceAccountKey, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.ServiceAccountKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetServiceAccountKeysInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetServiceAccountKeysInterface)(nil).Do), opts...)
+}
+
+// MockCreateServiceAccountKeysInterface is a mock of CreateServiceAccountKeysInterface interface.
+type MockCreateServiceAccountKeysInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateServiceAccountKeysInterfaceMockRecorder
+}
+
+// MockCreateServiceAccountKeysInterfaceMockRecorder is the mock recorder for MockCreateServiceAccountKeysInterface.
+type MockCreateServiceAccountKeysInterfaceMockRecorder struct {
+	mock *MockCreateServiceAccountKeysInterface
+}
+
+// NewMockCreateServiceAccountKeysInterface creates a new mock instance.
+func NewMockCreateServiceAccountKeysInterface(ctrl *gomock.Controller) *MockCreateServiceAccountKeysInterface {
+	mock := &MockCreateServiceAccountKeysInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateServiceAccountKeysInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateServiceAccountKeysInterface) EXPECT() *MockCreateServiceAccountKeysInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateServiceAccountKeysInterface) Do(opts ...googleapi.CallOption) (*v1.ServiceAccountKey, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.ServiceAccountKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateServiceAccountKeysInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateServiceAccountKeysInterface)(nil).Do), opts...)
+}
+
+// MockDeleteServiceAccountKeysInterface is a mock of DeleteServiceAccountKeysInterface interface.
+type MockDeleteServiceAccountKeysInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteServiceAccountKeysInterfaceMockRecorder
+}
+
+// MockDeleteServiceAccountKeysInterfaceMockRecorder is the mock recorder for MockDeleteServiceAccountKeysInterface.
+type MockDeleteServiceAccountKeysInterfaceMockRecorder struct {
+	mock *MockDeleteServiceAccountKeysInterface
+}
+
+// NewMockDeleteServiceAccountKeysInterface creates a new mock instance.
+func NewMockDeleteServiceAccountKeysInterface(ctrl *gomock.Controller) *MockDeleteServiceAccountKeysInterface {
+	mock := &MockDeleteServiceAccountKeysInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteServiceAccountKeysInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteServiceAccountKeysInterface) EXPECT() *MockDeleteServiceAccountKeysInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteServiceAccountKeysInterface) Do(opts ...googleapi.CallOption) (*v1.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteServiceAccountKeysInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteServiceAccountKeysInterface)(nil).Do), opts...)
+}
@@ -10,6 +10,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	v1 "google.golang.org/api/compute/v1"
 	v10 "google.golang.org/api/container/v1"
+	v11 "google.golang.org/api/dns/v1"
 	googleapi "google.golang.org/api/googleapi"
 )
 
@@ -36,6 +37,104 @@ func (m *MockInstancesInterface) EXPECT() *MockInstancesInterfaceMockRecorder {
 	return m.recorder
 }
 
+// AddAccessConfig mocks base method.
+func (m *MockInstancesInterface) AddAccessConfig(project, zone, instance, networkInterface string, accessConfig *v1.AccessConfig) AddAccessConfigInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAccessConfig", project, zone, instance, networkInterface, accessConfig)
+	ret0, _ := ret[0].(AddAccessConfigInstancesInterface)
+	return ret0
+}
+
+// AddAccessConfig indicates an expected call of AddAccessConfig.
+func (mr *MockInstancesInterfaceMockRecorder) AddAccessConfig(project, zone, instance, networkInterface, accessConfig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccessConfig", reflect.TypeOf((*MockInstancesInterface)(nil).AddAccessConfig), project, zone, instance, networkInterface, accessConfig)
+}
+
+// AttachDisk mocks base method.
+func (m *MockInstancesInterface) AttachDisk(project, zone, instance string, disk *v1.AttachedDisk) AttachDiskInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachDisk", project, zone, instance, disk)
+	ret0, _ := ret[0].(AttachDiskInstancesInterface)
+	return ret0
+}
+
+// AttachDisk indicates an expected call of AttachDisk.
+func (mr *MockInstancesInterfaceMockRecorder) AttachDisk(project, zone, instance, disk interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachDisk", reflect.TypeOf((*MockInstancesInterface)(nil).AttachDisk), project, zone, instance, disk)
+}
+
+// DeleteAccessConfig mocks base method.
+func (m *MockInstancesInterface) DeleteAccessConfig(project, zone, instance, accessConfig, networkInterface string) DeleteAccessConfigInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccessConfig", project, zone, instance, accessConfig, networkInterface)
+	ret0, _ := ret[0].(DeleteAccessConfigInstancesInterface)
+	return ret0
+}
+
+// DeleteAccessConfig indicates an expected call of DeleteAccessConfig.
+func (mr *MockInstancesInterfaceMockRecorder) DeleteAccessConfig(project, zone, instance, accessConfig, networkInterface interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccessConfig", reflect.TypeOf((*MockInstancesInterface)(nil).DeleteAccessConfig), project, zone, instance, accessConfig, networkInterface)
+}
+
+// DetachDisk mocks base method.
+func (m *MockInstancesInterface) DetachDisk(project, zone, instance, deviceName string) DetachDiskInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachDisk", project, zone, instance, deviceName)
+	ret0, _ := ret[0].(DetachDiskInstancesInterface)
+	return ret0
+}
+
+// DetachDisk indicates an expected call of DetachDisk.
+func (mr *MockInstancesInterfaceMockRecorder) DetachDisk(project, zone, instance, deviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachDisk", reflect.TypeOf((*MockInstancesInterface)(nil).DetachDisk), project, zone, instance, deviceName)
+}
+
+// Get mocks base method.
+func (m *MockInstancesInterface) Get(project, zone, instance string) GetInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, zone, instance)
+	ret0, _ := ret[0].(GetInstancesInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockInstancesInterfaceMockRecorder) Get(project, zone, instance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInstancesInterface)(nil).Get), project, zone, instance)
+}
+
+// GetIamPolicy mocks base method.
+func (m *MockInstancesInterface) GetIamPolicy(project, zone, instance string) GetIamPolicyInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIamPolicy", project, zone, instance)
+	ret0, _ := ret[0].(GetIamPolicyInstancesInterface)
+	return ret0
+}
+
+// GetIamPolicy indicates an expected call of GetIamPolicy.
+func (mr *MockInstancesInterfaceMockRecorder) GetIamPolicy(project, zone, instance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIamPolicy", reflect.TypeOf((*MockInstancesInterface)(nil).GetIamPolicy), project, zone, instance)
+}
+
+// Insert mocks base method.
+func (m *MockInstancesInterface) Insert(project, zone string, instance *v1.Instance) CreateInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", project, zone, instance)
+	ret0, _ := ret[0].(CreateInstancesInterface)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockInstancesInterfaceMockRecorder) Insert(project, zone, instance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockInstancesInterface)(nil).Insert), project, zone, instance)
+}
+
 // List mocks base method.
 func (m *MockInstancesInterface) List(project, zone string) ListInstancesInterface {
 	m.ctrl.T.Helper()
@@ -50,6 +149,76 @@ func (mr *MockInstancesInterfaceMockRecorder) List(project, zone interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockInstancesInterface)(nil).List), project, zone)
 }
 
+// SetIamPolicy mocks base method.
+func (m *MockInstancesInterface) SetIamPolicy(project, zone, instance string, request *v1.ZoneSetPolicyRequest) SetIamPolicyInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIamPolicy", project, zone, instance, request)
+	ret0, _ := ret[0].(SetIamPolicyInstancesInterface)
+	return ret0
+}
+
+// SetIamPolicy indicates an expected call of SetIamPolicy.
+func (mr *MockInstancesInterfaceMockRecorder) SetIamPolicy(project, zone, instance, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIamPolicy", reflect.TypeOf((*MockInstancesInterface)(nil).SetIamPolicy), project, zone, instance, request)
+}
+
+// SetMachineType mocks base method.
+func (m *MockInstancesInterface) SetMachineType(project, zone, instance string, request *v1.InstancesSetMachineTypeRequest) SetMachineTypeInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMachineType", project, zone, instance, request)
+	ret0, _ := ret[0].(SetMachineTypeInstancesInterface)
+	return ret0
+}
+
+// SetMachineType indicates an expected call of SetMachineType.
+func (mr *MockInstancesInterfaceMockRecorder) SetMachineType(project, zone, instance, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMachineType", reflect.TypeOf((*MockInstancesInterface)(nil).SetMachineType), project, zone, instance, request)
+}
+
+// SetMetadata mocks base method.
+func (m *MockInstancesInterface) SetMetadata(project, zone, instance string, metadata *v1.Metadata) SetMetadataInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMetadata", project, zone, instance, metadata)
+	ret0, _ := ret[0].(SetMetadataInstancesInterface)
+	return ret0
+}
+
+// SetMetadata indicates an expected call of SetMetadata.
+func (mr *MockInstancesInterfaceMockRecorder) SetMetadata(project, zone, instance, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMetadata", reflect.TypeOf((*MockInstancesInterface)(nil).SetMetadata), project, zone, instance, metadata)
+}
+
+// Start mocks base method.
+func (m *MockInstancesInterface) Start(project, zone, instance string) StartInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", project, zone, instance)
+	ret0, _ := ret[0].(StartInstancesInterface)
+	return ret0
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockInstancesInterfaceMockRecorder) Start(project, zone, instance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockInstancesInterface)(nil).Start), project, zone, instance)
+}
+
+// Stop mocks base method.
+func (m *MockInstancesInterface) Stop(project, zone, instance string) StopInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", project, zone, instance)
+	ret0, _ := ret[0].(StopInstancesInterface)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockInstancesInterfaceMockRecorder) Stop(project, zone, instance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockInstancesInterface)(nil).Stop), project, zone, instance)
+}
+
 // MockNetworksInterface is a mock of NetworksInterface interface.
 type MockNetworksInterface struct {
 	ctrl     *gomock.Controller
@@ -73,6 +242,20 @@ func (m *MockNetworksInterface) EXPECT() *MockNetworksInterfaceMockRecorder {
 	return m.recorder
 }
 
+// AddPeering mocks base method.
+func (m *MockNetworksInterface) AddPeering(project, network string, req *v1.NetworksAddPeeringRequest) AddPeeringNetworksInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPeering", project, network, req)
+	ret0, _ := ret[0].(AddPeeringNetworksInterface)
+	return ret0
+}
+
+// AddPeering indicates an expected call of AddPeering.
+func (mr *MockNetworksInterfaceMockRecorder) AddPeering(project, network, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPeering", reflect.TypeOf((*MockNetworksInterface)(nil).AddPeering), project, network, req)
+}
+
 // Delete mocks base method.
 func (m *MockNetworksInterface) Delete(project, network string) DeleteNetworksInterface {
 	m.ctrl.T.Helper()
@@ -129,515 +312,4508 @@ func (mr *MockNetworksInterfaceMockRecorder) List(project interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockNetworksInterface)(nil).List), project)
 }
 
-// MockClustersInterface is a mock of ClustersInterface interface.
-type MockClustersInterface struct {
+// Patch mocks base method.
+func (m *MockNetworksInterface) Patch(project, network string, networkResource *v1.Network) PatchNetworksInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", project, network, networkResource)
+	ret0, _ := ret[0].(PatchNetworksInterface)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockNetworksInterfaceMockRecorder) Patch(project, network, networkResource interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockNetworksInterface)(nil).Patch), project, network, networkResource)
+}
+
+// RemovePeering mocks base method.
+func (m *MockNetworksInterface) RemovePeering(project, network string, req *v1.NetworksRemovePeeringRequest) RemovePeeringNetworksInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePeering", project, network, req)
+	ret0, _ := ret[0].(RemovePeeringNetworksInterface)
+	return ret0
+}
+
+// RemovePeering indicates an expected call of RemovePeering.
+func (mr *MockNetworksInterfaceMockRecorder) RemovePeering(project, network, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePeering", reflect.TypeOf((*MockNetworksInterface)(nil).RemovePeering), project, network, req)
+}
+
+// SwitchToCustomMode mocks base method.
+func (m *MockNetworksInterface) SwitchToCustomMode(project, network string) SwitchToCustomModeNetworksInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwitchToCustomMode", project, network)
+	ret0, _ := ret[0].(SwitchToCustomModeNetworksInterface)
+	return ret0
+}
+
+// SwitchToCustomMode indicates an expected call of SwitchToCustomMode.
+func (mr *MockNetworksInterfaceMockRecorder) SwitchToCustomMode(project, network interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwitchToCustomMode", reflect.TypeOf((*MockNetworksInterface)(nil).SwitchToCustomMode), project, network)
+}
+
+// UpdatePeering mocks base method.
+func (m *MockNetworksInterface) UpdatePeering(project, network string, req *v1.NetworksUpdatePeeringRequest) UpdatePeeringNetworksInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePeering", project, network, req)
+	ret0, _ := ret[0].(UpdatePeeringNetworksInterface)
+	return ret0
+}
+
+// UpdatePeering indicates an expected call of UpdatePeering.
+func (mr *MockNetworksInterfaceMockRecorder) UpdatePeering(project, network, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePeering", reflect.TypeOf((*MockNetworksInterface)(nil).UpdatePeering), project, network, req)
+}
+
+// MockZoneOperationsInterface is a mock of ZoneOperationsInterface interface.
+type MockZoneOperationsInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockClustersInterfaceMockRecorder
+	recorder *MockZoneOperationsInterfaceMockRecorder
 }
 
-// MockClustersInterfaceMockRecorder is the mock recorder for MockClustersInterface.
-type MockClustersInterfaceMockRecorder struct {
-	mock *MockClustersInterface
+// MockZoneOperationsInterfaceMockRecorder is the mock recorder for MockZoneOperationsInterface.
+type MockZoneOperationsInterfaceMockRecorder struct {
+	mock *MockZoneOperationsInterface
 }
 
-// NewMockClustersInterface creates a new mock instance.
-func NewMockClustersInterface(ctrl *gomock.Controller) *MockClustersInterface {
-	mock := &MockClustersInterface{ctrl: ctrl}
-	mock.recorder = &MockClustersInterfaceMockRecorder{mock}
+// NewMockZoneOperationsInterface creates a new mock instance.
+func NewMockZoneOperationsInterface(ctrl *gomock.Controller) *MockZoneOperationsInterface {
+	mock := &MockZoneOperationsInterface{ctrl: ctrl}
+	mock.recorder = &MockZoneOperationsInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockClustersInterface) EXPECT() *MockClustersInterfaceMockRecorder {
+func (m *MockZoneOperationsInterface) EXPECT() *MockZoneOperationsInterfaceMockRecorder {
 	return m.recorder
 }
 
-// Create mocks base method.
-func (m *MockClustersInterface) Create(project, zone string, cluster *v10.CreateClusterRequest) CreateClustersInterface {
+// Wait mocks base method.
+func (m *MockZoneOperationsInterface) Wait(project, zone, operation string) WaitZoneOperationsInterface {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", project, zone, cluster)
-	ret0, _ := ret[0].(CreateClustersInterface)
+	ret := m.ctrl.Call(m, "Wait", project, zone, operation)
+	ret0, _ := ret[0].(WaitZoneOperationsInterface)
+	return ret0
+}
+
+// Wait indicates an expected call of Wait.
+func (mr *MockZoneOperationsInterfaceMockRecorder) Wait(project, zone, operation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockZoneOperationsInterface)(nil).Wait), project, zone, operation)
+}
+
+// MockDisksInterface is a mock of DisksInterface interface.
+type MockDisksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDisksInterfaceMockRecorder
+}
+
+// MockDisksInterfaceMockRecorder is the mock recorder for MockDisksInterface.
+type MockDisksInterfaceMockRecorder struct {
+	mock *MockDisksInterface
+}
+
+// NewMockDisksInterface creates a new mock instance.
+func NewMockDisksInterface(ctrl *gomock.Controller) *MockDisksInterface {
+	mock := &MockDisksInterface{ctrl: ctrl}
+	mock.recorder = &MockDisksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDisksInterface) EXPECT() *MockDisksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockDisksInterface) Delete(project, zone, disk string) DeleteDisksInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, zone, disk)
+	ret0, _ := ret[0].(DeleteDisksInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDisksInterfaceMockRecorder) Delete(project, zone, disk interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDisksInterface)(nil).Delete), project, zone, disk)
+}
+
+// Get mocks base method.
+func (m *MockDisksInterface) Get(project, zone, disk string) GetDisksInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, zone, disk)
+	ret0, _ := ret[0].(GetDisksInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDisksInterfaceMockRecorder) Get(project, zone, disk interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDisksInterface)(nil).Get), project, zone, disk)
+}
+
+// Insert mocks base method.
+func (m *MockDisksInterface) Insert(project, zone string, disk *v1.Disk) CreateDisksInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", project, zone, disk)
+	ret0, _ := ret[0].(CreateDisksInterface)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockDisksInterfaceMockRecorder) Insert(project, zone, disk interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockDisksInterface)(nil).Insert), project, zone, disk)
+}
+
+// Resize mocks base method.
+func (m *MockDisksInterface) Resize(project, zone, disk string, request *v1.DisksResizeRequest) ResizeDisksInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resize", project, zone, disk, request)
+	ret0, _ := ret[0].(ResizeDisksInterface)
+	return ret0
+}
+
+// Resize indicates an expected call of Resize.
+func (mr *MockDisksInterfaceMockRecorder) Resize(project, zone, disk, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resize", reflect.TypeOf((*MockDisksInterface)(nil).Resize), project, zone, disk, request)
+}
+
+// SetLabels mocks base method.
+func (m *MockDisksInterface) SetLabels(project, zone, disk string, request *v1.ZoneSetLabelsRequest) SetLabelsDisksInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLabels", project, zone, disk, request)
+	ret0, _ := ret[0].(SetLabelsDisksInterface)
+	return ret0
+}
+
+// SetLabels indicates an expected call of SetLabels.
+func (mr *MockDisksInterfaceMockRecorder) SetLabels(project, zone, disk, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLabels", reflect.TypeOf((*MockDisksInterface)(nil).SetLabels), project, zone, disk, request)
+}
+
+// MockGlobalOperationsInterface is a mock of GlobalOperationsInterface interface.
+type MockGlobalOperationsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGlobalOperationsInterfaceMockRecorder
+}
+
+// MockGlobalOperationsInterfaceMockRecorder is the mock recorder for MockGlobalOperationsInterface.
+type MockGlobalOperationsInterfaceMockRecorder struct {
+	mock *MockGlobalOperationsInterface
+}
+
+// NewMockGlobalOperationsInterface creates a new mock instance.
+func NewMockGlobalOperationsInterface(ctrl *gomock.Controller) *MockGlobalOperationsInterface {
+	mock := &MockGlobalOperationsInterface{ctrl: ctrl}
+	mock.recorder = &MockGlobalOperationsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGlobalOperationsInterface) EXPECT() *MockGlobalOperationsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Wait mocks base method.
+func (m *MockGlobalOperationsInterface) Wait(project, operation string) WaitGlobalOperationsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Wait", project, operation)
+	ret0, _ := ret[0].(WaitGlobalOperationsInterface)
+	return ret0
+}
+
+// Wait indicates an expected call of Wait.
+func (mr *MockGlobalOperationsInterfaceMockRecorder) Wait(project, operation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockGlobalOperationsInterface)(nil).Wait), project, operation)
+}
+
+// MockSnapshotsInterface is a mock of SnapshotsInterface interface.
+type MockSnapshotsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnapshotsInterfaceMockRecorder
+}
+
+// MockSnapshotsInterfaceMockRecorder is the mock recorder for MockSnapshotsInterface.
+type MockSnapshotsInterfaceMockRecorder struct {
+	mock *MockSnapshotsInterface
+}
+
+// NewMockSnapshotsInterface creates a new mock instance.
+func NewMockSnapshotsInterface(ctrl *gomock.Controller) *MockSnapshotsInterface {
+	mock := &MockSnapshotsInterface{ctrl: ctrl}
+	mock.recorder = &MockSnapshotsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnapshotsInterface) EXPECT() *MockSnapshotsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSnapshotsInterface) Delete(project, snapshot string) DeleteSnapshotsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, snapshot)
+	ret0, _ := ret[0].(DeleteSnapshotsInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSnapshotsInterfaceMockRecorder) Delete(project, snapshot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSnapshotsInterface)(nil).Delete), project, snapshot)
+}
+
+// Get mocks base method.
+func (m *MockSnapshotsInterface) Get(project, snapshot string) GetSnapshotsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, snapshot)
+	ret0, _ := ret[0].(GetSnapshotsInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSnapshotsInterfaceMockRecorder) Get(project, snapshot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSnapshotsInterface)(nil).Get), project, snapshot)
+}
+
+// Insert mocks base method.
+func (m *MockSnapshotsInterface) Insert(project string, snapshot *v1.Snapshot) CreateSnapshotsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", project, snapshot)
+	ret0, _ := ret[0].(CreateSnapshotsInterface)
 	return ret0
 }
 
-// Create indicates an expected call of Create.
-func (mr *MockClustersInterfaceMockRecorder) Create(project, zone, cluster interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockClustersInterface)(nil).Create), project, zone, cluster)
+// Insert indicates an expected call of Insert.
+func (mr *MockSnapshotsInterfaceMockRecorder) Insert(project, snapshot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSnapshotsInterface)(nil).Insert), project, snapshot)
+}
+
+// MockInstanceTemplatesInterface is a mock of InstanceTemplatesInterface interface.
+type MockInstanceTemplatesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInstanceTemplatesInterfaceMockRecorder
+}
+
+// MockInstanceTemplatesInterfaceMockRecorder is the mock recorder for MockInstanceTemplatesInterface.
+type MockInstanceTemplatesInterfaceMockRecorder struct {
+	mock *MockInstanceTemplatesInterface
+}
+
+// NewMockInstanceTemplatesInterface creates a new mock instance.
+func NewMockInstanceTemplatesInterface(ctrl *gomock.Controller) *MockInstanceTemplatesInterface {
+	mock := &MockInstanceTemplatesInterface{ctrl: ctrl}
+	mock.recorder = &MockInstanceTemplatesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInstanceTemplatesInterface) EXPECT() *MockInstanceTemplatesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockInstanceTemplatesInterface) Delete(project, instanceTemplate string) DeleteInstanceTemplatesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, instanceTemplate)
+	ret0, _ := ret[0].(DeleteInstanceTemplatesInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockInstanceTemplatesInterfaceMockRecorder) Delete(project, instanceTemplate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockInstanceTemplatesInterface)(nil).Delete), project, instanceTemplate)
+}
+
+// Get mocks base method.
+func (m *MockInstanceTemplatesInterface) Get(project, instanceTemplate string) GetInstanceTemplatesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, instanceTemplate)
+	ret0, _ := ret[0].(GetInstanceTemplatesInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockInstanceTemplatesInterfaceMockRecorder) Get(project, instanceTemplate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInstanceTemplatesInterface)(nil).Get), project, instanceTemplate)
+}
+
+// Insert mocks base method.
+func (m *MockInstanceTemplatesInterface) Insert(project string, instanceTemplate *v1.InstanceTemplate) CreateInstanceTemplatesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", project, instanceTemplate)
+	ret0, _ := ret[0].(CreateInstanceTemplatesInterface)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockInstanceTemplatesInterfaceMockRecorder) Insert(project, instanceTemplate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockInstanceTemplatesInterface)(nil).Insert), project, instanceTemplate)
+}
+
+// MockInstanceGroupManagersInterface is a mock of InstanceGroupManagersInterface interface.
+type MockInstanceGroupManagersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInstanceGroupManagersInterfaceMockRecorder
+}
+
+// MockInstanceGroupManagersInterfaceMockRecorder is the mock recorder for MockInstanceGroupManagersInterface.
+type MockInstanceGroupManagersInterfaceMockRecorder struct {
+	mock *MockInstanceGroupManagersInterface
+}
+
+// NewMockInstanceGroupManagersInterface creates a new mock instance.
+func NewMockInstanceGroupManagersInterface(ctrl *gomock.Controller) *MockInstanceGroupManagersInterface {
+	mock := &MockInstanceGroupManagersInterface{ctrl: ctrl}
+	mock.recorder = &MockInstanceGroupManagersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInstanceGroupManagersInterface) EXPECT() *MockInstanceGroupManagersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockInstanceGroupManagersInterface) Delete(project, zone, instanceGroupManager string) DeleteInstanceGroupManagersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, zone, instanceGroupManager)
+	ret0, _ := ret[0].(DeleteInstanceGroupManagersInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockInstanceGroupManagersInterfaceMockRecorder) Delete(project, zone, instanceGroupManager interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockInstanceGroupManagersInterface)(nil).Delete), project, zone, instanceGroupManager)
+}
+
+// Get mocks base method.
+func (m *MockInstanceGroupManagersInterface) Get(project, zone, instanceGroupManager string) GetInstanceGroupManagersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, zone, instanceGroupManager)
+	ret0, _ := ret[0].(GetInstanceGroupManagersInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockInstanceGroupManagersInterfaceMockRecorder) Get(project, zone, instanceGroupManager interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockInstanceGroupManagersInterface)(nil).Get), project, zone, instanceGroupManager)
+}
+
+// Insert mocks base method.
+func (m *MockInstanceGroupManagersInterface) Insert(project, zone string, instanceGroupManager *v1.InstanceGroupManager) CreateInstanceGroupManagersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", project, zone, instanceGroupManager)
+	ret0, _ := ret[0].(CreateInstanceGroupManagersInterface)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockInstanceGroupManagersInterfaceMockRecorder) Insert(project, zone, instanceGroupManager interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockInstanceGroupManagersInterface)(nil).Insert), project, zone, instanceGroupManager)
+}
+
+// Patch mocks base method.
+func (m *MockInstanceGroupManagersInterface) Patch(project, zone, instanceGroupManager string, request *v1.InstanceGroupManager) PatchInstanceGroupManagersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", project, zone, instanceGroupManager, request)
+	ret0, _ := ret[0].(PatchInstanceGroupManagersInterface)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockInstanceGroupManagersInterfaceMockRecorder) Patch(project, zone, instanceGroupManager, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockInstanceGroupManagersInterface)(nil).Patch), project, zone, instanceGroupManager, request)
+}
+
+// Resize mocks base method.
+func (m *MockInstanceGroupManagersInterface) Resize(project, zone, instanceGroupManager string, size int64) ResizeInstanceGroupManagersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resize", project, zone, instanceGroupManager, size)
+	ret0, _ := ret[0].(ResizeInstanceGroupManagersInterface)
+	return ret0
+}
+
+// Resize indicates an expected call of Resize.
+func (mr *MockInstanceGroupManagersInterfaceMockRecorder) Resize(project, zone, instanceGroupManager, size interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resize", reflect.TypeOf((*MockInstanceGroupManagersInterface)(nil).Resize), project, zone, instanceGroupManager, size)
+}
+
+// MockRegionOperationsInterface is a mock of RegionOperationsInterface interface.
+type MockRegionOperationsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegionOperationsInterfaceMockRecorder
+}
+
+// MockRegionOperationsInterfaceMockRecorder is the mock recorder for MockRegionOperationsInterface.
+type MockRegionOperationsInterfaceMockRecorder struct {
+	mock *MockRegionOperationsInterface
+}
+
+// NewMockRegionOperationsInterface creates a new mock instance.
+func NewMockRegionOperationsInterface(ctrl *gomock.Controller) *MockRegionOperationsInterface {
+	mock := &MockRegionOperationsInterface{ctrl: ctrl}
+	mock.recorder = &MockRegionOperationsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegionOperationsInterface) EXPECT() *MockRegionOperationsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Wait mocks base method.
+func (m *MockRegionOperationsInterface) Wait(project, region, operation string) WaitRegionOperationsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Wait", project, region, operation)
+	ret0, _ := ret[0].(WaitRegionOperationsInterface)
+	return ret0
+}
+
+// Wait indicates an expected call of Wait.
+func (mr *MockRegionOperationsInterfaceMockRecorder) Wait(project, region, operation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockRegionOperationsInterface)(nil).Wait), project, region, operation)
+}
+
+// MockAddressesInterface is a mock of AddressesInterface interface.
+type MockAddressesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddressesInterfaceMockRecorder
+}
+
+// MockAddressesInterfaceMockRecorder is the mock recorder for MockAddressesInterface.
+type MockAddressesInterfaceMockRecorder struct {
+	mock *MockAddressesInterface
+}
+
+// NewMockAddressesInterface creates a new mock instance.
+func NewMockAddressesInterface(ctrl *gomock.Controller) *MockAddressesInterface {
+	mock := &MockAddressesInterface{ctrl: ctrl}
+	mock.recorder = &MockAddressesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddressesInterface) EXPECT() *MockAddressesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockAddressesInterface) Delete(project, region, address string) DeleteAddressesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, region, address)
+	ret0, _ := ret[0].(DeleteAddressesInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAddressesInterfaceMockRecorder) Delete(project, region, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAddressesInterface)(nil).Delete), project, region, address)
+}
+
+// Get mocks base method.
+func (m *MockAddressesInterface) Get(project, region, address string) GetAddressesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, region, address)
+	ret0, _ := ret[0].(GetAddressesInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAddressesInterfaceMockRecorder) Get(project, region, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAddressesInterface)(nil).Get), project, region, address)
+}
+
+// Insert mocks base method.
+func (m *MockAddressesInterface) Insert(project, region string, address *v1.Address) CreateAddressesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", project, region, address)
+	ret0, _ := ret[0].(CreateAddressesInterface)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockAddressesInterfaceMockRecorder) Insert(project, region, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockAddressesInterface)(nil).Insert), project, region, address)
+}
+
+// MockGlobalAddressesInterface is a mock of GlobalAddressesInterface interface.
+type MockGlobalAddressesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGlobalAddressesInterfaceMockRecorder
+}
+
+// MockGlobalAddressesInterfaceMockRecorder is the mock recorder for MockGlobalAddressesInterface.
+type MockGlobalAddressesInterfaceMockRecorder struct {
+	mock *MockGlobalAddressesInterface
+}
+
+// NewMockGlobalAddressesInterface creates a new mock instance.
+func NewMockGlobalAddressesInterface(ctrl *gomock.Controller) *MockGlobalAddressesInterface {
+	mock := &MockGlobalAddressesInterface{ctrl: ctrl}
+	mock.recorder = &MockGlobalAddressesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGlobalAddressesInterface) EXPECT() *MockGlobalAddressesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockGlobalAddressesInterface) Delete(project, address string) DeleteGlobalAddressesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, address)
+	ret0, _ := ret[0].(DeleteGlobalAddressesInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockGlobalAddressesInterfaceMockRecorder) Delete(project, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockGlobalAddressesInterface)(nil).Delete), project, address)
+}
+
+// Get mocks base method.
+func (m *MockGlobalAddressesInterface) Get(project, address string) GetGlobalAddressesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, address)
+	ret0, _ := ret[0].(GetGlobalAddressesInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockGlobalAddressesInterfaceMockRecorder) Get(project, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGlobalAddressesInterface)(nil).Get), project, address)
+}
+
+// Insert mocks base method.
+func (m *MockGlobalAddressesInterface) Insert(project string, address *v1.Address) CreateGlobalAddressesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", project, address)
+	ret0, _ := ret[0].(CreateGlobalAddressesInterface)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockGlobalAddressesInterfaceMockRecorder) Insert(project, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockGlobalAddressesInterface)(nil).Insert), project, address)
+}
+
+// MockSubnetworksInterface is a mock of SubnetworksInterface interface.
+type MockSubnetworksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubnetworksInterfaceMockRecorder
+}
+
+// MockSubnetworksInterfaceMockRecorder is the mock recorder for MockSubnetworksInterface.
+type MockSubnetworksInterfaceMockRecorder struct {
+	mock *MockSubnetworksInterface
+}
+
+// NewMockSubnetworksInterface creates a new mock instance.
+func NewMockSubnetworksInterface(ctrl *gomock.Controller) *MockSubnetworksInterface {
+	mock := &MockSubnetworksInterface{ctrl: ctrl}
+	mock.recorder = &MockSubnetworksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubnetworksInterface) EXPECT() *MockSubnetworksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSubnetworksInterface) Delete(project, region, subnetwork string) DeleteSubnetworksInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, region, subnetwork)
+	ret0, _ := ret[0].(DeleteSubnetworksInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSubnetworksInterfaceMockRecorder) Delete(project, region, subnetwork interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSubnetworksInterface)(nil).Delete), project, region, subnetwork)
+}
+
+// ExpandIpCidrRange mocks base method.
+func (m *MockSubnetworksInterface) ExpandIpCidrRange(project, region, subnetwork string, req *v1.SubnetworksExpandIpCidrRangeRequest) ExpandIpCidrRangeSubnetworksInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpandIpCidrRange", project, region, subnetwork, req)
+	ret0, _ := ret[0].(ExpandIpCidrRangeSubnetworksInterface)
+	return ret0
+}
+
+// ExpandIpCidrRange indicates an expected call of ExpandIpCidrRange.
+func (mr *MockSubnetworksInterfaceMockRecorder) ExpandIpCidrRange(project, region, subnetwork, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpandIpCidrRange", reflect.TypeOf((*MockSubnetworksInterface)(nil).ExpandIpCidrRange), project, region, subnetwork, req)
+}
+
+// Get mocks base method.
+func (m *MockSubnetworksInterface) Get(project, region, subnetwork string) GetSubnetworksInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, region, subnetwork)
+	ret0, _ := ret[0].(GetSubnetworksInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSubnetworksInterfaceMockRecorder) Get(project, region, subnetwork interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSubnetworksInterface)(nil).Get), project, region, subnetwork)
+}
+
+// Insert mocks base method.
+func (m *MockSubnetworksInterface) Insert(project, region string, subnetwork *v1.Subnetwork) CreateSubnetworksInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", project, region, subnetwork)
+	ret0, _ := ret[0].(CreateSubnetworksInterface)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockSubnetworksInterfaceMockRecorder) Insert(project, region, subnetwork interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSubnetworksInterface)(nil).Insert), project, region, subnetwork)
+}
+
+// Patch mocks base method.
+func (m *MockSubnetworksInterface) Patch(project, region, subnetwork string, subnetworkResource *v1.Subnetwork) PatchSubnetworksInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", project, region, subnetwork, subnetworkResource)
+	ret0, _ := ret[0].(PatchSubnetworksInterface)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockSubnetworksInterfaceMockRecorder) Patch(project, region, subnetwork, subnetworkResource interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockSubnetworksInterface)(nil).Patch), project, region, subnetwork, subnetworkResource)
+}
+
+// SetPrivateIpGoogleAccess mocks base method.
+func (m *MockSubnetworksInterface) SetPrivateIpGoogleAccess(project, region, subnetwork string, req *v1.SubnetworksSetPrivateIpGoogleAccessRequest) SetPrivateIpGoogleAccessSubnetworksInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrivateIpGoogleAccess", project, region, subnetwork, req)
+	ret0, _ := ret[0].(SetPrivateIpGoogleAccessSubnetworksInterface)
+	return ret0
+}
+
+// SetPrivateIpGoogleAccess indicates an expected call of SetPrivateIpGoogleAccess.
+func (mr *MockSubnetworksInterfaceMockRecorder) SetPrivateIpGoogleAccess(project, region, subnetwork, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrivateIpGoogleAccess", reflect.TypeOf((*MockSubnetworksInterface)(nil).SetPrivateIpGoogleAccess), project, region, subnetwork, req)
+}
+
+// MockFirewallsInterface is a mock of FirewallsInterface interface.
+type MockFirewallsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockFirewallsInterfaceMockRecorder
+}
+
+// MockFirewallsInterfaceMockRecorder is the mock recorder for MockFirewallsInterface.
+type MockFirewallsInterfaceMockRecorder struct {
+	mock *MockFirewallsInterface
+}
+
+// NewMockFirewallsInterface creates a new mock instance.
+func NewMockFirewallsInterface(ctrl *gomock.Controller) *MockFirewallsInterface {
+	mock := &MockFirewallsInterface{ctrl: ctrl}
+	mock.recorder = &MockFirewallsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFirewallsInterface) EXPECT() *MockFirewallsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockFirewallsInterface) Delete(project, firewall string) DeleteFirewallsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, firewall)
+	ret0, _ := ret[0].(DeleteFirewallsInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFirewallsInterfaceMockRecorder) Delete(project, firewall interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFirewallsInterface)(nil).Delete), project, firewall)
+}
+
+// Get mocks base method.
+func (m *MockFirewallsInterface) Get(project, firewall string) GetFirewallsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, firewall)
+	ret0, _ := ret[0].(GetFirewallsInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockFirewallsInterfaceMockRecorder) Get(project, firewall interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFirewallsInterface)(nil).Get), project, firewall)
+}
+
+// Insert mocks base method.
+func (m *MockFirewallsInterface) Insert(project string, firewall *v1.Firewall) CreateFirewallsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", project, firewall)
+	ret0, _ := ret[0].(CreateFirewallsInterface)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockFirewallsInterfaceMockRecorder) Insert(project, firewall interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockFirewallsInterface)(nil).Insert), project, firewall)
+}
+
+// Patch mocks base method.
+func (m *MockFirewallsInterface) Patch(project, firewall string, firewallResource *v1.Firewall) PatchFirewallsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", project, firewall, firewallResource)
+	ret0, _ := ret[0].(PatchFirewallsInterface)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockFirewallsInterfaceMockRecorder) Patch(project, firewall, firewallResource interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockFirewallsInterface)(nil).Patch), project, firewall, firewallResource)
+}
+
+// MockRoutersInterface is a mock of RoutersInterface interface.
+type MockRoutersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoutersInterfaceMockRecorder
+}
+
+// MockRoutersInterfaceMockRecorder is the mock recorder for MockRoutersInterface.
+type MockRoutersInterfaceMockRecorder struct {
+	mock *MockRoutersInterface
+}
+
+// NewMockRoutersInterface creates a new mock instance.
+func NewMockRoutersInterface(ctrl *gomock.Controller) *MockRoutersInterface {
+	mock := &MockRoutersInterface{ctrl: ctrl}
+	mock.recorder = &MockRoutersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoutersInterface) EXPECT() *MockRoutersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockRoutersInterface) Delete(project, region, router string) DeleteRoutersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, region, router)
+	ret0, _ := ret[0].(DeleteRoutersInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRoutersInterfaceMockRecorder) Delete(project, region, router interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoutersInterface)(nil).Delete), project, region, router)
+}
+
+// Get mocks base method.
+func (m *MockRoutersInterface) Get(project, region, router string) GetRoutersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, region, router)
+	ret0, _ := ret[0].(GetRoutersInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRoutersInterfaceMockRecorder) Get(project, region, router interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRoutersInterface)(nil).Get), project, region, router)
+}
+
+// Insert mocks base method.
+func (m *MockRoutersInterface) Insert(project, region string, router *v1.Router) CreateRoutersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", project, region, router)
+	ret0, _ := ret[0].(CreateRoutersInterface)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockRoutersInterfaceMockRecorder) Insert(project, region, router interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockRoutersInterface)(nil).Insert), project, region, router)
+}
+
+// Patch mocks base method.
+func (m *MockRoutersInterface) Patch(project, region, router string, routerResource *v1.Router) PatchRoutersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", project, region, router, routerResource)
+	ret0, _ := ret[0].(PatchRoutersInterface)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockRoutersInterfaceMockRecorder) Patch(project, region, router, routerResource interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockRoutersInterface)(nil).Patch), project, region, router, routerResource)
+}
+
+// MockRoutesInterface is a mock of RoutesInterface interface.
+type MockRoutesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoutesInterfaceMockRecorder
+}
+
+// MockRoutesInterfaceMockRecorder is the mock recorder for MockRoutesInterface.
+type MockRoutesInterfaceMockRecorder struct {
+	mock *MockRoutesInterface
+}
+
+// NewMockRoutesInterface creates a new mock instance.
+func NewMockRoutesInterface(ctrl *gomock.Controller) *MockRoutesInterface {
+	mock := &MockRoutesInterface{ctrl: ctrl}
+	mock.recorder = &MockRoutesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoutesInterface) EXPECT() *MockRoutesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockRoutesInterface) Delete(project, route string) DeleteRoutesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, route)
+	ret0, _ := ret[0].(DeleteRoutesInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRoutesInterfaceMockRecorder) Delete(project, route interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRoutesInterface)(nil).Delete), project, route)
+}
+
+// Get mocks base method.
+func (m *MockRoutesInterface) Get(project, route string) GetRoutesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, route)
+	ret0, _ := ret[0].(GetRoutesInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRoutesInterfaceMockRecorder) Get(project, route interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRoutesInterface)(nil).Get), project, route)
+}
+
+// Insert mocks base method.
+func (m *MockRoutesInterface) Insert(project string, route *v1.Route) CreateRoutesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", project, route)
+	ret0, _ := ret[0].(CreateRoutesInterface)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockRoutesInterfaceMockRecorder) Insert(project, route interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockRoutesInterface)(nil).Insert), project, route)
+}
+
+// MockClustersInterface is a mock of ClustersInterface interface.
+type MockClustersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockClustersInterfaceMockRecorder
+}
+
+// MockClustersInterfaceMockRecorder is the mock recorder for MockClustersInterface.
+type MockClustersInterfaceMockRecorder struct {
+	mock *MockClustersInterface
+}
+
+// NewMockClustersInterface creates a new mock instance.
+func NewMockClustersInterface(ctrl *gomock.Controller) *MockClustersInterface {
+	mock := &MockClustersInterface{ctrl: ctrl}
+	mock.recorder = &MockClustersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClustersInterface) EXPECT() *MockClustersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockClustersInterface) Create(project, zone string, cluster *v10.CreateClusterRequest) CreateClustersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", project, zone, cluster)
+	ret0, _ := ret[0].(CreateClustersInterface)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockClustersInterfaceMockRecorder) Create(project, zone, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockClustersInterface)(nil).Create), project, zone, cluster)
+}
+
+// Delete mocks base method.
+func (m *MockClustersInterface) Delete(project, zone, cluster string) DeleteClustersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, zone, cluster)
+	ret0, _ := ret[0].(DeleteClustersInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockClustersInterfaceMockRecorder) Delete(project, zone, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockClustersInterface)(nil).Delete), project, zone, cluster)
+}
+
+// Get mocks base method.
+func (m *MockClustersInterface) Get(project, zone, cluster string) GetClustersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, zone, cluster)
+	ret0, _ := ret[0].(GetClustersInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockClustersInterfaceMockRecorder) Get(project, zone, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockClustersInterface)(nil).Get), project, zone, cluster)
+}
+
+// List mocks base method.
+func (m *MockClustersInterface) List(project, zone string) ListClustersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", project, zone)
+	ret0, _ := ret[0].(ListClustersInterface)
+	return ret0
+}
+
+// List indicates an expected call of List.
+func (mr *MockClustersInterfaceMockRecorder) List(project, zone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockClustersInterface)(nil).List), project, zone)
+}
+
+// Update mocks base method.
+func (m *MockClustersInterface) Update(project, zone, cluster string, update *v10.UpdateClusterRequest) UpdateClustersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", project, zone, cluster, update)
+	ret0, _ := ret[0].(UpdateClustersInterface)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockClustersInterfaceMockRecorder) Update(project, zone, cluster, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockClustersInterface)(nil).Update), project, zone, cluster, update)
+}
+
+// MockManagedZonesInterface is a mock of ManagedZonesInterface interface.
+type MockManagedZonesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagedZonesInterfaceMockRecorder
+}
+
+// MockManagedZonesInterfaceMockRecorder is the mock recorder for MockManagedZonesInterface.
+type MockManagedZonesInterfaceMockRecorder struct {
+	mock *MockManagedZonesInterface
+}
+
+// NewMockManagedZonesInterface creates a new mock instance.
+func NewMockManagedZonesInterface(ctrl *gomock.Controller) *MockManagedZonesInterface {
+	mock := &MockManagedZonesInterface{ctrl: ctrl}
+	mock.recorder = &MockManagedZonesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagedZonesInterface) EXPECT() *MockManagedZonesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockManagedZonesInterface) Create(project string, managedZone *v11.ManagedZone) CreateManagedZonesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", project, managedZone)
+	ret0, _ := ret[0].(CreateManagedZonesInterface)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockManagedZonesInterfaceMockRecorder) Create(project, managedZone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockManagedZonesInterface)(nil).Create), project, managedZone)
+}
+
+// Delete mocks base method.
+func (m *MockManagedZonesInterface) Delete(project, managedZone string) DeleteManagedZonesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, managedZone)
+	ret0, _ := ret[0].(DeleteManagedZonesInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockManagedZonesInterfaceMockRecorder) Delete(project, managedZone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockManagedZonesInterface)(nil).Delete), project, managedZone)
+}
+
+// Get mocks base method.
+func (m *MockManagedZonesInterface) Get(project, managedZone string) GetManagedZonesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, managedZone)
+	ret0, _ := ret[0].(GetManagedZonesInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockManagedZonesInterfaceMockRecorder) Get(project, managedZone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockManagedZonesInterface)(nil).Get), project, managedZone)
+}
+
+// Patch mocks base method.
+func (m *MockManagedZonesInterface) Patch(project, managedZone string, managedZoneResource *v11.ManagedZone) PatchManagedZonesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", project, managedZone, managedZoneResource)
+	ret0, _ := ret[0].(PatchManagedZonesInterface)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockManagedZonesInterfaceMockRecorder) Patch(project, managedZone, managedZoneResource interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockManagedZonesInterface)(nil).Patch), project, managedZone, managedZoneResource)
+}
+
+// MockResourceRecordSetsInterface is a mock of ResourceRecordSetsInterface interface.
+type MockResourceRecordSetsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockResourceRecordSetsInterfaceMockRecorder
+}
+
+// MockResourceRecordSetsInterfaceMockRecorder is the mock recorder for MockResourceRecordSetsInterface.
+type MockResourceRecordSetsInterfaceMockRecorder struct {
+	mock *MockResourceRecordSetsInterface
+}
+
+// NewMockResourceRecordSetsInterface creates a new mock instance.
+func NewMockResourceRecordSetsInterface(ctrl *gomock.Controller) *MockResourceRecordSetsInterface {
+	mock := &MockResourceRecordSetsInterface{ctrl: ctrl}
+	mock.recorder = &MockResourceRecordSetsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResourceRecordSetsInterface) EXPECT() *MockResourceRecordSetsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockResourceRecordSetsInterface) Create(project, managedZone string, rrset *v11.ResourceRecordSet) CreateResourceRecordSetsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", project, managedZone, rrset)
+	ret0, _ := ret[0].(CreateResourceRecordSetsInterface)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockResourceRecordSetsInterfaceMockRecorder) Create(project, managedZone, rrset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockResourceRecordSetsInterface)(nil).Create), project, managedZone, rrset)
+}
+
+// Delete mocks base method.
+func (m *MockResourceRecordSetsInterface) Delete(project, managedZone, name, rrsetType string) DeleteResourceRecordSetsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, managedZone, name, rrsetType)
+	ret0, _ := ret[0].(DeleteResourceRecordSetsInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockResourceRecordSetsInterfaceMockRecorder) Delete(project, managedZone, name, rrsetType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockResourceRecordSetsInterface)(nil).Delete), project, managedZone, name, rrsetType)
+}
+
+// Get mocks base method.
+func (m *MockResourceRecordSetsInterface) Get(project, managedZone, name, rrsetType string) GetResourceRecordSetsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, managedZone, name, rrsetType)
+	ret0, _ := ret[0].(GetResourceRecordSetsInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockResourceRecordSetsInterfaceMockRecorder) Get(project, managedZone, name, rrsetType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockResourceRecordSetsInterface)(nil).Get), project, managedZone, name, rrsetType)
+}
+
+// Patch mocks base method.
+func (m *MockResourceRecordSetsInterface) Patch(project, managedZone, name, rrsetType string, rrset *v11.ResourceRecordSet) PatchResourceRecordSetsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", project, managedZone, name, rrsetType, rrset)
+	ret0, _ := ret[0].(PatchResourceRecordSetsInterface)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockResourceRecordSetsInterfaceMockRecorder) Patch(project, managedZone, name, rrsetType, rrset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockResourceRecordSetsInterface)(nil).Patch), project, managedZone, name, rrsetType, rrset)
+}
+
+// MockManagedZoneOperationsInterface is a mock of ManagedZoneOperationsInterface interface.
+type MockManagedZoneOperationsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagedZoneOperationsInterfaceMockRecorder
+}
+
+// MockManagedZoneOperationsInterfaceMockRecorder is the mock recorder for MockManagedZoneOperationsInterface.
+type MockManagedZoneOperationsInterfaceMockRecorder struct {
+	mock *MockManagedZoneOperationsInterface
+}
+
+// NewMockManagedZoneOperationsInterface creates a new mock instance.
+func NewMockManagedZoneOperationsInterface(ctrl *gomock.Controller) *MockManagedZoneOperationsInterface {
+	mock := &MockManagedZoneOperationsInterface{ctrl: ctrl}
+	mock.recorder = &MockManagedZoneOperationsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagedZoneOperationsInterface) EXPECT() *MockManagedZoneOperationsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockManagedZoneOperationsInterface) Get(project, managedZone, operation string) GetManagedZoneOperationsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, managedZone, operation)
+	ret0, _ := ret[0].(GetManagedZoneOperationsInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockManagedZoneOperationsInterfaceMockRecorder) Get(project, managedZone, operation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockManagedZoneOperationsInterface)(nil).Get), project, managedZone, operation)
+}
+
+// MockListInstancesInterface is a mock of ListInstancesInterface interface.
+type MockListInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockListInstancesInterfaceMockRecorder
+}
+
+// MockListInstancesInterfaceMockRecorder is the mock recorder for MockListInstancesInterface.
+type MockListInstancesInterfaceMockRecorder struct {
+	mock *MockListInstancesInterface
+}
+
+// NewMockListInstancesInterface creates a new mock instance.
+func NewMockListInstancesInterface(ctrl *gomock.Controller) *MockListInstancesInterface {
+	mock := &MockListInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockListInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListInstancesInterface) EXPECT() *MockListInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.InstanceList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.InstanceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListInstancesInterface)(nil).Do), opts...)
+}
+
+// MockGetInstancesInterface is a mock of GetInstancesInterface interface.
+type MockGetInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetInstancesInterfaceMockRecorder
+}
+
+// MockGetInstancesInterfaceMockRecorder is the mock recorder for MockGetInstancesInterface.
+type MockGetInstancesInterfaceMockRecorder struct {
+	mock *MockGetInstancesInterface
+}
+
+// NewMockGetInstancesInterface creates a new mock instance.
+func NewMockGetInstancesInterface(ctrl *gomock.Controller) *MockGetInstancesInterface {
+	mock := &MockGetInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockGetInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetInstancesInterface) EXPECT() *MockGetInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Instance, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Instance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetInstancesInterface)(nil).Do), opts...)
+}
+
+// MockCreateInstancesInterface is a mock of CreateInstancesInterface interface.
+type MockCreateInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateInstancesInterfaceMockRecorder
+}
+
+// MockCreateInstancesInterfaceMockRecorder is the mock recorder for MockCreateInstancesInterface.
+type MockCreateInstancesInterfaceMockRecorder struct {
+	mock *MockCreateInstancesInterface
+}
+
+// NewMockCreateInstancesInterface creates a new mock instance.
+func NewMockCreateInstancesInterface(ctrl *gomock.Controller) *MockCreateInstancesInterface {
+	mock := &MockCreateInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateInstancesInterface) EXPECT() *MockCreateInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateInstancesInterface)(nil).Do), opts...)
+}
+
+// MockSetMetadataInstancesInterface is a mock of SetMetadataInstancesInterface interface.
+type MockSetMetadataInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetMetadataInstancesInterfaceMockRecorder
+}
+
+// MockSetMetadataInstancesInterfaceMockRecorder is the mock recorder for MockSetMetadataInstancesInterface.
+type MockSetMetadataInstancesInterfaceMockRecorder struct {
+	mock *MockSetMetadataInstancesInterface
+}
+
+// NewMockSetMetadataInstancesInterface creates a new mock instance.
+func NewMockSetMetadataInstancesInterface(ctrl *gomock.Controller) *MockSetMetadataInstancesInterface {
+	mock := &MockSetMetadataInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockSetMetadataInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetMetadataInstancesInterface) EXPECT() *MockSetMetadataInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSetMetadataInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSetMetadataInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSetMetadataInstancesInterface)(nil).Do), opts...)
+}
+
+// MockGetIamPolicyInstancesInterface is a mock of GetIamPolicyInstancesInterface interface.
+type MockGetIamPolicyInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetIamPolicyInstancesInterfaceMockRecorder
+}
+
+// MockGetIamPolicyInstancesInterfaceMockRecorder is the mock recorder for MockGetIamPolicyInstancesInterface.
+type MockGetIamPolicyInstancesInterfaceMockRecorder struct {
+	mock *MockGetIamPolicyInstancesInterface
+}
+
+// NewMockGetIamPolicyInstancesInterface creates a new mock instance.
+func NewMockGetIamPolicyInstancesInterface(ctrl *gomock.Controller) *MockGetIamPolicyInstancesInterface {
+	mock := &MockGetIamPolicyInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockGetIamPolicyInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetIamPolicyInstancesInterface) EXPECT() *MockGetIamPolicyInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetIamPolicyInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Policy, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetIamPolicyInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetIamPolicyInstancesInterface)(nil).Do), opts...)
+}
+
+// MockSetIamPolicyInstancesInterface is a mock of SetIamPolicyInstancesInterface interface.
+type MockSetIamPolicyInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetIamPolicyInstancesInterfaceMockRecorder
+}
+
+// MockSetIamPolicyInstancesInterfaceMockRecorder is the mock recorder for MockSetIamPolicyInstancesInterface.
+type MockSetIamPolicyInstancesInterfaceMockRecorder struct {
+	mock *MockSetIamPolicyInstancesInterface
+}
+
+// NewMockSetIamPolicyInstancesInterface creates a new mock instance.
+func NewMockSetIamPolicyInstancesInterface(ctrl *gomock.Controller) *MockSetIamPolicyInstancesInterface {
+	mock := &MockSetIamPolicyInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockSetIamPolicyInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetIamPolicyInstancesInterface) EXPECT() *MockSetIamPolicyInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSetIamPolicyInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Policy, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSetIamPolicyInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSetIamPolicyInstancesInterface)(nil).Do), opts...)
+}
+
+// MockStopInstancesInterface is a mock of StopInstancesInterface interface.
+type MockStopInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockStopInstancesInterfaceMockRecorder
+}
+
+// MockStopInstancesInterfaceMockRecorder is the mock recorder for MockStopInstancesInterface.
+type MockStopInstancesInterfaceMockRecorder struct {
+	mock *MockStopInstancesInterface
+}
+
+// NewMockStopInstancesInterface creates a new mock instance.
+func NewMockStopInstancesInterface(ctrl *gomock.Controller) *MockStopInstancesInterface {
+	mock := &MockStopInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockStopInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStopInstancesInterface) EXPECT() *MockStopInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockStopInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockStopInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockStopInstancesInterface)(nil).Do), opts...)
+}
+
+// MockStartInstancesInterface is a mock of StartInstancesInterface interface.
+type MockStartInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockStartInstancesInterfaceMockRecorder
+}
+
+// MockStartInstancesInterfaceMockRecorder is the mock recorder for MockStartInstancesInterface.
+type MockStartInstancesInterfaceMockRecorder struct {
+	mock *MockStartInstancesInterface
+}
+
+// NewMockStartInstancesInterface creates a new mock instance.
+func NewMockStartInstancesInterface(ctrl *gomock.Controller) *MockStartInstancesInterface {
+	mock := &MockStartInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockStartInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStartInstancesInterface) EXPECT() *MockStartInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockStartInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockStartInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockStartInstancesInterface)(nil).Do), opts...)
+}
+
+// MockSetMachineTypeInstancesInterface is a mock of SetMachineTypeInstancesInterface interface.
+type MockSetMachineTypeInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetMachineTypeInstancesInterfaceMockRecorder
+}
+
+// MockSetMachineTypeInstancesInterfaceMockRecorder is the mock recorder for MockSetMachineTypeInstancesInterface.
+type MockSetMachineTypeInstancesInterfaceMockRecorder struct {
+	mock *MockSetMachineTypeInstancesInterface
+}
+
+// NewMockSetMachineTypeInstancesInterface creates a new mock instance.
+func NewMockSetMachineTypeInstancesInterface(ctrl *gomock.Controller) *MockSetMachineTypeInstancesInterface {
+	mock := &MockSetMachineTypeInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockSetMachineTypeInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetMachineTypeInstancesInterface) EXPECT() *MockSetMachineTypeInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSetMachineTypeInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSetMachineTypeInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSetMachineTypeInstancesInterface)(nil).Do), opts...)
+}
+
+// MockAttachDiskInstancesInterface is a mock of AttachDiskInstancesInterface interface.
+type MockAttachDiskInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachDiskInstancesInterfaceMockRecorder
+}
+
+// MockAttachDiskInstancesInterfaceMockRecorder is the mock recorder for MockAttachDiskInstancesInterface.
+type MockAttachDiskInstancesInterfaceMockRecorder struct {
+	mock *MockAttachDiskInstancesInterface
+}
+
+// NewMockAttachDiskInstancesInterface creates a new mock instance.
+func NewMockAttachDiskInstancesInterface(ctrl *gomock.Controller) *MockAttachDiskInstancesInterface {
+	mock := &MockAttachDiskInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockAttachDiskInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachDiskInstancesInterface) EXPECT() *MockAttachDiskInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockAttachDiskInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockAttachDiskInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockAttachDiskInstancesInterface)(nil).Do), opts...)
+}
+
+// MockDetachDiskInstancesInterface is a mock of DetachDiskInstancesInterface interface.
+type MockDetachDiskInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDetachDiskInstancesInterfaceMockRecorder
+}
+
+// MockDetachDiskInstancesInterfaceMockRecorder is the mock recorder for MockDetachDiskInstancesInterface.
+type MockDetachDiskInstancesInterfaceMockRecorder struct {
+	mock *MockDetachDiskInstancesInterface
+}
+
+// NewMockDetachDiskInstancesInterface creates a new mock instance.
+func NewMockDetachDiskInstancesInterface(ctrl *gomock.Controller) *MockDetachDiskInstancesInterface {
+	mock := &MockDetachDiskInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockDetachDiskInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDetachDiskInstancesInterface) EXPECT() *MockDetachDiskInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDetachDiskInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDetachDiskInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDetachDiskInstancesInterface)(nil).Do), opts...)
+}
+
+// MockAddAccessConfigInstancesInterface is a mock of AddAccessConfigInstancesInterface interface.
+type MockAddAccessConfigInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddAccessConfigInstancesInterfaceMockRecorder
+}
+
+// MockAddAccessConfigInstancesInterfaceMockRecorder is the mock recorder for MockAddAccessConfigInstancesInterface.
+type MockAddAccessConfigInstancesInterfaceMockRecorder struct {
+	mock *MockAddAccessConfigInstancesInterface
+}
+
+// NewMockAddAccessConfigInstancesInterface creates a new mock instance.
+func NewMockAddAccessConfigInstancesInterface(ctrl *gomock.Controller) *MockAddAccessConfigInstancesInterface {
+	mock := &MockAddAccessConfigInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockAddAccessConfigInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddAccessConfigInstancesInterface) EXPECT() *MockAddAccessConfigInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockAddAccessConfigInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockAddAccessConfigInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockAddAccessConfigInstancesInterface)(nil).Do), opts...)
+}
+
+// MockDeleteAccessConfigInstancesInterface is a mock of DeleteAccessConfigInstancesInterface interface.
+type MockDeleteAccessConfigInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteAccessConfigInstancesInterfaceMockRecorder
+}
+
+// MockDeleteAccessConfigInstancesInterfaceMockRecorder is the mock recorder for MockDeleteAccessConfigInstancesInterface.
+type MockDeleteAccessConfigInstancesInterfaceMockRecorder struct {
+	mock *MockDeleteAccessConfigInstancesInterface
+}
+
+// NewMockDeleteAccessConfigInstancesInterface creates a new mock instance.
+func NewMockDeleteAccessConfigInstancesInterface(ctrl *gomock.Controller) *MockDeleteAccessConfigInstancesInterface {
+	mock := &MockDeleteAccessConfigInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteAccessConfigInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteAccessConfigInstancesInterface) EXPECT() *MockDeleteAccessConfigInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteAccessConfigInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteAccessConfigInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteAccessConfigInstancesInterface)(nil).Do), opts...)
+}
+
+// MockListNetworksInterface is a mock of ListNetworksInterface interface.
+type MockListNetworksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockListNetworksInterfaceMockRecorder
+}
+
+// MockListNetworksInterfaceMockRecorder is the mock recorder for MockListNetworksInterface.
+type MockListNetworksInterfaceMockRecorder struct {
+	mock *MockListNetworksInterface
+}
+
+// NewMockListNetworksInterface creates a new mock instance.
+func NewMockListNetworksInterface(ctrl *gomock.Controller) *MockListNetworksInterface {
+	mock := &MockListNetworksInterface{ctrl: ctrl}
+	mock.recorder = &MockListNetworksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListNetworksInterface) EXPECT() *MockListNetworksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListNetworksInterface) Do(opts ...googleapi.CallOption) (*v1.NetworkList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.NetworkList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListNetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListNetworksInterface)(nil).Do), opts...)
+}
+
+// MockGetNetworksInterface is a mock of GetNetworksInterface interface.
+type MockGetNetworksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetNetworksInterfaceMockRecorder
+}
+
+// MockGetNetworksInterfaceMockRecorder is the mock recorder for MockGetNetworksInterface.
+type MockGetNetworksInterfaceMockRecorder struct {
+	mock *MockGetNetworksInterface
+}
+
+// NewMockGetNetworksInterface creates a new mock instance.
+func NewMockGetNetworksInterface(ctrl *gomock.Controller) *MockGetNetworksInterface {
+	mock := &MockGetNetworksInterface{ctrl: ctrl}
+	mock.recorder = &MockGetNetworksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetNetworksInterface) EXPECT() *MockGetNetworksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetNetworksInterface) Do(opts ...googleapi.CallOption) (*v1.Network, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetNetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetNetworksInterface)(nil).Do), opts...)
+}
+
+// MockCreateNetworksInterface is a mock of CreateNetworksInterface interface.
+type MockCreateNetworksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateNetworksInterfaceMockRecorder
+}
+
+// MockCreateNetworksInterfaceMockRecorder is the mock recorder for MockCreateNetworksInterface.
+type MockCreateNetworksInterfaceMockRecorder struct {
+	mock *MockCreateNetworksInterface
+}
+
+// NewMockCreateNetworksInterface creates a new mock instance.
+func NewMockCreateNetworksInterface(ctrl *gomock.Controller) *MockCreateNetworksInterface {
+	mock := &MockCreateNetworksInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateNetworksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateNetworksInterface) EXPECT() *MockCreateNetworksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateNetworksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateNetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateNetworksInterface)(nil).Do), opts...)
+}
+
+// MockDeleteNetworksInterface is a mock of DeleteNetworksInterface interface.
+type MockDeleteNetworksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteNetworksInterfaceMockRecorder
+}
+
+// MockDeleteNetworksInterfaceMockRecorder is the mock recorder for MockDeleteNetworksInterface.
+type MockDeleteNetworksInterfaceMockRecorder struct {
+	mock *MockDeleteNetworksInterface
+}
+
+// NewMockDeleteNetworksInterface creates a new mock instance.
+func NewMockDeleteNetworksInterface(ctrl *gomock.Controller) *MockDeleteNetworksInterface {
+	mock := &MockDeleteNetworksInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteNetworksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteNetworksInterface) EXPECT() *MockDeleteNetworksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteNetworksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteNetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteNetworksInterface)(nil).Do), opts...)
+}
+
+// MockAddPeeringNetworksInterface is a mock of AddPeeringNetworksInterface interface.
+type MockAddPeeringNetworksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddPeeringNetworksInterfaceMockRecorder
+}
+
+// MockAddPeeringNetworksInterfaceMockRecorder is the mock recorder for MockAddPeeringNetworksInterface.
+type MockAddPeeringNetworksInterfaceMockRecorder struct {
+	mock *MockAddPeeringNetworksInterface
+}
+
+// NewMockAddPeeringNetworksInterface creates a new mock instance.
+func NewMockAddPeeringNetworksInterface(ctrl *gomock.Controller) *MockAddPeeringNetworksInterface {
+	mock := &MockAddPeeringNetworksInterface{ctrl: ctrl}
+	mock.recorder = &MockAddPeeringNetworksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddPeeringNetworksInterface) EXPECT() *MockAddPeeringNetworksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockAddPeeringNetworksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockAddPeeringNetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockAddPeeringNetworksInterface)(nil).Do), opts...)
+}
+
+// MockRemovePeeringNetworksInterface is a mock of RemovePeeringNetworksInterface interface.
+type MockRemovePeeringNetworksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemovePeeringNetworksInterfaceMockRecorder
+}
+
+// MockRemovePeeringNetworksInterfaceMockRecorder is the mock recorder for MockRemovePeeringNetworksInterface.
+type MockRemovePeeringNetworksInterfaceMockRecorder struct {
+	mock *MockRemovePeeringNetworksInterface
+}
+
+// NewMockRemovePeeringNetworksInterface creates a new mock instance.
+func NewMockRemovePeeringNetworksInterface(ctrl *gomock.Controller) *MockRemovePeeringNetworksInterface {
+	mock := &MockRemovePeeringNetworksInterface{ctrl: ctrl}
+	mock.recorder = &MockRemovePeeringNetworksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemovePeeringNetworksInterface) EXPECT() *MockRemovePeeringNetworksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockRemovePeeringNetworksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockRemovePeeringNetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockRemovePeeringNetworksInterface)(nil).Do), opts...)
+}
+
+// MockUpdatePeeringNetworksInterface is a mock of UpdatePeeringNetworksInterface interface.
+type MockUpdatePeeringNetworksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdatePeeringNetworksInterfaceMockRecorder
+}
+
+// MockUpdatePeeringNetworksInterfaceMockRecorder is the mock recorder for MockUpdatePeeringNetworksInterface.
+type MockUpdatePeeringNetworksInterfaceMockRecorder struct {
+	mock *MockUpdatePeeringNetworksInterface
+}
+
+// NewMockUpdatePeeringNetworksInterface creates a new mock instance.
+func NewMockUpdatePeeringNetworksInterface(ctrl *gomock.Controller) *MockUpdatePeeringNetworksInterface {
+	mock := &MockUpdatePeeringNetworksInterface{ctrl: ctrl}
+	mock.recorder = &MockUpdatePeeringNetworksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdatePeeringNetworksInterface) EXPECT() *MockUpdatePeeringNetworksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUpdatePeeringNetworksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUpdatePeeringNetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUpdatePeeringNetworksInterface)(nil).Do), opts...)
+}
+
+// MockPatchNetworksInterface is a mock of PatchNetworksInterface interface.
+type MockPatchNetworksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPatchNetworksInterfaceMockRecorder
+}
+
+// MockPatchNetworksInterfaceMockRecorder is the mock recorder for MockPatchNetworksInterface.
+type MockPatchNetworksInterfaceMockRecorder struct {
+	mock *MockPatchNetworksInterface
+}
+
+// NewMockPatchNetworksInterface creates a new mock instance.
+func NewMockPatchNetworksInterface(ctrl *gomock.Controller) *MockPatchNetworksInterface {
+	mock := &MockPatchNetworksInterface{ctrl: ctrl}
+	mock.recorder = &MockPatchNetworksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPatchNetworksInterface) EXPECT() *MockPatchNetworksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPatchNetworksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPatchNetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPatchNetworksInterface)(nil).Do), opts...)
+}
+
+// MockSwitchToCustomModeNetworksInterface is a mock of SwitchToCustomModeNetworksInterface interface.
+type MockSwitchToCustomModeNetworksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSwitchToCustomModeNetworksInterfaceMockRecorder
+}
+
+// MockSwitchToCustomModeNetworksInterfaceMockRecorder is the mock recorder for MockSwitchToCustomModeNetworksInterface.
+type MockSwitchToCustomModeNetworksInterfaceMockRecorder struct {
+	mock *MockSwitchToCustomModeNetworksInterface
+}
+
+// NewMockSwitchToCustomModeNetworksInterface creates a new mock instance.
+func NewMockSwitchToCustomModeNetworksInterface(ctrl *gomock.Controller) *MockSwitchToCustomModeNetworksInterface {
+	mock := &MockSwitchToCustomModeNetworksInterface{ctrl: ctrl}
+	mock.recorder = &MockSwitchToCustomModeNetworksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSwitchToCustomModeNetworksInterface) EXPECT() *MockSwitchToCustomModeNetworksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSwitchToCustomModeNetworksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSwitchToCustomModeNetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSwitchToCustomModeNetworksInterface)(nil).Do), opts...)
+}
+
+// MockWaitZoneOperationsInterface is a mock of WaitZoneOperationsInterface interface.
+type MockWaitZoneOperationsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitZoneOperationsInterfaceMockRecorder
+}
+
+// MockWaitZoneOperationsInterfaceMockRecorder is the mock recorder for MockWaitZoneOperationsInterface.
+type MockWaitZoneOperationsInterfaceMockRecorder struct {
+	mock *MockWaitZoneOperationsInterface
+}
+
+// NewMockWaitZoneOperationsInterface creates a new mock instance.
+func NewMockWaitZoneOperationsInterface(ctrl *gomock.Controller) *MockWaitZoneOperationsInterface {
+	mock := &MockWaitZoneOperationsInterface{ctrl: ctrl}
+	mock.recorder = &MockWaitZoneOperationsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitZoneOperationsInterface) EXPECT() *MockWaitZoneOperationsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockWaitZoneOperationsInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockWaitZoneOperationsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockWaitZoneOperationsInterface)(nil).Do), opts...)
+}
+
+// MockGetDisksInterface is a mock of GetDisksInterface interface.
+type MockGetDisksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetDisksInterfaceMockRecorder
+}
+
+// MockGetDisksInterfaceMockRecorder is the mock recorder for MockGetDisksInterface.
+type MockGetDisksInterfaceMockRecorder struct {
+	mock *MockGetDisksInterface
+}
+
+// NewMockGetDisksInterface creates a new mock instance.
+func NewMockGetDisksInterface(ctrl *gomock.Controller) *MockGetDisksInterface {
+	mock := &MockGetDisksInterface{ctrl: ctrl}
+	mock.recorder = &MockGetDisksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetDisksInterface) EXPECT() *MockGetDisksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetDisksInterface) Do(opts ...googleapi.CallOption) (*v1.Disk, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetDisksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetDisksInterface)(nil).Do), opts...)
+}
+
+// MockCreateDisksInterface is a mock of CreateDisksInterface interface.
+type MockCreateDisksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateDisksInterfaceMockRecorder
+}
+
+// MockCreateDisksInterfaceMockRecorder is the mock recorder for MockCreateDisksInterface.
+type MockCreateDisksInterfaceMockRecorder struct {
+	mock *MockCreateDisksInterface
+}
+
+// NewMockCreateDisksInterface creates a new mock instance.
+func NewMockCreateDisksInterface(ctrl *gomock.Controller) *MockCreateDisksInterface {
+	mock := &MockCreateDisksInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateDisksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateDisksInterface) EXPECT() *MockCreateDisksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateDisksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateDisksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateDisksInterface)(nil).Do), opts...)
+}
+
+// MockDeleteDisksInterface is a mock of DeleteDisksInterface interface.
+type MockDeleteDisksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteDisksInterfaceMockRecorder
+}
+
+// MockDeleteDisksInterfaceMockRecorder is the mock recorder for MockDeleteDisksInterface.
+type MockDeleteDisksInterfaceMockRecorder struct {
+	mock *MockDeleteDisksInterface
+}
+
+// NewMockDeleteDisksInterface creates a new mock instance.
+func NewMockDeleteDisksInterface(ctrl *gomock.Controller) *MockDeleteDisksInterface {
+	mock := &MockDeleteDisksInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteDisksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteDisksInterface) EXPECT() *MockDeleteDisksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteDisksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteDisksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteDisksInterface)(nil).Do), opts...)
+}
+
+// MockResizeDisksInterface is a mock of ResizeDisksInterface interface.
+type MockResizeDisksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockResizeDisksInterfaceMockRecorder
+}
+
+// MockResizeDisksInterfaceMockRecorder is the mock recorder for MockResizeDisksInterface.
+type MockResizeDisksInterfaceMockRecorder struct {
+	mock *MockResizeDisksInterface
+}
+
+// NewMockResizeDisksInterface creates a new mock instance.
+func NewMockResizeDisksInterface(ctrl *gomock.Controller) *MockResizeDisksInterface {
+	mock := &MockResizeDisksInterface{ctrl: ctrl}
+	mock.recorder = &MockResizeDisksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResizeDisksInterface) EXPECT() *MockResizeDisksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockResizeDisksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockResizeDisksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockResizeDisksInterface)(nil).Do), opts...)
+}
+
+// MockSetLabelsDisksInterface is a mock of SetLabelsDisksInterface interface.
+type MockSetLabelsDisksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetLabelsDisksInterfaceMockRecorder
+}
+
+// MockSetLabelsDisksInterfaceMockRecorder is the mock recorder for MockSetLabelsDisksInterface.
+type MockSetLabelsDisksInterfaceMockRecorder struct {
+	mock *MockSetLabelsDisksInterface
+}
+
+// NewMockSetLabelsDisksInterface creates a new mock instance.
+func NewMockSetLabelsDisksInterface(ctrl *gomock.Controller) *MockSetLabelsDisksInterface {
+	mock := &MockSetLabelsDisksInterface{ctrl: ctrl}
+	mock.recorder = &MockSetLabelsDisksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetLabelsDisksInterface) EXPECT() *MockSetLabelsDisksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSetLabelsDisksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSetLabelsDisksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSetLabelsDisksInterface)(nil).Do), opts...)
+}
+
+// MockWaitGlobalOperationsInterface is a mock of WaitGlobalOperationsInterface interface.
+type MockWaitGlobalOperationsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitGlobalOperationsInterfaceMockRecorder
+}
+
+// MockWaitGlobalOperationsInterfaceMockRecorder is the mock recorder for MockWaitGlobalOperationsInterface.
+type MockWaitGlobalOperationsInterfaceMockRecorder struct {
+	mock *MockWaitGlobalOperationsInterface
+}
+
+// NewMockWaitGlobalOperationsInterface creates a new mock instance.
+func NewMockWaitGlobalOperationsInterface(ctrl *gomock.Controller) *MockWaitGlobalOperationsInterface {
+	mock := &MockWaitGlobalOperationsInterface{ctrl: ctrl}
+	mock.recorder = &MockWaitGlobalOperationsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitGlobalOperationsInterface) EXPECT() *MockWaitGlobalOperationsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockWaitGlobalOperationsInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockWaitGlobalOperationsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockWaitGlobalOperationsInterface)(nil).Do), opts...)
+}
+
+// MockGetSnapshotsInterface is a mock of GetSnapshotsInterface interface.
+type MockGetSnapshotsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetSnapshotsInterfaceMockRecorder
+}
+
+// MockGetSnapshotsInterfaceMockRecorder is the mock recorder for MockGetSnapshotsInterface.
+type MockGetSnapshotsInterfaceMockRecorder struct {
+	mock *MockGetSnapshotsInterface
+}
+
+// NewMockGetSnapshotsInterface creates a new mock instance.
+func NewMockGetSnapshotsInterface(ctrl *gomock.Controller) *MockGetSnapshotsInterface {
+	mock := &MockGetSnapshotsInterface{ctrl: ctrl}
+	mock.recorder = &MockGetSnapshotsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetSnapshotsInterface) EXPECT() *MockGetSnapshotsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetSnapshotsInterface) Do(opts ...googleapi.CallOption) (*v1.Snapshot, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetSnapshotsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetSnapshotsInterface)(nil).Do), opts...)
+}
+
+// MockCreateSnapshotsInterface is a mock of CreateSnapshotsInterface interface.
+type MockCreateSnapshotsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateSnapshotsInterfaceMockRecorder
+}
+
+// MockCreateSnapshotsInterfaceMockRecorder is the mock recorder for MockCreateSnapshotsInterface.
+type MockCreateSnapshotsInterfaceMockRecorder struct {
+	mock *MockCreateSnapshotsInterface
+}
+
+// NewMockCreateSnapshotsInterface creates a new mock instance.
+func NewMockCreateSnapshotsInterface(ctrl *gomock.Controller) *MockCreateSnapshotsInterface {
+	mock := &MockCreateSnapshotsInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateSnapshotsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateSnapshotsInterface) EXPECT() *MockCreateSnapshotsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateSnapshotsInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateSnapshotsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateSnapshotsInterface)(nil).Do), opts...)
+}
+
+// MockDeleteSnapshotsInterface is a mock of DeleteSnapshotsInterface interface.
+type MockDeleteSnapshotsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteSnapshotsInterfaceMockRecorder
+}
+
+// MockDeleteSnapshotsInterfaceMockRecorder is the mock recorder for MockDeleteSnapshotsInterface.
+type MockDeleteSnapshotsInterfaceMockRecorder struct {
+	mock *MockDeleteSnapshotsInterface
+}
+
+// NewMockDeleteSnapshotsInterface creates a new mock instance.
+func NewMockDeleteSnapshotsInterface(ctrl *gomock.Controller) *MockDeleteSnapshotsInterface {
+	mock := &MockDeleteSnapshotsInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteSnapshotsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteSnapshotsInterface) EXPECT() *MockDeleteSnapshotsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteSnapshotsInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteSnapshotsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteSnapshotsInterface)(nil).Do), opts...)
+}
+
+// MockGetInstanceTemplatesInterface is a mock of GetInstanceTemplatesInterface interface.
+type MockGetInstanceTemplatesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetInstanceTemplatesInterfaceMockRecorder
+}
+
+// MockGetInstanceTemplatesInterfaceMockRecorder is the mock recorder for MockGetInstanceTemplatesInterface.
+type MockGetInstanceTemplatesInterfaceMockRecorder struct {
+	mock *MockGetInstanceTemplatesInterface
+}
+
+// NewMockGetInstanceTemplatesInterface creates a new mock instance.
+func NewMockGetInstanceTemplatesInterface(ctrl *gomock.Controller) *MockGetInstanceTemplatesInterface {
+	mock := &MockGetInstanceTemplatesInterface{ctrl: ctrl}
+	mock.recorder = &MockGetInstanceTemplatesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetInstanceTemplatesInterface) EXPECT() *MockGetInstanceTemplatesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetInstanceTemplatesInterface) Do(opts ...googleapi.CallOption) (*v1.InstanceTemplate, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.InstanceTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetInstanceTemplatesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetInstanceTemplatesInterface)(nil).Do), opts...)
+}
+
+// MockCreateInstanceTemplatesInterface is a mock of CreateInstanceTemplatesInterface interface.
+type MockCreateInstanceTemplatesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateInstanceTemplatesInterfaceMockRecorder
+}
+
+// MockCreateInstanceTemplatesInterfaceMockRecorder is the mock recorder for MockCreateInstanceTemplatesInterface.
+type MockCreateInstanceTemplatesInterfaceMockRecorder struct {
+	mock *MockCreateInstanceTemplatesInterface
+}
+
+// NewMockCreateInstanceTemplatesInterface creates a new mock instance.
+func NewMockCreateInstanceTemplatesInterface(ctrl *gomock.Controller) *MockCreateInstanceTemplatesInterface {
+	mock := &MockCreateInstanceTemplatesInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateInstanceTemplatesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateInstanceTemplatesInterface) EXPECT() *MockCreateInstanceTemplatesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateInstanceTemplatesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateInstanceTemplatesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateInstanceTemplatesInterface)(nil).Do), opts...)
+}
+
+// MockDeleteInstanceTemplatesInterface is a mock of DeleteInstanceTemplatesInterface interface.
+type MockDeleteInstanceTemplatesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteInstanceTemplatesInterfaceMockRecorder
+}
+
+// MockDeleteInstanceTemplatesInterfaceMockRecorder is the mock recorder for MockDeleteInstanceTemplatesInterface.
+type MockDeleteInstanceTemplatesInterfaceMockRecorder struct {
+	mock *MockDeleteInstanceTemplatesInterface
+}
+
+// NewMockDeleteInstanceTemplatesInterface creates a new mock instance.
+func NewMockDeleteInstanceTemplatesInterface(ctrl *gomock.Controller) *MockDeleteInstanceTemplatesInterface {
+	mock := &MockDeleteInstanceTemplatesInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteInstanceTemplatesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteInstanceTemplatesInterface) EXPECT() *MockDeleteInstanceTemplatesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteInstanceTemplatesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteInstanceTemplatesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteInstanceTemplatesInterface)(nil).Do), opts...)
+}
+
+// MockGetInstanceGroupManagersInterface is a mock of GetInstanceGroupManagersInterface interface.
+type MockGetInstanceGroupManagersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetInstanceGroupManagersInterfaceMockRecorder
+}
+
+// MockGetInstanceGroupManagersInterfaceMockRecorder is the mock recorder for MockGetInstanceGroupManagersInterface.
+type MockGetInstanceGroupManagersInterfaceMockRecorder struct {
+	mock *MockGetInstanceGroupManagersInterface
+}
+
+// NewMockGetInstanceGroupManagersInterface creates a new mock instance.
+func NewMockGetInstanceGroupManagersInterface(ctrl *gomock.Controller) *MockGetInstanceGroupManagersInterface {
+	mock := &MockGetInstanceGroupManagersInterface{ctrl: ctrl}
+	mock.recorder = &MockGetInstanceGroupManagersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetInstanceGroupManagersInterface) EXPECT() *MockGetInstanceGroupManagersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetInstanceGroupManagersInterface) Do(opts ...googleapi.CallOption) (*v1.InstanceGroupManager, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.InstanceGroupManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetInstanceGroupManagersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetInstanceGroupManagersInterface)(nil).Do), opts...)
+}
+
+// MockCreateInstanceGroupManagersInterface is a mock of CreateInstanceGroupManagersInterface interface.
+type MockCreateInstanceGroupManagersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateInstanceGroupManagersInterfaceMockRecorder
+}
+
+// MockCreateInstanceGroupManagersInterfaceMockRecorder is the mock recorder for MockCreateInstanceGroupManagersInterface.
+type MockCreateInstanceGroupManagersInterfaceMockRecorder struct {
+	mock *MockCreateInstanceGroupManagersInterface
+}
+
+// NewMockCreateInstanceGroupManagersInterface creates a new mock instance.
+func NewMockCreateInstanceGroupManagersInterface(ctrl *gomock.Controller) *MockCreateInstanceGroupManagersInterface {
+	mock := &MockCreateInstanceGroupManagersInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateInstanceGroupManagersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateInstanceGroupManagersInterface) EXPECT() *MockCreateInstanceGroupManagersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateInstanceGroupManagersInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateInstanceGroupManagersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateInstanceGroupManagersInterface)(nil).Do), opts...)
+}
+
+// MockPatchInstanceGroupManagersInterface is a mock of PatchInstanceGroupManagersInterface interface.
+type MockPatchInstanceGroupManagersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPatchInstanceGroupManagersInterfaceMockRecorder
+}
+
+// MockPatchInstanceGroupManagersInterfaceMockRecorder is the mock recorder for MockPatchInstanceGroupManagersInterface.
+type MockPatchInstanceGroupManagersInterfaceMockRecorder struct {
+	mock *MockPatchInstanceGroupManagersInterface
+}
+
+// NewMockPatchInstanceGroupManagersInterface creates a new mock instance.
+func NewMockPatchInstanceGroupManagersInterface(ctrl *gomock.Controller) *MockPatchInstanceGroupManagersInterface {
+	mock := &MockPatchInstanceGroupManagersInterface{ctrl: ctrl}
+	mock.recorder = &MockPatchInstanceGroupManagersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPatchInstanceGroupManagersInterface) EXPECT() *MockPatchInstanceGroupManagersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPatchInstanceGroupManagersInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPatchInstanceGroupManagersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPatchInstanceGroupManagersInterface)(nil).Do), opts...)
+}
+
+// MockResizeInstanceGroupManagersInterface is a mock of ResizeInstanceGroupManagersInterface interface.
+type MockResizeInstanceGroupManagersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockResizeInstanceGroupManagersInterfaceMockRecorder
+}
+
+// MockResizeInstanceGroupManagersInterfaceMockRecorder is the mock recorder for MockResizeInstanceGroupManagersInterface.
+type MockResizeInstanceGroupManagersInterfaceMockRecorder struct {
+	mock *MockResizeInstanceGroupManagersInterface
+}
+
+// NewMockResizeInstanceGroupManagersInterface creates a new mock instance.
+func NewMockResizeInstanceGroupManagersInterface(ctrl *gomock.Controller) *MockResizeInstanceGroupManagersInterface {
+	mock := &MockResizeInstanceGroupManagersInterface{ctrl: ctrl}
+	mock.recorder = &MockResizeInstanceGroupManagersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResizeInstanceGroupManagersInterface) EXPECT() *MockResizeInstanceGroupManagersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockResizeInstanceGroupManagersInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockResizeInstanceGroupManagersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockResizeInstanceGroupManagersInterface)(nil).Do), opts...)
+}
+
+// MockDeleteInstanceGroupManagersInterface is a mock of DeleteInstanceGroupManagersInterface interface.
+type MockDeleteInstanceGroupManagersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteInstanceGroupManagersInterfaceMockRecorder
+}
+
+// MockDeleteInstanceGroupManagersInterfaceMockRecorder is the mock recorder for MockDeleteInstanceGroupManagersInterface.
+type MockDeleteInstanceGroupManagersInterfaceMockRecorder struct {
+	mock *MockDeleteInstanceGroupManagersInterface
+}
+
+// NewMockDeleteInstanceGroupManagersInterface creates a new mock instance.
+func NewMockDeleteInstanceGroupManagersInterface(ctrl *gomock.Controller) *MockDeleteInstanceGroupManagersInterface {
+	mock := &MockDeleteInstanceGroupManagersInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteInstanceGroupManagersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteInstanceGroupManagersInterface) EXPECT() *MockDeleteInstanceGroupManagersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteInstanceGroupManagersInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteInstanceGroupManagersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteInstanceGroupManagersInterface)(nil).Do), opts...)
+}
+
+// MockWaitRegionOperationsInterface is a mock of WaitRegionOperationsInterface interface.
+type MockWaitRegionOperationsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitRegionOperationsInterfaceMockRecorder
+}
+
+// MockWaitRegionOperationsInterfaceMockRecorder is the mock recorder for MockWaitRegionOperationsInterface.
+type MockWaitRegionOperationsInterfaceMockRecorder struct {
+	mock *MockWaitRegionOperationsInterface
+}
+
+// NewMockWaitRegionOperationsInterface creates a new mock instance.
+func NewMockWaitRegionOperationsInterface(ctrl *gomock.Controller) *MockWaitRegionOperationsInterface {
+	mock := &MockWaitRegionOperationsInterface{ctrl: ctrl}
+	mock.recorder = &MockWaitRegionOperationsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitRegionOperationsInterface) EXPECT() *MockWaitRegionOperationsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockWaitRegionOperationsInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockWaitRegionOperationsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockWaitRegionOperationsInterface)(nil).Do), opts...)
+}
+
+// MockGetAddressesInterface is a mock of GetAddressesInterface interface.
+type MockGetAddressesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAddressesInterfaceMockRecorder
+}
+
+// MockGetAddressesInterfaceMockRecorder is the mock recorder for MockGetAddressesInterface.
+type MockGetAddressesInterfaceMockRecorder struct {
+	mock *MockGetAddressesInterface
+}
+
+// NewMockGetAddressesInterface creates a new mock instance.
+func NewMockGetAddressesInterface(ctrl *gomock.Controller) *MockGetAddressesInterface {
+	mock := &MockGetAddressesInterface{ctrl: ctrl}
+	mock.recorder = &MockGetAddressesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAddressesInterface) EXPECT() *MockGetAddressesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetAddressesInterface) Do(opts ...googleapi.CallOption) (*v1.Address, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetAddressesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetAddressesInterface)(nil).Do), opts...)
+}
+
+// MockCreateAddressesInterface is a mock of CreateAddressesInterface interface.
+type MockCreateAddressesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateAddressesInterfaceMockRecorder
+}
+
+// MockCreateAddressesInterfaceMockRecorder is the mock recorder for MockCreateAddressesInterface.
+type MockCreateAddressesInterfaceMockRecorder struct {
+	mock *MockCreateAddressesInterface
+}
+
+// NewMockCreateAddressesInterface creates a new mock instance.
+func NewMockCreateAddressesInterface(ctrl *gomock.Controller) *MockCreateAddressesInterface {
+	mock := &MockCreateAddressesInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateAddressesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateAddressesInterface) EXPECT() *MockCreateAddressesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateAddressesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateAddressesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateAddressesInterface)(nil).Do), opts...)
+}
+
+// MockDeleteAddressesInterface is a mock of DeleteAddressesInterface interface.
+type MockDeleteAddressesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteAddressesInterfaceMockRecorder
+}
+
+// MockDeleteAddressesInterfaceMockRecorder is the mock recorder for MockDeleteAddressesInterface.
+type MockDeleteAddressesInterfaceMockRecorder struct {
+	mock *MockDeleteAddressesInterface
+}
+
+// NewMockDeleteAddressesInterface creates a new mock instance.
+func NewMockDeleteAddressesInterface(ctrl *gomock.Controller) *MockDeleteAddressesInterface {
+	mock := &MockDeleteAddressesInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteAddressesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteAddressesInterface) EXPECT() *MockDeleteAddressesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteAddressesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteAddressesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteAddressesInterface)(nil).Do), opts...)
+}
+
+// MockGetGlobalAddressesInterface is a mock of GetGlobalAddressesInterface interface.
+type MockGetGlobalAddressesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetGlobalAddressesInterfaceMockRecorder
+}
+
+// MockGetGlobalAddressesInterfaceMockRecorder is the mock recorder for MockGetGlobalAddressesInterface.
+type MockGetGlobalAddressesInterfaceMockRecorder struct {
+	mock *MockGetGlobalAddressesInterface
+}
+
+// NewMockGetGlobalAddressesInterface creates a new mock instance.
+func NewMockGetGlobalAddressesInterface(ctrl *gomock.Controller) *MockGetGlobalAddressesInterface {
+	mock := &MockGetGlobalAddressesInterface{ctrl: ctrl}
+	mock.recorder = &MockGetGlobalAddressesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetGlobalAddressesInterface) EXPECT() *MockGetGlobalAddressesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetGlobalAddressesInterface) Do(opts ...googleapi.CallOption) (*v1.Address, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetGlobalAddressesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetGlobalAddressesInterface)(nil).Do), opts...)
+}
+
+// MockCreateGlobalAddressesInterface is a mock of CreateGlobalAddressesInterface interface.
+type MockCreateGlobalAddressesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateGlobalAddressesInterfaceMockRecorder
+}
+
+// MockCreateGlobalAddressesInterfaceMockRecorder is the mock recorder for MockCreateGlobalAddressesInterface.
+type MockCreateGlobalAddressesInterfaceMockRecorder struct {
+	mock *MockCreateGlobalAddressesInterface
+}
+
+// NewMockCreateGlobalAddressesInterface creates a new mock instance.
+func NewMockCreateGlobalAddressesInterface(ctrl *gomock.Controller) *MockCreateGlobalAddressesInterface {
+	mock := &MockCreateGlobalAddressesInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateGlobalAddressesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateGlobalAddressesInterface) EXPECT() *MockCreateGlobalAddressesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateGlobalAddressesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateGlobalAddressesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateGlobalAddressesInterface)(nil).Do), opts...)
+}
+
+// MockDeleteGlobalAddressesInterface is a mock of DeleteGlobalAddressesInterface interface.
+type MockDeleteGlobalAddressesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteGlobalAddressesInterfaceMockRecorder
+}
+
+// MockDeleteGlobalAddressesInterfaceMockRecorder is the mock recorder for MockDeleteGlobalAddressesInterface.
+type MockDeleteGlobalAddressesInterfaceMockRecorder struct {
+	mock *MockDeleteGlobalAddressesInterface
+}
+
+// NewMockDeleteGlobalAddressesInterface creates a new mock instance.
+func NewMockDeleteGlobalAddressesInterface(ctrl *gomock.Controller) *MockDeleteGlobalAddressesInterface {
+	mock := &MockDeleteGlobalAddressesInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteGlobalAddressesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteGlobalAddressesInterface) EXPECT() *MockDeleteGlobalAddressesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteGlobalAddressesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteGlobalAddressesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteGlobalAddressesInterface)(nil).Do), opts...)
+}
+
+// MockGetSubnetworksInterface is a mock of GetSubnetworksInterface interface.
+type MockGetSubnetworksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetSubnetworksInterfaceMockRecorder
+}
+
+// MockGetSubnetworksInterfaceMockRecorder is the mock recorder for MockGetSubnetworksInterface.
+type MockGetSubnetworksInterfaceMockRecorder struct {
+	mock *MockGetSubnetworksInterface
+}
+
+// NewMockGetSubnetworksInterface creates a new mock instance.
+func NewMockGetSubnetworksInterface(ctrl *gomock.Controller) *MockGetSubnetworksInterface {
+	mock := &MockGetSubnetworksInterface{ctrl: ctrl}
+	mock.recorder = &MockGetSubnetworksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetSubnetworksInterface) EXPECT() *MockGetSubnetworksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetSubnetworksInterface) Do(opts ...googleapi.CallOption) (*v1.Subnetwork, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Subnetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetSubnetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetSubnetworksInterface)(nil).Do), opts...)
+}
+
+// MockCreateSubnetworksInterface is a mock of CreateSubnetworksInterface interface.
+type MockCreateSubnetworksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateSubnetworksInterfaceMockRecorder
+}
+
+// MockCreateSubnetworksInterfaceMockRecorder is the mock recorder for MockCreateSubnetworksInterface.
+type MockCreateSubnetworksInterfaceMockRecorder struct {
+	mock *MockCreateSubnetworksInterface
+}
+
+// NewMockCreateSubnetworksInterface creates a new mock instance.
+func NewMockCreateSubnetworksInterface(ctrl *gomock.Controller) *MockCreateSubnetworksInterface {
+	mock := &MockCreateSubnetworksInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateSubnetworksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateSubnetworksInterface) EXPECT() *MockCreateSubnetworksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateSubnetworksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateSubnetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateSubnetworksInterface)(nil).Do), opts...)
+}
+
+// MockPatchSubnetworksInterface is a mock of PatchSubnetworksInterface interface.
+type MockPatchSubnetworksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPatchSubnetworksInterfaceMockRecorder
+}
+
+// MockPatchSubnetworksInterfaceMockRecorder is the mock recorder for MockPatchSubnetworksInterface.
+type MockPatchSubnetworksInterfaceMockRecorder struct {
+	mock *MockPatchSubnetworksInterface
+}
+
+// NewMockPatchSubnetworksInterface creates a new mock instance.
+func NewMockPatchSubnetworksInterface(ctrl *gomock.Controller) *MockPatchSubnetworksInterface {
+	mock := &MockPatchSubnetworksInterface{ctrl: ctrl}
+	mock.recorder = &MockPatchSubnetworksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPatchSubnetworksInterface) EXPECT() *MockPatchSubnetworksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPatchSubnetworksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPatchSubnetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPatchSubnetworksInterface)(nil).Do), opts...)
+}
+
+// MockExpandIpCidrRangeSubnetworksInterface is a mock of ExpandIpCidrRangeSubnetworksInterface interface.
+type MockExpandIpCidrRangeSubnetworksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockExpandIpCidrRangeSubnetworksInterfaceMockRecorder
+}
+
+// MockExpandIpCidrRangeSubnetworksInterfaceMockRecorder is the mock recorder for MockExpandIpCidrRangeSubnetworksInterface.
+type MockExpandIpCidrRangeSubnetworksInterfaceMockRecorder struct {
+	mock *MockExpandIpCidrRangeSubnetworksInterface
+}
+
+// NewMockExpandIpCidrRangeSubnetworksInterface creates a new mock instance.
+func NewMockExpandIpCidrRangeSubnetworksInterface(ctrl *gomock.Controller) *MockExpandIpCidrRangeSubnetworksInterface {
+	mock := &MockExpandIpCidrRangeSubnetworksInterface{ctrl: ctrl}
+	mock.recorder = &MockExpandIpCidrRangeSubnetworksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExpandIpCidrRangeSubnetworksInterface) EXPECT() *MockExpandIpCidrRangeSubnetworksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockExpandIpCidrRangeSubnetworksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockExpandIpCidrRangeSubnetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockExpandIpCidrRangeSubnetworksInterface)(nil).Do), opts...)
+}
+
+// MockSetPrivateIpGoogleAccessSubnetworksInterface is a mock of SetPrivateIpGoogleAccessSubnetworksInterface interface.
+type MockSetPrivateIpGoogleAccessSubnetworksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetPrivateIpGoogleAccessSubnetworksInterfaceMockRecorder
+}
+
+// MockSetPrivateIpGoogleAccessSubnetworksInterfaceMockRecorder is the mock recorder for MockSetPrivateIpGoogleAccessSubnetworksInterface.
+type MockSetPrivateIpGoogleAccessSubnetworksInterfaceMockRecorder struct {
+	mock *MockSetPrivateIpGoogleAccessSubnetworksInterface
+}
+
+// NewMockSetPrivateIpGoogleAccessSubnetworksInterface creates a new mock instance.
+func NewMockSetPrivateIpGoogleAccessSubnetworksInterface(ctrl *gomock.Controller) *MockSetPrivateIpGoogleAccessSubnetworksInterface {
+	mock := &MockSetPrivateIpGoogleAccessSubnetworksInterface{ctrl: ctrl}
+	mock.recorder = &MockSetPrivateIpGoogleAccessSubnetworksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetPrivateIpGoogleAccessSubnetworksInterface) EXPECT() *MockSetPrivateIpGoogleAccessSubnetworksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSetPrivateIpGoogleAccessSubnetworksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSetPrivateIpGoogleAccessSubnetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSetPrivateIpGoogleAccessSubnetworksInterface)(nil).Do), opts...)
+}
+
+// MockDeleteSubnetworksInterface is a mock of DeleteSubnetworksInterface interface.
+type MockDeleteSubnetworksInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteSubnetworksInterfaceMockRecorder
+}
+
+// MockDeleteSubnetworksInterfaceMockRecorder is the mock recorder for MockDeleteSubnetworksInterface.
+type MockDeleteSubnetworksInterfaceMockRecorder struct {
+	mock *MockDeleteSubnetworksInterface
+}
+
+// NewMockDeleteSubnetworksInterface creates a new mock instance.
+func NewMockDeleteSubnetworksInterface(ctrl *gomock.Controller) *MockDeleteSubnetworksInterface {
+	mock := &MockDeleteSubnetworksInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteSubnetworksInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteSubnetworksInterface) EXPECT() *MockDeleteSubnetworksInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteSubnetworksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteSubnetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteSubnetworksInterface)(nil).Do), opts...)
+}
+
+// MockGetFirewallsInterface is a mock of GetFirewallsInterface interface.
+type MockGetFirewallsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetFirewallsInterfaceMockRecorder
+}
+
+// MockGetFirewallsInterfaceMockRecorder is the mock recorder for MockGetFirewallsInterface.
+type MockGetFirewallsInterfaceMockRecorder struct {
+	mock *MockGetFirewallsInterface
+}
+
+// NewMockGetFirewallsInterface creates a new mock instance.
+func NewMockGetFirewallsInterface(ctrl *gomock.Controller) *MockGetFirewallsInterface {
+	mock := &MockGetFirewallsInterface{ctrl: ctrl}
+	mock.recorder = &MockGetFirewallsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetFirewallsInterface) EXPECT() *MockGetFirewallsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetFirewallsInterface) Do(opts ...googleapi.CallOption) (*v1.Firewall, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Firewall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetFirewallsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetFirewallsInterface)(nil).Do), opts...)
+}
+
+// MockCreateFirewallsInterface is a mock of CreateFirewallsInterface interface.
+type MockCreateFirewallsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateFirewallsInterfaceMockRecorder
+}
+
+// MockCreateFirewallsInterfaceMockRecorder is the mock recorder for MockCreateFirewallsInterface.
+type MockCreateFirewallsInterfaceMockRecorder struct {
+	mock *MockCreateFirewallsInterface
+}
+
+// NewMockCreateFirewallsInterface creates a new mock instance.
+func NewMockCreateFirewallsInterface(ctrl *gomock.Controller) *MockCreateFirewallsInterface {
+	mock := &MockCreateFirewallsInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateFirewallsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateFirewallsInterface) EXPECT() *MockCreateFirewallsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateFirewallsInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateFirewallsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateFirewallsInterface)(nil).Do), opts...)
+}
+
+// MockPatchFirewallsInterface is a mock of PatchFirewallsInterface interface.
+type MockPatchFirewallsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPatchFirewallsInterfaceMockRecorder
+}
+
+// MockPatchFirewallsInterfaceMockRecorder is the mock recorder for MockPatchFirewallsInterface.
+type MockPatchFirewallsInterfaceMockRecorder struct {
+	mock *MockPatchFirewallsInterface
+}
+
+// NewMockPatchFirewallsInterface creates a new mock instance.
+func NewMockPatchFirewallsInterface(ctrl *gomock.Controller) *MockPatchFirewallsInterface {
+	mock := &MockPatchFirewallsInterface{ctrl: ctrl}
+	mock.recorder = &MockPatchFirewallsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPatchFirewallsInterface) EXPECT() *MockPatchFirewallsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPatchFirewallsInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPatchFirewallsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPatchFirewallsInterface)(nil).Do), opts...)
+}
+
+// MockDeleteFirewallsInterface is a mock of DeleteFirewallsInterface interface.
+type MockDeleteFirewallsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteFirewallsInterfaceMockRecorder
+}
+
+// MockDeleteFirewallsInterfaceMockRecorder is the mock recorder for MockDeleteFirewallsInterface.
+type MockDeleteFirewallsInterfaceMockRecorder struct {
+	mock *MockDeleteFirewallsInterface
+}
+
+// NewMockDeleteFirewallsInterface creates a new mock instance.
+func NewMockDeleteFirewallsInterface(ctrl *gomock.Controller) *MockDeleteFirewallsInterface {
+	mock := &MockDeleteFirewallsInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteFirewallsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteFirewallsInterface) EXPECT() *MockDeleteFirewallsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteFirewallsInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteFirewallsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteFirewallsInterface)(nil).Do), opts...)
+}
+
+// MockGetRoutersInterface is a mock of GetRoutersInterface interface.
+type MockGetRoutersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetRoutersInterfaceMockRecorder
+}
+
+// MockGetRoutersInterfaceMockRecorder is the mock recorder for MockGetRoutersInterface.
+type MockGetRoutersInterfaceMockRecorder struct {
+	mock *MockGetRoutersInterface
+}
+
+// NewMockGetRoutersInterface creates a new mock instance.
+func NewMockGetRoutersInterface(ctrl *gomock.Controller) *MockGetRoutersInterface {
+	mock := &MockGetRoutersInterface{ctrl: ctrl}
+	mock.recorder = &MockGetRoutersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetRoutersInterface) EXPECT() *MockGetRoutersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetRoutersInterface) Do(opts ...googleapi.CallOption) (*v1.Router, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Router)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetRoutersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetRoutersInterface)(nil).Do), opts...)
+}
+
+// MockCreateRoutersInterface is a mock of CreateRoutersInterface interface.
+type MockCreateRoutersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateRoutersInterfaceMockRecorder
+}
+
+// MockCreateRoutersInterfaceMockRecorder is the mock recorder for MockCreateRoutersInterface.
+type MockCreateRoutersInterfaceMockRecorder struct {
+	mock *MockCreateRoutersInterface
+}
+
+// NewMockCreateRoutersInterface creates a new mock instance.
+func NewMockCreateRoutersInterface(ctrl *gomock.Controller) *MockCreateRoutersInterface {
+	mock := &MockCreateRoutersInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateRoutersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateRoutersInterface) EXPECT() *MockCreateRoutersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateRoutersInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateRoutersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateRoutersInterface)(nil).Do), opts...)
+}
+
+// MockPatchRoutersInterface is a mock of PatchRoutersInterface interface.
+type MockPatchRoutersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPatchRoutersInterfaceMockRecorder
+}
+
+// MockPatchRoutersInterfaceMockRecorder is the mock recorder for MockPatchRoutersInterface.
+type MockPatchRoutersInterfaceMockRecorder struct {
+	mock *MockPatchRoutersInterface
+}
+
+// NewMockPatchRoutersInterface creates a new mock instance.
+func NewMockPatchRoutersInterface(ctrl *gomock.Controller) *MockPatchRoutersInterface {
+	mock := &MockPatchRoutersInterface{ctrl: ctrl}
+	mock.recorder = &MockPatchRoutersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPatchRoutersInterface) EXPECT() *MockPatchRoutersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPatchRoutersInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPatchRoutersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPatchRoutersInterface)(nil).Do), opts...)
+}
+
+// MockDeleteRoutersInterface is a mock of DeleteRoutersInterface interface.
+type MockDeleteRoutersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteRoutersInterfaceMockRecorder
+}
+
+// MockDeleteRoutersInterfaceMockRecorder is the mock recorder for MockDeleteRoutersInterface.
+type MockDeleteRoutersInterfaceMockRecorder struct {
+	mock *MockDeleteRoutersInterface
+}
+
+// NewMockDeleteRoutersInterface creates a new mock instance.
+func NewMockDeleteRoutersInterface(ctrl *gomock.Controller) *MockDeleteRoutersInterface {
+	mock := &MockDeleteRoutersInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteRoutersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteRoutersInterface) EXPECT() *MockDeleteRoutersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteRoutersInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteRoutersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteRoutersInterface)(nil).Do), opts...)
+}
+
+// MockGetRoutesInterface is a mock of GetRoutesInterface interface.
+type MockGetRoutesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetRoutesInterfaceMockRecorder
+}
+
+// MockGetRoutesInterfaceMockRecorder is the mock recorder for MockGetRoutesInterface.
+type MockGetRoutesInterfaceMockRecorder struct {
+	mock *MockGetRoutesInterface
+}
+
+// NewMockGetRoutesInterface creates a new mock instance.
+func NewMockGetRoutesInterface(ctrl *gomock.Controller) *MockGetRoutesInterface {
+	mock := &MockGetRoutesInterface{ctrl: ctrl}
+	mock.recorder = &MockGetRoutesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetRoutesInterface) EXPECT() *MockGetRoutesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetRoutesInterface) Do(opts ...googleapi.CallOption) (*v1.Route, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Route)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetRoutesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetRoutesInterface)(nil).Do), opts...)
+}
+
+// MockCreateRoutesInterface is a mock of CreateRoutesInterface interface.
+type MockCreateRoutesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateRoutesInterfaceMockRecorder
+}
+
+// MockCreateRoutesInterfaceMockRecorder is the mock recorder for MockCreateRoutesInterface.
+type MockCreateRoutesInterfaceMockRecorder struct {
+	mock *MockCreateRoutesInterface
+}
+
+// NewMockCreateRoutesInterface creates a new mock instance.
+func NewMockCreateRoutesInterface(ctrl *gomock.Controller) *MockCreateRoutesInterface {
+	mock := &MockCreateRoutesInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateRoutesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateRoutesInterface) EXPECT() *MockCreateRoutesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateRoutesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateRoutesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateRoutesInterface)(nil).Do), opts...)
+}
+
+// MockDeleteRoutesInterface is a mock of DeleteRoutesInterface interface.
+type MockDeleteRoutesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteRoutesInterfaceMockRecorder
+}
+
+// MockDeleteRoutesInterfaceMockRecorder is the mock recorder for MockDeleteRoutesInterface.
+type MockDeleteRoutesInterfaceMockRecorder struct {
+	mock *MockDeleteRoutesInterface
+}
+
+// NewMockDeleteRoutesInterface creates a new mock instance.
+func NewMockDeleteRoutesInterface(ctrl *gomock.Controller) *MockDeleteRoutesInterface {
+	mock := &MockDeleteRoutesInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteRoutesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteRoutesInterface) EXPECT() *MockDeleteRoutesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteRoutesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteRoutesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteRoutesInterface)(nil).Do), opts...)
+}
+
+// MockListClustersInterface is a mock of ListClustersInterface interface.
+type MockListClustersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockListClustersInterfaceMockRecorder
+}
+
+// MockListClustersInterfaceMockRecorder is the mock recorder for MockListClustersInterface.
+type MockListClustersInterfaceMockRecorder struct {
+	mock *MockListClustersInterface
+}
+
+// NewMockListClustersInterface creates a new mock instance.
+func NewMockListClustersInterface(ctrl *gomock.Controller) *MockListClustersInterface {
+	mock := &MockListClustersInterface{ctrl: ctrl}
+	mock.recorder = &MockListClustersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListClustersInterface) EXPECT() *MockListClustersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListClustersInterface) Do(opts ...googleapi.CallOption) (*v10.ListClustersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v10.ListClustersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListClustersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListClustersInterface)(nil).Do), opts...)
+}
+
+// MockGetClustersInterface is a mock of GetClustersInterface interface.
+type MockGetClustersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetClustersInterfaceMockRecorder
+}
+
+// MockGetClustersInterfaceMockRecorder is the mock recorder for MockGetClustersInterface.
+type MockGetClustersInterfaceMockRecorder struct {
+	mock *MockGetClustersInterface
+}
+
+// NewMockGetClustersInterface creates a new mock instance.
+func NewMockGetClustersInterface(ctrl *gomock.Controller) *MockGetClustersInterface {
+	mock := &MockGetClustersInterface{ctrl: ctrl}
+	mock.recorder = &MockGetClustersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetClustersInterface) EXPECT() *MockGetClustersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetClustersInterface) Do(opts ...googleapi.CallOption) (*v10.Cluster, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v10.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetClustersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetClustersInterface)(nil).Do), opts...)
+}
+
+// MockCreateClustersInterface is a mock of CreateClustersInterface interface.
+type MockCreateClustersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateClustersInterfaceMockRecorder
+}
+
+// MockCreateClustersInterfaceMockRecorder is the mock recorder for MockCreateClustersInterface.
+type MockCreateClustersInterfaceMockRecorder struct {
+	mock *MockCreateClustersInterface
+}
+
+// NewMockCreateClustersInterface creates a new mock instance.
+func NewMockCreateClustersInterface(ctrl *gomock.Controller) *MockCreateClustersInterface {
+	mock := &MockCreateClustersInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateClustersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateClustersInterface) EXPECT() *MockCreateClustersInterfaceMockRecorder {
+	return m.recorder
 }
 
-// Delete mocks base method.
-func (m *MockClustersInterface) Delete(project, zone, cluster string) DeleteClustersInterface {
+// Do mocks base method.
+func (m *MockCreateClustersInterface) Do(opts ...googleapi.CallOption) (*v10.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", project, zone, cluster)
-	ret0, _ := ret[0].(DeleteClustersInterface)
-	return ret0
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v10.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *MockClustersInterfaceMockRecorder) Delete(project, zone, cluster interface{}) *gomock.Call {
+// Do indicates an expected call of Do.
+func (mr *MockCreateClustersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockClustersInterface)(nil).Delete), project, zone, cluster)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateClustersInterface)(nil).Do), opts...)
 }
 
-// Get mocks base method.
-func (m *MockClustersInterface) Get(project, zone, cluster string) GetClustersInterface {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", project, zone, cluster)
-	ret0, _ := ret[0].(GetClustersInterface)
-	return ret0
+// MockDeleteClustersInterface is a mock of DeleteClustersInterface interface.
+type MockDeleteClustersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteClustersInterfaceMockRecorder
 }
 
-// Get indicates an expected call of Get.
-func (mr *MockClustersInterfaceMockRecorder) Get(project, zone, cluster interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockClustersInterface)(nil).Get), project, zone, cluster)
+// MockDeleteClustersInterfaceMockRecorder is the mock recorder for MockDeleteClustersInterface.
+type MockDeleteClustersInterfaceMockRecorder struct {
+	mock *MockDeleteClustersInterface
 }
 
-// List mocks base method.
-func (m *MockClustersInterface) List(project, zone string) ListClustersInterface {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", project, zone)
-	ret0, _ := ret[0].(ListClustersInterface)
-	return ret0
+// NewMockDeleteClustersInterface creates a new mock instance.
+func NewMockDeleteClustersInterface(ctrl *gomock.Controller) *MockDeleteClustersInterface {
+	mock := &MockDeleteClustersInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteClustersInterfaceMockRecorder{mock}
+	return mock
 }
 
-// List indicates an expected call of List.
-func (mr *MockClustersInterfaceMockRecorder) List(project, zone interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockClustersInterface)(nil).List), project, zone)
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteClustersInterface) EXPECT() *MockDeleteClustersInterfaceMockRecorder {
+	return m.recorder
 }
 
-// Update mocks base method.
-func (m *MockClustersInterface) Update(project, zone, cluster string, update *v10.UpdateClusterRequest) UpdateClustersInterface {
+// Do mocks base method.
+func (m *MockDeleteClustersInterface) Do(opts ...googleapi.CallOption) (*v10.Operation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", project, zone, cluster, update)
-	ret0, _ := ret[0].(UpdateClustersInterface)
-	return ret0
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v10.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Update indicates an expected call of Update.
-func (mr *MockClustersInterfaceMockRecorder) Update(project, zone, cluster, update interface{}) *gomock.Call {
+// Do indicates an expected call of Do.
+func (mr *MockDeleteClustersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockClustersInterface)(nil).Update), project, zone, cluster, update)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteClustersInterface)(nil).Do), opts...)
 }
 
-// MockListInstancesInterface is a mock of ListInstancesInterface interface.
-type MockListInstancesInterface struct {
+// MockUpdateClustersInterface is a mock of UpdateClustersInterface interface.
+type MockUpdateClustersInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockListInstancesInterfaceMockRecorder
+	recorder *MockUpdateClustersInterfaceMockRecorder
 }
 
-// MockListInstancesInterfaceMockRecorder is the mock recorder for MockListInstancesInterface.
-type MockListInstancesInterfaceMockRecorder struct {
-	mock *MockListInstancesInterface
+// MockUpdateClustersInterfaceMockRecorder is the mock recorder for MockUpdateClustersInterface.
+type MockUpdateClustersInterfaceMockRecorder struct {
+	mock *MockUpdateClustersInterface
 }
 
-// NewMockListInstancesInterface creates a new mock instance.
-func NewMockListInstancesInterface(ctrl *gomock.Controller) *MockListInstancesInterface {
-	mock := &MockListInstancesInterface{ctrl: ctrl}
-	mock.recorder = &MockListInstancesInterfaceMockRecorder{mock}
+// NewMockUpdateClustersInterface creates a new mock instance.
+func NewMockUpdateClustersInterface(ctrl *gomock.Controller) *MockUpdateClustersInterface {
+	mock := &MockUpdateClustersInterface{ctrl: ctrl}
+	mock.recorder = &MockUpdateClustersInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockListInstancesInterface) EXPECT() *MockListInstancesInterfaceMockRecorder {
+func (m *MockUpdateClustersInterface) EXPECT() *MockUpdateClustersInterfaceMockRecorder {
 	return m.recorder
 }
 
 // Do mocks base method.
-func (m *MockListInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.InstanceList, error) {
+func (m *MockUpdateClustersInterface) Do(opts ...googleapi.CallOption) (*v10.Operation, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Do", varargs...)
-	ret0, _ := ret[0].(*v1.InstanceList)
+	ret0, _ := ret[0].(*v10.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Do indicates an expected call of Do.
-func (mr *MockListInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+func (mr *MockUpdateClustersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListInstancesInterface)(nil).Do), opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUpdateClustersInterface)(nil).Do), opts...)
 }
 
-// MockListNetworksInterface is a mock of ListNetworksInterface interface.
-type MockListNetworksInterface struct {
+// MockGetManagedZonesInterface is a mock of GetManagedZonesInterface interface.
+type MockGetManagedZonesInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockListNetworksInterfaceMockRecorder
+	recorder *MockGetManagedZonesInterfaceMockRecorder
 }
 
-// MockListNetworksInterfaceMockRecorder is the mock recorder for MockListNetworksInterface.
-type MockListNetworksInterfaceMockRecorder struct {
-	mock *MockListNetworksInterface
+// MockGetManagedZonesInterfaceMockRecorder is the mock recorder for MockGetManagedZonesInterface.
+type MockGetManagedZonesInterfaceMockRecorder struct {
+	mock *MockGetManagedZonesInterface
 }
 
-// NewMockListNetworksInterface creates a new mock instance.
-func NewMockListNetworksInterface(ctrl *gomock.Controller) *MockListNetworksInterface {
-	mock := &MockListNetworksInterface{ctrl: ctrl}
-	mock.recorder = &MockListNetworksInterfaceMockRecorder{mock}
+// NewMockGetManagedZonesInterface creates a new mock instance.
+func NewMockGetManagedZonesInterface(ctrl *gomock.Controller) *MockGetManagedZonesInterface {
+	mock := &MockGetManagedZonesInterface{ctrl: ctrl}
+	mock.recorder = &MockGetManagedZonesInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockListNetworksInterface) EXPECT() *MockListNetworksInterfaceMockRecorder {
+func (m *MockGetManagedZonesInterface) EXPECT() *MockGetManagedZonesInterfaceMockRecorder {
 	return m.recorder
 }
 
 // Do mocks base method.
-func (m *MockListNetworksInterface) Do(opts ...googleapi.CallOption) (*v1.NetworkList, error) {
+func (m *MockGetManagedZonesInterface) Do(opts ...googleapi.CallOption) (*v11.ManagedZone, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Do", varargs...)
-	ret0, _ := ret[0].(*v1.NetworkList)
+	ret0, _ := ret[0].(*v11.ManagedZone)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Do indicates an expected call of Do.
-func (mr *MockListNetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+func (mr *MockGetManagedZonesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListNetworksInterface)(nil).Do), opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetManagedZonesInterface)(nil).Do), opts...)
 }
 
-// MockGetNetworksInterface is a mock of GetNetworksInterface interface.
-type MockGetNetworksInterface struct {
+// MockCreateManagedZonesInterface is a mock of CreateManagedZonesInterface interface.
+type MockCreateManagedZonesInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockGetNetworksInterfaceMockRecorder
+	recorder *MockCreateManagedZonesInterfaceMockRecorder
 }
 
-// MockGetNetworksInterfaceMockRecorder is the mock recorder for MockGetNetworksInterface.
-type MockGetNetworksInterfaceMockRecorder struct {
-	mock *MockGetNetworksInterface
+// MockCreateManagedZonesInterfaceMockRecorder is the mock recorder for MockCreateManagedZonesInterface.
+type MockCreateManagedZonesInterfaceMockRecorder struct {
+	mock *MockCreateManagedZonesInterface
 }
 
-// NewMockGetNetworksInterface creates a new mock instance.
-func NewMockGetNetworksInterface(ctrl *gomock.Controller) *MockGetNetworksInterface {
-	mock := &MockGetNetworksInterface{ctrl: ctrl}
-	mock.recorder = &MockGetNetworksInterfaceMockRecorder{mock}
+// NewMockCreateManagedZonesInterface creates a new mock instance.
+func NewMockCreateManagedZonesInterface(ctrl *gomock.Controller) *MockCreateManagedZonesInterface {
+	mock := &MockCreateManagedZonesInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateManagedZonesInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockGetNetworksInterface) EXPECT() *MockGetNetworksInterfaceMockRecorder {
+func (m *MockCreateManagedZonesInterface) EXPECT() *MockCreateManagedZonesInterfaceMockRecorder {
 	return m.recorder
 }
 
 // Do mocks base method.
-func (m *MockGetNetworksInterface) Do(opts ...googleapi.CallOption) (*v1.Network, error) {
+func (m *MockCreateManagedZonesInterface) Do(opts ...googleapi.CallOption) (*v11.ManagedZone, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Do", varargs...)
-	ret0, _ := ret[0].(*v1.Network)
+	ret0, _ := ret[0].(*v11.ManagedZone)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Do indicates an expected call of Do.
-func (mr *MockGetNetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+func (mr *MockCreateManagedZonesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetNetworksInterface)(nil).Do), opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateManagedZonesInterface)(nil).Do), opts...)
 }
 
-// MockCreateNetworksInterface is a mock of CreateNetworksInterface interface.
-type MockCreateNetworksInterface struct {
+// MockPatchManagedZonesInterface is a mock of PatchManagedZonesInterface interface.
+type MockPatchManagedZonesInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockCreateNetworksInterfaceMockRecorder
+	recorder *MockPatchManagedZonesInterfaceMockRecorder
 }
 
-// MockCreateNetworksInterfaceMockRecorder is the mock recorder for MockCreateNetworksInterface.
-type MockCreateNetworksInterfaceMockRecorder struct {
-	mock *MockCreateNetworksInterface
+// MockPatchManagedZonesInterfaceMockRecorder is the mock recorder for MockPatchManagedZonesInterface.
+type MockPatchManagedZonesInterfaceMockRecorder struct {
+	mock *MockPatchManagedZonesInterface
 }
 
-// NewMockCreateNetworksInterface creates a new mock instance.
-func NewMockCreateNetworksInterface(ctrl *gomock.Controller) *MockCreateNetworksInterface {
-	mock := &MockCreateNetworksInterface{ctrl: ctrl}
-	mock.recorder = &MockCreateNetworksInterfaceMockRecorder{mock}
+// NewMockPatchManagedZonesInterface creates a new mock instance.
+func NewMockPatchManagedZonesInterface(ctrl *gomock.Controller) *MockPatchManagedZonesInterface {
+	mock := &MockPatchManagedZonesInterface{ctrl: ctrl}
+	mock.recorder = &MockPatchManagedZonesInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCreateNetworksInterface) EXPECT() *MockCreateNetworksInterfaceMockRecorder {
+func (m *MockPatchManagedZonesInterface) EXPECT() *MockPatchManagedZonesInterfaceMockRecorder {
 	return m.recorder
 }
 
 // Do mocks base method.
-func (m *MockCreateNetworksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+func (m *MockPatchManagedZonesInterface) Do(opts ...googleapi.CallOption) (*v11.Operation, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Do", varargs...)
-	ret0, _ := ret[0].(*v1.Operation)
+	ret0, _ := ret[0].(*v11.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Do indicates an expected call of Do.
-func (mr *MockCreateNetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+func (mr *MockPatchManagedZonesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateNetworksInterface)(nil).Do), opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPatchManagedZonesInterface)(nil).Do), opts...)
 }
 
-// MockDeleteNetworksInterface is a mock of DeleteNetworksInterface interface.
-type MockDeleteNetworksInterface struct {
+// MockDeleteManagedZonesInterface is a mock of DeleteManagedZonesInterface interface.
+type MockDeleteManagedZonesInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockDeleteNetworksInterfaceMockRecorder
+	recorder *MockDeleteManagedZonesInterfaceMockRecorder
 }
 
-// MockDeleteNetworksInterfaceMockRecorder is the mock recorder for MockDeleteNetworksInterface.
-type MockDeleteNetworksInterfaceMockRecorder struct {
-	mock *MockDeleteNetworksInterface
+// MockDeleteManagedZonesInterfaceMockRecorder is the mock recorder for MockDeleteManagedZonesInterface.
+type MockDeleteManagedZonesInterfaceMockRecorder struct {
+	mock *MockDeleteManagedZonesInterface
 }
 
-// NewMockDeleteNetworksInterface creates a new mock instance.
-func NewMockDeleteNetworksInterface(ctrl *gomock.Controller) *MockDeleteNetworksInterface {
-	mock := &MockDeleteNetworksInterface{ctrl: ctrl}
-	mock.recorder = &MockDeleteNetworksInterfaceMockRecorder{mock}
+// NewMockDeleteManagedZonesInterface creates a new mock instance.
+func NewMockDeleteManagedZonesInterface(ctrl *gomock.Controller) *MockDeleteManagedZonesInterface {
+	mock := &MockDeleteManagedZonesInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteManagedZonesInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockDeleteNetworksInterface) EXPECT() *MockDeleteNetworksInterfaceMockRecorder {
+func (m *MockDeleteManagedZonesInterface) EXPECT() *MockDeleteManagedZonesInterfaceMockRecorder {
 	return m.recorder
 }
 
 // Do mocks base method.
-func (m *MockDeleteNetworksInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+func (m *MockDeleteManagedZonesInterface) Do(opts ...googleapi.CallOption) error {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Do", varargs...)
-	ret0, _ := ret[0].(*v1.Operation)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // Do indicates an expected call of Do.
-func (mr *MockDeleteNetworksInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+func (mr *MockDeleteManagedZonesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteNetworksInterface)(nil).Do), opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteManagedZonesInterface)(nil).Do), opts...)
 }
 
-// MockListClustersInterface is a mock of ListClustersInterface interface.
-type MockListClustersInterface struct {
+// MockGetResourceRecordSetsInterface is a mock of GetResourceRecordSetsInterface interface.
+type MockGetResourceRecordSetsInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockListClustersInterfaceMockRecorder
+	recorder *MockGetResourceRecordSetsInterfaceMockRecorder
 }
 
-// MockListClustersInterfaceMockRecorder is the mock recorder for MockListClustersInterface.
-type MockListClustersInterfaceMockRecorder struct {
-	mock *MockListClustersInterface
+// MockGetResourceRecordSetsInterfaceMockRecorder is the mock recorder for MockGetResourceRecordSetsInterface.
+type MockGetResourceRecordSetsInterfaceMockRecorder struct {
+	mock *MockGetResourceRecordSetsInterface
 }
 
-// NewMockListClustersInterface creates a new mock instance.
-func NewMockListClustersInterface(ctrl *gomock.Controller) *MockListClustersInterface {
-	mock := &MockListClustersInterface{ctrl: ctrl}
-	mock.recorder = &MockListClustersInterfaceMockRecorder{mock}
+// NewMockGetResourceRecordSetsInterface creates a new mock instance.
+func NewMockGetResourceRecordSetsInterface(ctrl *gomock.Controller) *MockGetResourceRecordSetsInterface {
+	mock := &MockGetResourceRecordSetsInterface{ctrl: ctrl}
+	mock.recorder = &MockGetResourceRecordSetsInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockListClustersInterface) EXPECT() *MockListClustersInterfaceMockRecorder {
+func (m *MockGetResourceRecordSetsInterface) EXPECT() *MockGetResourceRecordSetsInterfaceMockRecorder {
 	return m.recorder
 }
 
 // Do mocks base method.
-func (m *MockListClustersInterface) Do(opts ...googleapi.CallOption) (*v10.ListClustersResponse, error) {
+func (m *MockGetResourceRecordSetsInterface) Do(opts ...googleapi.CallOption) (*v11.ResourceRecordSet, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Do", varargs...)
-	ret0, _ := ret[0].(*v10.ListClustersResponse)
+	ret0, _ := ret[0].(*v11.ResourceRecordSet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Do indicates an expected call of Do.
-func (mr *MockListClustersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+func (mr *MockGetResourceRecordSetsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListClustersInterface)(nil).Do), opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetResourceRecordSetsInterface)(nil).Do), opts...)
 }
 
-// MockGetClustersInterface is a mock of GetClustersInterface interface.
-type MockGetClustersInterface struct {
+// MockCreateResourceRecordSetsInterface is a mock of CreateResourceRecordSetsInterface interface.
+type MockCreateResourceRecordSetsInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockGetClustersInterfaceMockRecorder
+	recorder *MockCreateResourceRecordSetsInterfaceMockRecorder
 }
 
-// MockGetClustersInterfaceMockRecorder is the mock recorder for MockGetClustersInterface.
-type MockGetClustersInterfaceMockRecorder struct {
-	mock *MockGetClustersInterface
+// MockCreateResourceRecordSetsInterfaceMockRecorder is the mock recorder for MockCreateResourceRecordSetsInterface.
+type MockCreateResourceRecordSetsInterfaceMockRecorder struct {
+	mock *MockCreateResourceRecordSetsInterface
 }
 
-// NewMockGetClustersInterface creates a new mock instance.
-func NewMockGetClustersInterface(ctrl *gomock.Controller) *MockGetClustersInterface {
-	mock := &MockGetClustersInterface{ctrl: ctrl}
-	mock.recorder = &MockGetClustersInterfaceMockRecorder{mock}
+// NewMockCreateResourceRecordSetsInterface creates a new mock instance.
+func NewMockCreateResourceRecordSetsInterface(ctrl *gomock.Controller) *MockCreateResourceRecordSetsInterface {
+	mock := &MockCreateResourceRecordSetsInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateResourceRecordSetsInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockGetClustersInterface) EXPECT() *MockGetClustersInterfaceMockRecorder {
+func (m *MockCreateResourceRecordSetsInterface) EXPECT() *MockCreateResourceRecordSetsInterfaceMockRecorder {
 	return m.recorder
 }
 
 // Do mocks base method.
-func (m *MockGetClustersInterface) Do(opts ...googleapi.CallOption) (*v10.Cluster, error) {
+func (m *MockCreateResourceRecordSetsInterface) Do(opts ...googleapi.CallOption) (*v11.ResourceRecordSet, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Do", varargs...)
-	ret0, _ := ret[0].(*v10.Cluster)
+	ret0, _ := ret[0].(*v11.ResourceRecordSet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Do indicates an expected call of Do.
-func (mr *MockGetClustersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+func (mr *MockCreateResourceRecordSetsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetClustersInterface)(nil).Do), opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateResourceRecordSetsInterface)(nil).Do), opts...)
 }
 
-// MockCreateClustersInterface is a mock of CreateClustersInterface interface.
-type MockCreateClustersInterface struct {
+// MockPatchResourceRecordSetsInterface is a mock of PatchResourceRecordSetsInterface interface.
+type MockPatchResourceRecordSetsInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockCreateClustersInterfaceMockRecorder
+	recorder *MockPatchResourceRecordSetsInterfaceMockRecorder
 }
 
-// MockCreateClustersInterfaceMockRecorder is the mock recorder for MockCreateClustersInterface.
-type MockCreateClustersInterfaceMockRecorder struct {
-	mock *MockCreateClustersInterface
+// MockPatchResourceRecordSetsInterfaceMockRecorder is the mock recorder for MockPatchResourceRecordSetsInterface.
+type MockPatchResourceRecordSetsInterfaceMockRecorder struct {
+	mock *MockPatchResourceRecordSetsInterface
 }
 
-// NewMockCreateClustersInterface creates a new mock instance.
-func NewMockCreateClustersInterface(ctrl *gomock.Controller) *MockCreateClustersInterface {
-	mock := &MockCreateClustersInterface{ctrl: ctrl}
-	mock.recorder = &MockCreateClustersInterfaceMockRecorder{mock}
+// NewMockPatchResourceRecordSetsInterface creates a new mock instance.
+func NewMockPatchResourceRecordSetsInterface(ctrl *gomock.Controller) *MockPatchResourceRecordSetsInterface {
+	mock := &MockPatchResourceRecordSetsInterface{ctrl: ctrl}
+	mock.recorder = &MockPatchResourceRecordSetsInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCreateClustersInterface) EXPECT() *MockCreateClustersInterfaceMockRecorder {
+func (m *MockPatchResourceRecordSetsInterface) EXPECT() *MockPatchResourceRecordSetsInterfaceMockRecorder {
 	return m.recorder
 }
 
 // Do mocks base method.
-func (m *MockCreateClustersInterface) Do(opts ...googleapi.CallOption) (*v10.Operation, error) {
+func (m *MockPatchResourceRecordSetsInterface) Do(opts ...googleapi.CallOption) (*v11.ResourceRecordSet, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Do", varargs...)
-	ret0, _ := ret[0].(*v10.Operation)
+	ret0, _ := ret[0].(*v11.ResourceRecordSet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Do indicates an expected call of Do.
-func (mr *MockCreateClustersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+func (mr *MockPatchResourceRecordSetsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateClustersInterface)(nil).Do), opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPatchResourceRecordSetsInterface)(nil).Do), opts...)
 }
 
-// MockDeleteClustersInterface is a mock of DeleteClustersInterface interface.
-type MockDeleteClustersInterface struct {
+// MockDeleteResourceRecordSetsInterface is a mock of DeleteResourceRecordSetsInterface interface.
+type MockDeleteResourceRecordSetsInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockDeleteClustersInterfaceMockRecorder
+	recorder *MockDeleteResourceRecordSetsInterfaceMockRecorder
 }
 
-// MockDeleteClustersInterfaceMockRecorder is the mock recorder for MockDeleteClustersInterface.
-type MockDeleteClustersInterfaceMockRecorder struct {
-	mock *MockDeleteClustersInterface
+// MockDeleteResourceRecordSetsInterfaceMockRecorder is the mock recorder for MockDeleteResourceRecordSetsInterface.
+type MockDeleteResourceRecordSetsInterfaceMockRecorder struct {
+	mock *MockDeleteResourceRecordSetsInterface
 }
 
-// NewMockDeleteClustersInterface creates a new mock instance.
-func NewMockDeleteClustersInterface(ctrl *gomock.Controller) *MockDeleteClustersInterface {
-	mock := &MockDeleteClustersInterface{ctrl: ctrl}
-	mock.recorder = &MockDeleteClustersInterfaceMockRecorder{mock}
+// NewMockDeleteResourceRecordSetsInterface creates a new mock instance.
+func NewMockDeleteResourceRecordSetsInterface(ctrl *gomock.Controller) *MockDeleteResourceRecordSetsInterface {
+	mock := &MockDeleteResourceRecordSetsInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteResourceRecordSetsInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockDeleteClustersInterface) EXPECT() *MockDeleteClustersInterfaceMockRecorder {
+func (m *MockDeleteResourceRecordSetsInterface) EXPECT() *MockDeleteResourceRecordSetsInterfaceMockRecorder {
 	return m.recorder
 }
 
 // Do mocks base method.
-func (m *MockDeleteClustersInterface) Do(opts ...googleapi.CallOption) (*v10.Operation, error) {
+func (m *MockDeleteResourceRecordSetsInterface) Do(opts ...googleapi.CallOption) (*v11.ResourceRecordSetsDeleteResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Do", varargs...)
-	ret0, _ := ret[0].(*v10.Operation)
+	ret0, _ := ret[0].(*v11.ResourceRecordSetsDeleteResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Do indicates an expected call of Do.
-func (mr *MockDeleteClustersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+func (mr *MockDeleteResourceRecordSetsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteClustersInterface)(nil).Do), opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteResourceRecordSetsInterface)(nil).Do), opts...)
 }
 
-// MockUpdateClustersInterface is a mock of UpdateClustersInterface interface.
-type MockUpdateClustersInterface struct {
+// MockGetManagedZoneOperationsInterface is a mock of GetManagedZoneOperationsInterface interface.
+type MockGetManagedZoneOperationsInterface struct {
 	ctrl     *gomock.Controller
-	recorder *MockUpdateClustersInterfaceMockRecorder
+	recorder *MockGetManagedZoneOperationsInterfaceMockRecorder
 }
 
-// MockUpdateClustersInterfaceMockRecorder is the mock recorder for MockUpdateClustersInterface.
-type MockUpdateClustersInterfaceMockRecorder struct {
-	mock *MockUpdateClustersInterface
+// MockGetManagedZoneOperationsInterfaceMockRecorder is the mock recorder for MockGetManagedZoneOperationsInterface.
+type MockGetManagedZoneOperationsInterfaceMockRecorder struct {
+	mock *MockGetManagedZoneOperationsInterface
 }
 
-// NewMockUpdateClustersInterface creates a new mock instance.
-func NewMockUpdateClustersInterface(ctrl *gomock.Controller) *MockUpdateClustersInterface {
-	mock := &MockUpdateClustersInterface{ctrl: ctrl}
-	mock.recorder = &MockUpdateClustersInterfaceMockRecorder{mock}
+// NewMockGetManagedZoneOperationsInterface creates a new mock instance.
+func NewMockGetManagedZoneOperationsInterface(ctrl *gomock.Controller) *MockGetManagedZoneOperationsInterface {
+	mock := &MockGetManagedZoneOperationsInterface{ctrl: ctrl}
+	mock.recorder = &MockGetManagedZoneOperationsInterfaceMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockUpdateClustersInterface) EXPECT() *MockUpdateClustersInterfaceMockRecorder {
+func (m *MockGetManagedZoneOperationsInterface) EXPECT() *MockGetManagedZoneOperationsInterfaceMockRecorder {
 	return m.recorder
 }
 
 // Do mocks base method.
-func (m *MockUpdateClustersInterface) Do(opts ...googleapi.CallOption) (*v10.Operation, error) {
+func (m *MockGetManagedZoneOperationsInterface) Do(opts ...googleapi.CallOption) (*v11.Operation, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "Do", varargs...)
-	ret0, _ := ret[0].(*v10.Operation)
+	ret0, _ := ret[0].(*v11.Operation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Do indicates an expected call of Do.
-func (mr *MockUpdateClustersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+func (mr *MockGetManagedZoneOperationsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUpdateClustersInterface)(nil).Do), opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetManagedZoneOperationsInterface)(nil).Do), opts...)
 }
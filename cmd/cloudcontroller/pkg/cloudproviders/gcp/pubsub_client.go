@@ -0,0 +1,238 @@
+package gcp
+
+import (
+	"fmt"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/pubsub/v1"
+)
+
+//===============================================================================================
+// TYPES AND INTERFACES
+//===============================================================================================
+
+// Services
+type (
+	PubSubService struct {
+		Clients PubSubClients
+	}
+)
+
+// Clients
+type (
+	PubSubClients struct {
+		Topics        TopicsInterface
+		Subscriptions SubscriptionsInterface
+	}
+)
+
+// Resources
+type (
+	// pubsub resources
+	GCPTopics struct {
+		TopicsService *pubsub.ProjectsTopicsService
+	}
+	GCPSubscriptions struct {
+		SubscriptionsService *pubsub.ProjectsSubscriptionsService
+	}
+)
+
+// Interfaces
+type (
+	// pubsub interfaces
+	//// topics
+	TopicsInterface interface {
+		Get(project, topic string) GetTopicsInterface
+		Create(project, topic string, topicResource *pubsub.Topic) CreateTopicsInterface
+		Patch(project, topic string, request *pubsub.UpdateTopicRequest) PatchTopicsInterface
+		Delete(project, topic string) DeleteTopicsInterface
+		GetIamPolicy(project, topic string) GetIamPolicyTopicsInterface
+		SetIamPolicy(project, topic string, request *pubsub.SetIamPolicyRequest) SetIamPolicyTopicsInterface
+	}
+	//// subscriptions
+	SubscriptionsInterface interface {
+		Get(project, subscription string) GetSubscriptionsInterface
+		Create(project, subscription string, subscriptionResource *pubsub.Subscription) CreateSubscriptionsInterface
+		Patch(project, subscription string, request *pubsub.UpdateSubscriptionRequest) PatchSubscriptionsInterface
+		Delete(project, subscription string) DeleteSubscriptionsInterface
+	}
+)
+
+// Requests
+type (
+	// pubsub do interfaces
+	//// topics
+	GetTopicsInterface interface {
+		Do(opts ...googleapi.CallOption) (*pubsub.Topic, error)
+	}
+	CreateTopicsInterface interface {
+		Do(opts ...googleapi.CallOption) (*pubsub.Topic, error)
+	}
+	PatchTopicsInterface interface {
+		Do(opts ...googleapi.CallOption) (*pubsub.Topic, error)
+	}
+	DeleteTopicsInterface interface {
+		Do(opts ...googleapi.CallOption) (*pubsub.Empty, error)
+	}
+	GetIamPolicyTopicsInterface interface {
+		Do(opts ...googleapi.CallOption) (*pubsub.Policy, error)
+	}
+	SetIamPolicyTopicsInterface interface {
+		Do(opts ...googleapi.CallOption) (*pubsub.Policy, error)
+	}
+	//// subscriptions
+	GetSubscriptionsInterface interface {
+		Do(opts ...googleapi.CallOption) (*pubsub.Subscription, error)
+	}
+	CreateSubscriptionsInterface interface {
+		Do(opts ...googleapi.CallOption) (*pubsub.Subscription, error)
+	}
+	PatchSubscriptionsInterface interface {
+		Do(opts ...googleapi.CallOption) (*pubsub.Subscription, error)
+	}
+	DeleteSubscriptionsInterface interface {
+		Do(opts ...googleapi.CallOption) (*pubsub.Empty, error)
+	}
+)
+
+// Executor requests
+type (
+	// pubsub google calls
+	//// topics
+	GetTopicsRequest struct {
+		googleCall *pubsub.ProjectsTopicsGetCall
+	}
+	CreateTopicsRequest struct {
+		googleCall *pubsub.ProjectsTopicsCreateCall
+	}
+	PatchTopicsRequest struct {
+		googleCall *pubsub.ProjectsTopicsPatchCall
+	}
+	DeleteTopicsRequest struct {
+		googleCall *pubsub.ProjectsTopicsDeleteCall
+	}
+	GetIamPolicyTopicsRequest struct {
+		googleCall *pubsub.ProjectsTopicsGetIamPolicyCall
+	}
+	SetIamPolicyTopicsRequest struct {
+		googleCall *pubsub.ProjectsTopicsSetIamPolicyCall
+	}
+	//// subscriptions
+	GetSubscriptionsRequest struct {
+		googleCall *pubsub.ProjectsSubscriptionsGetCall
+	}
+	CreateSubscriptionsRequest struct {
+		googleCall *pubsub.ProjectsSubscriptionsCreateCall
+	}
+	PatchSubscriptionsRequest struct {
+		googleCall *pubsub.ProjectsSubscriptionsPatchCall
+	}
+	DeleteSubscriptionsRequest struct {
+		googleCall *pubsub.ProjectsSubscriptionsDeleteCall
+	}
+)
+
+// ===============================================================================================
+// FUNCTIONS
+// ===============================================================================================
+// Verbs
+// // PubSub
+// ///// Topics
+func (t *GCPTopics) Get(projectID, topic string) GetTopicsInterface {
+	return &GetTopicsRequest{
+		googleCall: t.TopicsService.Get(TopicPath(projectID, topic)),
+	}
+}
+func (t *GCPTopics) Create(projectID, topic string, topicResource *pubsub.Topic) CreateTopicsInterface {
+	return &CreateTopicsRequest{
+		googleCall: t.TopicsService.Create(TopicPath(projectID, topic), topicResource),
+	}
+}
+func (t *GCPTopics) Patch(projectID, topic string, request *pubsub.UpdateTopicRequest) PatchTopicsInterface {
+	return &PatchTopicsRequest{
+		googleCall: t.TopicsService.Patch(TopicPath(projectID, topic), request),
+	}
+}
+func (t *GCPTopics) Delete(projectID, topic string) DeleteTopicsInterface {
+	return &DeleteTopicsRequest{
+		googleCall: t.TopicsService.Delete(TopicPath(projectID, topic)),
+	}
+}
+
+func (t *GCPTopics) GetIamPolicy(projectID, topic string) GetIamPolicyTopicsInterface {
+	return &GetIamPolicyTopicsRequest{
+		googleCall: t.TopicsService.GetIamPolicy(TopicPath(projectID, topic)).OptionsRequestedPolicyVersion(iamPolicyVersion),
+	}
+}
+func (t *GCPTopics) SetIamPolicy(projectID, topic string, request *pubsub.SetIamPolicyRequest) SetIamPolicyTopicsInterface {
+	return &SetIamPolicyTopicsRequest{
+		googleCall: t.TopicsService.SetIamPolicy(TopicPath(projectID, topic), request),
+	}
+}
+
+// ///// Subscriptions
+func (s *GCPSubscriptions) Get(projectID, subscription string) GetSubscriptionsInterface {
+	return &GetSubscriptionsRequest{
+		googleCall: s.SubscriptionsService.Get(SubscriptionPath(projectID, subscription)),
+	}
+}
+func (s *GCPSubscriptions) Create(projectID, subscription string, subscriptionResource *pubsub.Subscription) CreateSubscriptionsInterface {
+	return &CreateSubscriptionsRequest{
+		googleCall: s.SubscriptionsService.Create(SubscriptionPath(projectID, subscription), subscriptionResource),
+	}
+}
+func (s *GCPSubscriptions) Patch(projectID, subscription string, request *pubsub.UpdateSubscriptionRequest) PatchSubscriptionsInterface {
+	return &PatchSubscriptionsRequest{
+		googleCall: s.SubscriptionsService.Patch(SubscriptionPath(projectID, subscription), request),
+	}
+}
+func (s *GCPSubscriptions) Delete(projectID, subscription string) DeleteSubscriptionsInterface {
+	return &DeleteSubscriptionsRequest{
+		googleCall: s.SubscriptionsService.Delete(SubscriptionPath(projectID, subscription)),
+	}
+}
+
+// TopicPath returns the resource name of the topic, e.g. projects/my-project/topics/my-topic
+func TopicPath(projectID, topic string) string {
+	return fmt.Sprintf("projects/%s/topics/%s", projectID, topic)
+}
+
+// SubscriptionPath returns the resource name of the subscription, e.g. projects/my-project/subscriptions/my-sub
+func SubscriptionPath(projectID, subscription string) string {
+	return fmt.Sprintf("projects/%s/subscriptions/%s", projectID, subscription)
+}
+
+// Execs
+// // PubSub
+// //// Topics
+func (lc *GetTopicsRequest) Do(opts ...googleapi.CallOption) (*pubsub.Topic, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateTopicsRequest) Do(opts ...googleapi.CallOption) (*pubsub.Topic, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *PatchTopicsRequest) Do(opts ...googleapi.CallOption) (*pubsub.Topic, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteTopicsRequest) Do(opts ...googleapi.CallOption) (*pubsub.Empty, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *GetIamPolicyTopicsRequest) Do(opts ...googleapi.CallOption) (*pubsub.Policy, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *SetIamPolicyTopicsRequest) Do(opts ...googleapi.CallOption) (*pubsub.Policy, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Subscriptions
+func (lc *GetSubscriptionsRequest) Do(opts ...googleapi.CallOption) (*pubsub.Subscription, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateSubscriptionsRequest) Do(opts ...googleapi.CallOption) (*pubsub.Subscription, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *PatchSubscriptionsRequest) Do(opts ...googleapi.CallOption) (*pubsub.Subscription, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteSubscriptionsRequest) Do(opts ...googleapi.CallOption) (*pubsub.Empty, error) {
+	return lc.googleCall.Do(opts...)
+}
@@ -0,0 +1,619 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/cloudproviders/gcp/pubsub_client.go
+
+// Package gcp is a generated GoMock package.
+package gcp
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	googleapi "google.golang.org/api/googleapi"
+	v1 "google.golang.org/api/pubsub/v1"
+)
+
+// MockTopicsInterface is a mock of TopicsInterface interface.
+type MockTopicsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTopicsInterfaceMockRecorder
+}
+
+// MockTopicsInterfaceMockRecorder is the mock recorder for MockTopicsInterface.
+type MockTopicsInterfaceMockRecorder struct {
+	mock *MockTopicsInterface
+}
+
+// NewMockTopicsInterface creates a new mock instance.
+func NewMockTopicsInterface(ctrl *gomock.Controller) *MockTopicsInterface {
+	mock := &MockTopicsInterface{ctrl: ctrl}
+	mock.recorder = &MockTopicsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTopicsInterface) EXPECT() *MockTopicsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTopicsInterface) Create(project, topic string, topicResource *v1.Topic) CreateTopicsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", project, topic, topicResource)
+	ret0, _ := ret[0].(CreateTopicsInterface)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTopicsInterfaceMockRecorder) Create(project, topic, topicResource interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTopicsInterface)(nil).Create), project, topic, topicResource)
+}
+
+// Delete mocks base method.
+func (m *MockTopicsInterface) Delete(project, topic string) DeleteTopicsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, topic)
+	ret0, _ := ret[0].(DeleteTopicsInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTopicsInterfaceMockRecorder) Delete(project, topic interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTopicsInterface)(nil).Delete), project, topic)
+}
+
+// Get mocks base method.
+func (m *MockTopicsInterface) Get(project, topic string) GetTopicsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, topic)
+	ret0, _ := ret[0].(GetTopicsInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTopicsInterfaceMockRecorder) Get(project, topic interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTopicsInterface)(nil).Get), project, topic)
+}
+
+// GetIamPolicy mocks base method.
+func (m *MockTopicsInterface) GetIamPolicy(project, topic string) GetIamPolicyTopicsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIamPolicy", project, topic)
+	ret0, _ := ret[0].(GetIamPolicyTopicsInterface)
+	return ret0
+}
+
+// GetIamPolicy indicates an expected call of GetIamPolicy.
+func (mr *MockTopicsInterfaceMockRecorder) GetIamPolicy(project, topic interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIamPolicy", reflect.TypeOf((*MockTopicsInterface)(nil).GetIamPolicy), project, topic)
+}
+
+// Patch mocks base method.
+func (m *MockTopicsInterface) Patch(project, topic string, request *v1.UpdateTopicRequest) PatchTopicsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", project, topic, request)
+	ret0, _ := ret[0].(PatchTopicsInterface)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockTopicsInterfaceMockRecorder) Patch(project, topic, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockTopicsInterface)(nil).Patch), project, topic, request)
+}
+
+// SetIamPolicy mocks base method.
+func (m *MockTopicsInterface) SetIamPolicy(project, topic string, request *v1.SetIamPolicyRequest) SetIamPolicyTopicsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIamPolicy", project, topic, request)
+	ret0, _ := ret[0].(SetIamPolicyTopicsInterface)
+	return ret0
+}
+
+// SetIamPolicy indicates an expected call of SetIamPolicy.
+func (mr *MockTopicsInterfaceMockRecorder) SetIamPolicy(project, topic, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIamPolicy", reflect.TypeOf((*MockTopicsInterface)(nil).SetIamPolicy), project, topic, request)
+}
+
+// MockSubscriptionsInterface is a mock of SubscriptionsInterface interface.
+type MockSubscriptionsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriptionsInterfaceMockRecorder
+}
+
+// MockSubscriptionsInterfaceMockRecorder is the mock recorder for MockSubscriptionsInterface.
+type MockSubscriptionsInterfaceMockRecorder struct {
+	mock *MockSubscriptionsInterface
+}
+
+// NewMockSubscriptionsInterface creates a new mock instance.
+func NewMockSubscriptionsInterface(ctrl *gomock.Controller) *MockSubscriptionsInterface {
+	mock := &MockSubscriptionsInterface{ctrl: ctrl}
+	mock.recorder = &MockSubscriptionsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubscriptionsInterface) EXPECT() *MockSubscriptionsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSubscriptionsInterface) Create(project, subscription string, subscriptionResource *v1.Subscription) CreateSubscriptionsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", project, subscription, subscriptionResource)
+	ret0, _ := ret[0].(CreateSubscriptionsInterface)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSubscriptionsInterfaceMockRecorder) Create(project, subscription, subscriptionResource interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSubscriptionsInterface)(nil).Create), project, subscription, subscriptionResource)
+}
+
+// Delete mocks base method.
+func (m *MockSubscriptionsInterface) Delete(project, subscription string) DeleteSubscriptionsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, subscription)
+	ret0, _ := ret[0].(DeleteSubscriptionsInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSubscriptionsInterfaceMockRecorder) Delete(project, subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSubscriptionsInterface)(nil).Delete), project, subscription)
+}
+
+// Get mocks base method.
+func (m *MockSubscriptionsInterface) Get(project, subscription string) GetSubscriptionsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, subscription)
+	ret0, _ := ret[0].(GetSubscriptionsInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSubscriptionsInterfaceMockRecorder) Get(project, subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSubscriptionsInterface)(nil).Get), project, subscription)
+}
+
+// Patch mocks base method.
+func (m *MockSubscriptionsInterface) Patch(project, subscription string, request *v1.UpdateSubscriptionRequest) PatchSubscriptionsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", project, subscription, request)
+	ret0, _ := ret[0].(PatchSubscriptionsInterface)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockSubscriptionsInterfaceMockRecorder) Patch(project, subscription, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockSubscriptionsInterface)(nil).Patch), project, subscription, request)
+}
+
+// MockGetTopicsInterface is a mock of GetTopicsInterface interface.
+type MockGetTopicsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetTopicsInterfaceMockRecorder
+}
+
+// MockGetTopicsInterfaceMockRecorder is the mock recorder for MockGetTopicsInterface.
+type MockGetTopicsInterfaceMockRecorder struct {
+	mock *MockGetTopicsInterface
+}
+
+// NewMockGetTopicsInterface creates a new mock instance.
+func NewMockGetTopicsInterface(ctrl *gomock.Controller) *MockGetTopicsInterface {
+	mock := &MockGetTopicsInterface{ctrl: ctrl}
+	mock.recorder = &MockGetTopicsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetTopicsInterface) EXPECT() *MockGetTopicsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetTopicsInterface) Do(opts ...googleapi.CallOption) (*v1.Topic, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Topic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetTopicsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetTopicsInterface)(nil).Do), opts...)
+}
+
+// MockCreateTopicsInterface is a mock of CreateTopicsInterface interface.
+type MockCreateTopicsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateTopicsInterfaceMockRecorder
+}
+
+// MockCreateTopicsInterfaceMockRecorder is the mock recorder for MockCreateTopicsInterface.
+type MockCreateTopicsInterfaceMockRecorder struct {
+	mock *MockCreateTopicsInterface
+}
+
+// NewMockCreateTopicsInterface creates a new mock instance.
+func NewMockCreateTopicsInterface(ctrl *gomock.Controller) *MockCreateTopicsInterface {
+	mock := &MockCreateTopicsInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateTopicsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateTopicsInterface) EXPECT() *MockCreateTopicsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateTopicsInterface) Do(opts ...googleapi.CallOption) (*v1.Topic, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Topic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateTopicsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateTopicsInterface)(nil).Do), opts...)
+}
+
+// MockPatchTopicsInterface is a mock of PatchTopicsInterface interface.
+type MockPatchTopicsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPatchTopicsInterfaceMockRecorder
+}
+
+// MockPatchTopicsInterfaceMockRecorder is the mock recorder for MockPatchTopicsInterface.
+type MockPatchTopicsInterfaceMockRecorder struct {
+	mock *MockPatchTopicsInterface
+}
+
+// NewMockPatchTopicsInterface creates a new mock instance.
+func NewMockPatchTopicsInterface(ctrl *gomock.Controller) *MockPatchTopicsInterface {
+	mock := &MockPatchTopicsInterface{ctrl: ctrl}
+	mock.recorder = &MockPatchTopicsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPatchTopicsInterface) EXPECT() *MockPatchTopicsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPatchTopicsInterface) Do(opts ...googleapi.CallOption) (*v1.Topic, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Topic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPatchTopicsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPatchTopicsInterface)(nil).Do), opts...)
+}
+
+// MockDeleteTopicsInterface is a mock of DeleteTopicsInterface interface.
+type MockDeleteTopicsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteTopicsInterfaceMockRecorder
+}
+
+// MockDeleteTopicsInterfaceMockRecorder is the mock recorder for MockDeleteTopicsInterface.
+type MockDeleteTopicsInterfaceMockRecorder struct {
+	mock *MockDeleteTopicsInterface
+}
+
+// NewMockDeleteTopicsInterface creates a new mock instance.
+func NewMockDeleteTopicsInterface(ctrl *gomock.Controller) *MockDeleteTopicsInterface {
+	mock := &MockDeleteTopicsInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteTopicsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteTopicsInterface) EXPECT() *MockDeleteTopicsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteTopicsInterface) Do(opts ...googleapi.CallOption) (*v1.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteTopicsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteTopicsInterface)(nil).Do), opts...)
+}
+
+// MockGetIamPolicyTopicsInterface is a mock of GetIamPolicyTopicsInterface interface.
+type MockGetIamPolicyTopicsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetIamPolicyTopicsInterfaceMockRecorder
+}
+
+// MockGetIamPolicyTopicsInterfaceMockRecorder is the mock recorder for MockGetIamPolicyTopicsInterface.
+type MockGetIamPolicyTopicsInterfaceMockRecorder struct {
+	mock *MockGetIamPolicyTopicsInterface
+}
+
+// NewMockGetIamPolicyTopicsInterface creates a new mock instance.
+func NewMockGetIamPolicyTopicsInterface(ctrl *gomock.Controller) *MockGetIamPolicyTopicsInterface {
+	mock := &MockGetIamPolicyTopicsInterface{ctrl: ctrl}
+	mock.recorder = &MockGetIamPolicyTopicsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetIamPolicyTopicsInterface) EXPECT() *MockGetIamPolicyTopicsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetIamPolicyTopicsInterface) Do(opts ...googleapi.CallOption) (*v1.Policy, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetIamPolicyTopicsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetIamPolicyTopicsInterface)(nil).Do), opts...)
+}
+
+// MockSetIamPolicyTopicsInterface is a mock of SetIamPolicyTopicsInterface interface.
+type MockSetIamPolicyTopicsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetIamPolicyTopicsInterfaceMockRecorder
+}
+
+// MockSetIamPolicyTopicsInterfaceMockRecorder is the mock recorder for MockSetIamPolicyTopicsInterface.
+type MockSetIamPolicyTopicsInterfaceMockRecorder struct {
+	mock *MockSetIamPolicyTopicsInterface
+}
+
+// NewMockSetIamPolicyTopicsInterface creates a new mock instance.
+func NewMockSetIamPolicyTopicsInterface(ctrl *gomock.Controller) *MockSetIamPolicyTopicsInterface {
+	mock := &MockSetIamPolicyTopicsInterface{ctrl: ctrl}
+	mock.recorder = &MockSetIamPolicyTopicsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetIamPolicyTopicsInterface) EXPECT() *MockSetIamPolicyTopicsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSetIamPolicyTopicsInterface) Do(opts ...googleapi.CallOption) (*v1.Policy, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSetIamPolicyTopicsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSetIamPolicyTopicsInterface)(nil).Do), opts...)
+}
+
+// MockGetSubscriptionsInterface is a mock of GetSubscriptionsInterface interface.
+type MockGetSubscriptionsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetSubscriptionsInterfaceMockRecorder
+}
+
+// MockGetSubscriptionsInterfaceMockRecorder is the mock recorder for MockGetSubscriptionsInterface.
+type MockGetSubscriptionsInterfaceMockRecorder struct {
+	mock *MockGetSubscriptionsInterface
+}
+
+// NewMockGetSubscriptionsInterface creates a new mock instance.
+func NewMockGetSubscriptionsInterface(ctrl *gomock.Controller) *MockGetSubscriptionsInterface {
+	mock := &MockGetSubscriptionsInterface{ctrl: ctrl}
+	mock.recorder = &MockGetSubscriptionsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetSubscriptionsInterface) EXPECT() *MockGetSubscriptionsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetSubscriptionsInterface) Do(opts ...googleapi.CallOption) (*v1.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetSubscriptionsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetSubscriptionsInterface)(nil).Do), opts...)
+}
+
+// MockCreateSubscriptionsInterface is a mock of CreateSubscriptionsInterface interface.
+type MockCreateSubscriptionsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateSubscriptionsInterfaceMockRecorder
+}
+
+// MockCreateSubscriptionsInterfaceMockRecorder is the mock recorder for MockCreateSubscriptionsInterface.
+type MockCreateSubscriptionsInterfaceMockRecorder struct {
+	mock *MockCreateSubscriptionsInterface
+}
+
+// NewMockCreateSubscriptionsInterface creates a new mock instance.
+func NewMockCreateSubscriptionsInterface(ctrl *gomock.Controller) *MockCreateSubscriptionsInterface {
+	mock := &MockCreateSubscriptionsInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateSubscriptionsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateSubscriptionsInterface) EXPECT() *MockCreateSubscriptionsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateSubscriptionsInterface) Do(opts ...googleapi.CallOption) (*v1.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateSubscriptionsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateSubscriptionsInterface)(nil).Do), opts...)
+}
+
+// MockPatchSubscriptionsInterface is a mock of PatchSubscriptionsInterface interface.
+type MockPatchSubscriptionsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPatchSubscriptionsInterfaceMockRecorder
+}
+
+// MockPatchSubscriptionsInterfaceMockRecorder is the mock recorder for MockPatchSubscriptionsInterface.
+type MockPatchSubscriptionsInterfaceMockRecorder struct {
+	mock *MockPatchSubscriptionsInterface
+}
+
+// NewMockPatchSubscriptionsInterface creates a new mock instance.
+func NewMockPatchSubscriptionsInterface(ctrl *gomock.Controller) *MockPatchSubscriptionsInterface {
+	mock := &MockPatchSubscriptionsInterface{ctrl: ctrl}
+	mock.recorder = &MockPatchSubscriptionsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPatchSubscriptionsInterface) EXPECT() *MockPatchSubscriptionsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPatchSubscriptionsInterface) Do(opts ...googleapi.CallOption) (*v1.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPatchSubscriptionsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPatchSubscriptionsInterface)(nil).Do), opts...)
+}
+
+// MockDeleteSubscriptionsInterface is a mock of DeleteSubscriptionsInterface interface.
+type MockDeleteSubscriptionsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteSubscriptionsInterfaceMockRecorder
+}
+
+// MockDeleteSubscriptionsInterfaceMockRecorder is the mock recorder for MockDeleteSubscriptionsInterface.
+type MockDeleteSubscriptionsInterfaceMockRecorder struct {
+	mock *MockDeleteSubscriptionsInterface
+}
+
+// NewMockDeleteSubscriptionsInterface creates a new mock instance.
+func NewMockDeleteSubscriptionsInterface(ctrl *gomock.Controller) *MockDeleteSubscriptionsInterface {
+	mock := &MockDeleteSubscriptionsInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteSubscriptionsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteSubscriptionsInterface) EXPECT() *MockDeleteSubscriptionsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteSubscriptionsInterface) Do(opts ...googleapi.CallOption) (*v1.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteSubscriptionsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteSubscriptionsInterface)(nil).Do), opts...)
+}
@@ -0,0 +1,173 @@
+package gcp
+
+import (
+	"fmt"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/redis/v1"
+)
+
+//===============================================================================================
+// TYPES AND INTERFACES
+//===============================================================================================
+
+// Services
+type (
+	RedisService struct {
+		Clients RedisClients
+	}
+)
+
+// Clients
+type (
+	RedisClients struct {
+		Instances  RedisInstancesInterface
+		Operations RedisOperationsInterface
+	}
+)
+
+// Resources
+type (
+	// redis resources
+	GCPRedisInstances struct {
+		InstancesService *redis.ProjectsLocationsInstancesService
+	}
+	GCPRedisOperations struct {
+		OperationsService *redis.ProjectsLocationsOperationsService
+	}
+)
+
+// Interfaces
+type (
+	// redis interfaces
+	//// instances
+	RedisInstancesInterface interface {
+		Get(project, region, instance string) GetRedisInstancesInterface
+		Create(project, region, instance string, instanceResource *redis.Instance) CreateRedisInstancesInterface
+		Patch(project, region, instance string, instanceResource *redis.Instance, updateMask string) PatchRedisInstancesInterface
+		Delete(project, region, instance string) DeleteRedisInstancesInterface
+		GetAuthString(project, region, instance string) GetAuthStringRedisInstancesInterface
+	}
+	//// operations
+	RedisOperationsInterface interface {
+		Get(operation string) GetRedisOperationsInterface
+	}
+)
+
+// Requests
+type (
+	// redis do interfaces
+	//// instances
+	GetRedisInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*redis.Instance, error)
+	}
+	CreateRedisInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*redis.Operation, error)
+	}
+	PatchRedisInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*redis.Operation, error)
+	}
+	DeleteRedisInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*redis.Operation, error)
+	}
+	GetAuthStringRedisInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*redis.InstanceAuthString, error)
+	}
+	//// operations
+	GetRedisOperationsInterface interface {
+		Do(opts ...googleapi.CallOption) (*redis.Operation, error)
+	}
+)
+
+// Executor requests
+type (
+	// redis google calls
+	//// instances
+	GetRedisInstancesRequest struct {
+		googleCall *redis.ProjectsLocationsInstancesGetCall
+	}
+	CreateRedisInstancesRequest struct {
+		googleCall *redis.ProjectsLocationsInstancesCreateCall
+	}
+	PatchRedisInstancesRequest struct {
+		googleCall *redis.ProjectsLocationsInstancesPatchCall
+	}
+	DeleteRedisInstancesRequest struct {
+		googleCall *redis.ProjectsLocationsInstancesDeleteCall
+	}
+	GetAuthStringRedisInstancesRequest struct {
+		googleCall *redis.ProjectsLocationsInstancesGetAuthStringCall
+	}
+	//// operations
+	GetRedisOperationsRequest struct {
+		googleCall *redis.ProjectsLocationsOperationsGetCall
+	}
+)
+
+// ===============================================================================================
+// FUNCTIONS
+// ===============================================================================================
+// Verbs
+// // Redis
+// ///// Instances
+func (r *GCPRedisInstances) Get(projectID, region, instance string) GetRedisInstancesInterface {
+	return &GetRedisInstancesRequest{
+		googleCall: r.InstancesService.Get(RedisInstancePath(projectID, region, instance)),
+	}
+}
+func (r *GCPRedisInstances) Create(projectID, region, instance string, instanceResource *redis.Instance) CreateRedisInstancesInterface {
+	return &CreateRedisInstancesRequest{
+		googleCall: r.InstancesService.Create(fmt.Sprintf("projects/%s/locations/%s", projectID, region), instanceResource).InstanceId(instance),
+	}
+}
+func (r *GCPRedisInstances) Patch(projectID, region, instance string, instanceResource *redis.Instance, updateMask string) PatchRedisInstancesInterface {
+	return &PatchRedisInstancesRequest{
+		googleCall: r.InstancesService.Patch(RedisInstancePath(projectID, region, instance), instanceResource).UpdateMask(updateMask),
+	}
+}
+func (r *GCPRedisInstances) Delete(projectID, region, instance string) DeleteRedisInstancesInterface {
+	return &DeleteRedisInstancesRequest{
+		googleCall: r.InstancesService.Delete(RedisInstancePath(projectID, region, instance)),
+	}
+}
+func (r *GCPRedisInstances) GetAuthString(projectID, region, instance string) GetAuthStringRedisInstancesInterface {
+	return &GetAuthStringRedisInstancesRequest{
+		googleCall: r.InstancesService.GetAuthString(RedisInstancePath(projectID, region, instance)),
+	}
+}
+
+// ///// Operations
+func (o *GCPRedisOperations) Get(operation string) GetRedisOperationsInterface {
+	return &GetRedisOperationsRequest{
+		googleCall: o.OperationsService.Get(operation),
+	}
+}
+
+// RedisInstancePath returns the resource name of the instance, e.g.
+// projects/my-project/locations/us-central1/instances/my-cache
+func RedisInstancePath(projectID, region, instance string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/instances/%s", projectID, region, instance)
+}
+
+// Execs
+// // Redis
+// //// Instances
+func (lc *GetRedisInstancesRequest) Do(opts ...googleapi.CallOption) (*redis.Instance, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateRedisInstancesRequest) Do(opts ...googleapi.CallOption) (*redis.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *PatchRedisInstancesRequest) Do(opts ...googleapi.CallOption) (*redis.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteRedisInstancesRequest) Do(opts ...googleapi.CallOption) (*redis.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *GetAuthStringRedisInstancesRequest) Do(opts ...googleapi.CallOption) (*redis.InstanceAuthString, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Operations
+func (lc *GetRedisOperationsRequest) Do(opts ...googleapi.CallOption) (*redis.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
@@ -0,0 +1,395 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/cloudproviders/gcp/redis_client.go
+
+// Package gcp is a generated GoMock package.
+package gcp
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	googleapi "google.golang.org/api/googleapi"
+	v1 "google.golang.org/api/redis/v1"
+)
+
+// MockRedisInstancesInterface is a mock of RedisInstancesInterface interface.
+type MockRedisInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRedisInstancesInterfaceMockRecorder
+}
+
+// MockRedisInstancesInterfaceMockRecorder is the mock recorder for MockRedisInstancesInterface.
+type MockRedisInstancesInterfaceMockRecorder struct {
+	mock *MockRedisInstancesInterface
+}
+
+// NewMockRedisInstancesInterface creates a new mock instance.
+func NewMockRedisInstancesInterface(ctrl *gomock.Controller) *MockRedisInstancesInterface {
+	mock := &MockRedisInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockRedisInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRedisInstancesInterface) EXPECT() *MockRedisInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRedisInstancesInterface) Create(project, region, instance string, instanceResource *v1.Instance) CreateRedisInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", project, region, instance, instanceResource)
+	ret0, _ := ret[0].(CreateRedisInstancesInterface)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRedisInstancesInterfaceMockRecorder) Create(project, region, instance, instanceResource interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRedisInstancesInterface)(nil).Create), project, region, instance, instanceResource)
+}
+
+// Delete mocks base method.
+func (m *MockRedisInstancesInterface) Delete(project, region, instance string) DeleteRedisInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, region, instance)
+	ret0, _ := ret[0].(DeleteRedisInstancesInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRedisInstancesInterfaceMockRecorder) Delete(project, region, instance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRedisInstancesInterface)(nil).Delete), project, region, instance)
+}
+
+// Get mocks base method.
+func (m *MockRedisInstancesInterface) Get(project, region, instance string) GetRedisInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, region, instance)
+	ret0, _ := ret[0].(GetRedisInstancesInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRedisInstancesInterfaceMockRecorder) Get(project, region, instance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRedisInstancesInterface)(nil).Get), project, region, instance)
+}
+
+// GetAuthString mocks base method.
+func (m *MockRedisInstancesInterface) GetAuthString(project, region, instance string) GetAuthStringRedisInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthString", project, region, instance)
+	ret0, _ := ret[0].(GetAuthStringRedisInstancesInterface)
+	return ret0
+}
+
+// GetAuthString indicates an expected call of GetAuthString.
+func (mr *MockRedisInstancesInterfaceMockRecorder) GetAuthString(project, region, instance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthString", reflect.TypeOf((*MockRedisInstancesInterface)(nil).GetAuthString), project, region, instance)
+}
+
+// Patch mocks base method.
+func (m *MockRedisInstancesInterface) Patch(project, region, instance string, instanceResource *v1.Instance, updateMask string) PatchRedisInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", project, region, instance, instanceResource, updateMask)
+	ret0, _ := ret[0].(PatchRedisInstancesInterface)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockRedisInstancesInterfaceMockRecorder) Patch(project, region, instance, instanceResource, updateMask interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockRedisInstancesInterface)(nil).Patch), project, region, instance, instanceResource, updateMask)
+}
+
+// MockRedisOperationsInterface is a mock of RedisOperationsInterface interface.
+type MockRedisOperationsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRedisOperationsInterfaceMockRecorder
+}
+
+// MockRedisOperationsInterfaceMockRecorder is the mock recorder for MockRedisOperationsInterface.
+type MockRedisOperationsInterfaceMockRecorder struct {
+	mock *MockRedisOperationsInterface
+}
+
+// NewMockRedisOperationsInterface creates a new mock instance.
+func NewMockRedisOperationsInterface(ctrl *gomock.Controller) *MockRedisOperationsInterface {
+	mock := &MockRedisOperationsInterface{ctrl: ctrl}
+	mock.recorder = &MockRedisOperationsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRedisOperationsInterface) EXPECT() *MockRedisOperationsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockRedisOperationsInterface) Get(operation string) GetRedisOperationsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", operation)
+	ret0, _ := ret[0].(GetRedisOperationsInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRedisOperationsInterfaceMockRecorder) Get(operation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRedisOperationsInterface)(nil).Get), operation)
+}
+
+// MockGetRedisInstancesInterface is a mock of GetRedisInstancesInterface interface.
+type MockGetRedisInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetRedisInstancesInterfaceMockRecorder
+}
+
+// MockGetRedisInstancesInterfaceMockRecorder is the mock recorder for MockGetRedisInstancesInterface.
+type MockGetRedisInstancesInterfaceMockRecorder struct {
+	mock *MockGetRedisInstancesInterface
+}
+
+// NewMockGetRedisInstancesInterface creates a new mock instance.
+func NewMockGetRedisInstancesInterface(ctrl *gomock.Controller) *MockGetRedisInstancesInterface {
+	mock := &MockGetRedisInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockGetRedisInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetRedisInstancesInterface) EXPECT() *MockGetRedisInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetRedisInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Instance, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Instance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetRedisInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetRedisInstancesInterface)(nil).Do), opts...)
+}
+
+// MockCreateRedisInstancesInterface is a mock of CreateRedisInstancesInterface interface.
+type MockCreateRedisInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateRedisInstancesInterfaceMockRecorder
+}
+
+// MockCreateRedisInstancesInterfaceMockRecorder is the mock recorder for MockCreateRedisInstancesInterface.
+type MockCreateRedisInstancesInterfaceMockRecorder struct {
+	mock *MockCreateRedisInstancesInterface
+}
+
+// NewMockCreateRedisInstancesInterface creates a new mock instance.
+func NewMockCreateRedisInstancesInterface(ctrl *gomock.Controller) *MockCreateRedisInstancesInterface {
+	mock := &MockCreateRedisInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateRedisInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateRedisInstancesInterface) EXPECT() *MockCreateRedisInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateRedisInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateRedisInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateRedisInstancesInterface)(nil).Do), opts...)
+}
+
+// MockPatchRedisInstancesInterface is a mock of PatchRedisInstancesInterface interface.
+type MockPatchRedisInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPatchRedisInstancesInterfaceMockRecorder
+}
+
+// MockPatchRedisInstancesInterfaceMockRecorder is the mock recorder for MockPatchRedisInstancesInterface.
+type MockPatchRedisInstancesInterfaceMockRecorder struct {
+	mock *MockPatchRedisInstancesInterface
+}
+
+// NewMockPatchRedisInstancesInterface creates a new mock instance.
+func NewMockPatchRedisInstancesInterface(ctrl *gomock.Controller) *MockPatchRedisInstancesInterface {
+	mock := &MockPatchRedisInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockPatchRedisInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPatchRedisInstancesInterface) EXPECT() *MockPatchRedisInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPatchRedisInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPatchRedisInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPatchRedisInstancesInterface)(nil).Do), opts...)
+}
+
+// MockDeleteRedisInstancesInterface is a mock of DeleteRedisInstancesInterface interface.
+type MockDeleteRedisInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteRedisInstancesInterfaceMockRecorder
+}
+
+// MockDeleteRedisInstancesInterfaceMockRecorder is the mock recorder for MockDeleteRedisInstancesInterface.
+type MockDeleteRedisInstancesInterfaceMockRecorder struct {
+	mock *MockDeleteRedisInstancesInterface
+}
+
+// NewMockDeleteRedisInstancesInterface creates a new mock instance.
+func NewMockDeleteRedisInstancesInterface(ctrl *gomock.Controller) *MockDeleteRedisInstancesInterface {
+	mock := &MockDeleteRedisInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteRedisInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteRedisInstancesInterface) EXPECT() *MockDeleteRedisInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteRedisInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteRedisInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteRedisInstancesInterface)(nil).Do), opts...)
+}
+
+// MockGetAuthStringRedisInstancesInterface is a mock of GetAuthStringRedisInstancesInterface interface.
+type MockGetAuthStringRedisInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetAuthStringRedisInstancesInterfaceMockRecorder
+}
+
+// MockGetAuthStringRedisInstancesInterfaceMockRecorder is the mock recorder for MockGetAuthStringRedisInstancesInterface.
+type MockGetAuthStringRedisInstancesInterfaceMockRecorder struct {
+	mock *MockGetAuthStringRedisInstancesInterface
+}
+
+// NewMockGetAuthStringRedisInstancesInterface creates a new mock instance.
+func NewMockGetAuthStringRedisInstancesInterface(ctrl *gomock.Controller) *MockGetAuthStringRedisInstancesInterface {
+	mock := &MockGetAuthStringRedisInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockGetAuthStringRedisInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetAuthStringRedisInstancesInterface) EXPECT() *MockGetAuthStringRedisInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetAuthStringRedisInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.InstanceAuthString, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.InstanceAuthString)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetAuthStringRedisInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetAuthStringRedisInstancesInterface)(nil).Do), opts...)
+}
+
+// MockGetRedisOperationsInterface is a mock of GetRedisOperationsInterface interface.
+type MockGetRedisOperationsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetRedisOperationsInterfaceMockRecorder
+}
+
+// MockGetRedisOperationsInterfaceMockRecorder is the mock recorder for MockGetRedisOperationsInterface.
+type MockGetRedisOperationsInterfaceMockRecorder struct {
+	mock *MockGetRedisOperationsInterface
+}
+
+// NewMockGetRedisOperationsInterface creates a new mock instance.
+func NewMockGetRedisOperationsInterface(ctrl *gomock.Controller) *MockGetRedisOperationsInterface {
+	mock := &MockGetRedisOperationsInterface{ctrl: ctrl}
+	mock.recorder = &MockGetRedisOperationsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetRedisOperationsInterface) EXPECT() *MockGetRedisOperationsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetRedisOperationsInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetRedisOperationsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetRedisOperationsInterface)(nil).Do), opts...)
+}
@@ -0,0 +1,295 @@
+package gcp
+
+import (
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/sqladmin/v1"
+)
+
+//===============================================================================================
+// TYPES AND INTERFACES
+//===============================================================================================
+
+// Services
+type (
+	SQLAdminService struct {
+		Clients SQLAdminClients
+	}
+)
+
+// Clients
+type (
+	SQLAdminClients struct {
+		Instances  SQLInstancesInterface
+		Databases  SQLDatabasesInterface
+		Users      SQLUsersInterface
+		Operations SQLOperationsInterface
+	}
+)
+
+// Resources
+type (
+	// sql admin resources
+	GCPSQLInstances struct {
+		InstancesService *sqladmin.InstancesService
+	}
+	GCPSQLDatabases struct {
+		DatabasesService *sqladmin.DatabasesService
+	}
+	GCPSQLUsers struct {
+		UsersService *sqladmin.UsersService
+	}
+	GCPSQLOperations struct {
+		OperationsService *sqladmin.OperationsService
+	}
+)
+
+// Interfaces
+type (
+	// sql admin interfaces
+	//// instances
+	SQLInstancesInterface interface {
+		Get(project, instance string) GetSQLInstancesInterface
+		Insert(project string, instance *sqladmin.DatabaseInstance) CreateSQLInstancesInterface
+		Patch(project, instance string, instanceResource *sqladmin.DatabaseInstance) PatchSQLInstancesInterface
+		Delete(project, instance string) DeleteSQLInstancesInterface
+	}
+	//// databases
+	SQLDatabasesInterface interface {
+		Get(project, instance, database string) GetSQLDatabasesInterface
+		Insert(project, instance string, database *sqladmin.Database) CreateSQLDatabasesInterface
+		Delete(project, instance, database string) DeleteSQLDatabasesInterface
+	}
+	//// users
+	SQLUsersInterface interface {
+		Get(project, instance, name, host string) GetSQLUsersInterface
+		Insert(project, instance string, user *sqladmin.User) CreateSQLUsersInterface
+		Update(project, instance string, user *sqladmin.User) UpdateSQLUsersInterface
+		Delete(project, instance, name, host string) DeleteSQLUsersInterface
+	}
+	//// operations
+	SQLOperationsInterface interface {
+		Get(project, operation string) GetSQLOperationsInterface
+	}
+)
+
+// Requests
+type (
+	// sql admin do interfaces
+	//// instances
+	GetSQLInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*sqladmin.DatabaseInstance, error)
+	}
+	CreateSQLInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error)
+	}
+	PatchSQLInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error)
+	}
+	DeleteSQLInstancesInterface interface {
+		Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error)
+	}
+	//// databases
+	GetSQLDatabasesInterface interface {
+		Do(opts ...googleapi.CallOption) (*sqladmin.Database, error)
+	}
+	CreateSQLDatabasesInterface interface {
+		Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error)
+	}
+	DeleteSQLDatabasesInterface interface {
+		Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error)
+	}
+	//// users
+	GetSQLUsersInterface interface {
+		Do(opts ...googleapi.CallOption) (*sqladmin.User, error)
+	}
+	CreateSQLUsersInterface interface {
+		Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error)
+	}
+	UpdateSQLUsersInterface interface {
+		Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error)
+	}
+	DeleteSQLUsersInterface interface {
+		Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error)
+	}
+	//// operations
+	GetSQLOperationsInterface interface {
+		Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error)
+	}
+)
+
+// Executor requests
+type (
+	// sql admin google calls
+	//// instances
+	GetSQLInstancesRequest struct {
+		googleCall *sqladmin.InstancesGetCall
+	}
+	CreateSQLInstancesRequest struct {
+		googleCall *sqladmin.InstancesInsertCall
+	}
+	PatchSQLInstancesRequest struct {
+		googleCall *sqladmin.InstancesPatchCall
+	}
+	DeleteSQLInstancesRequest struct {
+		googleCall *sqladmin.InstancesDeleteCall
+	}
+	//// databases
+	GetSQLDatabasesRequest struct {
+		googleCall *sqladmin.DatabasesGetCall
+	}
+	CreateSQLDatabasesRequest struct {
+		googleCall *sqladmin.DatabasesInsertCall
+	}
+	DeleteSQLDatabasesRequest struct {
+		googleCall *sqladmin.DatabasesDeleteCall
+	}
+	//// users
+	GetSQLUsersRequest struct {
+		googleCall *sqladmin.UsersGetCall
+	}
+	CreateSQLUsersRequest struct {
+		googleCall *sqladmin.UsersInsertCall
+	}
+	UpdateSQLUsersRequest struct {
+		googleCall *sqladmin.UsersUpdateCall
+	}
+	DeleteSQLUsersRequest struct {
+		googleCall *sqladmin.UsersDeleteCall
+	}
+	//// operations
+	GetSQLOperationsRequest struct {
+		googleCall *sqladmin.OperationsGetCall
+	}
+)
+
+// ===============================================================================================
+// FUNCTIONS
+// ===============================================================================================
+// Verbs
+// // SQL Admin
+// ///// Instances
+func (i *GCPSQLInstances) Get(projectID, instance string) GetSQLInstancesInterface {
+	return &GetSQLInstancesRequest{
+		googleCall: i.InstancesService.Get(projectID, instance),
+	}
+}
+func (i *GCPSQLInstances) Insert(projectID string, instance *sqladmin.DatabaseInstance) CreateSQLInstancesInterface {
+	return &CreateSQLInstancesRequest{
+		googleCall: i.InstancesService.Insert(projectID, instance),
+	}
+}
+func (i *GCPSQLInstances) Patch(projectID, instance string, instanceResource *sqladmin.DatabaseInstance) PatchSQLInstancesInterface {
+	return &PatchSQLInstancesRequest{
+		googleCall: i.InstancesService.Patch(projectID, instance, instanceResource),
+	}
+}
+func (i *GCPSQLInstances) Delete(projectID, instance string) DeleteSQLInstancesInterface {
+	return &DeleteSQLInstancesRequest{
+		googleCall: i.InstancesService.Delete(projectID, instance),
+	}
+}
+
+// ///// Databases
+func (d *GCPSQLDatabases) Get(projectID, instance, database string) GetSQLDatabasesInterface {
+	return &GetSQLDatabasesRequest{
+		googleCall: d.DatabasesService.Get(projectID, instance, database),
+	}
+}
+func (d *GCPSQLDatabases) Insert(projectID, instance string, database *sqladmin.Database) CreateSQLDatabasesInterface {
+	return &CreateSQLDatabasesRequest{
+		googleCall: d.DatabasesService.Insert(projectID, instance, database),
+	}
+}
+func (d *GCPSQLDatabases) Delete(projectID, instance, database string) DeleteSQLDatabasesInterface {
+	return &DeleteSQLDatabasesRequest{
+		googleCall: d.DatabasesService.Delete(projectID, instance, database),
+	}
+}
+
+// ///// Users
+// the host only applies to MySQL users, it is left out for PostgreSQL and SQL Server users
+func (u *GCPSQLUsers) Get(projectID, instance, name, host string) GetSQLUsersInterface {
+	call := u.UsersService.Get(projectID, instance, name)
+	if host != "" {
+		call = call.Host(host)
+	}
+	return &GetSQLUsersRequest{
+		googleCall: call,
+	}
+}
+func (u *GCPSQLUsers) Insert(projectID, instance string, user *sqladmin.User) CreateSQLUsersInterface {
+	return &CreateSQLUsersRequest{
+		googleCall: u.UsersService.Insert(projectID, instance, user),
+	}
+}
+func (u *GCPSQLUsers) Update(projectID, instance string, user *sqladmin.User) UpdateSQLUsersInterface {
+	call := u.UsersService.Update(projectID, instance, user).Name(user.Name)
+	if user.Host != "" {
+		call = call.Host(user.Host)
+	}
+	return &UpdateSQLUsersRequest{
+		googleCall: call,
+	}
+}
+func (u *GCPSQLUsers) Delete(projectID, instance, name, host string) DeleteSQLUsersInterface {
+	call := u.UsersService.Delete(projectID, instance).Name(name)
+	if host != "" {
+		call = call.Host(host)
+	}
+	return &DeleteSQLUsersRequest{
+		googleCall: call,
+	}
+}
+
+// ///// Operations
+func (o *GCPSQLOperations) Get(projectID, operation string) GetSQLOperationsInterface {
+	return &GetSQLOperationsRequest{
+		googleCall: o.OperationsService.Get(projectID, operation),
+	}
+}
+
+// Execs
+// // SQL Admin
+// //// Instances
+func (lc *GetSQLInstancesRequest) Do(opts ...googleapi.CallOption) (*sqladmin.DatabaseInstance, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateSQLInstancesRequest) Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *PatchSQLInstancesRequest) Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteSQLInstancesRequest) Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Databases
+func (lc *GetSQLDatabasesRequest) Do(opts ...googleapi.CallOption) (*sqladmin.Database, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateSQLDatabasesRequest) Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteSQLDatabasesRequest) Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Users
+func (lc *GetSQLUsersRequest) Do(opts ...googleapi.CallOption) (*sqladmin.User, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateSQLUsersRequest) Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *UpdateSQLUsersRequest) Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteSQLUsersRequest) Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Operations
+func (lc *GetSQLOperationsRequest) Do(opts ...googleapi.CallOption) (*sqladmin.Operation, error) {
+	return lc.googleCall.Do(opts...)
+}
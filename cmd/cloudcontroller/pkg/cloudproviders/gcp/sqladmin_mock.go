@@ -0,0 +1,777 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/cloudproviders/gcp/sqladmin_client.go
+
+// Package gcp is a generated GoMock package.
+package gcp
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	googleapi "google.golang.org/api/googleapi"
+	v1 "google.golang.org/api/sqladmin/v1"
+)
+
+// MockSQLInstancesInterface is a mock of SQLInstancesInterface interface.
+type MockSQLInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSQLInstancesInterfaceMockRecorder
+}
+
+// MockSQLInstancesInterfaceMockRecorder is the mock recorder for MockSQLInstancesInterface.
+type MockSQLInstancesInterfaceMockRecorder struct {
+	mock *MockSQLInstancesInterface
+}
+
+// NewMockSQLInstancesInterface creates a new mock instance.
+func NewMockSQLInstancesInterface(ctrl *gomock.Controller) *MockSQLInstancesInterface {
+	mock := &MockSQLInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockSQLInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSQLInstancesInterface) EXPECT() *MockSQLInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSQLInstancesInterface) Delete(project, instance string) DeleteSQLInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, instance)
+	ret0, _ := ret[0].(DeleteSQLInstancesInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSQLInstancesInterfaceMockRecorder) Delete(project, instance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSQLInstancesInterface)(nil).Delete), project, instance)
+}
+
+// Get mocks base method.
+func (m *MockSQLInstancesInterface) Get(project, instance string) GetSQLInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, instance)
+	ret0, _ := ret[0].(GetSQLInstancesInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSQLInstancesInterfaceMockRecorder) Get(project, instance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSQLInstancesInterface)(nil).Get), project, instance)
+}
+
+// Insert mocks base method.
+func (m *MockSQLInstancesInterface) Insert(project string, instance *v1.DatabaseInstance) CreateSQLInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", project, instance)
+	ret0, _ := ret[0].(CreateSQLInstancesInterface)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockSQLInstancesInterfaceMockRecorder) Insert(project, instance interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSQLInstancesInterface)(nil).Insert), project, instance)
+}
+
+// Patch mocks base method.
+func (m *MockSQLInstancesInterface) Patch(project, instance string, instanceResource *v1.DatabaseInstance) PatchSQLInstancesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", project, instance, instanceResource)
+	ret0, _ := ret[0].(PatchSQLInstancesInterface)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockSQLInstancesInterfaceMockRecorder) Patch(project, instance, instanceResource interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockSQLInstancesInterface)(nil).Patch), project, instance, instanceResource)
+}
+
+// MockSQLDatabasesInterface is a mock of SQLDatabasesInterface interface.
+type MockSQLDatabasesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSQLDatabasesInterfaceMockRecorder
+}
+
+// MockSQLDatabasesInterfaceMockRecorder is the mock recorder for MockSQLDatabasesInterface.
+type MockSQLDatabasesInterfaceMockRecorder struct {
+	mock *MockSQLDatabasesInterface
+}
+
+// NewMockSQLDatabasesInterface creates a new mock instance.
+func NewMockSQLDatabasesInterface(ctrl *gomock.Controller) *MockSQLDatabasesInterface {
+	mock := &MockSQLDatabasesInterface{ctrl: ctrl}
+	mock.recorder = &MockSQLDatabasesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSQLDatabasesInterface) EXPECT() *MockSQLDatabasesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSQLDatabasesInterface) Delete(project, instance, database string) DeleteSQLDatabasesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, instance, database)
+	ret0, _ := ret[0].(DeleteSQLDatabasesInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSQLDatabasesInterfaceMockRecorder) Delete(project, instance, database interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSQLDatabasesInterface)(nil).Delete), project, instance, database)
+}
+
+// Get mocks base method.
+func (m *MockSQLDatabasesInterface) Get(project, instance, database string) GetSQLDatabasesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, instance, database)
+	ret0, _ := ret[0].(GetSQLDatabasesInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSQLDatabasesInterfaceMockRecorder) Get(project, instance, database interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSQLDatabasesInterface)(nil).Get), project, instance, database)
+}
+
+// Insert mocks base method.
+func (m *MockSQLDatabasesInterface) Insert(project, instance string, database *v1.Database) CreateSQLDatabasesInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", project, instance, database)
+	ret0, _ := ret[0].(CreateSQLDatabasesInterface)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockSQLDatabasesInterfaceMockRecorder) Insert(project, instance, database interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSQLDatabasesInterface)(nil).Insert), project, instance, database)
+}
+
+// MockSQLUsersInterface is a mock of SQLUsersInterface interface.
+type MockSQLUsersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSQLUsersInterfaceMockRecorder
+}
+
+// MockSQLUsersInterfaceMockRecorder is the mock recorder for MockSQLUsersInterface.
+type MockSQLUsersInterfaceMockRecorder struct {
+	mock *MockSQLUsersInterface
+}
+
+// NewMockSQLUsersInterface creates a new mock instance.
+func NewMockSQLUsersInterface(ctrl *gomock.Controller) *MockSQLUsersInterface {
+	mock := &MockSQLUsersInterface{ctrl: ctrl}
+	mock.recorder = &MockSQLUsersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSQLUsersInterface) EXPECT() *MockSQLUsersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSQLUsersInterface) Delete(project, instance, name, host string) DeleteSQLUsersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", project, instance, name, host)
+	ret0, _ := ret[0].(DeleteSQLUsersInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSQLUsersInterfaceMockRecorder) Delete(project, instance, name, host interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSQLUsersInterface)(nil).Delete), project, instance, name, host)
+}
+
+// Get mocks base method.
+func (m *MockSQLUsersInterface) Get(project, instance, name, host string) GetSQLUsersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, instance, name, host)
+	ret0, _ := ret[0].(GetSQLUsersInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSQLUsersInterfaceMockRecorder) Get(project, instance, name, host interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSQLUsersInterface)(nil).Get), project, instance, name, host)
+}
+
+// Insert mocks base method.
+func (m *MockSQLUsersInterface) Insert(project, instance string, user *v1.User) CreateSQLUsersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", project, instance, user)
+	ret0, _ := ret[0].(CreateSQLUsersInterface)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockSQLUsersInterfaceMockRecorder) Insert(project, instance, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSQLUsersInterface)(nil).Insert), project, instance, user)
+}
+
+// Update mocks base method.
+func (m *MockSQLUsersInterface) Update(project, instance string, user *v1.User) UpdateSQLUsersInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", project, instance, user)
+	ret0, _ := ret[0].(UpdateSQLUsersInterface)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSQLUsersInterfaceMockRecorder) Update(project, instance, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSQLUsersInterface)(nil).Update), project, instance, user)
+}
+
+// MockSQLOperationsInterface is a mock of SQLOperationsInterface interface.
+type MockSQLOperationsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSQLOperationsInterfaceMockRecorder
+}
+
+// MockSQLOperationsInterfaceMockRecorder is the mock recorder for MockSQLOperationsInterface.
+type MockSQLOperationsInterfaceMockRecorder struct {
+	mock *MockSQLOperationsInterface
+}
+
+// NewMockSQLOperationsInterface creates a new mock instance.
+func NewMockSQLOperationsInterface(ctrl *gomock.Controller) *MockSQLOperationsInterface {
+	mock := &MockSQLOperationsInterface{ctrl: ctrl}
+	mock.recorder = &MockSQLOperationsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSQLOperationsInterface) EXPECT() *MockSQLOperationsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockSQLOperationsInterface) Get(project, operation string) GetSQLOperationsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", project, operation)
+	ret0, _ := ret[0].(GetSQLOperationsInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSQLOperationsInterfaceMockRecorder) Get(project, operation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSQLOperationsInterface)(nil).Get), project, operation)
+}
+
+// MockGetSQLInstancesInterface is a mock of GetSQLInstancesInterface interface.
+type MockGetSQLInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetSQLInstancesInterfaceMockRecorder
+}
+
+// MockGetSQLInstancesInterfaceMockRecorder is the mock recorder for MockGetSQLInstancesInterface.
+type MockGetSQLInstancesInterfaceMockRecorder struct {
+	mock *MockGetSQLInstancesInterface
+}
+
+// NewMockGetSQLInstancesInterface creates a new mock instance.
+func NewMockGetSQLInstancesInterface(ctrl *gomock.Controller) *MockGetSQLInstancesInterface {
+	mock := &MockGetSQLInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockGetSQLInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetSQLInstancesInterface) EXPECT() *MockGetSQLInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetSQLInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.DatabaseInstance, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.DatabaseInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetSQLInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetSQLInstancesInterface)(nil).Do), opts...)
+}
+
+// MockCreateSQLInstancesInterface is a mock of CreateSQLInstancesInterface interface.
+type MockCreateSQLInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateSQLInstancesInterfaceMockRecorder
+}
+
+// MockCreateSQLInstancesInterfaceMockRecorder is the mock recorder for MockCreateSQLInstancesInterface.
+type MockCreateSQLInstancesInterfaceMockRecorder struct {
+	mock *MockCreateSQLInstancesInterface
+}
+
+// NewMockCreateSQLInstancesInterface creates a new mock instance.
+func NewMockCreateSQLInstancesInterface(ctrl *gomock.Controller) *MockCreateSQLInstancesInterface {
+	mock := &MockCreateSQLInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateSQLInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateSQLInstancesInterface) EXPECT() *MockCreateSQLInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateSQLInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateSQLInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateSQLInstancesInterface)(nil).Do), opts...)
+}
+
+// MockPatchSQLInstancesInterface is a mock of PatchSQLInstancesInterface interface.
+type MockPatchSQLInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPatchSQLInstancesInterfaceMockRecorder
+}
+
+// MockPatchSQLInstancesInterfaceMockRecorder is the mock recorder for MockPatchSQLInstancesInterface.
+type MockPatchSQLInstancesInterfaceMockRecorder struct {
+	mock *MockPatchSQLInstancesInterface
+}
+
+// NewMockPatchSQLInstancesInterface creates a new mock instance.
+func NewMockPatchSQLInstancesInterface(ctrl *gomock.Controller) *MockPatchSQLInstancesInterface {
+	mock := &MockPatchSQLInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockPatchSQLInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPatchSQLInstancesInterface) EXPECT() *MockPatchSQLInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPatchSQLInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPatchSQLInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPatchSQLInstancesInterface)(nil).Do), opts...)
+}
+
+// MockDeleteSQLInstancesInterface is a mock of DeleteSQLInstancesInterface interface.
+type MockDeleteSQLInstancesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteSQLInstancesInterfaceMockRecorder
+}
+
+// MockDeleteSQLInstancesInterfaceMockRecorder is the mock recorder for MockDeleteSQLInstancesInterface.
+type MockDeleteSQLInstancesInterfaceMockRecorder struct {
+	mock *MockDeleteSQLInstancesInterface
+}
+
+// NewMockDeleteSQLInstancesInterface creates a new mock instance.
+func NewMockDeleteSQLInstancesInterface(ctrl *gomock.Controller) *MockDeleteSQLInstancesInterface {
+	mock := &MockDeleteSQLInstancesInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteSQLInstancesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteSQLInstancesInterface) EXPECT() *MockDeleteSQLInstancesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteSQLInstancesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteSQLInstancesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteSQLInstancesInterface)(nil).Do), opts...)
+}
+
+// MockGetSQLDatabasesInterface is a mock of GetSQLDatabasesInterface interface.
+type MockGetSQLDatabasesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetSQLDatabasesInterfaceMockRecorder
+}
+
+// MockGetSQLDatabasesInterfaceMockRecorder is the mock recorder for MockGetSQLDatabasesInterface.
+type MockGetSQLDatabasesInterfaceMockRecorder struct {
+	mock *MockGetSQLDatabasesInterface
+}
+
+// NewMockGetSQLDatabasesInterface creates a new mock instance.
+func NewMockGetSQLDatabasesInterface(ctrl *gomock.Controller) *MockGetSQLDatabasesInterface {
+	mock := &MockGetSQLDatabasesInterface{ctrl: ctrl}
+	mock.recorder = &MockGetSQLDatabasesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetSQLDatabasesInterface) EXPECT() *MockGetSQLDatabasesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetSQLDatabasesInterface) Do(opts ...googleapi.CallOption) (*v1.Database, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Database)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetSQLDatabasesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetSQLDatabasesInterface)(nil).Do), opts...)
+}
+
+// MockCreateSQLDatabasesInterface is a mock of CreateSQLDatabasesInterface interface.
+type MockCreateSQLDatabasesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateSQLDatabasesInterfaceMockRecorder
+}
+
+// MockCreateSQLDatabasesInterfaceMockRecorder is the mock recorder for MockCreateSQLDatabasesInterface.
+type MockCreateSQLDatabasesInterfaceMockRecorder struct {
+	mock *MockCreateSQLDatabasesInterface
+}
+
+// NewMockCreateSQLDatabasesInterface creates a new mock instance.
+func NewMockCreateSQLDatabasesInterface(ctrl *gomock.Controller) *MockCreateSQLDatabasesInterface {
+	mock := &MockCreateSQLDatabasesInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateSQLDatabasesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateSQLDatabasesInterface) EXPECT() *MockCreateSQLDatabasesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateSQLDatabasesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateSQLDatabasesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateSQLDatabasesInterface)(nil).Do), opts...)
+}
+
+// MockDeleteSQLDatabasesInterface is a mock of DeleteSQLDatabasesInterface interface.
+type MockDeleteSQLDatabasesInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteSQLDatabasesInterfaceMockRecorder
+}
+
+// MockDeleteSQLDatabasesInterfaceMockRecorder is the mock recorder for MockDeleteSQLDatabasesInterface.
+type MockDeleteSQLDatabasesInterfaceMockRecorder struct {
+	mock *MockDeleteSQLDatabasesInterface
+}
+
+// NewMockDeleteSQLDatabasesInterface creates a new mock instance.
+func NewMockDeleteSQLDatabasesInterface(ctrl *gomock.Controller) *MockDeleteSQLDatabasesInterface {
+	mock := &MockDeleteSQLDatabasesInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteSQLDatabasesInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteSQLDatabasesInterface) EXPECT() *MockDeleteSQLDatabasesInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteSQLDatabasesInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteSQLDatabasesInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteSQLDatabasesInterface)(nil).Do), opts...)
+}
+
+// MockGetSQLUsersInterface is a mock of GetSQLUsersInterface interface.
+type MockGetSQLUsersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetSQLUsersInterfaceMockRecorder
+}
+
+// MockGetSQLUsersInterfaceMockRecorder is the mock recorder for MockGetSQLUsersInterface.
+type MockGetSQLUsersInterfaceMockRecorder struct {
+	mock *MockGetSQLUsersInterface
+}
+
+// NewMockGetSQLUsersInterface creates a new mock instance.
+func NewMockGetSQLUsersInterface(ctrl *gomock.Controller) *MockGetSQLUsersInterface {
+	mock := &MockGetSQLUsersInterface{ctrl: ctrl}
+	mock.recorder = &MockGetSQLUsersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetSQLUsersInterface) EXPECT() *MockGetSQLUsersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetSQLUsersInterface) Do(opts ...googleapi.CallOption) (*v1.User, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetSQLUsersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetSQLUsersInterface)(nil).Do), opts...)
+}
+
+// MockCreateSQLUsersInterface is a mock of CreateSQLUsersInterface interface.
+type MockCreateSQLUsersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateSQLUsersInterfaceMockRecorder
+}
+
+// MockCreateSQLUsersInterfaceMockRecorder is the mock recorder for MockCreateSQLUsersInterface.
+type MockCreateSQLUsersInterfaceMockRecorder struct {
+	mock *MockCreateSQLUsersInterface
+}
+
+// NewMockCreateSQLUsersInterface creates a new mock instance.
+func NewMockCreateSQLUsersInterface(ctrl *gomock.Controller) *MockCreateSQLUsersInterface {
+	mock := &MockCreateSQLUsersInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateSQLUsersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateSQLUsersInterface) EXPECT() *MockCreateSQLUsersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateSQLUsersInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateSQLUsersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateSQLUsersInterface)(nil).Do), opts...)
+}
+
+// MockUpdateSQLUsersInterface is a mock of UpdateSQLUsersInterface interface.
+type MockUpdateSQLUsersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateSQLUsersInterfaceMockRecorder
+}
+
+// MockUpdateSQLUsersInterfaceMockRecorder is the mock recorder for MockUpdateSQLUsersInterface.
+type MockUpdateSQLUsersInterfaceMockRecorder struct {
+	mock *MockUpdateSQLUsersInterface
+}
+
+// NewMockUpdateSQLUsersInterface creates a new mock instance.
+func NewMockUpdateSQLUsersInterface(ctrl *gomock.Controller) *MockUpdateSQLUsersInterface {
+	mock := &MockUpdateSQLUsersInterface{ctrl: ctrl}
+	mock.recorder = &MockUpdateSQLUsersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateSQLUsersInterface) EXPECT() *MockUpdateSQLUsersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockUpdateSQLUsersInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockUpdateSQLUsersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockUpdateSQLUsersInterface)(nil).Do), opts...)
+}
+
+// MockDeleteSQLUsersInterface is a mock of DeleteSQLUsersInterface interface.
+type MockDeleteSQLUsersInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteSQLUsersInterfaceMockRecorder
+}
+
+// MockDeleteSQLUsersInterfaceMockRecorder is the mock recorder for MockDeleteSQLUsersInterface.
+type MockDeleteSQLUsersInterfaceMockRecorder struct {
+	mock *MockDeleteSQLUsersInterface
+}
+
+// NewMockDeleteSQLUsersInterface creates a new mock instance.
+func NewMockDeleteSQLUsersInterface(ctrl *gomock.Controller) *MockDeleteSQLUsersInterface {
+	mock := &MockDeleteSQLUsersInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteSQLUsersInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteSQLUsersInterface) EXPECT() *MockDeleteSQLUsersInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteSQLUsersInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteSQLUsersInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteSQLUsersInterface)(nil).Do), opts...)
+}
+
+// MockGetSQLOperationsInterface is a mock of GetSQLOperationsInterface interface.
+type MockGetSQLOperationsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetSQLOperationsInterfaceMockRecorder
+}
+
+// MockGetSQLOperationsInterfaceMockRecorder is the mock recorder for MockGetSQLOperationsInterface.
+type MockGetSQLOperationsInterfaceMockRecorder struct {
+	mock *MockGetSQLOperationsInterface
+}
+
+// NewMockGetSQLOperationsInterface creates a new mock instance.
+func NewMockGetSQLOperationsInterface(ctrl *gomock.Controller) *MockGetSQLOperationsInterface {
+	mock := &MockGetSQLOperationsInterface{ctrl: ctrl}
+	mock.recorder = &MockGetSQLOperationsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetSQLOperationsInterface) EXPECT() *MockGetSQLOperationsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetSQLOperationsInterface) Do(opts ...googleapi.CallOption) (*v1.Operation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetSQLOperationsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetSQLOperationsInterface)(nil).Do), opts...)
+}
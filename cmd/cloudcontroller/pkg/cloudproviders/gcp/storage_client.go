@@ -0,0 +1,198 @@
+package gcp
+
+import (
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/storage/v1"
+)
+
+//===============================================================================================
+// TYPES AND INTERFACES
+//===============================================================================================
+
+// Services
+type (
+	StorageService struct {
+		Clients StorageClients
+	}
+)
+
+// Clients
+type (
+	StorageClients struct {
+		Buckets BucketsInterface
+		Objects ObjectsInterface
+	}
+)
+
+// Resources
+type (
+	// storage resources
+	GCPBuckets struct {
+		BucketsService *storage.BucketsService
+	}
+	GCPObjects struct {
+		ObjectsService *storage.ObjectsService
+	}
+)
+
+// Interfaces
+type (
+	// storage interfaces
+	//// buckets
+	BucketsInterface interface {
+		Get(bucket string) GetBucketsInterface
+		Insert(project string, bucket *storage.Bucket) CreateBucketsInterface
+		Patch(bucket string, bucketResource *storage.Bucket) PatchBucketsInterface
+		Delete(bucket string) DeleteBucketsInterface
+		GetIamPolicy(bucket string) GetIamPolicyBucketsInterface
+		SetIamPolicy(bucket string, policy *storage.Policy) SetIamPolicyBucketsInterface
+	}
+	//// objects
+	ObjectsInterface interface {
+		List(bucket, pageToken string) ListObjectsInterface
+		Delete(bucket, object string, generation int64) DeleteObjectsInterface
+	}
+)
+
+// Requests
+type (
+	// storage do interfaces
+	//// buckets
+	GetBucketsInterface interface {
+		Do(opts ...googleapi.CallOption) (*storage.Bucket, error)
+	}
+	CreateBucketsInterface interface {
+		Do(opts ...googleapi.CallOption) (*storage.Bucket, error)
+	}
+	PatchBucketsInterface interface {
+		Do(opts ...googleapi.CallOption) (*storage.Bucket, error)
+	}
+	DeleteBucketsInterface interface {
+		Do(opts ...googleapi.CallOption) error
+	}
+	GetIamPolicyBucketsInterface interface {
+		Do(opts ...googleapi.CallOption) (*storage.Policy, error)
+	}
+	SetIamPolicyBucketsInterface interface {
+		Do(opts ...googleapi.CallOption) (*storage.Policy, error)
+	}
+	//// objects
+	ListObjectsInterface interface {
+		Do(opts ...googleapi.CallOption) (*storage.Objects, error)
+	}
+	DeleteObjectsInterface interface {
+		Do(opts ...googleapi.CallOption) error
+	}
+)
+
+// Executor requests
+type (
+	// storage google calls
+	//// buckets
+	GetBucketsRequest struct {
+		googleCall *storage.BucketsGetCall
+	}
+	CreateBucketsRequest struct {
+		googleCall *storage.BucketsInsertCall
+	}
+	PatchBucketsRequest struct {
+		googleCall *storage.BucketsPatchCall
+	}
+	DeleteBucketsRequest struct {
+		googleCall *storage.BucketsDeleteCall
+	}
+	GetIamPolicyBucketsRequest struct {
+		googleCall *storage.BucketsGetIamPolicyCall
+	}
+	SetIamPolicyBucketsRequest struct {
+		googleCall *storage.BucketsSetIamPolicyCall
+	}
+	//// objects
+	ListObjectsRequest struct {
+		googleCall *storage.ObjectsListCall
+	}
+	DeleteObjectsRequest struct {
+		googleCall *storage.ObjectsDeleteCall
+	}
+)
+
+// ===============================================================================================
+// FUNCTIONS
+// ===============================================================================================
+// Verbs
+// // Storage
+// ///// Buckets
+func (b *GCPBuckets) Get(bucket string) GetBucketsInterface {
+	return &GetBucketsRequest{
+		googleCall: b.BucketsService.Get(bucket),
+	}
+}
+func (b *GCPBuckets) Insert(projectID string, bucket *storage.Bucket) CreateBucketsInterface {
+	return &CreateBucketsRequest{
+		googleCall: b.BucketsService.Insert(projectID, bucket),
+	}
+}
+func (b *GCPBuckets) Patch(bucket string, bucketResource *storage.Bucket) PatchBucketsInterface {
+	return &PatchBucketsRequest{
+		googleCall: b.BucketsService.Patch(bucket, bucketResource),
+	}
+}
+func (b *GCPBuckets) Delete(bucket string) DeleteBucketsInterface {
+	return &DeleteBucketsRequest{
+		googleCall: b.BucketsService.Delete(bucket),
+	}
+}
+
+func (b *GCPBuckets) GetIamPolicy(bucket string) GetIamPolicyBucketsInterface {
+	return &GetIamPolicyBucketsRequest{
+		googleCall: b.BucketsService.GetIamPolicy(bucket).OptionsRequestedPolicyVersion(iamPolicyVersion),
+	}
+}
+func (b *GCPBuckets) SetIamPolicy(bucket string, policy *storage.Policy) SetIamPolicyBucketsInterface {
+	return &SetIamPolicyBucketsRequest{
+		googleCall: b.BucketsService.SetIamPolicy(bucket, policy),
+	}
+}
+
+// ///// Objects
+// List lists every version of the objects of the bucket, one page at a time
+func (o *GCPObjects) List(bucket, pageToken string) ListObjectsInterface {
+	return &ListObjectsRequest{
+		googleCall: o.ObjectsService.List(bucket).Versions(true).PageToken(pageToken),
+	}
+}
+func (o *GCPObjects) Delete(bucket, object string, generation int64) DeleteObjectsInterface {
+	return &DeleteObjectsRequest{
+		googleCall: o.ObjectsService.Delete(bucket, object).Generation(generation),
+	}
+}
+
+// Execs
+// // Storage
+// //// Buckets
+func (lc *GetBucketsRequest) Do(opts ...googleapi.CallOption) (*storage.Bucket, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *CreateBucketsRequest) Do(opts ...googleapi.CallOption) (*storage.Bucket, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *PatchBucketsRequest) Do(opts ...googleapi.CallOption) (*storage.Bucket, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteBucketsRequest) Do(opts ...googleapi.CallOption) error {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *GetIamPolicyBucketsRequest) Do(opts ...googleapi.CallOption) (*storage.Policy, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *SetIamPolicyBucketsRequest) Do(opts ...googleapi.CallOption) (*storage.Policy, error) {
+	return lc.googleCall.Do(opts...)
+}
+
+// //// Objects
+func (lc *ListObjectsRequest) Do(opts ...googleapi.CallOption) (*storage.Objects, error) {
+	return lc.googleCall.Do(opts...)
+}
+func (lc *DeleteObjectsRequest) Do(opts ...googleapi.CallOption) error {
+	return lc.googleCall.Do(opts...)
+}
@@ -0,0 +1,505 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/cloudproviders/gcp/storage_client.go
+
+// Package gcp is a generated GoMock package.
+package gcp
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	googleapi "google.golang.org/api/googleapi"
+	v1 "google.golang.org/api/storage/v1"
+)
+
+// MockBucketsInterface is a mock of BucketsInterface interface.
+type MockBucketsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockBucketsInterfaceMockRecorder
+}
+
+// MockBucketsInterfaceMockRecorder is the mock recorder for MockBucketsInterface.
+type MockBucketsInterfaceMockRecorder struct {
+	mock *MockBucketsInterface
+}
+
+// NewMockBucketsInterface creates a new mock instance.
+func NewMockBucketsInterface(ctrl *gomock.Controller) *MockBucketsInterface {
+	mock := &MockBucketsInterface{ctrl: ctrl}
+	mock.recorder = &MockBucketsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBucketsInterface) EXPECT() *MockBucketsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockBucketsInterface) Delete(bucket string) DeleteBucketsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", bucket)
+	ret0, _ := ret[0].(DeleteBucketsInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBucketsInterfaceMockRecorder) Delete(bucket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBucketsInterface)(nil).Delete), bucket)
+}
+
+// Get mocks base method.
+func (m *MockBucketsInterface) Get(bucket string) GetBucketsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", bucket)
+	ret0, _ := ret[0].(GetBucketsInterface)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBucketsInterfaceMockRecorder) Get(bucket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBucketsInterface)(nil).Get), bucket)
+}
+
+// GetIamPolicy mocks base method.
+func (m *MockBucketsInterface) GetIamPolicy(bucket string) GetIamPolicyBucketsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIamPolicy", bucket)
+	ret0, _ := ret[0].(GetIamPolicyBucketsInterface)
+	return ret0
+}
+
+// GetIamPolicy indicates an expected call of GetIamPolicy.
+func (mr *MockBucketsInterfaceMockRecorder) GetIamPolicy(bucket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIamPolicy", reflect.TypeOf((*MockBucketsInterface)(nil).GetIamPolicy), bucket)
+}
+
+// Insert mocks base method.
+func (m *MockBucketsInterface) Insert(project string, bucket *v1.Bucket) CreateBucketsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", project, bucket)
+	ret0, _ := ret[0].(CreateBucketsInterface)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockBucketsInterfaceMockRecorder) Insert(project, bucket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockBucketsInterface)(nil).Insert), project, bucket)
+}
+
+// Patch mocks base method.
+func (m *MockBucketsInterface) Patch(bucket string, bucketResource *v1.Bucket) PatchBucketsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", bucket, bucketResource)
+	ret0, _ := ret[0].(PatchBucketsInterface)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockBucketsInterfaceMockRecorder) Patch(bucket, bucketResource interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockBucketsInterface)(nil).Patch), bucket, bucketResource)
+}
+
+// SetIamPolicy mocks base method.
+func (m *MockBucketsInterface) SetIamPolicy(bucket string, policy *v1.Policy) SetIamPolicyBucketsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIamPolicy", bucket, policy)
+	ret0, _ := ret[0].(SetIamPolicyBucketsInterface)
+	return ret0
+}
+
+// SetIamPolicy indicates an expected call of SetIamPolicy.
+func (mr *MockBucketsInterfaceMockRecorder) SetIamPolicy(bucket, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIamPolicy", reflect.TypeOf((*MockBucketsInterface)(nil).SetIamPolicy), bucket, policy)
+}
+
+// MockObjectsInterface is a mock of ObjectsInterface interface.
+type MockObjectsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockObjectsInterfaceMockRecorder
+}
+
+// MockObjectsInterfaceMockRecorder is the mock recorder for MockObjectsInterface.
+type MockObjectsInterfaceMockRecorder struct {
+	mock *MockObjectsInterface
+}
+
+// NewMockObjectsInterface creates a new mock instance.
+func NewMockObjectsInterface(ctrl *gomock.Controller) *MockObjectsInterface {
+	mock := &MockObjectsInterface{ctrl: ctrl}
+	mock.recorder = &MockObjectsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockObjectsInterface) EXPECT() *MockObjectsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockObjectsInterface) Delete(bucket, object string, generation int64) DeleteObjectsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", bucket, object, generation)
+	ret0, _ := ret[0].(DeleteObjectsInterface)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockObjectsInterfaceMockRecorder) Delete(bucket, object, generation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockObjectsInterface)(nil).Delete), bucket, object, generation)
+}
+
+// List mocks base method.
+func (m *MockObjectsInterface) List(bucket, pageToken string) ListObjectsInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", bucket, pageToken)
+	ret0, _ := ret[0].(ListObjectsInterface)
+	return ret0
+}
+
+// List indicates an expected call of List.
+func (mr *MockObjectsInterfaceMockRecorder) List(bucket, pageToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockObjectsInterface)(nil).List), bucket, pageToken)
+}
+
+// MockGetBucketsInterface is a mock of GetBucketsInterface interface.
+type MockGetBucketsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetBucketsInterfaceMockRecorder
+}
+
+// MockGetBucketsInterfaceMockRecorder is the mock recorder for MockGetBucketsInterface.
+type MockGetBucketsInterfaceMockRecorder struct {
+	mock *MockGetBucketsInterface
+}
+
+// NewMockGetBucketsInterface creates a new mock instance.
+func NewMockGetBucketsInterface(ctrl *gomock.Controller) *MockGetBucketsInterface {
+	mock := &MockGetBucketsInterface{ctrl: ctrl}
+	mock.recorder = &MockGetBucketsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetBucketsInterface) EXPECT() *MockGetBucketsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetBucketsInterface) Do(opts ...googleapi.CallOption) (*v1.Bucket, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Bucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetBucketsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetBucketsInterface)(nil).Do), opts...)
+}
+
+// MockCreateBucketsInterface is a mock of CreateBucketsInterface interface.
+type MockCreateBucketsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateBucketsInterfaceMockRecorder
+}
+
+// MockCreateBucketsInterfaceMockRecorder is the mock recorder for MockCreateBucketsInterface.
+type MockCreateBucketsInterfaceMockRecorder struct {
+	mock *MockCreateBucketsInterface
+}
+
+// NewMockCreateBucketsInterface creates a new mock instance.
+func NewMockCreateBucketsInterface(ctrl *gomock.Controller) *MockCreateBucketsInterface {
+	mock := &MockCreateBucketsInterface{ctrl: ctrl}
+	mock.recorder = &MockCreateBucketsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateBucketsInterface) EXPECT() *MockCreateBucketsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockCreateBucketsInterface) Do(opts ...googleapi.CallOption) (*v1.Bucket, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Bucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockCreateBucketsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockCreateBucketsInterface)(nil).Do), opts...)
+}
+
+// MockPatchBucketsInterface is a mock of PatchBucketsInterface interface.
+type MockPatchBucketsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPatchBucketsInterfaceMockRecorder
+}
+
+// MockPatchBucketsInterfaceMockRecorder is the mock recorder for MockPatchBucketsInterface.
+type MockPatchBucketsInterfaceMockRecorder struct {
+	mock *MockPatchBucketsInterface
+}
+
+// NewMockPatchBucketsInterface creates a new mock instance.
+func NewMockPatchBucketsInterface(ctrl *gomock.Controller) *MockPatchBucketsInterface {
+	mock := &MockPatchBucketsInterface{ctrl: ctrl}
+	mock.recorder = &MockPatchBucketsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPatchBucketsInterface) EXPECT() *MockPatchBucketsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockPatchBucketsInterface) Do(opts ...googleapi.CallOption) (*v1.Bucket, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Bucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockPatchBucketsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockPatchBucketsInterface)(nil).Do), opts...)
+}
+
+// MockDeleteBucketsInterface is a mock of DeleteBucketsInterface interface.
+type MockDeleteBucketsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteBucketsInterfaceMockRecorder
+}
+
+// MockDeleteBucketsInterfaceMockRecorder is the mock recorder for MockDeleteBucketsInterface.
+type MockDeleteBucketsInterfaceMockRecorder struct {
+	mock *MockDeleteBucketsInterface
+}
+
+// NewMockDeleteBucketsInterface creates a new mock instance.
+func NewMockDeleteBucketsInterface(ctrl *gomock.Controller) *MockDeleteBucketsInterface {
+	mock := &MockDeleteBucketsInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteBucketsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteBucketsInterface) EXPECT() *MockDeleteBucketsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteBucketsInterface) Do(opts ...googleapi.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteBucketsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteBucketsInterface)(nil).Do), opts...)
+}
+
+// MockGetIamPolicyBucketsInterface is a mock of GetIamPolicyBucketsInterface interface.
+type MockGetIamPolicyBucketsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetIamPolicyBucketsInterfaceMockRecorder
+}
+
+// MockGetIamPolicyBucketsInterfaceMockRecorder is the mock recorder for MockGetIamPolicyBucketsInterface.
+type MockGetIamPolicyBucketsInterfaceMockRecorder struct {
+	mock *MockGetIamPolicyBucketsInterface
+}
+
+// NewMockGetIamPolicyBucketsInterface creates a new mock instance.
+func NewMockGetIamPolicyBucketsInterface(ctrl *gomock.Controller) *MockGetIamPolicyBucketsInterface {
+	mock := &MockGetIamPolicyBucketsInterface{ctrl: ctrl}
+	mock.recorder = &MockGetIamPolicyBucketsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetIamPolicyBucketsInterface) EXPECT() *MockGetIamPolicyBucketsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGetIamPolicyBucketsInterface) Do(opts ...googleapi.CallOption) (*v1.Policy, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGetIamPolicyBucketsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGetIamPolicyBucketsInterface)(nil).Do), opts...)
+}
+
+// MockSetIamPolicyBucketsInterface is a mock of SetIamPolicyBucketsInterface interface.
+type MockSetIamPolicyBucketsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetIamPolicyBucketsInterfaceMockRecorder
+}
+
+// MockSetIamPolicyBucketsInterfaceMockRecorder is the mock recorder for MockSetIamPolicyBucketsInterface.
+type MockSetIamPolicyBucketsInterfaceMockRecorder struct {
+	mock *MockSetIamPolicyBucketsInterface
+}
+
+// NewMockSetIamPolicyBucketsInterface creates a new mock instance.
+func NewMockSetIamPolicyBucketsInterface(ctrl *gomock.Controller) *MockSetIamPolicyBucketsInterface {
+	mock := &MockSetIamPolicyBucketsInterface{ctrl: ctrl}
+	mock.recorder = &MockSetIamPolicyBucketsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetIamPolicyBucketsInterface) EXPECT() *MockSetIamPolicyBucketsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockSetIamPolicyBucketsInterface) Do(opts ...googleapi.CallOption) (*v1.Policy, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockSetIamPolicyBucketsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockSetIamPolicyBucketsInterface)(nil).Do), opts...)
+}
+
+// MockListObjectsInterface is a mock of ListObjectsInterface interface.
+type MockListObjectsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockListObjectsInterfaceMockRecorder
+}
+
+// MockListObjectsInterfaceMockRecorder is the mock recorder for MockListObjectsInterface.
+type MockListObjectsInterfaceMockRecorder struct {
+	mock *MockListObjectsInterface
+}
+
+// NewMockListObjectsInterface creates a new mock instance.
+func NewMockListObjectsInterface(ctrl *gomock.Controller) *MockListObjectsInterface {
+	mock := &MockListObjectsInterface{ctrl: ctrl}
+	mock.recorder = &MockListObjectsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListObjectsInterface) EXPECT() *MockListObjectsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockListObjectsInterface) Do(opts ...googleapi.CallOption) (*v1.Objects, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(*v1.Objects)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockListObjectsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockListObjectsInterface)(nil).Do), opts...)
+}
+
+// MockDeleteObjectsInterface is a mock of DeleteObjectsInterface interface.
+type MockDeleteObjectsInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteObjectsInterfaceMockRecorder
+}
+
+// MockDeleteObjectsInterfaceMockRecorder is the mock recorder for MockDeleteObjectsInterface.
+type MockDeleteObjectsInterfaceMockRecorder struct {
+	mock *MockDeleteObjectsInterface
+}
+
+// NewMockDeleteObjectsInterface creates a new mock instance.
+func NewMockDeleteObjectsInterface(ctrl *gomock.Controller) *MockDeleteObjectsInterface {
+	mock := &MockDeleteObjectsInterface{ctrl: ctrl}
+	mock.recorder = &MockDeleteObjectsInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteObjectsInterface) EXPECT() *MockDeleteObjectsInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockDeleteObjectsInterface) Do(opts ...googleapi.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Do", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockDeleteObjectsInterfaceMockRecorder) Do(opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockDeleteObjectsInterface)(nil).Do), opts...)
+}
@@ -28,3 +28,13 @@ func getConfig(filepath string) (Config, error) {
 	}
 	return config, nil
 }
+
+// convertIamPolicy copies the IAM policy of a GCP service into the IAM policy type of another service. The services
+// share the JSON representation of IAM policies, fields unknown to the destination are dropped.
+func convertIamPolicy(src, dst any) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
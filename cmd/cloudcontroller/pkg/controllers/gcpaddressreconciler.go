@@ -0,0 +1,280 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"slices"
+	"strings"
+	"time"
+)
+
+type GCPAddressReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPAddressReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpaddress", req.NamespacedName)
+
+	ga := benzaiten.GCPAddress{}
+	err := cr.Get(ctx, req.NamespacedName, &ga)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpaddress not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !ga.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &ga)
+	}
+
+	if controllerutil.AddFinalizer(&ga, gcpFinalizer) {
+		err = cr.Update(ctx, &ga)
+		if err != nil {
+			logger.Error(err, "error adding gcpaddress finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// does address exist in GCP?
+	address, err := cr.getAddress(&ga)
+	if err != nil && notFoundGCPResource(err) {
+		// address does not exist in GCP
+		logger.Info("gcpaddress not found, reserving address...")
+		op, err := cr.createAddress(&ga)
+		if err != nil {
+			logger.Error(err, "error reserving gcpaddress")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.waitOperation(&ga, op)
+		})
+		if err != nil {
+			logger.Error(err, "error reserving gcpaddress")
+			cr.eventRecorder.Event(&ga, "Warning", "AddressFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		address, err = cr.getAddress(&ga)
+		if err != nil {
+			logger.Error(err, "error verifying address status")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&ga, "Normal", "AddressReserved", fmt.Sprintf("GCP Address %s reserved", address.Address))
+	} else if err != nil {
+		logger.Error(err, "error getting gcpaddress")
+		return ctrl.Result{}, err
+	}
+
+	// update status
+	previous := ga.DeepCopyObject().(*benzaiten.GCPAddress)
+	ga.Status.Phase = benzaiten.AddressStatus(address.Status)
+	ga.Status.Address = address.Address
+	ga.Status.SelfLink = address.SelfLink
+	ga.Status.Users = address.Users
+	if !equality.Semantic.DeepEqual(previous.Status, ga.Status) {
+		err = cr.Status().Update(ctx, &ga)
+		if err != nil {
+			logger.Error(err, "error updating gcpaddress status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp address reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+func (cr *GCPAddressReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, ga *benzaiten.GCPAddress) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(ga, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	address, err := cr.getAddress(ga)
+	if err != nil && !notFoundGCPResource(err) {
+		logger.Error(err, "error getting gcpaddress")
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		// releasing an address in use would take the IP away from its users, wait for them to release it. Objects
+		// referencing the address keep it too, e.g. while an instance is recreated or before a NAT is attached.
+		referrers, err := cr.addressReferrers(ctx, ga)
+		if err != nil {
+			logger.Error(err, "error listing gcpaddress referrers")
+			return ctrl.Result{}, err
+		}
+		if len(address.Users) > 0 || len(referrers) > 0 {
+			condition := metav1.Condition{
+				Type:               benzaiten.AddressConditionDeletionBlocked,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: ga.Generation,
+				Reason:             "AddressInUse",
+				Message:            fmt.Sprintf("address is used by %s", strings.Join(append(lastURLSegments(address.Users), referrers...), ", ")),
+			}
+			if len(address.Users) == 0 {
+				condition.Reason = "AddressReferenced"
+			}
+			if !meta.IsStatusConditionPresentAndEqual(ga.Status.Conditions, condition.Type, condition.Status) {
+				cr.eventRecorder.Event(ga, "Warning", condition.Reason, fmt.Sprintf("GCP Address is in use, deletion blocked: %s", condition.Message))
+			}
+			previous := ga.DeepCopyObject().(*benzaiten.GCPAddress)
+			meta.SetStatusCondition(&ga.Status.Conditions, condition)
+			ga.Status.Users = address.Users
+			if !equality.Semantic.DeepEqual(previous.Status, ga.Status) {
+				err = cr.Status().Update(ctx, ga)
+				if err != nil {
+					logger.Error(err, "error updating gcpaddress status")
+					return ctrl.Result{}, err
+				}
+			}
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		}
+
+		logger.Info("releasing gcpaddress...")
+		op, err := cr.deleteAddress(ga)
+		if err != nil {
+			logger.Error(err, "error releasing gcpaddress")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.waitOperation(ga, op)
+		})
+		if err != nil {
+			logger.Error(err, "error releasing gcpaddress")
+			return ctrl.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(ga, gcpFinalizer)
+	err = cr.Update(ctx, ga)
+	if err != nil {
+		logger.Error(err, "error removing gcpaddress finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp address deleted")
+	return ctrl.Result{}, nil
+}
+
+// addressReferrers returns the GCPInstances and GCPRouters referencing the address, e.g. GCPInstance/my-vm
+func (cr *GCPAddressReconciler) addressReferrers(ctx context.Context, ga *benzaiten.GCPAddress) ([]string, error) {
+	var referrers []string
+
+	gis := benzaiten.GCPInstanceList{}
+	err := cr.List(ctx, &gis, client.InNamespace(ga.Namespace))
+	if err != nil {
+		return nil, fmt.Errorf("unable to list gcpinstances: %w", err)
+	}
+	for _, gi := range gis.Items {
+		if gi.Spec.AddressRef != nil && gi.Spec.AddressRef.Name == ga.Name {
+			referrers = append(referrers, "GCPInstance/"+gi.Name)
+		}
+	}
+
+	grs := benzaiten.GCPRouterList{}
+	err = cr.List(ctx, &grs, client.InNamespace(ga.Namespace))
+	if err != nil {
+		return nil, fmt.Errorf("unable to list gcprouters: %w", err)
+	}
+	for _, gr := range grs.Items {
+		if routerReferencesAddress(&gr, ga.Name) {
+			referrers = append(referrers, "GCPRouter/"+gr.Name)
+		}
+	}
+
+	return referrers, nil
+}
+
+// routerReferencesAddress reports whether a NAT of the router uses the GCPAddress
+func routerReferencesAddress(gr *benzaiten.GCPRouter, name string) bool {
+	for _, nat := range gr.Spec.NATs {
+		if slices.Contains(nat.AddressRefs, benzaiten.ResourceRef{Name: name}) {
+			return true
+		}
+	}
+	return false
+}
+
+// getAddress gets the regional or global address
+func (cr *GCPAddressReconciler) getAddress(ga *benzaiten.GCPAddress) (*compute.Address, error) {
+	if ga.Spec.Region == "" {
+		return cr.cloud.GCP.GetGlobalAddress(ga.Spec.Name)
+	}
+	return cr.cloud.GCP.GetAddress(ga.Spec.Region, ga.Spec.Name)
+}
+
+// createAddress reserves the regional or global address
+func (cr *GCPAddressReconciler) createAddress(ga *benzaiten.GCPAddress) (*compute.Operation, error) {
+	address := &compute.Address{
+		Name:         ga.Spec.Name,
+		AddressType:  ga.Spec.AddressType,
+		Address:      ga.Spec.Address,
+		NetworkTier:  ga.Spec.NetworkTier,
+		Subnetwork:   ga.Spec.Subnetwork,
+		Network:      ga.Spec.Network,
+		Purpose:      ga.Spec.Purpose,
+		PrefixLength: ga.Spec.PrefixLength,
+		Description:  ga.Spec.Description,
+	}
+	if ga.Spec.Region == "" {
+		return cr.cloud.GCP.CreateGlobalAddress(address)
+	}
+	return cr.cloud.GCP.CreateAddress(ga.Spec.Region, address)
+}
+
+// deleteAddress releases the regional or global address
+func (cr *GCPAddressReconciler) deleteAddress(ga *benzaiten.GCPAddress) (*compute.Operation, error) {
+	if ga.Spec.Region == "" {
+		return cr.cloud.GCP.DeleteGlobalAddress(ga.Spec.Name)
+	}
+	return cr.cloud.GCP.DeleteAddress(ga.Spec.Region, ga.Spec.Name)
+}
+
+// waitOperation waits for the regional or global operation on the address
+func (cr *GCPAddressReconciler) waitOperation(ga *benzaiten.GCPAddress, op *compute.Operation) (*compute.Operation, error) {
+	if ga.Spec.Region == "" {
+		return cr.cloud.GCP.WaitGlobalOperation(op.Name)
+	}
+	return cr.cloud.GCP.WaitRegionOperation(ga.Spec.Region, op.Name)
+}
+
+func (cr *GCPAddressReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPAddress{}).
+		Complete(cr)
+}
+
+func setupGCPAddressController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpaddress")
+	cc := GCPAddressReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPAddressReconciler"),
+	}
+
+	// create GCPAddress controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPAddress controller: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package controllers
+
+import (
+	"context"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"slices"
+	"testing"
+)
+
+func TestAddressReferrers(t *testing.T) {
+	ga := &benzaiten.GCPAddress{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-address", Namespace: "default"},
+	}
+	// the instance keeps referencing the address while it is recreated
+	gi := &benzaiten.GCPInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Spec:       benzaiten.GCPInstanceSpec{AddressRef: &benzaiten.ResourceRef{Name: "test-address"}},
+	}
+	gr := &benzaiten.GCPRouter{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-router", Namespace: "default"},
+		Spec: benzaiten.GCPRouterSpec{
+			NATs: []benzaiten.RouterNAT{
+				{Name: "test-nat", IPAllocation: "MANUAL_ONLY", AddressRefs: []benzaiten.ResourceRef{{Name: "other-address"}, {Name: "test-address"}}},
+			},
+		},
+	}
+	other := &benzaiten.GCPInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-instance", Namespace: "default"},
+		Spec:       benzaiten.GCPInstanceSpec{AddressRef: &benzaiten.ResourceRef{Name: "other-address"}},
+	}
+	cr := &GCPAddressReconciler{
+		Client: fake.NewClientBuilder().WithScheme(Scheme).WithObjects(ga, gi, gr, other).Build(),
+	}
+
+	referrers, err := cr.addressReferrers(context.Background(), ga)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(referrers, []string{"GCPInstance/test-instance", "GCPRouter/test-router"}) {
+		t.Fatalf("unexpected referrers %v", referrers)
+	}
+
+	ga.Name = "unused-address"
+	referrers, err = cr.addressReferrers(context.Background(), ga)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(referrers) != 0 {
+		t.Fatalf("expected no referrers, got %v", referrers)
+	}
+}
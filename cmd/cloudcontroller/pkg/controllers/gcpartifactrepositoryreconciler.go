@@ -0,0 +1,354 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/artifactregistry/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"maps"
+	"reflect"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	repositoryFormatDocker     = "DOCKER"
+	roleArtifactRegistryReader = "roles/artifactregistry.reader"
+	roleArtifactRegistryWriter = "roles/artifactregistry.writer"
+)
+
+type GCPArtifactRepositoryReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPArtifactRepositoryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpartifactrepository", req.NamespacedName)
+
+	gar := benzaiten.GCPArtifactRepository{}
+	err := cr.Get(ctx, req.NamespacedName, &gar)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpartifactrepository not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gar.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gar)
+	}
+
+	if controllerutil.AddFinalizer(&gar, gcpFinalizer) {
+		err = cr.Update(ctx, &gar)
+		if err != nil {
+			logger.Error(err, "error adding gcpartifactrepository finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	desired := newRepository(&gar)
+
+	// does repository exist in GCP?
+	repository, err := cr.cloud.GCP.GetRepository(gar.Spec.Location, gar.Spec.Name)
+	if err != nil && notFoundGCPResource(err) {
+		// repository does not exist in GCP
+		logger.Info("gcpartifactrepository not found, creating repository...")
+		op, err := cr.cloud.GCP.CreateRepository(gar.Spec.Location, gar.Spec.Name, desired)
+		if err == nil {
+			err = waitArtifactRegistryOperation(ctx, func() (*artifactregistry.Operation, error) {
+				return cr.cloud.GCP.GetArtifactRegistryOperation(op.Name)
+			})
+		}
+		if err != nil {
+			logger.Error(err, "error creating gcpartifactrepository")
+			cr.eventRecorder.Event(&gar, "Warning", "RepositoryFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		repository, err = cr.cloud.GCP.GetRepository(gar.Spec.Location, gar.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error getting gcpartifactrepository")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gar, "Normal", "RepositoryCreated", "GCP Artifact Repository created")
+	} else if err != nil {
+		logger.Error(err, "error getting gcpartifactrepository")
+		return ctrl.Result{}, err
+	} else if updateMask := repositoryUpdateMask(repository, desired); len(updateMask) > 0 {
+		logger.Info("gcpartifactrepository out of sync, updating repository...", "fields", updateMask)
+		repository, err = cr.cloud.GCP.PatchRepository(gar.Spec.Location, gar.Spec.Name, desired, updateMask)
+		if err != nil {
+			logger.Error(err, "error updating gcpartifactrepository")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gar, "Normal", "RepositoryUpdated", fmt.Sprintf("GCP Artifact Repository updated: %s", strings.Join(updateMask, ", ")))
+	}
+
+	// the members granted the reader and writer roles by the operator follow the spec
+	bindings := repositoryIAMBindings(gar.Spec.Readers, gar.Spec.Writers)
+	policy, err := cr.cloud.GCP.GetRepositoryIamPolicy(gar.Spec.Location, gar.Spec.Name)
+	if err != nil {
+		logger.Error(err, "error getting gcpartifactrepository iam policy")
+		return ctrl.Result{}, err
+	}
+	if applyRepositoryIAMBindings(policy, bindings, repositoryIAMBindings(gar.Status.Readers, gar.Status.Writers)) {
+		logger.Info("gcpartifactrepository iam policy out of sync, updating policy...")
+		_, err = cr.cloud.GCP.SetRepositoryIamPolicy(gar.Spec.Location, gar.Spec.Name, policy)
+		if err != nil {
+			logger.Error(err, "error updating gcpartifactrepository iam policy")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gar, "Normal", "RepositoryIAMUpdated", "GCP Artifact Repository IAM policy updated")
+	}
+
+	// update status
+	previous := gar.DeepCopyObject().(*benzaiten.GCPArtifactRepository)
+	gar.Status.Path = repository.Name
+	gar.Status.URL = repositoryURL(repository, cr.cloud.GCP.ProjectId, gar.Spec.Location, gar.Spec.Name)
+	gar.Status.Readers = slices.Clone(gar.Spec.Readers)
+	gar.Status.Writers = slices.Clone(gar.Spec.Writers)
+	if !equality.Semantic.DeepEqual(previous.Status, gar.Status) {
+		err = cr.Status().Update(ctx, &gar)
+		if err != nil {
+			logger.Error(err, "error updating gcpartifactrepository status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp artifact repository reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+func (cr *GCPArtifactRepositoryReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gar *benzaiten.GCPArtifactRepository) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gar, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("deleting gcpartifactrepository...")
+	op, err := cr.cloud.GCP.DeleteRepository(gar.Spec.Location, gar.Spec.Name)
+	if err == nil {
+		err = waitArtifactRegistryOperation(ctx, func() (*artifactregistry.Operation, error) {
+			return cr.cloud.GCP.GetArtifactRegistryOperation(op.Name)
+		})
+	}
+	if err != nil && !notFoundGCPResource(err) {
+		logger.Error(err, "error deleting gcpartifactrepository")
+		return ctrl.Result{}, err
+	}
+
+	controllerutil.RemoveFinalizer(gar, gcpFinalizer)
+	err = cr.Update(ctx, gar)
+	if err != nil {
+		logger.Error(err, "error removing gcpartifactrepository finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp artifact repository deleted")
+	return ctrl.Result{}, nil
+}
+
+func (cr *GCPArtifactRepositoryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPArtifactRepository{}).
+		Complete(cr)
+}
+
+func setupGCPArtifactRepositoryController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpartifactrepository")
+	cc := GCPArtifactRepositoryReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPArtifactRepositoryReconciler"),
+	}
+
+	// create GCPArtifactRepository controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPArtifactRepository controller: %w", err)
+	}
+
+	return nil
+}
+
+// newRepository returns the GCP repository described by the spec
+func newRepository(gar *benzaiten.GCPArtifactRepository) *artifactregistry.Repository {
+	repository := &artifactregistry.Repository{
+		Format:              gar.Spec.Format,
+		Description:         gar.Spec.Description,
+		Labels:              gar.Spec.Labels,
+		KmsKeyName:          gar.Spec.KMSKeyName,
+		CleanupPolicyDryRun: gar.Spec.CleanupPolicyDryRun,
+	}
+	if gar.Spec.Format == repositoryFormatDocker {
+		repository.DockerConfig = &artifactregistry.DockerRepositoryConfig{ImmutableTags: gar.Spec.ImmutableTags}
+	}
+	if len(gar.Spec.CleanupPolicies) > 0 {
+		repository.CleanupPolicies = make(map[string]artifactregistry.CleanupPolicy, len(gar.Spec.CleanupPolicies))
+	}
+	for _, policy := range gar.Spec.CleanupPolicies {
+		cleanupPolicy := artifactregistry.CleanupPolicy{
+			Id:     policy.ID,
+			Action: policy.Action,
+		}
+		if policy.Condition != nil {
+			cleanupPolicy.Condition = &artifactregistry.CleanupPolicyCondition{
+				TagState:            policy.Condition.TagState,
+				TagPrefixes:         policy.Condition.TagPrefixes,
+				VersionNamePrefixes: policy.Condition.VersionNamePrefixes,
+				PackageNamePrefixes: policy.Condition.PackageNamePrefixes,
+				OlderThan:           cleanupPolicyDuration(policy.Condition.OlderThan),
+				NewerThan:           cleanupPolicyDuration(policy.Condition.NewerThan),
+			}
+		}
+		if policy.MostRecentVersions != nil {
+			cleanupPolicy.MostRecentVersions = &artifactregistry.CleanupPolicyMostRecentVersions{
+				KeepCount:           policy.MostRecentVersions.KeepCount,
+				PackageNamePrefixes: policy.MostRecentVersions.PackageNamePrefixes,
+			}
+		}
+		repository.CleanupPolicies[policy.ID] = cleanupPolicy
+	}
+	return repository
+}
+
+// cleanupPolicyDuration formats the duration in seconds, e.g. 2592000s for 720h
+func cleanupPolicyDuration(d *metav1.Duration) string {
+	if d == nil {
+		return ""
+	}
+	return fmt.Sprintf("%ds", int64(d.Seconds()))
+}
+
+// repositoryUpdateMask returns the fields of the repository differing from the desired repository
+func repositoryUpdateMask(current, desired *artifactregistry.Repository) []string {
+	var updateMask []string
+	if current.Description != desired.Description {
+		updateMask = append(updateMask, "description")
+	}
+	if !maps.Equal(current.Labels, desired.Labels) {
+		updateMask = append(updateMask, "labels")
+	}
+	if desired.DockerConfig != nil && (current.DockerConfig == nil || current.DockerConfig.ImmutableTags != desired.DockerConfig.ImmutableTags) {
+		updateMask = append(updateMask, "dockerConfig")
+	}
+	if !cleanupPoliciesEqual(current.CleanupPolicies, desired.CleanupPolicies) {
+		updateMask = append(updateMask, "cleanupPolicies")
+	}
+	if current.CleanupPolicyDryRun != desired.CleanupPolicyDryRun {
+		updateMask = append(updateMask, "cleanupPolicyDryRun")
+	}
+	return updateMask
+}
+
+// cleanupPoliciesEqual reports whether both repositories have the same cleanup policies
+func cleanupPoliciesEqual(a, b map[string]artifactregistry.CleanupPolicy) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for id, pa := range a {
+		pb, ok := b[id]
+		if !ok || pa.Action != pb.Action || !reflect.DeepEqual(pa.Condition, pb.Condition) ||
+			!reflect.DeepEqual(pa.MostRecentVersions, pb.MostRecentVersions) {
+			return false
+		}
+	}
+	return true
+}
+
+// repositoryIAMBindings returns the members of the reader and writer roles, roles without members are left out
+func repositoryIAMBindings(readers, writers []string) map[string][]string {
+	bindings := map[string][]string{}
+	if len(readers) > 0 {
+		bindings[roleArtifactRegistryReader] = readers
+	}
+	if len(writers) > 0 {
+		bindings[roleArtifactRegistryWriter] = writers
+	}
+	return bindings
+}
+
+// applyRepositoryIAMBindings grants the members of the bindings on the policy and revokes the previously granted
+// members no longer listed. Members granted outside of the repository and conditional bindings are left untouched.
+// It reports whether the policy changed.
+func applyRepositoryIAMBindings(policy *artifactregistry.Policy, bindings, granted map[string][]string) bool {
+	changed := false
+	for _, role := range slices.Sorted(maps.Keys(granted)) {
+		for _, member := range granted[role] {
+			if !slices.Contains(bindings[role], member) && removeRepositoryPolicyMember(policy, role, member) {
+				changed = true
+			}
+		}
+	}
+	for _, role := range slices.Sorted(maps.Keys(bindings)) {
+		for _, member := range bindings[role] {
+			if addRepositoryPolicyMember(policy, role, member) {
+				changed = true
+			}
+		}
+	}
+
+	return changed
+}
+
+// addRepositoryPolicyMember adds the member to the unconditional binding of the role and reports whether it was
+// missing
+func addRepositoryPolicyMember(policy *artifactregistry.Policy, role, member string) bool {
+	for _, binding := range policy.Bindings {
+		if binding.Role == role && binding.Condition == nil {
+			if slices.Contains(binding.Members, member) {
+				return false
+			}
+			binding.Members = append(binding.Members, member)
+			return true
+		}
+	}
+
+	policy.Bindings = append(policy.Bindings, &artifactregistry.Binding{Role: role, Members: []string{member}})
+	return true
+}
+
+// removeRepositoryPolicyMember removes the member from the unconditional binding of the role, dropping the binding
+// once empty, and reports whether it was present
+func removeRepositoryPolicyMember(policy *artifactregistry.Policy, role, member string) bool {
+	for i, binding := range policy.Bindings {
+		if binding.Role != role || binding.Condition != nil {
+			continue
+		}
+		j := slices.Index(binding.Members, member)
+		if j < 0 {
+			return false
+		}
+		binding.Members = slices.Delete(binding.Members, j, j+1)
+		if len(binding.Members) == 0 {
+			policy.Bindings = slices.Delete(policy.Bindings, i, i+1)
+		}
+		return true
+	}
+	return false
+}
+
+// repositoryURL returns the address packages are pushed to, e.g. us-central1-docker.pkg.dev/my-project/my-repo for a
+// Docker repository or https://us-central1-npm.pkg.dev/my-project/my-repo/ for an npm repository
+func repositoryURL(repository *artifactregistry.Repository, projectID, location, name string) string {
+	host := fmt.Sprintf("%s-%s.pkg.dev", location, strings.ToLower(repository.Format))
+	if repository.Format == repositoryFormatDocker {
+		return fmt.Sprintf("%s/%s/%s", host, projectID, name)
+	}
+	return fmt.Sprintf("https://%s/%s/%s/", host, projectID, name)
+}
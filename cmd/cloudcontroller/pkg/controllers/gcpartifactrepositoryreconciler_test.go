@@ -0,0 +1,107 @@
+package controllers
+
+import (
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/artifactregistry/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRepositoryUpdateMask(t *testing.T) {
+	gar := &benzaiten.GCPArtifactRepository{
+		Spec: benzaiten.GCPArtifactRepositorySpec{
+			Name:          "test-repository",
+			Location:      "us-central1",
+			Format:        "DOCKER",
+			ImmutableTags: true,
+			CleanupPolicies: []benzaiten.RepositoryCleanupPolicy{
+				{
+					ID:     "delete-untagged",
+					Action: "DELETE",
+					Condition: &benzaiten.RepositoryCleanupCondition{
+						TagState:  "UNTAGGED",
+						OlderThan: &metav1.Duration{Duration: 720 * time.Hour},
+					},
+				},
+			},
+			Labels: map[string]string{"team": "platform"},
+		},
+	}
+	desired := newRepository(gar)
+
+	// the repository as returned by GCP
+	current := &artifactregistry.Repository{
+		Name:         "projects/test-project/locations/us-central1/repositories/test-repository",
+		Format:       "DOCKER",
+		DockerConfig: &artifactregistry.DockerRepositoryConfig{ImmutableTags: true},
+		CleanupPolicies: map[string]artifactregistry.CleanupPolicy{
+			"delete-untagged": {
+				Id:        "delete-untagged",
+				Action:    "DELETE",
+				Condition: &artifactregistry.CleanupPolicyCondition{TagState: "UNTAGGED", OlderThan: "2592000s"},
+			},
+		},
+		Labels: map[string]string{"team": "platform"},
+	}
+	if updateMask := repositoryUpdateMask(current, desired); len(updateMask) > 0 {
+		t.Fatalf("expected repository to be in sync, got %v", updateMask)
+	}
+
+	// removing the cleanup policies and allowing tags to move
+	gar.Spec.CleanupPolicies = nil
+	gar.Spec.ImmutableTags = false
+	updateMask := repositoryUpdateMask(current, newRepository(gar))
+	if !slices.Equal(updateMask, []string{"dockerConfig", "cleanupPolicies"}) {
+		t.Fatalf("unexpected update mask %v", updateMask)
+	}
+}
+
+func TestApplyRepositoryIAMBindings(t *testing.T) {
+	policy := &artifactregistry.Policy{
+		Etag: "BwXhqDZd6eY=",
+		Bindings: []*artifactregistry.Binding{
+			// jane was granted the reader role with gcloud
+			{Role: roleArtifactRegistryReader, Members: []string{"user:jane@example.com"}},
+			{Role: roleArtifactRegistryWriter, Members: []string{"serviceAccount:old-ci@test-project.iam.gserviceaccount.com"}},
+			{Role: "roles/artifactregistry.admin", Members: []string{"group:platform@example.com"}},
+		},
+	}
+	readers := []string{"serviceAccount:node@test-project.iam.gserviceaccount.com"}
+
+	// the writers were removed from the spec, the admin role is not managed
+	granted := repositoryIAMBindings(nil, []string{"serviceAccount:old-ci@test-project.iam.gserviceaccount.com"})
+	if !applyRepositoryIAMBindings(policy, repositoryIAMBindings(readers, nil), granted) {
+		t.Fatalf("expected the policy to change")
+	}
+	if len(policy.Bindings) != 2 {
+		t.Fatalf("expected the reader and admin bindings, got %+v", policy.Bindings)
+	}
+	expected := []string{"user:jane@example.com", "serviceAccount:node@test-project.iam.gserviceaccount.com"}
+	if policy.Bindings[0].Role != roleArtifactRegistryReader || !slices.Equal(policy.Bindings[0].Members, expected) {
+		t.Fatalf("unexpected reader binding %+v", policy.Bindings[0])
+	}
+	if applyRepositoryIAMBindings(policy, repositoryIAMBindings(readers, nil), repositoryIAMBindings(readers, nil)) {
+		t.Fatalf("expected the policy to be in sync")
+	}
+
+	// removing the readers from the spec keeps the member granted outside of the operator
+	if !applyRepositoryIAMBindings(policy, repositoryIAMBindings(nil, nil), repositoryIAMBindings(readers, nil)) {
+		t.Fatalf("expected the policy to change")
+	}
+	if !slices.Equal(policy.Bindings[0].Members, expected[:1]) {
+		t.Fatalf("expected jane to be kept, got %+v", policy.Bindings[0])
+	}
+}
+
+func TestRepositoryURL(t *testing.T) {
+	docker := &artifactregistry.Repository{Format: "DOCKER"}
+	if url := repositoryURL(docker, "test-project", "europe-west1", "images"); url != "europe-west1-docker.pkg.dev/test-project/images" {
+		t.Fatalf("unexpected docker url %s", url)
+	}
+	npm := &artifactregistry.Repository{Format: "NPM"}
+	if url := repositoryURL(npm, "test-project", "us", "packages"); url != "https://us-npm.pkg.dev/test-project/packages/" {
+		t.Fatalf("unexpected npm url %s", url)
+	}
+}
@@ -0,0 +1,229 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"maps"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"time"
+)
+
+type GCPDiskReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPDiskReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpdisk", req.NamespacedName)
+
+	gd := benzaiten.GCPDisk{}
+	err := cr.Get(ctx, req.NamespacedName, &gd)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpdisk not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gd.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gd)
+	}
+
+	if controllerutil.AddFinalizer(&gd, gcpFinalizer) {
+		err = cr.Update(ctx, &gd)
+		if err != nil {
+			logger.Error(err, "error adding gcpdisk finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// does disk exist in GCP?
+	disk, err := cr.cloud.GCP.GetDisk(gd.Spec.Zone, gd.Spec.Name)
+	if err != nil && notFoundGCPResource(err) {
+		// disk does not exist in GCP
+		logger.Info("gcpdisk not found, creating disk...")
+		op, err := cr.cloud.GCP.CreateDisk(gd.Spec.Zone, &compute.Disk{
+			Name:           gd.Spec.Name,
+			SizeGb:         gd.Spec.SizeGb,
+			Type:           fmt.Sprintf("zones/%s/diskTypes/%s", gd.Spec.Zone, gd.Spec.Type),
+			SourceImage:    gd.Spec.SourceImage,
+			SourceSnapshot: gd.Spec.SourceSnapshot,
+			Labels:         gd.Spec.Labels,
+		})
+		if err != nil {
+			logger.Error(err, "error creating gcpdisk")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gd, "Normal", "DiskCreating", "GCP Disk creating")
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitZoneOperation(gd.Spec.Zone, op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error creating gcpdisk")
+			cr.eventRecorder.Event(&gd, "Warning", "DiskFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		disk, err = cr.cloud.GCP.GetDisk(gd.Spec.Zone, gd.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error verifying disk status")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gd, "Normal", "DiskCreated", "GCP Disk created")
+	} else if err != nil {
+		logger.Error(err, "error getting gcpdisk")
+		return ctrl.Result{}, err
+	}
+
+	// synchronize changes
+	sizeCondition := metav1.Condition{
+		Type:               benzaiten.DiskConditionSizeSynced,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gd.Generation,
+		Reason:             "Synced",
+		Message:            fmt.Sprintf("disk size is %dGB", gd.Spec.SizeGb),
+	}
+	switch {
+	case gd.Spec.SizeGb > disk.SizeGb:
+		// disks can be grown online, the guest file system has to be resized separately
+		logger.Info("gcpdisk size increased, resizing...", "from", disk.SizeGb, "to", gd.Spec.SizeGb)
+		op, err := cr.cloud.GCP.ResizeDisk(gd.Spec.Zone, gd.Spec.Name, gd.Spec.SizeGb)
+		if err != nil {
+			logger.Error(err, "error resizing gcpdisk")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitZoneOperation(gd.Spec.Zone, op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error resizing gcpdisk")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gd, "Normal", "DiskResized", fmt.Sprintf("GCP Disk resized from %dGB to %dGB", disk.SizeGb, gd.Spec.SizeGb))
+		disk.SizeGb = gd.Spec.SizeGb
+	case gd.Spec.SizeGb < disk.SizeGb:
+		sizeCondition.Status = metav1.ConditionFalse
+		sizeCondition.Reason = "ShrinkNotSupported"
+		sizeCondition.Message = fmt.Sprintf("disk cannot be shrunk from %dGB to %dGB", disk.SizeGb, gd.Spec.SizeGb)
+		if !meta.IsStatusConditionPresentAndEqual(gd.Status.Conditions, sizeCondition.Type, sizeCondition.Status) {
+			cr.eventRecorder.Event(&gd, "Warning", "ShrinkNotSupported", sizeCondition.Message)
+		}
+	}
+
+	if !maps.Equal(disk.Labels, gd.Spec.Labels) {
+		logger.Info("gcpdisk labels changed, updating...")
+		op, err := cr.cloud.GCP.SetDiskLabels(gd.Spec.Zone, gd.Spec.Name, disk.LabelFingerprint, gd.Spec.Labels)
+		if err != nil {
+			logger.Error(err, "error updating gcpdisk labels")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitZoneOperation(gd.Spec.Zone, op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error updating gcpdisk labels")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// update status
+	previous := gd.DeepCopyObject().(*benzaiten.GCPDisk)
+	gd.Status.Phase = benzaiten.DiskStatus(disk.Status)
+	gd.Status.SelfLink = disk.SelfLink
+	gd.Status.SizeGb = disk.SizeGb
+	gd.Status.Users = disk.Users
+	meta.SetStatusCondition(&gd.Status.Conditions, sizeCondition)
+	if !equality.Semantic.DeepEqual(previous.Status, gd.Status) {
+		err = cr.Status().Update(ctx, &gd)
+		if err != nil {
+			logger.Error(err, "error updating gcpdisk status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp disk reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+func (cr *GCPDiskReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gd *benzaiten.GCPDisk) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gd, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	disk, err := cr.cloud.GCP.GetDisk(gd.Spec.Zone, gd.Spec.Name)
+	if err != nil && !notFoundGCPResource(err) {
+		logger.Error(err, "error getting gcpdisk")
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		// a disk cannot be deleted while attached, wait for the instances to detach it
+		if len(disk.Users) > 0 {
+			cr.eventRecorder.Event(gd, "Warning", "DiskInUse", fmt.Sprintf("GCP Disk is attached to %d instance(s), waiting for detach", len(disk.Users)))
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		}
+
+		logger.Info("deleting gcpdisk...")
+		op, err := cr.cloud.GCP.DeleteDisk(gd.Spec.Zone, gd.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error deleting gcpdisk")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitZoneOperation(gd.Spec.Zone, op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error deleting gcpdisk")
+			return ctrl.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(gd, gcpFinalizer)
+	err = cr.Update(ctx, gd)
+	if err != nil {
+		logger.Error(err, "error removing gcpdisk finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp disk deleted")
+	return ctrl.Result{}, nil
+}
+
+func (cr *GCPDiskReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPDisk{}).
+		Complete(cr)
+}
+
+func setupGCPDiskController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpdisk")
+	cc := GCPDiskReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPDiskReconciler"),
+	}
+
+	// create GCPDisk controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPDisk controller: %w", err)
+	}
+
+	return nil
+}
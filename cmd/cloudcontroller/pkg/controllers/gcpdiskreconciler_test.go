@@ -0,0 +1,152 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"github.com/muraduiurie/cloudcontroller/pkg/cloudproviders/gcp"
+	"google.golang.org/api/compute/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"testing"
+)
+
+const (
+	defaultDiskName = "test-disk"
+)
+
+func newFakeDiskReconciler(log logr.Logger) *GCPDiskReconciler {
+	er := k8sMgr.GetEventRecorderFor("gcpdisk")
+	return &GCPDiskReconciler{
+		Client:        k8sClient,
+		Scheme:        k8sScheme,
+		eventRecorder: er,
+		Log:           log,
+	}
+}
+
+func fakeApiResizeDisk(ctrl *gomock.Controller) *gcp.API {
+	mockDisksInterface := gcp.NewMockDisksInterface(ctrl)
+	mockGetDisksInterface := gcp.NewMockGetDisksInterface(ctrl)
+	mockResizeDisksInterface := gcp.NewMockResizeDisksInterface(ctrl)
+	mockZoneOperationsInterface := gcp.NewMockZoneOperationsInterface(ctrl)
+	mockWaitZoneOperationsInterface := gcp.NewMockWaitZoneOperationsInterface(ctrl)
+
+	// Disk exists with the initial size
+	mockDisksInterface.EXPECT().
+		Get(defaultProjectID, defaultZone, defaultDiskName).
+		Return(mockGetDisksInterface)
+	mockGetDisksInterface.EXPECT().
+		Do().
+		Return(&compute.Disk{
+			Name:     defaultDiskName,
+			SizeGb:   10,
+			Status:   string(benzaiten.DiskStatusReady),
+			SelfLink: "https://www.googleapis.com/compute/v1/projects/test-project/zones/test-zone/disks/test-disk",
+		}, nil)
+
+	// Disk is resized to the size of the spec
+	mockDisksInterface.EXPECT().
+		Resize(defaultProjectID, defaultZone, defaultDiskName, &compute.DisksResizeRequest{SizeGb: 20}).
+		Return(mockResizeDisksInterface)
+	mockResizeDisksInterface.EXPECT().
+		Do().
+		Return(&compute.Operation{Name: "resize"}, nil)
+	mockZoneOperationsInterface.EXPECT().
+		Wait(defaultProjectID, defaultZone, "resize").
+		Return(mockWaitZoneOperationsInterface)
+	mockWaitZoneOperationsInterface.EXPECT().
+		Do().
+		Return(&compute.Operation{Name: "resize", Status: "DONE"}, nil)
+
+	return &gcp.API{
+		Compute: gcp.ComputeService{
+			Clients: gcp.ComputeClients{
+				Disks:          mockDisksInterface,
+				ZoneOperations: mockZoneOperationsInterface,
+			},
+		},
+		Config: gcp.Config{
+			ProjectId: defaultProjectID,
+		},
+	}
+}
+
+func createFakeDisk(ctx context.Context, fakeClient client.Client, sizeGb int64, name, namespace, zone string) (*benzaiten.GCPDisk, error) {
+	gdCreate := benzaiten.GCPDisk{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  namespace,
+			Finalizers: []string{gcpFinalizer},
+		},
+		Spec: benzaiten.GCPDiskSpec{
+			Name:   name,
+			Zone:   zone,
+			SizeGb: sizeGb,
+			Type:   "pd-balanced",
+		},
+	}
+
+	err := fakeClient.Create(ctx, &gdCreate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create fake GCPDisk: %w", err)
+	}
+
+	return &gdCreate, nil
+}
+
+////////////////////////////////////////////////////
+// TESTS
+////////////////////////////////////////////////////
+
+func TestGCPDiskReconciler_ResizeDisk(t *testing.T) {
+	logger := testLogger()
+	logger.WithValues("name", "resize of an existing disk").Info("starting test")
+
+	ctx := context.Background()
+	rec := newFakeDiskReconciler(logger)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	rec.cloud = CloudProviders{
+		GCP: fakeApiResizeDisk(mockCtrl),
+	}
+
+	gd, err := createFakeDisk(ctx, rec.Client, 20, defaultDiskName, defaultNamespace, defaultZone)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	_, err = rec.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: gd.Name, Namespace: gd.Namespace}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var gdResized benzaiten.GCPDisk
+	err = rec.Get(ctx, types.NamespacedName{Name: gd.Name, Namespace: gd.Namespace}, &gdResized)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if gdResized.Status.SizeGb != 20 {
+		t.Fatalf("expected disk size 20, got %d", gdResized.Status.SizeGb)
+	}
+	if gdResized.Status.Phase != benzaiten.DiskStatusReady {
+		t.Fatalf("expected disk status READY, got %v", gdResized.Status.Phase)
+	}
+
+	// remove the finalizer so the envtest api server can delete the object
+	gdResized.Finalizers = nil
+	err = rec.Client.Update(ctx, &gdResized)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err = rec.Client.Delete(ctx, &gdResized)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
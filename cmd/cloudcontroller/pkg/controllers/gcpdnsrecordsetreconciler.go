@@ -0,0 +1,317 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/dns/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"slices"
+	"strings"
+	"time"
+)
+
+type GCPDNSRecordSetReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPDNSRecordSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpdnsrecordset", req.NamespacedName)
+
+	grs := benzaiten.GCPDNSRecordSet{}
+	err := cr.Get(ctx, req.NamespacedName, &grs)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpdnsrecordset not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !grs.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &grs)
+	}
+
+	if controllerutil.AddFinalizer(&grs, gcpFinalizer) {
+		err = cr.Update(ctx, &grs)
+		if err != nil {
+			logger.Error(err, "error adding gcpdnsrecordset finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := grs.DeepCopyObject().(*benzaiten.GCPDNSRecordSet)
+
+	// the record set is created in the referenced zone
+	gz := benzaiten.GCPDNSZone{}
+	err = cr.Get(ctx, types.NamespacedName{Namespace: grs.Namespace, Name: grs.Spec.ZoneRef.Name}, &gz)
+	if err != nil && !kerr.IsNotFound(err) {
+		logger.Error(err, "error getting gcpdnszone")
+		return ctrl.Result{}, err
+	}
+	if err != nil || gz.Status.ID == "" {
+		meta.SetStatusCondition(&grs.Status.Conditions, metav1.Condition{
+			Type:               benzaiten.RecordSetConditionZoneReady,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: grs.Generation,
+			Reason:             "ZoneNotReady",
+			Message:            fmt.Sprintf("waiting for GCPDNSZone %s", grs.Spec.ZoneRef.Name),
+		})
+		if !equality.Semantic.DeepEqual(previous.Status, grs.Status) {
+			err = cr.Status().Update(ctx, &grs)
+			if err != nil {
+				logger.Error(err, "error updating gcpdnsrecordset status")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+	meta.SetStatusCondition(&grs.Status.Conditions, metav1.Condition{
+		Type:               benzaiten.RecordSetConditionZoneReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: grs.Generation,
+		Reason:             "ZoneReady",
+		Message:            fmt.Sprintf("GCPDNSZone %s is ready", grs.Spec.ZoneRef.Name),
+	})
+
+	// rrdatas taken from other objects follow their IPs
+	rrdatas := grs.Spec.Rrdatas
+	if len(grs.Spec.RrdatasFrom) > 0 {
+		var condition metav1.Condition
+		rrdatas, condition, err = resolveRrdatas(ctx, cr.Client, &grs)
+		if err != nil {
+			logger.Error(err, "error resolving gcpdnsrecordset rrdatas")
+			return ctrl.Result{}, err
+		}
+		meta.SetStatusCondition(&grs.Status.Conditions, condition)
+		if condition.Status != metav1.ConditionTrue {
+			if !equality.Semantic.DeepEqual(previous.Status, grs.Status) {
+				err = cr.Status().Update(ctx, &grs)
+				if err != nil {
+					logger.Error(err, "error updating gcpdnsrecordset status")
+					return ctrl.Result{}, err
+				}
+			}
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		}
+	}
+	desired := newResourceRecordSet(&grs, rrdatas)
+
+	// does record set exist in GCP?
+	rrset, err := cr.cloud.GCP.GetResourceRecordSet(gz.Spec.Name, grs.Spec.Name, grs.Spec.Type)
+	if err != nil && notFoundGCPResource(err) {
+		// record set does not exist in GCP
+		logger.Info("gcpdnsrecordset not found, creating record set...")
+		rrset, err = cr.cloud.GCP.CreateResourceRecordSet(gz.Spec.Name, desired)
+		if err != nil {
+			logger.Error(err, "error creating gcpdnsrecordset")
+			cr.eventRecorder.Event(&grs, "Warning", "RecordSetFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&grs, "Normal", "RecordSetCreated", fmt.Sprintf("GCP DNS Record Set created: %s", strings.Join(rrset.Rrdatas, ", ")))
+	} else if err != nil {
+		logger.Error(err, "error getting gcpdnsrecordset")
+		return ctrl.Result{}, err
+	} else if !recordSetInSync(rrset, desired) {
+		logger.Info("gcpdnsrecordset out of sync, updating record set...")
+		rrset, err = cr.cloud.GCP.PatchResourceRecordSet(gz.Spec.Name, desired)
+		if err != nil {
+			logger.Error(err, "error updating gcpdnsrecordset")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&grs, "Normal", "RecordSetUpdated", fmt.Sprintf("GCP DNS Record Set updated: %s", strings.Join(rrset.Rrdatas, ", ")))
+	}
+
+	// update status
+	grs.Status.Rrdatas = rrset.Rrdatas
+	if !equality.Semantic.DeepEqual(previous.Status, grs.Status) {
+		err = cr.Status().Update(ctx, &grs)
+		if err != nil {
+			logger.Error(err, "error updating gcpdnsrecordset status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp dns record set reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+func (cr *GCPDNSRecordSetReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, grs *benzaiten.GCPDNSRecordSet) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(grs, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	// the zone waits for its record sets before being deleted, without a zone there is nothing left to delete
+	gz := benzaiten.GCPDNSZone{}
+	err := cr.Get(ctx, types.NamespacedName{Namespace: grs.Namespace, Name: grs.Spec.ZoneRef.Name}, &gz)
+	if err != nil && !kerr.IsNotFound(err) {
+		logger.Error(err, "error getting gcpdnszone")
+		return ctrl.Result{}, err
+	}
+	if err == nil && gz.Status.ID != "" {
+		logger.Info("deleting gcpdnsrecordset...")
+		err = cr.cloud.GCP.DeleteResourceRecordSet(gz.Spec.Name, grs.Spec.Name, grs.Spec.Type)
+		if err != nil && !notFoundGCPResource(err) {
+			logger.Error(err, "error deleting gcpdnsrecordset")
+			return ctrl.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(grs, gcpFinalizer)
+	err = cr.Update(ctx, grs)
+	if err != nil {
+		logger.Error(err, "error removing gcpdnsrecordset finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp dns record set deleted")
+	return ctrl.Result{}, nil
+}
+
+func (cr *GCPDNSRecordSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPDNSRecordSet{}).
+		Watches(&benzaiten.GCPDNSZone{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForReference)).
+		Watches(&benzaiten.GCPInstance{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForReference)).
+		Watches(&benzaiten.GCPAddress{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForReference)).
+		Complete(cr)
+}
+
+// requestsForReference returns the GCPDNSRecordSets referencing the GCPDNSZone, GCPInstance or GCPAddress
+func (cr *GCPDNSRecordSetReconciler) requestsForReference(ctx context.Context, obj client.Object) []reconcile.Request {
+	grss := benzaiten.GCPDNSRecordSetList{}
+	err := cr.List(ctx, &grss, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		cr.Log.Error(err, "unable to list gcpdnsrecordsets")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, grs := range grss.Items {
+		var referenced bool
+		switch obj.(type) {
+		case *benzaiten.GCPDNSZone:
+			referenced = grs.Spec.ZoneRef.Name == obj.GetName()
+		case *benzaiten.GCPInstance:
+			referenced = slices.ContainsFunc(grs.Spec.RrdatasFrom, func(source benzaiten.RecordSetSource) bool {
+				return source.InstanceRef != nil && source.InstanceRef.Name == obj.GetName()
+			})
+		case *benzaiten.GCPAddress:
+			referenced = slices.ContainsFunc(grs.Spec.RrdatasFrom, func(source benzaiten.RecordSetSource) bool {
+				return source.AddressRef != nil && source.AddressRef.Name == obj.GetName()
+			})
+		}
+		if referenced {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: grs.Name, Namespace: grs.Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
+func setupGCPDNSRecordSetController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpdnsrecordset")
+	cc := GCPDNSRecordSetReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPDNSRecordSetReconciler"),
+	}
+
+	// create GCPDNSRecordSet controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPDNSRecordSet controller: %w", err)
+	}
+
+	return nil
+}
+
+// resolveRrdatas resolves the rrdatasFrom sources of the record set to their IPs. The returned condition is False
+// until every source provides an IP.
+func resolveRrdatas(ctx context.Context, c client.Client, grs *benzaiten.GCPDNSRecordSet) ([]string, metav1.Condition, error) {
+	condition := metav1.Condition{
+		Type:               benzaiten.RecordSetConditionSourcesReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: grs.Generation,
+		Reason:             "SourcesReady",
+		Message:            "sources provide an IP",
+	}
+	var rrdatas, pending []string
+
+	for _, source := range grs.Spec.RrdatasFrom {
+		var ip string
+		switch {
+		case source.InstanceRef != nil:
+			gi := benzaiten.GCPInstance{}
+			err := c.Get(ctx, types.NamespacedName{Namespace: grs.Namespace, Name: source.InstanceRef.Name}, &gi)
+			if err != nil && !kerr.IsNotFound(err) {
+				return nil, condition, fmt.Errorf("unable to get gcpinstance %s: %w", source.InstanceRef.Name, err)
+			}
+			ip = gi.Status.InternalIP
+			if source.AddressType == addressTypeExternal {
+				ip = gi.Status.ExternalIP
+			}
+			if ip == "" {
+				pending = append(pending, "GCPInstance "+source.InstanceRef.Name)
+			}
+		case source.AddressRef != nil:
+			ga := benzaiten.GCPAddress{}
+			err := c.Get(ctx, types.NamespacedName{Namespace: grs.Namespace, Name: source.AddressRef.Name}, &ga)
+			if err != nil && !kerr.IsNotFound(err) {
+				return nil, condition, fmt.Errorf("unable to get gcpaddress %s: %w", source.AddressRef.Name, err)
+			}
+			ip = ga.Status.Address
+			if ip == "" {
+				pending = append(pending, "GCPAddress "+source.AddressRef.Name)
+			}
+		}
+		// Cloud DNS rejects duplicate rrdatas, e.g. two instances sharing an address
+		if ip != "" && !slices.Contains(rrdatas, ip) {
+			rrdatas = append(rrdatas, ip)
+		}
+	}
+
+	if len(pending) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "SourcesNotReady"
+		condition.Message = fmt.Sprintf("waiting for %s", strings.Join(pending, ", "))
+	}
+
+	return rrdatas, condition, nil
+}
+
+// newResourceRecordSet builds the Cloud DNS record set described by the GCPDNSRecordSet spec
+func newResourceRecordSet(grs *benzaiten.GCPDNSRecordSet, rrdatas []string) *dns.ResourceRecordSet {
+	return &dns.ResourceRecordSet{
+		Name:    grs.Spec.Name,
+		Type:    grs.Spec.Type,
+		Ttl:     grs.Spec.TTL,
+		Rrdatas: rrdatas,
+	}
+}
+
+// recordSetInSync reports whether the record set matches the desired record set
+func recordSetInSync(current, desired *dns.ResourceRecordSet) bool {
+	return current.Ttl == desired.Ttl && stringSetsEqual(current.Rrdatas, desired.Rrdatas)
+}
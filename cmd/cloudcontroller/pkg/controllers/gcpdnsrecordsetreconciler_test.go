@@ -0,0 +1,88 @@
+package controllers
+
+import (
+	"context"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/dns/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"slices"
+	"testing"
+)
+
+func TestResolveRrdatas(t *testing.T) {
+	gi := &benzaiten.GCPInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Status:     benzaiten.GCPInstanceStatus{InternalIP: "10.0.0.2"},
+	}
+	ga := &benzaiten.GCPAddress{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-address", Namespace: "default"},
+	}
+	grs := &benzaiten.GCPDNSRecordSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-recordset", Namespace: "default"},
+		Spec: benzaiten.GCPDNSRecordSetSpec{
+			Type: "A",
+			RrdatasFrom: []benzaiten.RecordSetSource{
+				{InstanceRef: &benzaiten.ResourceRef{Name: "test-instance"}, AddressType: addressTypeInternal},
+				{AddressRef: &benzaiten.ResourceRef{Name: "test-address"}},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(Scheme).WithObjects(gi, ga).Build()
+
+	// the address is not reserved yet
+	_, condition, err := resolveRrdatas(context.Background(), c, grs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if condition.Status != metav1.ConditionFalse || condition.Message != "waiting for GCPAddress test-address" {
+		t.Fatalf("expected to wait for the address, got %s: %s", condition.Status, condition.Message)
+	}
+
+	// an address shared with the instance is only listed once
+	ga.Status.Address = "10.0.0.2"
+	c = fake.NewClientBuilder().WithScheme(Scheme).WithObjects(gi, ga).Build()
+	rrdatas, condition, err := resolveRrdatas(context.Background(), c, grs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if condition.Status != metav1.ConditionTrue {
+		t.Fatalf("expected sources to be ready, got %s: %s", condition.Status, condition.Message)
+	}
+	if !slices.Equal(rrdatas, []string{"10.0.0.2"}) {
+		t.Fatalf("expected rrdatas [10.0.0.2], got %v", rrdatas)
+	}
+
+	// the record set follows the instance when its IP changes
+	gi.Status.InternalIP = "10.0.0.3"
+	c = fake.NewClientBuilder().WithScheme(Scheme).WithObjects(gi, ga).Build()
+	rrdatas, _, err = resolveRrdatas(context.Background(), c, grs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	current := &dns.ResourceRecordSet{Name: "vm.example.com.", Type: "A", Ttl: 300, Rrdatas: []string{"10.0.0.2"}}
+	if recordSetInSync(current, newResourceRecordSet(grs, rrdatas)) {
+		t.Fatalf("expected record set with rrdatas %v to be out of sync", rrdatas)
+	}
+}
+
+func TestRecordSetInSync(t *testing.T) {
+	desired := &dns.ResourceRecordSet{Name: "vm.example.com.", Type: "A", Ttl: 300, Rrdatas: []string{"10.0.0.2", "10.0.0.3"}}
+
+	tests := []struct {
+		name     string
+		current  *dns.ResourceRecordSet
+		expected bool
+	}{
+		{"in sync", &dns.ResourceRecordSet{Ttl: 300, Rrdatas: []string{"10.0.0.2", "10.0.0.3"}}, true},
+		{"rrdatas order", &dns.ResourceRecordSet{Ttl: 300, Rrdatas: []string{"10.0.0.3", "10.0.0.2"}}, true},
+		{"ttl changed", &dns.ResourceRecordSet{Ttl: 60, Rrdatas: []string{"10.0.0.2", "10.0.0.3"}}, false},
+		{"rrdatas changed", &dns.ResourceRecordSet{Ttl: 300, Rrdatas: []string{"10.0.0.2"}}, false},
+	}
+
+	for _, tt := range tests {
+		if got := recordSetInSync(tt.current, desired); got != tt.expected {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,348 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/dns/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type GCPDNSZoneReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPDNSZoneReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpdnszone", req.NamespacedName)
+
+	gz := benzaiten.GCPDNSZone{}
+	err := cr.Get(ctx, req.NamespacedName, &gz)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpdnszone not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gz.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gz)
+	}
+
+	if controllerutil.AddFinalizer(&gz, gcpFinalizer) {
+		err = cr.Update(ctx, &gz)
+		if err != nil {
+			logger.Error(err, "error adding gcpdnszone finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := gz.DeepCopyObject().(*benzaiten.GCPDNSZone)
+
+	// private zones are visible from the referenced networks only
+	var networks []string
+	if gz.Spec.Visibility == benzaiten.DNSZoneVisibilityPrivate {
+		var condition metav1.Condition
+		networks, condition, err = resolveZoneNetworks(ctx, cr.Client, &gz)
+		if err != nil {
+			logger.Error(err, "error resolving gcpdnszone networks")
+			return ctrl.Result{}, err
+		}
+		meta.SetStatusCondition(&gz.Status.Conditions, condition)
+		if condition.Status != metav1.ConditionTrue {
+			if !equality.Semantic.DeepEqual(previous.Status, gz.Status) {
+				err = cr.Status().Update(ctx, &gz)
+				if err != nil {
+					logger.Error(err, "error updating gcpdnszone status")
+					return ctrl.Result{}, err
+				}
+			}
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		}
+	}
+	desired := newManagedZone(&gz, networks)
+
+	// does managed zone exist in GCP?
+	zone, err := cr.cloud.GCP.GetManagedZone(gz.Spec.Name)
+	if err != nil && notFoundGCPResource(err) {
+		// managed zone does not exist in GCP
+		logger.Info("gcpdnszone not found, creating managed zone...")
+		zone, err = cr.cloud.GCP.CreateManagedZone(desired)
+		if err != nil {
+			logger.Error(err, "error creating gcpdnszone")
+			cr.eventRecorder.Event(&gz, "Warning", "DNSZoneFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gz, "Normal", "DNSZoneCreated", "GCP DNS Zone created")
+	} else if err != nil {
+		logger.Error(err, "error getting gcpdnszone")
+		return ctrl.Result{}, err
+	} else if !managedZoneInSync(zone, desired) {
+		logger.Info("gcpdnszone out of sync, updating managed zone...")
+		op, err := cr.cloud.GCP.PatchManagedZone(gz.Spec.Name, managedZonePatch(desired))
+		if err != nil {
+			logger.Error(err, "error updating gcpdnszone")
+			return ctrl.Result{}, err
+		}
+		err = waitDNSOperation(ctx, func() (*dns.Operation, error) {
+			return cr.cloud.GCP.GetManagedZoneOperation(gz.Spec.Name, op.Id)
+		})
+		if err != nil {
+			logger.Error(err, "error updating gcpdnszone")
+			return ctrl.Result{}, err
+		}
+		zone, err = cr.cloud.GCP.GetManagedZone(gz.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error verifying managed zone status")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gz, "Normal", "DNSZoneUpdated", "GCP DNS Zone updated")
+	}
+
+	// update status
+	gz.Status.ID = strconv.FormatUint(zone.Id, 10)
+	gz.Status.NameServers = zone.NameServers
+	if !equality.Semantic.DeepEqual(previous.Status, gz.Status) {
+		err = cr.Status().Update(ctx, &gz)
+		if err != nil {
+			logger.Error(err, "error updating gcpdnszone status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp dns zone reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+func (cr *GCPDNSZoneReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gz *benzaiten.GCPDNSZone) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gz, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	_, err := cr.cloud.GCP.GetManagedZone(gz.Spec.Name)
+	if err != nil && !notFoundGCPResource(err) {
+		logger.Error(err, "error getting gcpdnszone")
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		// the record sets must be deleted from the zone first, wait for their finalizers
+		grss := benzaiten.GCPDNSRecordSetList{}
+		err = cr.List(ctx, &grss, client.InNamespace(gz.Namespace))
+		if err != nil {
+			logger.Error(err, "error listing gcpdnsrecordsets")
+			return ctrl.Result{}, err
+		}
+		var recordSets []string
+		for _, grs := range grss.Items {
+			if grs.Spec.ZoneRef.Name == gz.Name {
+				recordSets = append(recordSets, grs.Name)
+			}
+		}
+		if len(recordSets) > 0 {
+			return cr.blockDelete(ctx, logger, gz, fmt.Sprintf("zone is used by record sets %s", strings.Join(recordSets, ", ")))
+		}
+
+		logger.Info("deleting gcpdnszone...")
+		err = cr.cloud.GCP.DeleteManagedZone(gz.Spec.Name)
+		if err != nil && !notFoundGCPResource(err) {
+			if notEmptyGCPResource(err) {
+				// GCP refuses to delete a zone holding record sets not known to the cluster
+				return cr.blockDelete(ctx, logger, gz, err.Error())
+			}
+			logger.Error(err, "error deleting gcpdnszone")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(gz, "Normal", "DNSZoneDeleted", "GCP DNS Zone deleted")
+	}
+
+	controllerutil.RemoveFinalizer(gz, gcpFinalizer)
+	err = cr.Update(ctx, gz)
+	if err != nil {
+		logger.Error(err, "error removing gcpdnszone finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp dns zone deleted")
+	return ctrl.Result{}, nil
+}
+
+// blockDelete reports the deletion of the zone as blocked and requeues it
+func (cr *GCPDNSZoneReconciler) blockDelete(ctx context.Context, logger logr.Logger, gz *benzaiten.GCPDNSZone, message string) (ctrl.Result, error) {
+	condition := metav1.Condition{
+		Type:               benzaiten.DNSZoneConditionDeletionBlocked,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gz.Generation,
+		Reason:             "ZoneNotEmpty",
+		Message:            message,
+	}
+	if !meta.IsStatusConditionPresentAndEqual(gz.Status.Conditions, condition.Type, condition.Status) {
+		cr.eventRecorder.Event(gz, "Warning", "ZoneNotEmpty", fmt.Sprintf("GCP DNS Zone is not empty, deletion blocked: %s", message))
+	}
+	previous := gz.DeepCopyObject().(*benzaiten.GCPDNSZone)
+	meta.SetStatusCondition(&gz.Status.Conditions, condition)
+	if !equality.Semantic.DeepEqual(previous.Status, gz.Status) {
+		err := cr.Status().Update(ctx, gz)
+		if err != nil {
+			logger.Error(err, "error updating gcpdnszone status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+}
+
+func (cr *GCPDNSZoneReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPDNSZone{}).
+		Watches(&benzaiten.GCPNetwork{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForNetwork)).
+		Complete(cr)
+}
+
+// requestsForNetwork returns the GCPDNSZones visible from the GCPNetwork
+func (cr *GCPDNSZoneReconciler) requestsForNetwork(ctx context.Context, obj client.Object) []reconcile.Request {
+	// GCP networks are global to the project, zones of any namespace may reference it
+	gzs := benzaiten.GCPDNSZoneList{}
+	err := cr.List(ctx, &gzs)
+	if err != nil {
+		cr.Log.Error(err, "unable to list gcpdnszones")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, gz := range gzs.Items {
+		for i := range gz.Spec.NetworkRefs {
+			if refersTo(&gz.Spec.NetworkRefs[i], gz.Namespace, obj) {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Name: gz.Name, Namespace: gz.Namespace},
+				})
+				break
+			}
+		}
+	}
+
+	return requests
+}
+
+func setupGCPDNSZoneController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpdnszone")
+	cc := GCPDNSZoneReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPDNSZoneReconciler"),
+	}
+
+	// create GCPDNSZone controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPDNSZone controller: %w", err)
+	}
+
+	return nil
+}
+
+// resolveZoneNetworks resolves the GCPNetwork references of a private zone to their self links. The returned
+// condition is False until every referenced network is ready.
+func resolveZoneNetworks(ctx context.Context, c client.Client, gz *benzaiten.GCPDNSZone) ([]string, metav1.Condition, error) {
+	condition := metav1.Condition{
+		Type:               benzaiten.DNSZoneConditionNetworksReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gz.Generation,
+		Reason:             "NetworksReady",
+		Message:            "referenced networks are ready",
+	}
+	var networks, pending []string
+
+	for _, ref := range gz.Spec.NetworkRefs {
+		key := ref.NamespacedName(gz.Namespace)
+		gn := benzaiten.GCPNetwork{}
+		err := c.Get(ctx, key, &gn)
+		if err != nil && !kerr.IsNotFound(err) {
+			return nil, condition, fmt.Errorf("unable to get gcpnetwork %s: %w", key, err)
+		}
+		if err != nil || gn.Status.SelfLink == "" || gn.Status.Phase != benzaiten.NetworkStatusReady {
+			pending = append(pending, "GCPNetwork "+key.String())
+		} else {
+			networks = append(networks, gn.Status.SelfLink)
+		}
+	}
+
+	if len(pending) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "NetworksNotReady"
+		condition.Message = fmt.Sprintf("waiting for %s", strings.Join(pending, ", "))
+	}
+
+	return networks, condition, nil
+}
+
+// newManagedZone builds the Cloud DNS managed zone described by the GCPDNSZone spec
+func newManagedZone(gz *benzaiten.GCPDNSZone, networks []string) *dns.ManagedZone {
+	zone := &dns.ManagedZone{
+		Name:        gz.Spec.Name,
+		DnsName:     gz.Spec.DNSName,
+		Visibility:  gz.Spec.Visibility,
+		Description: gz.Spec.Description,
+	}
+	if gz.Spec.Visibility == benzaiten.DNSZoneVisibilityPrivate {
+		zone.PrivateVisibilityConfig = &dns.ManagedZonePrivateVisibilityConfig{
+			// an empty list detaches the zone from every network
+			ForceSendFields: []string{"Networks"},
+		}
+		for _, network := range networks {
+			zone.PrivateVisibilityConfig.Networks = append(zone.PrivateVisibilityConfig.Networks, &dns.ManagedZonePrivateVisibilityConfigNetwork{
+				NetworkUrl: network,
+			})
+		}
+	}
+
+	return zone
+}
+
+// managedZoneInSync reports whether the mutable fields of the managed zone match the desired zone
+func managedZoneInSync(current, desired *dns.ManagedZone) bool {
+	return current.Description == desired.Description &&
+		stringSetsEqual(zoneNetworks(current), zoneNetworks(desired))
+}
+
+// managedZonePatch returns the patch applying the description and visibility of the desired managed zone. The
+// description is always sent, an empty description clears it.
+func managedZonePatch(desired *dns.ManagedZone) *dns.ManagedZone {
+	return &dns.ManagedZone{
+		Description:             desired.Description,
+		PrivateVisibilityConfig: desired.PrivateVisibilityConfig,
+		ForceSendFields:         []string{"Description"},
+	}
+}
+
+// zoneNetworks returns the URLs of the networks the managed zone is visible from
+func zoneNetworks(zone *dns.ManagedZone) []string {
+	var networks []string
+	if zone.PrivateVisibilityConfig != nil {
+		for _, network := range zone.PrivateVisibilityConfig.Networks {
+			networks = append(networks, network.NetworkUrl)
+		}
+	}
+	return networks
+}
@@ -0,0 +1,56 @@
+package controllers
+
+import (
+	"encoding/json"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/dns/v1"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestManagedZoneInSync(t *testing.T) {
+	network := "https://www.googleapis.com/compute/v1/projects/test-project/global/networks/test-network"
+	gz := &benzaiten.GCPDNSZone{
+		Spec: benzaiten.GCPDNSZoneSpec{
+			Name:        "test-zone",
+			DNSName:     "example.com.",
+			Visibility:  benzaiten.DNSZoneVisibilityPrivate,
+			Description: "test zone",
+		},
+	}
+	desired := newManagedZone(gz, []string{network})
+
+	current := &dns.ManagedZone{
+		Name:        "test-zone",
+		DnsName:     "example.com.",
+		Visibility:  "private",
+		Description: "test zone",
+		PrivateVisibilityConfig: &dns.ManagedZonePrivateVisibilityConfig{
+			Networks: []*dns.ManagedZonePrivateVisibilityConfigNetwork{{NetworkUrl: network}},
+		},
+	}
+	if !managedZoneInSync(current, desired) {
+		t.Fatalf("expected managed zone to be in sync")
+	}
+
+	// detaching the last network must be sent to GCP
+	desired = newManagedZone(gz, nil)
+	if managedZoneInSync(current, desired) {
+		t.Fatalf("expected managed zone visible from %s to be out of sync", network)
+	}
+	if !slices.Contains(desired.PrivateVisibilityConfig.ForceSendFields, "Networks") {
+		t.Fatalf("expected an empty network list to be sent")
+	}
+}
+
+func TestManagedZonePatch(t *testing.T) {
+	// the description was removed from the spec
+	body, err := json.Marshal(managedZonePatch(&dns.ManagedZone{Name: "test-zone"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(body), `"description":""`) {
+		t.Fatalf("expected the empty description to be sent, got %s", body)
+	}
+}
@@ -0,0 +1,351 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	firewallDirectionIngress = "INGRESS"
+	firewallDirectionEgress  = "EGRESS"
+	// firewallAnyRange is the range GCP applies rules without source or destination to
+	firewallAnyRange = "0.0.0.0/0"
+)
+
+type GCPFirewallRuleReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPFirewallRuleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpfirewallrule", req.NamespacedName)
+
+	gf := benzaiten.GCPFirewallRule{}
+	err := cr.Get(ctx, req.NamespacedName, &gf)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpfirewallrule not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gf.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gf)
+	}
+
+	if controllerutil.AddFinalizer(&gf, gcpFinalizer) {
+		err = cr.Update(ctx, &gf)
+		if err != nil {
+			logger.Error(err, "error adding gcpfirewallrule finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := gf.DeepCopyObject().(*benzaiten.GCPFirewallRule)
+
+	// the rule applies to the referenced network
+	gn := benzaiten.GCPNetwork{}
+	err = cr.Get(ctx, types.NamespacedName{Namespace: gf.Namespace, Name: gf.Spec.NetworkRef.Name}, &gn)
+	if err != nil && !kerr.IsNotFound(err) {
+		logger.Error(err, "error getting gcpnetwork")
+		return ctrl.Result{}, err
+	}
+	if err != nil || gn.Status.SelfLink == "" {
+		meta.SetStatusCondition(&gf.Status.Conditions, metav1.Condition{
+			Type:               benzaiten.FirewallRuleConditionNetworkReady,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: gf.Generation,
+			Reason:             "NetworkNotReady",
+			Message:            fmt.Sprintf("waiting for GCPNetwork %s", gf.Spec.NetworkRef.Name),
+		})
+		if !equality.Semantic.DeepEqual(previous.Status, gf.Status) {
+			err = cr.Status().Update(ctx, &gf)
+			if err != nil {
+				logger.Error(err, "error updating gcpfirewallrule status")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+	meta.SetStatusCondition(&gf.Status.Conditions, metav1.Condition{
+		Type:               benzaiten.FirewallRuleConditionNetworkReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gf.Generation,
+		Reason:             "NetworkReady",
+		Message:            fmt.Sprintf("GCPNetwork %s is ready", gf.Spec.NetworkRef.Name),
+	})
+	desired := newComputeFirewall(&gf, gn.Status.SelfLink)
+
+	// does firewall rule exist in GCP?
+	firewall, err := cr.cloud.GCP.GetFirewall(gf.Spec.Name)
+	if err != nil && notFoundGCPResource(err) {
+		// firewall rule does not exist in GCP
+		logger.Info("gcpfirewallrule not found, creating firewall rule...")
+		op, err := cr.cloud.GCP.CreateFirewall(desired)
+		if err != nil {
+			logger.Error(err, "error creating gcpfirewallrule")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitGlobalOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error creating gcpfirewallrule")
+			cr.eventRecorder.Event(&gf, "Warning", "FirewallRuleFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		firewall, err = cr.cloud.GCP.GetFirewall(gf.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error verifying firewall rule status")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gf, "Normal", "FirewallRuleCreated", "GCP Firewall Rule created")
+	} else if err != nil {
+		logger.Error(err, "error getting gcpfirewallrule")
+		return ctrl.Result{}, err
+	} else if !firewallInSync(firewall, desired) {
+		// firewall rule drifted from the spec
+		logger.Info("gcpfirewallrule out of sync, patching firewall rule...")
+		op, err := cr.cloud.GCP.PatchFirewall(gf.Spec.Name, desired)
+		if err != nil {
+			logger.Error(err, "error patching gcpfirewallrule")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitGlobalOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error patching gcpfirewallrule")
+			cr.eventRecorder.Event(&gf, "Warning", "FirewallRuleFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		firewall, err = cr.cloud.GCP.GetFirewall(gf.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error verifying firewall rule status")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gf, "Normal", "FirewallRuleUpdated", "GCP Firewall Rule updated")
+	}
+
+	// update status
+	gf.Status.SelfLink = firewall.SelfLink
+	if !equality.Semantic.DeepEqual(previous.Status, gf.Status) {
+		err = cr.Status().Update(ctx, &gf)
+		if err != nil {
+			logger.Error(err, "error updating gcpfirewallrule status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp firewall rule reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+func (cr *GCPFirewallRuleReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gf *benzaiten.GCPFirewallRule) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gf, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("deleting gcpfirewallrule...")
+	op, err := cr.cloud.GCP.DeleteFirewall(gf.Spec.Name)
+	if err != nil && !notFoundGCPResource(err) {
+		logger.Error(err, "error deleting gcpfirewallrule")
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitGlobalOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error deleting gcpfirewallrule")
+			return ctrl.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(gf, gcpFinalizer)
+	err = cr.Update(ctx, gf)
+	if err != nil {
+		logger.Error(err, "error removing gcpfirewallrule finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp firewall rule deleted")
+	return ctrl.Result{}, nil
+}
+
+func (cr *GCPFirewallRuleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPFirewallRule{}).
+		Watches(&benzaiten.GCPNetwork{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForGCPNetwork)).
+		Complete(cr)
+}
+
+// requestsForGCPNetwork returns the GCPFirewallRules referencing the GCPNetwork
+func (cr *GCPFirewallRuleReconciler) requestsForGCPNetwork(ctx context.Context, obj client.Object) []reconcile.Request {
+	gfs := benzaiten.GCPFirewallRuleList{}
+	err := cr.List(ctx, &gfs, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		cr.Log.Error(err, "unable to list gcpfirewallrules")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, gf := range gfs.Items {
+		if gf.Spec.NetworkRef.Name == obj.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: gf.Name, Namespace: gf.Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
+func setupGCPFirewallRuleController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpfirewallrule")
+	cc := GCPFirewallRuleReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPFirewallRuleReconciler"),
+	}
+
+	// create GCPFirewallRule controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPFirewallRule controller: %w", err)
+	}
+
+	return nil
+}
+
+// newComputeFirewall builds the GCP firewall rule described by the GCPFirewallRule spec
+func newComputeFirewall(gf *benzaiten.GCPFirewallRule, network string) *compute.Firewall {
+	direction := gf.Spec.Direction
+	if direction == "" {
+		direction = firewallDirectionIngress
+	}
+
+	firewall := &compute.Firewall{
+		Name:                  gf.Spec.Name,
+		Network:               network,
+		Direction:             direction,
+		Priority:              gf.Spec.Priority,
+		Allowed:               newFirewallAllowed(gf.Spec.Allowed),
+		Denied:                newFirewallDenied(gf.Spec.Denied),
+		SourceRanges:          gf.Spec.SourceRanges,
+		DestinationRanges:     gf.Spec.DestinationRanges,
+		SourceTags:            gf.Spec.SourceTags,
+		TargetTags:            gf.Spec.TargetTags,
+		TargetServiceAccounts: gf.Spec.TargetServiceAccounts,
+		LogConfig: &compute.FirewallLogConfig{
+			Enable:          gf.Spec.Logging,
+			ForceSendFields: []string{"Enable"},
+		},
+		Disabled:    gf.Spec.Disabled,
+		Description: gf.Spec.Description,
+		// patching replaces only the fields sent, cleared lists and disabled flags must be sent as well
+		ForceSendFields: []string{"Allowed", "Denied", "SourceRanges", "DestinationRanges", "SourceTags",
+			"TargetTags", "TargetServiceAccounts", "Disabled", "Description", "Priority"},
+	}
+	// GCP applies rules without source or destination to any address, make it explicit to compare the rules
+	if direction == firewallDirectionIngress && len(firewall.SourceRanges) == 0 && len(firewall.SourceTags) == 0 {
+		firewall.SourceRanges = []string{firewallAnyRange}
+	}
+	if direction == firewallDirectionEgress && len(firewall.DestinationRanges) == 0 {
+		firewall.DestinationRanges = []string{firewallAnyRange}
+	}
+
+	return firewall
+}
+
+func newFirewallAllowed(protocols []benzaiten.FirewallRuleProtocol) []*compute.FirewallAllowed {
+	var allowed []*compute.FirewallAllowed
+	for _, p := range protocols {
+		allowed = append(allowed, &compute.FirewallAllowed{IPProtocol: p.Protocol, Ports: p.Ports})
+	}
+	return allowed
+}
+
+func newFirewallDenied(protocols []benzaiten.FirewallRuleProtocol) []*compute.FirewallDenied {
+	var denied []*compute.FirewallDenied
+	for _, p := range protocols {
+		denied = append(denied, &compute.FirewallDenied{IPProtocol: p.Protocol, Ports: p.Ports})
+	}
+	return denied
+}
+
+// firewallInSync reports whether the GCP firewall rule matches the desired rule
+func firewallInSync(current, desired *compute.Firewall) bool {
+	currentLogging := current.LogConfig != nil && current.LogConfig.Enable
+	if current.Priority != desired.Priority ||
+		current.Disabled != desired.Disabled ||
+		current.Description != desired.Description ||
+		currentLogging != desired.LogConfig.Enable {
+		return false
+	}
+
+	var currentAllowed, desiredAllowed, currentDenied, desiredDenied []string
+	for _, a := range current.Allowed {
+		currentAllowed = append(currentAllowed, firewallProtocolKey(a.IPProtocol, a.Ports))
+	}
+	for _, a := range desired.Allowed {
+		desiredAllowed = append(desiredAllowed, firewallProtocolKey(a.IPProtocol, a.Ports))
+	}
+	for _, d := range current.Denied {
+		currentDenied = append(currentDenied, firewallProtocolKey(d.IPProtocol, d.Ports))
+	}
+	for _, d := range desired.Denied {
+		desiredDenied = append(desiredDenied, firewallProtocolKey(d.IPProtocol, d.Ports))
+	}
+
+	return stringSetsEqual(currentAllowed, desiredAllowed) &&
+		stringSetsEqual(currentDenied, desiredDenied) &&
+		stringSetsEqual(current.SourceRanges, desired.SourceRanges) &&
+		stringSetsEqual(current.DestinationRanges, desired.DestinationRanges) &&
+		stringSetsEqual(current.SourceTags, desired.SourceTags) &&
+		stringSetsEqual(current.TargetTags, desired.TargetTags) &&
+		stringSetsEqual(current.TargetServiceAccounts, desired.TargetServiceAccounts)
+}
+
+// firewallProtocolKey identifies a protocol and its ports, e.g. tcp:80,443
+func firewallProtocolKey(protocol string, ports []string) string {
+	sorted := slices.Clone(ports)
+	slices.Sort(sorted)
+	return strings.ToLower(protocol) + ":" + strings.Join(sorted, ",")
+}
+
+// stringSetsEqual reports whether both lists hold the same values, regardless of their order
+func stringSetsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa, sb := slices.Clone(a), slices.Clone(b)
+	slices.Sort(sa)
+	slices.Sort(sb)
+	return slices.Equal(sa, sb)
+}
@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/compute/v1"
+	"testing"
+)
+
+func TestNewComputeFirewall(t *testing.T) {
+	gf := &benzaiten.GCPFirewallRule{
+		Spec: benzaiten.GCPFirewallRuleSpec{
+			Name:       "test-rule",
+			TargetTags: []string{"web"},
+			Allowed:    []benzaiten.FirewallRuleProtocol{{Protocol: "tcp", Ports: []string{"443"}}},
+		},
+	}
+
+	firewall := newComputeFirewall(gf, "test-network")
+	if firewall.Direction != firewallDirectionIngress {
+		t.Fatalf("expected direction to default to ingress, got %s", firewall.Direction)
+	}
+	if len(firewall.SourceRanges) != 1 || firewall.SourceRanges[0] != firewallAnyRange {
+		t.Fatalf("expected ingress rule without source to apply to any address, got %v", firewall.SourceRanges)
+	}
+
+	gf.Spec.Direction = firewallDirectionEgress
+	firewall = newComputeFirewall(gf, "test-network")
+	if len(firewall.SourceRanges) != 0 || len(firewall.DestinationRanges) != 1 || firewall.DestinationRanges[0] != firewallAnyRange {
+		t.Fatalf("expected egress rule without destination to apply to any address, got %v", firewall.DestinationRanges)
+	}
+}
+
+func TestFirewallInSync(t *testing.T) {
+	gf := &benzaiten.GCPFirewallRule{
+		Spec: benzaiten.GCPFirewallRuleSpec{
+			Name:         "test-rule",
+			Priority:     1000,
+			SourceRanges: []string{"10.0.0.0/8", "192.168.0.0/16"},
+			Allowed: []benzaiten.FirewallRuleProtocol{
+				{Protocol: "tcp", Ports: []string{"80", "443"}},
+				{Protocol: "icmp"},
+			},
+		},
+	}
+	desired := newComputeFirewall(gf, "test-network")
+
+	current := &compute.Firewall{
+		Priority:     1000,
+		Direction:    firewallDirectionIngress,
+		SourceRanges: []string{"192.168.0.0/16", "10.0.0.0/8"},
+		Allowed: []*compute.FirewallAllowed{
+			{IPProtocol: "icmp"},
+			{IPProtocol: "tcp", Ports: []string{"443", "80"}},
+		},
+		LogConfig: &compute.FirewallLogConfig{Enable: false},
+	}
+	if !firewallInSync(current, desired) {
+		t.Fatalf("expected rules in a different order to be in sync")
+	}
+
+	current.Allowed[1].Ports = []string{"443"}
+	if firewallInSync(current, desired) {
+		t.Fatalf("expected a removed port to be out of sync")
+	}
+
+	current.Allowed[1].Ports = []string{"443", "80"}
+	current.Disabled = true
+	if firewallInSync(current, desired) {
+		t.Fatalf("expected a disabled rule to be out of sync")
+	}
+}
@@ -0,0 +1,389 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"github.com/muraduiurie/cloudcontroller/pkg/cloudproviders/gcp"
+	"google.golang.org/api/iam/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"slices"
+	"strings"
+	"time"
+)
+
+// iamPolicyAttempts is the number of read-modify-write cycles tried when the policy keeps being modified concurrently
+const iamPolicyAttempts = 5
+
+type GCPIAMPolicyMemberReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+// iamPolicyTarget is the GCP resource holding the IAM policy a GCPIAMPolicyMember binds to
+type iamPolicyTarget struct {
+	Kind gcp.IAMResourceKind
+	Name string
+	Path string
+}
+
+func (cr *GCPIAMPolicyMemberReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpiampolicymember", req.NamespacedName)
+
+	gpm := benzaiten.GCPIAMPolicyMember{}
+	err := cr.Get(ctx, req.NamespacedName, &gpm)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpiampolicymember not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gpm.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gpm)
+	}
+
+	if controllerutil.AddFinalizer(&gpm, gcpFinalizer) {
+		err = cr.Update(ctx, &gpm)
+		if err != nil {
+			logger.Error(err, "error adding gcpiampolicymember finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := gpm.DeepCopyObject().(*benzaiten.GCPIAMPolicyMember)
+
+	// the resource and the service account granted the role must exist in GCP
+	target, pending, err := resolveIAMPolicyTarget(ctx, cr.Client, &gpm, cr.cloud.GCP.ProjectId)
+	if err != nil {
+		logger.Error(err, "error resolving gcpiampolicymember resource")
+		return ctrl.Result{}, err
+	}
+	member, pendingMember, err := resolveIAMPolicyMember(ctx, cr.Client, &gpm)
+	if err != nil {
+		logger.Error(err, "error resolving gcpiampolicymember member")
+		return ctrl.Result{}, err
+	}
+	pending = append(pending, pendingMember...)
+	condition := metav1.Condition{
+		Type:               benzaiten.IAMPolicyMemberConditionReferencesReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gpm.Generation,
+		Reason:             "ReferencesResolved",
+		Message:            "referenced objects are ready",
+	}
+	if len(pending) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "ReferencesNotReady"
+		condition.Message = fmt.Sprintf("waiting for %s", strings.Join(pending, ", "))
+	}
+	meta.SetStatusCondition(&gpm.Status.Conditions, condition)
+	if condition.Status != metav1.ConditionTrue {
+		if !equality.Semantic.DeepEqual(previous.Status, gpm.Status) {
+			err = cr.Status().Update(ctx, &gpm)
+			if err != nil {
+				logger.Error(err, "error updating gcpiampolicymember status")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+
+	// a member bound before, e.g. with gcloud or by the iamBindings of a GCPStorageBucket, is not granted by this
+	// GCPIAMPolicyMember and is left bound on deletion
+	if !gpm.Status.Granted || gpm.Status.Member != member || gpm.Status.Resource != target.Path {
+		policy, err := cr.cloud.GCP.GetIamPolicy(target.Kind, target.Name)
+		if err != nil {
+			logger.Error(err, "error getting gcpiampolicymember iam policy")
+			return ctrl.Result{}, err
+		}
+		gpm.Status.Granted = !hasIAMPolicyMember(policy, gpm.Spec.Role, member, gpm.Spec.Condition)
+	}
+
+	// record the member before binding it, so the binding is removed even if the status update fails afterwards
+	gpm.Status.Resource = target.Path
+	gpm.Status.Member = member
+	if !equality.Semantic.DeepEqual(previous.Status, gpm.Status) {
+		err = cr.Status().Update(ctx, &gpm)
+		if err != nil {
+			logger.Error(err, "error updating gcpiampolicymember status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// is the member bound to the role?
+	added, err := modifyIAMPolicy(cr.cloud.GCP, target, func(policy *iam.Policy) bool {
+		return addIAMPolicyMember(policy, gpm.Spec.Role, member, gpm.Spec.Condition)
+	})
+	if err != nil {
+		logger.Error(err, "error binding gcpiampolicymember")
+		cr.eventRecorder.Event(&gpm, "Warning", "IAMPolicyMemberFailedState", err.Error())
+		return ctrl.Result{}, err
+	}
+	if added {
+		cr.eventRecorder.Event(&gpm, "Normal", "IAMPolicyMemberBound", fmt.Sprintf("GCP IAM role %s granted to %s on %s", gpm.Spec.Role, member, target.Path))
+	}
+
+	logger.Info("gcp iam policy member reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+func (cr *GCPIAMPolicyMemberReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gpm *benzaiten.GCPIAMPolicyMember) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gpm, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	// only a member granted by this GCPIAMPolicyMember is removed, a deleted resource takes its policy along
+	if gpm.Status.Granted && gpm.Status.Member != "" {
+		target, pending, err := resolveIAMPolicyTarget(ctx, cr.Client, gpm, cr.cloud.GCP.ProjectId)
+		if err != nil {
+			logger.Error(err, "error resolving gcpiampolicymember resource")
+			return ctrl.Result{}, err
+		}
+		shared, err := cr.sharedBinding(ctx, gpm)
+		if err != nil {
+			logger.Error(err, "error listing gcpiampolicymembers")
+			return ctrl.Result{}, err
+		}
+		if shared != nil && !shared.Status.Granted {
+			// the remaining GCPIAMPolicyMember takes the grant over
+			shared.Status.Granted = true
+			err = cr.Status().Update(ctx, shared)
+			if err != nil {
+				logger.Error(err, "error updating gcpiampolicymember status")
+				return ctrl.Result{}, err
+			}
+		}
+		if len(pending) == 0 && shared == nil {
+			logger.Info("removing gcpiampolicymember...")
+			_, err = modifyIAMPolicy(cr.cloud.GCP, target, func(policy *iam.Policy) bool {
+				return removeIAMPolicyMember(policy, gpm.Spec.Role, gpm.Status.Member, gpm.Spec.Condition)
+			})
+			if err != nil && !notFoundGCPResource(err) {
+				logger.Error(err, "error removing gcpiampolicymember")
+				return ctrl.Result{}, err
+			}
+		}
+	}
+
+	controllerutil.RemoveFinalizer(gpm, gcpFinalizer)
+	err := cr.Update(ctx, gpm)
+	if err != nil {
+		logger.Error(err, "error removing gcpiampolicymember finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp iam policy member deleted")
+	return ctrl.Result{}, nil
+}
+
+// sharedBinding returns another GCPIAMPolicyMember granting the same role to the same member on the same resource,
+// in which case the member is kept in the policy. It returns nil if there is none.
+func (cr *GCPIAMPolicyMemberReconciler) sharedBinding(ctx context.Context, gpm *benzaiten.GCPIAMPolicyMember) (*benzaiten.GCPIAMPolicyMember, error) {
+	gpms := benzaiten.GCPIAMPolicyMemberList{}
+	err := cr.List(ctx, &gpms)
+	if err != nil {
+		return nil, err
+	}
+	for i, other := range gpms.Items {
+		if other.UID == gpm.UID || !other.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if other.Status.Resource == gpm.Status.Resource && other.Status.Member == gpm.Status.Member &&
+			other.Spec.Role == gpm.Spec.Role && equality.Semantic.DeepEqual(other.Spec.Condition, gpm.Spec.Condition) {
+			return &gpms.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (cr *GCPIAMPolicyMemberReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPIAMPolicyMember{}).
+		Complete(cr)
+}
+
+func setupGCPIAMPolicyMemberController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpiampolicymember")
+	cc := GCPIAMPolicyMemberReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPIAMPolicyMemberReconciler"),
+	}
+
+	// create GCPIAMPolicyMember controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPIAMPolicyMember controller: %w", err)
+	}
+
+	return nil
+}
+
+// modifyIAMPolicy applies modify to the IAM policy of the target in a read-modify-write cycle. The write carries the
+// etag of the read, GCP rejects it if the policy changed in between, e.g. by another binding, and the cycle is
+// retried on the new policy. It reports whether the policy was written, modify returning false leaves it untouched.
+func modifyIAMPolicy(api *gcp.API, target iamPolicyTarget, modify func(*iam.Policy) bool) (bool, error) {
+	for attempt := 1; ; attempt++ {
+		policy, err := api.GetIamPolicy(target.Kind, target.Name)
+		if err != nil {
+			return false, err
+		}
+		if !modify(policy) {
+			return false, nil
+		}
+		err = api.SetIamPolicy(target.Kind, target.Name, policy)
+		if err == nil {
+			return true, nil
+		}
+		if !concurrentGCPModification(err) || attempt == iamPolicyAttempts {
+			return false, err
+		}
+	}
+}
+
+// hasIAMPolicyMember reports whether the member is bound to the role and condition
+func hasIAMPolicyMember(policy *iam.Policy, role, member string, condition *benzaiten.IAMCondition) bool {
+	for _, binding := range policy.Bindings {
+		if binding.Role == role && iamConditionEqual(binding.Condition, condition) && slices.Contains(binding.Members, member) {
+			return true
+		}
+	}
+	return false
+}
+
+// addIAMPolicyMember adds the member to the binding of the role and condition, creating the binding if missing. It
+// reports whether the policy changed.
+func addIAMPolicyMember(policy *iam.Policy, role, member string, condition *benzaiten.IAMCondition) bool {
+	for _, binding := range policy.Bindings {
+		if binding.Role == role && iamConditionEqual(binding.Condition, condition) {
+			if slices.Contains(binding.Members, member) {
+				return false
+			}
+			binding.Members = append(binding.Members, member)
+			return true
+		}
+	}
+
+	binding := &iam.Binding{Role: role, Members: []string{member}}
+	if condition != nil {
+		binding.Condition = &iam.Expr{
+			Title:       condition.Title,
+			Description: condition.Description,
+			Expression:  condition.Expression,
+		}
+		// conditional bindings are only accepted in policies of version 3
+		policy.Version = 3
+	}
+	policy.Bindings = append(policy.Bindings, binding)
+	return true
+}
+
+// removeIAMPolicyMember removes the member from the binding of the role and condition, dropping the binding once it
+// has no member left. It reports whether the policy changed.
+func removeIAMPolicyMember(policy *iam.Policy, role, member string, condition *benzaiten.IAMCondition) bool {
+	for i, binding := range policy.Bindings {
+		if binding.Role != role || !iamConditionEqual(binding.Condition, condition) {
+			continue
+		}
+		j := slices.Index(binding.Members, member)
+		if j < 0 {
+			return false
+		}
+		binding.Members = slices.Delete(binding.Members, j, j+1)
+		if len(binding.Members) == 0 {
+			policy.Bindings = slices.Delete(policy.Bindings, i, i+1)
+		}
+		return true
+	}
+	return false
+}
+
+// iamConditionEqual reports whether the condition of a binding is the desired condition
+func iamConditionEqual(expr *iam.Expr, condition *benzaiten.IAMCondition) bool {
+	if expr == nil || condition == nil {
+		return expr == nil && condition == nil
+	}
+	return expr.Title == condition.Title && expr.Description == condition.Description &&
+		expr.Expression == condition.Expression
+}
+
+// resolveIAMPolicyTarget returns the GCP resource holding the IAM policy, along with the referenced objects not ready
+// yet
+func resolveIAMPolicyTarget(ctx context.Context, c client.Client, gpm *benzaiten.GCPIAMPolicyMember, projectID string) (iamPolicyTarget, []string, error) {
+	resource := gpm.Spec.Resource
+	key := types.NamespacedName{Namespace: gpm.Namespace, Name: resource.Name}
+	pending := []string{resource.Kind + " " + key.String()}
+
+	switch resource.Kind {
+	case benzaiten.IAMPolicyResourceProject:
+		return iamPolicyTarget{Kind: gcp.IAMResourceProject, Path: gcp.ProjectPath(projectID)}, nil, nil
+	case benzaiten.IAMPolicyResourceGCPStorageBucket:
+		gb := benzaiten.GCPStorageBucket{}
+		err := c.Get(ctx, key, &gb)
+		if err != nil && !kerr.IsNotFound(err) {
+			return iamPolicyTarget{}, nil, fmt.Errorf("unable to get gcpstoragebucket %s: %w", key, err)
+		}
+		if err != nil || gb.Status.SelfLink == "" {
+			return iamPolicyTarget{}, pending, nil
+		}
+		return iamPolicyTarget{Kind: gcp.IAMResourceBucket, Name: gb.Spec.Name, Path: "projects/_/buckets/" + gb.Spec.Name}, nil, nil
+	case benzaiten.IAMPolicyResourceGCPServiceAccount:
+		gsa := benzaiten.GCPServiceAccount{}
+		err := c.Get(ctx, key, &gsa)
+		if err != nil && !kerr.IsNotFound(err) {
+			return iamPolicyTarget{}, nil, fmt.Errorf("unable to get gcpserviceaccount %s: %w", key, err)
+		}
+		if err != nil || gsa.Status.Email == "" {
+			return iamPolicyTarget{}, pending, nil
+		}
+		return iamPolicyTarget{Kind: gcp.IAMResourceServiceAccount, Name: gsa.Spec.AccountID, Path: gcp.ServiceAccountPath(projectID, gsa.Status.Email)}, nil, nil
+	case benzaiten.IAMPolicyResourceGCPPubSubTopic:
+		gt := benzaiten.GCPPubSubTopic{}
+		err := c.Get(ctx, key, &gt)
+		if err != nil && !kerr.IsNotFound(err) {
+			return iamPolicyTarget{}, nil, fmt.Errorf("unable to get gcppubsubtopic %s: %w", key, err)
+		}
+		if err != nil || gt.Status.Path == "" {
+			return iamPolicyTarget{}, pending, nil
+		}
+		return iamPolicyTarget{Kind: gcp.IAMResourceTopic, Name: gt.Spec.Name, Path: gt.Status.Path}, nil, nil
+	}
+
+	return iamPolicyTarget{}, nil, fmt.Errorf("unsupported resource kind %s", resource.Kind)
+}
+
+// resolveIAMPolicyMember returns the member granted the role, along with the referenced objects not ready yet
+func resolveIAMPolicyMember(ctx context.Context, c client.Client, gpm *benzaiten.GCPIAMPolicyMember) (string, []string, error) {
+	if gpm.Spec.ServiceAccountRef == nil {
+		return gpm.Spec.Member, nil, nil
+	}
+
+	key := types.NamespacedName{Namespace: gpm.Namespace, Name: gpm.Spec.ServiceAccountRef.Name}
+	gsa := benzaiten.GCPServiceAccount{}
+	err := c.Get(ctx, key, &gsa)
+	if err != nil && !kerr.IsNotFound(err) {
+		return "", nil, fmt.Errorf("unable to get gcpserviceaccount %s: %w", key, err)
+	}
+	if err != nil || gsa.Status.Email == "" {
+		return "", []string{"GCPServiceAccount " + key.String()}, nil
+	}
+	return "serviceAccount:" + gsa.Status.Email, nil, nil
+}
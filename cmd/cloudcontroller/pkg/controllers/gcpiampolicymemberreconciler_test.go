@@ -0,0 +1,192 @@
+package controllers
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"github.com/muraduiurie/cloudcontroller/pkg/cloudproviders/gcp"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iam/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"net/http"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"slices"
+	"testing"
+)
+
+func TestAddRemoveIAMPolicyMember(t *testing.T) {
+	condition := &benzaiten.IAMCondition{Title: "expires", Expression: `request.time < timestamp("2025-01-01T00:00:00Z")`}
+	policy := &iam.Policy{
+		Version: 1,
+		Bindings: []*iam.Binding{
+			{Role: "roles/viewer", Members: []string{"user:jane@example.com"}},
+		},
+	}
+
+	// the conditional grant gets its own binding
+	if !addIAMPolicyMember(policy, "roles/viewer", "user:joe@example.com", condition) {
+		t.Fatalf("expected the member to be added")
+	}
+	if len(policy.Bindings) != 2 || policy.Version != 3 {
+		t.Fatalf("expected a conditional binding in a version 3 policy, got %d bindings in version %d", len(policy.Bindings), policy.Version)
+	}
+	if addIAMPolicyMember(policy, "roles/viewer", "user:joe@example.com", condition) {
+		t.Fatalf("expected the bound member to be left untouched")
+	}
+
+	// the unconditional grant joins the existing binding
+	if !addIAMPolicyMember(policy, "roles/viewer", "user:joe@example.com", nil) {
+		t.Fatalf("expected the member to be added")
+	}
+	if !slices.Equal(policy.Bindings[0].Members, []string{"user:jane@example.com", "user:joe@example.com"}) {
+		t.Fatalf("unexpected members %v", policy.Bindings[0].Members)
+	}
+
+	// removing the conditional grant drops its binding and keeps the others
+	if !removeIAMPolicyMember(policy, "roles/viewer", "user:joe@example.com", condition) {
+		t.Fatalf("expected the member to be removed")
+	}
+	if len(policy.Bindings) != 1 || !slices.Equal(policy.Bindings[0].Members, []string{"user:jane@example.com", "user:joe@example.com"}) {
+		t.Fatalf("expected only the unconditional binding to remain, got %+v", policy.Bindings)
+	}
+	if removeIAMPolicyMember(policy, "roles/editor", "user:joe@example.com", nil) {
+		t.Fatalf("expected a member of another role to be left untouched")
+	}
+}
+
+func TestModifyIAMPolicyRetriesOnConflict(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockServiceAccountsInterface := gcp.NewMockServiceAccountsInterface(mockCtrl)
+	mockGetIamPolicyInterface := gcp.NewMockGetIamPolicyServiceAccountsInterface(mockCtrl)
+	mockSetIamPolicyInterface := gcp.NewMockSetIamPolicyServiceAccountsInterface(mockCtrl)
+	email := "app@test-project.iam.gserviceaccount.com"
+
+	// the first write loses against a concurrent binding, the second one is made on the updated policy
+	gomock.InOrder(
+		mockServiceAccountsInterface.EXPECT().GetIamPolicy("test-project", email).Return(mockGetIamPolicyInterface),
+		mockGetIamPolicyInterface.EXPECT().Do().Return(&iam.Policy{Etag: "etag-1"}, nil),
+		mockServiceAccountsInterface.EXPECT().SetIamPolicy("test-project", email, gomock.Any()).Return(mockSetIamPolicyInterface),
+		mockSetIamPolicyInterface.EXPECT().Do().Return(nil, &googleapi.Error{Code: http.StatusConflict}),
+		mockServiceAccountsInterface.EXPECT().GetIamPolicy("test-project", email).Return(mockGetIamPolicyInterface),
+		mockGetIamPolicyInterface.EXPECT().Do().Return(&iam.Policy{
+			Etag:     "etag-2",
+			Bindings: []*iam.Binding{{Role: "roles/iam.serviceAccountUser", Members: []string{"user:jane@example.com"}}},
+		}, nil),
+		mockServiceAccountsInterface.EXPECT().SetIamPolicy("test-project", email, &iam.SetIamPolicyRequest{
+			Policy: &iam.Policy{
+				Etag: "etag-2",
+				Bindings: []*iam.Binding{
+					{Role: "roles/iam.serviceAccountUser", Members: []string{"user:jane@example.com"}},
+					{Role: "roles/iam.serviceAccountTokenCreator", Members: []string{"user:joe@example.com"}},
+				},
+			},
+		}).Return(mockSetIamPolicyInterface),
+		mockSetIamPolicyInterface.EXPECT().Do().Return(&iam.Policy{}, nil),
+	)
+
+	api := &gcp.API{
+		IAM: gcp.IAMService{
+			Clients: gcp.IAMClients{
+				ServiceAccounts: mockServiceAccountsInterface,
+			},
+		},
+		Config: gcp.Config{
+			ProjectId: "test-project",
+		},
+	}
+
+	target := iamPolicyTarget{Kind: gcp.IAMResourceServiceAccount, Name: "app"}
+	written, err := modifyIAMPolicy(api, target, func(policy *iam.Policy) bool {
+		return addIAMPolicyMember(policy, "roles/iam.serviceAccountTokenCreator", "user:joe@example.com", nil)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !written {
+		t.Fatalf("expected the policy to be written")
+	}
+}
+
+func TestGCPIAMPolicyMemberKeepsForeignGrant(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockServiceAccountsInterface := gcp.NewMockServiceAccountsInterface(mockCtrl)
+	mockGetIamPolicyInterface := gcp.NewMockGetIamPolicyServiceAccountsInterface(mockCtrl)
+	mockSetIamPolicyInterface := gcp.NewMockSetIamPolicyServiceAccountsInterface(mockCtrl)
+	email := "app@test-project.iam.gserviceaccount.com"
+	foreign := &iam.Policy{
+		Etag:     "etag-1",
+		Bindings: []*iam.Binding{{Role: "roles/iam.serviceAccountUser", Members: []string{"user:jane@example.com"}}},
+	}
+
+	gsa := &benzaiten.GCPServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec:       benzaiten.GCPServiceAccountSpec{AccountID: "app"},
+		Status:     benzaiten.GCPServiceAccountStatus{Email: email},
+	}
+	// jane was bound with gcloud before the GCPIAMPolicyMember was created
+	gpm := &benzaiten.GCPIAMPolicyMember{
+		ObjectMeta: metav1.ObjectMeta{Name: "jane", Namespace: "default", Finalizers: []string{gcpFinalizer}},
+		Spec: benzaiten.GCPIAMPolicyMemberSpec{
+			Resource: benzaiten.IAMPolicyResource{Kind: benzaiten.IAMPolicyResourceGCPServiceAccount, Name: "app"},
+			Member:   "user:jane@example.com",
+			Role:     "roles/iam.serviceAccountUser",
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(Scheme).WithObjects(gsa, gpm).WithStatusSubresource(gpm).Build()
+	cr := GCPIAMPolicyMemberReconciler{
+		Client:        c,
+		Scheme:        Scheme,
+		eventRecorder: record.NewFakeRecorder(10),
+		cloud: CloudProviders{GCP: &gcp.API{
+			IAM:    gcp.IAMService{Clients: gcp.IAMClients{ServiceAccounts: mockServiceAccountsInterface}},
+			Config: gcp.Config{ProjectId: "test-project"},
+		}},
+		Log: logr.Discard(),
+	}
+
+	// the member is found bound twice, once for the grant check and once for the binding, the policy is not written
+	mockServiceAccountsInterface.EXPECT().GetIamPolicy("test-project", email).Return(mockGetIamPolicyInterface).Times(2)
+	mockGetIamPolicyInterface.EXPECT().Do().Return(foreign, nil).Times(2)
+	_, err := cr.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(gpm)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	current := benzaiten.GCPIAMPolicyMember{}
+	err = c.Get(ctx, client.ObjectKeyFromObject(gpm), &current)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if current.Status.Member != "user:jane@example.com" || current.Status.Granted {
+		t.Fatalf("expected the member to be recorded as not granted, got %+v", current.Status)
+	}
+
+	// deleting it leaves the foreign grant in place, the policy is neither read nor written
+	_, err = cr.reconcileDelete(ctx, logr.Discard(), &current)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// a member granted by the GCPIAMPolicyMember is removed on deletion
+	current.Status.Granted = true
+	current.Finalizers = []string{gcpFinalizer}
+	mockServiceAccountsInterface.EXPECT().GetIamPolicy("test-project", email).Return(mockGetIamPolicyInterface)
+	mockGetIamPolicyInterface.EXPECT().Do().Return(foreign, nil)
+	mockServiceAccountsInterface.EXPECT().SetIamPolicy("test-project", email, &iam.SetIamPolicyRequest{
+		Policy: &iam.Policy{Etag: "etag-1", Bindings: []*iam.Binding{}},
+	}).Return(mockSetIamPolicyInterface)
+	mockSetIamPolicyInterface.EXPECT().Do().Return(&iam.Policy{}, nil)
+	cr.Client = fake.NewClientBuilder().WithScheme(Scheme).WithObjects(gsa, &current).Build()
+	_, err = cr.reconcileDelete(ctx, logr.Discard(), &current)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -5,12 +5,34 @@ import (
 	"fmt"
 	"github.com/go-logr/logr"
 	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultInstanceNetwork   = "global/networks/default"
+	metadataKeySSHKeys       = "ssh-keys"
+	metadataKeyEnableOSLogin = "enable-oslogin"
+	roleOSLogin              = "roles/compute.osLogin"
+	roleOSAdminLogin         = "roles/compute.osAdminLogin"
+	addressTypeInternal      = "INTERNAL"
+	addressTypeExternal      = "EXTERNAL"
 )
 
 type GCPInstanceReconciler struct {
@@ -21,11 +43,23 @@ type GCPInstanceReconciler struct {
 	Log           logr.Logger
 }
 
+func (cr *GCPInstanceReconciler) updateStatus(ctx context.Context, gi *benzaiten.GCPInstance, is benzaiten.InstanceStatus, msg, rsn, et string) error {
+	cr.eventRecorder.Event(gi, et, rsn, msg)
+	gi.Status.Phase = is
+
+	err := cr.Status().Update(ctx, gi)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (cr *GCPInstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := cr.Log.WithValues("gcpinstance", req.NamespacedName)
 
-	gk := benzaiten.GCPInstance{}
-	err := cr.Get(ctx, req.NamespacedName, &gk)
+	gi := benzaiten.GCPInstance{}
+	err := cr.Get(ctx, req.NamespacedName, &gi)
 	if err != nil {
 		if kerr.IsNotFound(err) {
 			logger.Info("gcpinstance not found")
@@ -34,20 +68,670 @@ func (cr *GCPInstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return ctrl.Result{}, err
 	}
 
-	// TODO: Add reconciliation logic here
+	// resolve metadata values referencing ConfigMaps and Secrets
+	metadata, err := cr.resolveMetadata(ctx, &gi)
+	if err != nil {
+		logger.Error(err, "error resolving gcpinstance metadata")
+		cr.eventRecorder.Event(&gi, "Warning", "MetadataUnresolved", err.Error())
+		return ctrl.Result{}, err
+	}
+
+	// resolve the reserved IP of the instance
+	address, addressCondition, err := cr.resolveAddress(ctx, &gi)
+	if err != nil {
+		logger.Error(err, "error resolving gcpinstance address")
+		return ctrl.Result{}, err
+	}
+
+	// resolve the network and subnetwork of the instance
+	attachment, referencesCondition, err := resolveNetworkAttachment(ctx, cr.Client, &gi, benzaiten.InstanceConditionReferencesResolved, gi.Spec.NetworkRef, gi.Spec.SubnetworkRef)
+	if err != nil {
+		logger.Error(err, "error resolving gcpinstance references")
+		return ctrl.Result{}, err
+	}
+
+	// does instance exist in GCP?
+	instance, err := cr.cloud.GCP.GetInstance(gi.Spec.Zone, gi.Spec.Name)
+	if err != nil && notFoundGCPResource(err) {
+		// instance does not exist in GCP
+		if referencesCondition.Status == metav1.ConditionFalse {
+			// the instance must be created in its network
+			logger.Info("gcpinstance references not ready", "reason", referencesCondition.Message)
+			if meta.SetStatusCondition(&gi.Status.Conditions, referencesCondition) {
+				err = cr.Status().Update(ctx, &gi)
+				if err != nil {
+					logger.Error(err, "error updating gcpinstance status")
+					return ctrl.Result{}, err
+				}
+			}
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		}
+		if gi.Spec.AddressRef != nil && address == nil {
+			// the instance must be created with its reserved IP
+			logger.Info("gcpinstance address not ready", "reason", addressCondition.Message)
+			if meta.SetStatusCondition(&gi.Status.Conditions, addressCondition) {
+				err = cr.Status().Update(ctx, &gi)
+				if err != nil {
+					logger.Error(err, "error updating gcpinstance status")
+					return ctrl.Result{}, err
+				}
+			}
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		}
+		logger.Info("gcpinstance not found, creating instance...")
+		meta.SetStatusCondition(&gi.Status.Conditions, referencesCondition)
+		op, err := cr.cloud.GCP.CreateInstance(gi.Spec.Zone, newComputeInstance(&gi, attachment, metadata, address))
+		if err != nil {
+			logger.Error(err, "error creating gcpinstance")
+			return ctrl.Result{}, err
+		}
+		err = cr.updateStatus(ctx, &gi, benzaiten.InstanceStatusProvisioning, "GCP Instance provisioning", "InstanceProvisioning", "Normal")
+		if err != nil {
+			logger.Error(err, "error updating gcpinstance status")
+			return ctrl.Result{}, err
+		}
+		// wait for the instance to be created
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitZoneOperation(gi.Spec.Zone, op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error creating gcpinstance")
+			if err := cr.updateStatus(ctx, &gi, benzaiten.InstanceStatusError, "GCP Instance creation failed", "InstanceFailedState", "Warning"); err != nil {
+				logger.Error(err, "error updating gcpinstance status")
+			}
+			return ctrl.Result{}, err
+		}
+		instance, err = cr.cloud.GCP.GetInstance(gi.Spec.Zone, gi.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error verifying instance status")
+			return ctrl.Result{}, err
+		}
+		observeInstance(&gi.Status, instance)
+		gi.Status.MetadataKeys = metadataKeys(metadata)
+		gi.Status.SSHKeys = sshKeyLines(metadata)
+		gi.Status.OSLoginRole, gi.Status.OSLoginPrincipals, err = cr.syncOSLogin(&gi)
+		if err != nil {
+			logger.Error(err, "error updating gcpinstance os login principals")
+			return ctrl.Result{}, err
+		}
+		err = cr.updateStatus(ctx, &gi, benzaiten.InstanceStatus(instance.Status), "GCP Instance created", "InstanceCreated", "Normal")
+		if err != nil {
+			logger.Error(err, "error updating gcpinstance status")
+			return ctrl.Result{}, err
+		}
+		// requeue to attach the disks of the new instance
+		return ctrl.Result{Requeue: true}, nil
+	} else if err != nil {
+		logger.Error(err, "error getting gcpinstance")
+		return ctrl.Result{}, err
+	}
+
+	// synchronize changes if exists
+	logger.Info("gcpinstance found, synchronizing...")
+	// keys and ssh-keys lines set outside the spec, e.g. by gcloud compute ssh, are left untouched
+	desired := mergeSSHKeys(instance.Metadata, metadata, gi.Status.SSHKeys)
+	if items, changed := mergeMetadata(instance.Metadata, desired, gi.Status.MetadataKeys); changed {
+		logger.Info("gcpinstance metadata changed, updating...")
+		fingerprint := ""
+		if instance.Metadata != nil {
+			fingerprint = instance.Metadata.Fingerprint
+		}
+		op, err := cr.cloud.GCP.SetInstanceMetadata(gi.Spec.Zone, gi.Spec.Name, &compute.Metadata{
+			Fingerprint: fingerprint,
+			Items:       items,
+		})
+		if err != nil {
+			logger.Error(err, "error updating gcpinstance metadata")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitZoneOperation(gi.Spec.Zone, op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error updating gcpinstance metadata")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gi, "Normal", "MetadataUpdated", "GCP Instance metadata updated")
+	}
+
+	osLoginRole, osLoginPrincipals, err := cr.syncOSLogin(&gi)
+	if err != nil {
+		logger.Error(err, "error updating gcpinstance os login principals")
+		return ctrl.Result{}, err
+	}
+
+	instance, machineTypeCondition, err := cr.syncMachineType(ctx, &gi, instance)
+	if err != nil {
+		logger.Error(err, "error resizing gcpinstance")
+		return ctrl.Result{}, err
+	}
+
+	disksCondition, attachedDisks, err := cr.syncDisks(ctx, &gi, instance)
+	if err != nil {
+		logger.Error(err, "error attaching gcpinstance disks")
+		return ctrl.Result{}, err
+	}
+
+	instance, addressCondition, err = cr.syncAddress(ctx, &gi, instance, address, addressCondition)
+	if err != nil {
+		logger.Error(err, "error binding gcpinstance address")
+		return ctrl.Result{}, err
+	}
+
+	previous := gi.DeepCopyObject().(*benzaiten.GCPInstance)
+	meta.SetStatusCondition(&gi.Status.Conditions, machineTypeCondition)
+	meta.SetStatusCondition(&gi.Status.Conditions, disksCondition)
+	meta.SetStatusCondition(&gi.Status.Conditions, referencesCondition)
+	if gi.Spec.AddressRef != nil {
+		meta.SetStatusCondition(&gi.Status.Conditions, addressCondition)
+	} else {
+		meta.RemoveStatusCondition(&gi.Status.Conditions, benzaiten.InstanceConditionAddressBound)
+	}
+	gi.Status.AttachedDisks = attachedDisks
+	gi.Status.MetadataKeys = metadataKeys(metadata)
+	gi.Status.SSHKeys = sshKeyLines(metadata)
+	gi.Status.OSLoginRole = osLoginRole
+	gi.Status.OSLoginPrincipals = osLoginPrincipals
+	if gi.Status.Phase != benzaiten.InstanceStatus(instance.Status) {
+		cr.eventRecorder.Event(&gi, "Normal", "InstanceStatusChanged", fmt.Sprintf("GCP Instance %s", instance.Status))
+		gi.Status.Phase = benzaiten.InstanceStatus(instance.Status)
+	}
+	observeInstance(&gi.Status, instance)
+	if !equality.Semantic.DeepEqual(previous.Status, gi.Status) {
+		err = cr.Status().Update(ctx, &gi)
+		if err != nil {
+			logger.Error(err, "error updating gcpinstance status")
+			return ctrl.Result{}, err
+		}
+	}
 
 	logger.Info("gcp instance reconciled")
-	cr.eventRecorder.Event(&gk, "Normal", "Reconciled", "GCP Instance reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+// syncMachineType resizes the instance if its machine type differs from the spec and disruption is allowed.
+// It returns the refreshed instance and the MachineTypeSynced condition.
+func (cr *GCPInstanceReconciler) syncMachineType(ctx context.Context, gi *benzaiten.GCPInstance, instance *compute.Instance) (*compute.Instance, metav1.Condition, error) {
+	condition := metav1.Condition{
+		Type:               benzaiten.InstanceConditionMachineTypeSynced,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gi.Generation,
+		Reason:             "Synced",
+		Message:            fmt.Sprintf("instance runs with machine type %s", gi.Spec.MachineType),
+	}
+
+	current := lastURLSegment(instance.MachineType)
+	if current == gi.Spec.MachineType {
+		return instance, condition, nil
+	}
+
+	if !gi.Spec.AllowDisruption {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "DisruptionNotAllowed"
+		condition.Message = fmt.Sprintf("resize from %s to %s requires stopping the instance, set allowDisruption to apply it", current, gi.Spec.MachineType)
+		if !meta.IsStatusConditionPresentAndEqual(gi.Status.Conditions, condition.Type, condition.Status) {
+			cr.eventRecorder.Event(gi, "Warning", "ResizePending", condition.Message)
+		}
+		return instance, condition, nil
+	}
+
+	wait := func(op *compute.Operation) error {
+		return waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitZoneOperation(gi.Spec.Zone, op.Name)
+		})
+	}
+
+	// only a stopped instance can be resized
+	wasRunning := instance.Status == string(benzaiten.InstanceStatusRunning)
+	cr.eventRecorder.Event(gi, "Normal", "Resizing", fmt.Sprintf("GCP Instance resizing from %s to %s", current, gi.Spec.MachineType))
+	if wasRunning {
+		op, err := cr.cloud.GCP.StopInstance(gi.Spec.Zone, gi.Spec.Name)
+		if err != nil {
+			return nil, condition, err
+		}
+		if err = wait(op); err != nil {
+			return nil, condition, err
+		}
+	}
+
+	op, err := cr.cloud.GCP.SetInstanceMachineType(gi.Spec.Zone, gi.Spec.Name, gi.Spec.MachineType)
+	if err == nil {
+		err = wait(op)
+	}
+	if err != nil {
+		// bring the instance back with its previous machine type
+		if wasRunning {
+			if op, err := cr.cloud.GCP.StartInstance(gi.Spec.Zone, gi.Spec.Name); err == nil {
+				_ = wait(op)
+			}
+		}
+		return nil, condition, fmt.Errorf("unable to set machine type %s: %w", gi.Spec.MachineType, err)
+	}
+
+	if wasRunning {
+		op, err := cr.cloud.GCP.StartInstance(gi.Spec.Zone, gi.Spec.Name)
+		if err != nil {
+			return nil, condition, err
+		}
+		if err = wait(op); err != nil {
+			return nil, condition, err
+		}
+	}
+	cr.eventRecorder.Event(gi, "Normal", "Resized", fmt.Sprintf("GCP Instance resized to %s", gi.Spec.MachineType))
+
+	instance, err = cr.cloud.GCP.GetInstance(gi.Spec.Zone, gi.Spec.Name)
+	if err != nil {
+		return nil, condition, err
+	}
+
+	return instance, condition, nil
+}
+
+// syncDisks attaches the GCPDisks of the spec and detaches the ones the controller attached and which were removed from it.
+// It returns the DisksAttached condition and the self links of the GCPDisks attached to the instance.
+func (cr *GCPInstanceReconciler) syncDisks(ctx context.Context, gi *benzaiten.GCPInstance, instance *compute.Instance) (metav1.Condition, []string, error) {
+	condition := metav1.Condition{
+		Type:               benzaiten.InstanceConditionDisksAttached,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gi.Generation,
+		Reason:             "Attached",
+		Message:            "all disks attached",
+	}
+
+	attached := map[string]*compute.AttachedDisk{}
+	for _, d := range instance.Disks {
+		if !d.Boot {
+			attached[d.Source] = d
+		}
+	}
+
+	var desired, pending []string
+	for _, ref := range gi.Spec.Disks {
+		gd := benzaiten.GCPDisk{}
+		err := cr.Get(ctx, types.NamespacedName{Namespace: gi.Namespace, Name: ref.DiskRef.Name}, &gd)
+		if err != nil {
+			if kerr.IsNotFound(err) {
+				pending = append(pending, ref.DiskRef.Name)
+				continue
+			}
+			return condition, nil, err
+		}
+		if gd.Spec.Zone != gi.Spec.Zone {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "ZoneMismatch"
+			condition.Message = fmt.Sprintf("GCPDisk %s resides in zone %s, not in %s", gd.Name, gd.Spec.Zone, gi.Spec.Zone)
+			return condition, gi.Status.AttachedDisks, nil
+		}
+		if gd.Status.SelfLink == "" {
+			pending = append(pending, ref.DiskRef.Name)
+			continue
+		}
+		desired = append(desired, gd.Status.SelfLink)
+		if _, ok := attached[gd.Status.SelfLink]; ok {
+			continue
+		}
+		if gd.Status.Phase != benzaiten.DiskStatusReady {
+			pending = append(pending, ref.DiskRef.Name)
+			continue
+		}
+
+		mode := "READ_WRITE"
+		if ref.ReadOnly {
+			mode = "READ_ONLY"
+		}
+		op, err := cr.cloud.GCP.AttachInstanceDisk(gi.Spec.Zone, gi.Spec.Name, &compute.AttachedDisk{
+			Source:     gd.Status.SelfLink,
+			DeviceName: ref.DeviceName,
+			Mode:       mode,
+		})
+		if err != nil {
+			return condition, nil, fmt.Errorf("unable to attach disk %s: %w", gd.Spec.Name, err)
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitZoneOperation(gi.Spec.Zone, op.Name)
+		})
+		if err != nil {
+			return condition, nil, fmt.Errorf("unable to attach disk %s: %w", gd.Spec.Name, err)
+		}
+		attached[gd.Status.SelfLink] = &compute.AttachedDisk{Source: gd.Status.SelfLink}
+		cr.eventRecorder.Event(gi, "Normal", "DiskAttached", fmt.Sprintf("GCP Disk %s attached", gd.Spec.Name))
+	}
+
+	// detach the disks attached by the controller which are no longer referenced
+	for _, link := range gi.Status.AttachedDisks {
+		d, ok := attached[link]
+		if !ok || slices.Contains(desired, link) {
+			continue
+		}
+		op, err := cr.cloud.GCP.DetachInstanceDisk(gi.Spec.Zone, gi.Spec.Name, d.DeviceName)
+		if err != nil {
+			return condition, nil, fmt.Errorf("unable to detach disk %s: %w", lastURLSegment(link), err)
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitZoneOperation(gi.Spec.Zone, op.Name)
+		})
+		if err != nil {
+			return condition, nil, fmt.Errorf("unable to detach disk %s: %w", lastURLSegment(link), err)
+		}
+		delete(attached, link)
+		cr.eventRecorder.Event(gi, "Normal", "DiskDetached", fmt.Sprintf("GCP Disk %s detached", lastURLSegment(link)))
+	}
+
+	var links []string
+	for _, link := range desired {
+		if _, ok := attached[link]; ok {
+			links = append(links, link)
+		}
+	}
+	if len(pending) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "DisksNotReady"
+		condition.Message = fmt.Sprintf("waiting for GCPDisks %s", strings.Join(pending, ", "))
+	}
+
+	return condition, links, nil
+}
+
+// resolveAddress returns the GCPAddress referenced by the instance, or nil and the AddressBound condition explaining
+// why it cannot be used yet
+func (cr *GCPInstanceReconciler) resolveAddress(ctx context.Context, gi *benzaiten.GCPInstance) (*benzaiten.GCPAddress, metav1.Condition, error) {
+	condition := metav1.Condition{
+		Type:               benzaiten.InstanceConditionAddressBound,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: gi.Generation,
+		Reason:             "AddressNotReady",
+	}
+	if gi.Spec.AddressRef == nil {
+		return nil, condition, nil
+	}
+
+	ga := benzaiten.GCPAddress{}
+	err := cr.Get(ctx, types.NamespacedName{Namespace: gi.Namespace, Name: gi.Spec.AddressRef.Name}, &ga)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			condition.Message = fmt.Sprintf("GCPAddress %s not found", gi.Spec.AddressRef.Name)
+			return nil, condition, nil
+		}
+		return nil, condition, err
+	}
+	if region := zoneRegion(gi.Spec.Zone); ga.Spec.Region != region {
+		condition.Reason = "RegionMismatch"
+		condition.Message = fmt.Sprintf("GCPAddress %s is not a regional address of region %s", ga.Name, region)
+		return nil, condition, nil
+	}
+	if ga.Status.Address == "" {
+		condition.Message = fmt.Sprintf("waiting for GCPAddress %s to be reserved", ga.Name)
+		return nil, condition, nil
+	}
+
+	return &ga, condition, nil
+}
+
+// syncAddress binds the reserved IP to the primary network interface of the instance.
+// It returns the refreshed instance and the AddressBound condition.
+func (cr *GCPInstanceReconciler) syncAddress(ctx context.Context, gi *benzaiten.GCPInstance, instance *compute.Instance, address *benzaiten.GCPAddress, condition metav1.Condition) (*compute.Instance, metav1.Condition, error) {
+	if address == nil || len(instance.NetworkInterfaces) == 0 {
+		return instance, condition, nil
+	}
+	ip := address.Status.Address
+	nic := instance.NetworkInterfaces[0]
+	condition.Status = metav1.ConditionTrue
+	condition.Reason = "Bound"
+	condition.Message = fmt.Sprintf("instance uses the IP %s of GCPAddress %s", ip, address.Name)
+
+	if address.Spec.AddressType == addressTypeInternal {
+		if nic.NetworkIP != ip {
+			// the internal IP is only assigned when the instance is created
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "RecreateRequired"
+			condition.Message = fmt.Sprintf("internal IP %s cannot be changed to %s, recreate the instance to use it", nic.NetworkIP, ip)
+		}
+		return instance, condition, nil
+	}
+
+	var current *compute.AccessConfig
+	if len(nic.AccessConfigs) > 0 {
+		current = nic.AccessConfigs[0]
+	}
+	if current != nil && current.NatIP == ip {
+		return instance, condition, nil
+	}
+	if !gi.Spec.AllowDisruption {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "DisruptionNotAllowed"
+		condition.Message = fmt.Sprintf("swapping the external IP to %s interrupts the connectivity of the instance, set allowDisruption to apply it", ip)
+		if !meta.IsStatusConditionPresentAndEqual(gi.Status.Conditions, condition.Type, condition.Status) {
+			cr.eventRecorder.Event(gi, "Warning", "AddressPending", condition.Message)
+		}
+		return instance, condition, nil
+	}
+
+	wait := func(op *compute.Operation) error {
+		return waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitZoneOperation(gi.Spec.Zone, op.Name)
+		})
+	}
+
+	// an interface has a single access config, the ephemeral one is replaced
+	if current != nil {
+		op, err := cr.cloud.GCP.DeleteInstanceAccessConfig(gi.Spec.Zone, gi.Spec.Name, current.Name, nic.Name)
+		if err != nil {
+			return nil, condition, fmt.Errorf("unable to remove access config %s: %w", current.Name, err)
+		}
+		if err = wait(op); err != nil {
+			return nil, condition, fmt.Errorf("unable to remove access config %s: %w", current.Name, err)
+		}
+	}
+	op, err := cr.cloud.GCP.AddInstanceAccessConfig(gi.Spec.Zone, gi.Spec.Name, nic.Name, &compute.AccessConfig{
+		Name:  "External NAT",
+		Type:  "ONE_TO_ONE_NAT",
+		NatIP: ip,
+	})
+	if err != nil {
+		return nil, condition, fmt.Errorf("unable to bind address %s: %w", ip, err)
+	}
+	if err = wait(op); err != nil {
+		return nil, condition, fmt.Errorf("unable to bind address %s: %w", ip, err)
+	}
+	cr.eventRecorder.Event(gi, "Normal", "AddressBound", fmt.Sprintf("GCP Instance external IP set to %s", ip))
+
+	instance, err = cr.cloud.GCP.GetInstance(gi.Spec.Zone, gi.Spec.Name)
+	if err != nil {
+		return nil, condition, err
+	}
+
+	return instance, condition, nil
+}
 
-	return ctrl.Result{}, nil
+// resolveMetadata builds the instance metadata items, reading referenced ConfigMap and Secret keys
+func (cr *GCPInstanceReconciler) resolveMetadata(ctx context.Context, gi *benzaiten.GCPInstance) ([]*compute.MetadataItems, error) {
+	items, err := resolveMetadataItems(ctx, cr.Client, gi.Namespace, gi.Spec.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return cr.resolveSSHMetadata(ctx, gi, items)
+}
+
+// resolveSSHMetadata merges the SSH access of the GCPInstance into the metadata items
+func (cr *GCPInstanceReconciler) resolveSSHMetadata(ctx context.Context, gi *benzaiten.GCPInstance, items []*compute.MetadataItems) ([]*compute.MetadataItems, error) {
+	if gi.Spec.SSH == nil {
+		return items, nil
+	}
+
+	if gi.Spec.SSH.OSLogin != nil {
+		enabled := "TRUE"
+		return append(items, &compute.MetadataItems{
+			Key:   metadataKeyEnableOSLogin,
+			Value: &enabled,
+		}), nil
+	}
+
+	var keys []string
+	for _, k := range gi.Spec.SSH.Keys {
+		// a removed Secret or key revokes the access instead of failing the reconciliation
+		ref := k.SecretKeyRef
+		optional := true
+		ref.Optional = &optional
+		value, found, err := getSecretKey(ctx, cr.Client, gi.Namespace, &ref)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve ssh keys of %s: %w", k.Username, err)
+		}
+		if !found {
+			continue
+		}
+		for _, line := range strings.Split(value, "\n") {
+			line = strings.TrimSpace(line)
+			if line != "" {
+				keys = append(keys, fmt.Sprintf("%s:%s", k.Username, line))
+			}
+		}
+	}
+	if len(keys) == 0 {
+		return items, nil
+	}
+
+	// merge with the ssh-keys entry set explicitly, if any
+	for _, item := range items {
+		if item.Key == metadataKeySSHKeys {
+			merged := strings.Join(append([]string{strings.TrimSpace(*item.Value)}, keys...), "\n")
+			item.Value = &merged
+			return items, nil
+		}
+	}
+	merged := strings.Join(keys, "\n")
+
+	return append(items, &compute.MetadataItems{
+		Key:   metadataKeySSHKeys,
+		Value: &merged,
+	}), nil
+}
+
+// syncOSLogin grants the OS Login role of the spec on the instance to the configured principals and revokes the
+// grants recorded in the status that are no longer desired. It returns the granted role and principals.
+func (cr *GCPInstanceReconciler) syncOSLogin(gi *benzaiten.GCPInstance) (string, []string, error) {
+	role, principals := "", []string(nil)
+	if gi.Spec.SSH != nil && gi.Spec.SSH.OSLogin != nil && len(gi.Spec.SSH.OSLogin.Principals) > 0 {
+		role = roleOSLogin
+		if gi.Spec.SSH.OSLogin.Admin {
+			role = roleOSAdminLogin
+		}
+		principals = slices.Clone(gi.Spec.SSH.OSLogin.Principals)
+		slices.Sort(principals)
+		principals = slices.Compact(principals)
+	}
+	if role == "" && len(gi.Status.OSLoginPrincipals) == 0 {
+		// nothing granted, nothing to revoke
+		return "", nil, nil
+	}
+
+	policy, err := cr.cloud.GCP.GetInstanceIamPolicy(gi.Spec.Zone, gi.Spec.Name)
+	if err != nil {
+		return gi.Status.OSLoginRole, gi.Status.OSLoginPrincipals, err
+	}
+	if !updateOSLoginBindings(policy, gi.Status.OSLoginRole, gi.Status.OSLoginPrincipals, role, principals) {
+		return role, principals, nil
+	}
+
+	// the policy etag makes the update fail if the policy changed in the meantime
+	_, err = cr.cloud.GCP.SetInstanceIamPolicy(gi.Spec.Zone, gi.Spec.Name, policy)
+	if err != nil {
+		return gi.Status.OSLoginRole, gi.Status.OSLoginPrincipals, err
+	}
+	cr.eventRecorder.Event(gi, "Normal", "OSLoginUpdated", "GCP Instance OS Login principals updated")
+
+	return role, principals, nil
 }
 
 func (cr *GCPInstanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&benzaiten.GCPInstance{}).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForConfigMap)).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForSecret)).
+		Watches(&benzaiten.GCPDisk{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForGCPDisk)).
+		Watches(&benzaiten.GCPAddress{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForGCPAddress)).
+		Watches(&benzaiten.GCPNetwork{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForGCPNetwork)).
+		Watches(&benzaiten.GCPSubnetwork{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForGCPSubnetwork)).
 		Complete(cr)
 }
 
+// requestsForConfigMap returns the GCPInstances referencing the ConfigMap
+func (cr *GCPInstanceReconciler) requestsForConfigMap(ctx context.Context, obj client.Object) []reconcile.Request {
+	return cr.requestsReferencing(ctx, obj.GetNamespace(), func(gi *benzaiten.GCPInstance) bool {
+		return metadataReferences(gi.Spec.Metadata, obj)
+	})
+}
+
+// requestsForSecret returns the GCPInstances referencing the Secret
+func (cr *GCPInstanceReconciler) requestsForSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	return cr.requestsReferencing(ctx, obj.GetNamespace(), func(gi *benzaiten.GCPInstance) bool {
+		if metadataReferences(gi.Spec.Metadata, obj) {
+			return true
+		}
+		if gi.Spec.SSH != nil {
+			for _, k := range gi.Spec.SSH.Keys {
+				if k.SecretKeyRef.Name == obj.GetName() {
+					return true
+				}
+			}
+		}
+		return false
+	})
+}
+
+// requestsForGCPDisk returns the GCPInstances the GCPDisk is attached to
+func (cr *GCPInstanceReconciler) requestsForGCPDisk(ctx context.Context, obj client.Object) []reconcile.Request {
+	return cr.requestsReferencing(ctx, obj.GetNamespace(), func(gi *benzaiten.GCPInstance) bool {
+		for _, d := range gi.Spec.Disks {
+			if d.DiskRef.Name == obj.GetName() {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// requestsForGCPAddress returns the GCPInstances using the GCPAddress
+func (cr *GCPInstanceReconciler) requestsForGCPAddress(ctx context.Context, obj client.Object) []reconcile.Request {
+	return cr.requestsReferencing(ctx, obj.GetNamespace(), func(gi *benzaiten.GCPInstance) bool {
+		return gi.Spec.AddressRef != nil && gi.Spec.AddressRef.Name == obj.GetName()
+	})
+}
+
+// requestsForGCPNetwork returns the GCPInstances of every namespace connected to the GCPNetwork
+func (cr *GCPInstanceReconciler) requestsForGCPNetwork(ctx context.Context, obj client.Object) []reconcile.Request {
+	return cr.requestsReferencing(ctx, "", func(gi *benzaiten.GCPInstance) bool {
+		return refersTo(gi.Spec.NetworkRef, gi.Namespace, obj)
+	})
+}
+
+// requestsForGCPSubnetwork returns the GCPInstances of every namespace connected to the GCPSubnetwork
+func (cr *GCPInstanceReconciler) requestsForGCPSubnetwork(ctx context.Context, obj client.Object) []reconcile.Request {
+	return cr.requestsReferencing(ctx, "", func(gi *benzaiten.GCPInstance) bool {
+		return refersTo(gi.Spec.SubnetworkRef, gi.Namespace, obj)
+	})
+}
+
+func (cr *GCPInstanceReconciler) requestsReferencing(ctx context.Context, namespace string, references func(gi *benzaiten.GCPInstance) bool) []reconcile.Request {
+	gis := benzaiten.GCPInstanceList{}
+	err := cr.List(ctx, &gis, client.InNamespace(namespace))
+	if err != nil {
+		cr.Log.Error(err, "unable to list gcpinstances")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range gis.Items {
+		if references(&gis.Items[i]) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: gis.Items[i].Name, Namespace: gis.Items[i].Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
 func setupGCPInstanceController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
 	eventRecorder := mgr.GetEventRecorderFor("gcpinstance")
 	cc := GCPInstanceReconciler{
@@ -66,3 +750,273 @@ func setupGCPInstanceController(mgr manager.Manager, log logr.Logger, cp CloudPr
 
 	return nil
 }
+
+// newComputeInstance builds the compute instance described by the GCPInstance spec. Resolved references take the place
+// of the network.
+func newComputeInstance(gi *benzaiten.GCPInstance, attachment networkAttachment, metadata []*compute.MetadataItems, address *benzaiten.GCPAddress) *compute.Instance {
+	network := gi.Spec.Network
+	if attachment.Network != "" {
+		network = attachment.Network
+	}
+	// GCP derives the network from the subnetwork
+	if network == "" && attachment.Subnetwork == "" {
+		network = defaultInstanceNetwork
+	}
+
+	instance := &compute.Instance{
+		Name:        gi.Spec.Name,
+		MachineType: fmt.Sprintf("zones/%s/machineTypes/%s", gi.Spec.Zone, gi.Spec.MachineType),
+		Disks: []*compute.AttachedDisk{
+			{
+				Boot:       true,
+				AutoDelete: true,
+				InitializeParams: &compute.AttachedDiskInitializeParams{
+					SourceImage: gi.Spec.SourceImage,
+				},
+			},
+		},
+		NetworkInterfaces: []*compute.NetworkInterface{
+			{
+				Network:    network,
+				Subnetwork: attachment.Subnetwork,
+				AccessConfigs: []*compute.AccessConfig{
+					{
+						Name: "External NAT",
+						Type: "ONE_TO_ONE_NAT",
+					},
+				},
+			},
+		},
+		Metadata: &compute.Metadata{
+			Items: metadata,
+		},
+	}
+	if address != nil {
+		if address.Spec.AddressType == addressTypeInternal {
+			instance.NetworkInterfaces[0].NetworkIP = address.Status.Address
+		} else {
+			instance.NetworkInterfaces[0].AccessConfigs[0].NatIP = address.Status.Address
+		}
+	}
+
+	return instance
+}
+
+// observeInstance records the observed details of the instance in the status
+func observeInstance(status *benzaiten.GCPInstanceStatus, instance *compute.Instance) {
+	status.InstanceID = strconv.FormatUint(instance.Id, 10)
+	status.Zone = lastURLSegment(instance.Zone)
+	status.MachineType = lastURLSegment(instance.MachineType)
+	status.CPUPlatform = instance.CpuPlatform
+	status.InternalIP = ""
+	status.ExternalIP = ""
+	if len(instance.NetworkInterfaces) > 0 {
+		nic := instance.NetworkInterfaces[0]
+		status.InternalIP = nic.NetworkIP
+		for _, ac := range nic.AccessConfigs {
+			if ac.NatIP != "" {
+				status.ExternalIP = ac.NatIP
+				break
+			}
+		}
+	}
+	status.CreationTimestamp = parseGCPTimestamp(instance.CreationTimestamp)
+	status.LastStartTime = parseGCPTimestamp(instance.LastStartTimestamp)
+	status.LastStopTime = parseGCPTimestamp(instance.LastStopTimestamp)
+}
+
+// parseGCPTimestamp parses an RFC3339 GCP timestamp, truncated to the precision of metav1.Time
+func parseGCPTimestamp(ts string) *metav1.Time {
+	if ts == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, ts)
+	if err != nil {
+		return nil
+	}
+	mt := metav1.NewTime(t.Truncate(time.Second))
+	return &mt
+}
+
+// mergeSSHKeys returns the desired items with the lines of the instance ssh-keys entry not set by the controller, e.g.
+// added by gcloud compute ssh, prepended to the desired ssh-keys entry. The lines set by the controller before and no
+// longer desired are dropped.
+func mergeSSHKeys(current *compute.Metadata, desired []*compute.MetadataItems, managed []string) []*compute.MetadataItems {
+	var live []*compute.MetadataItems
+	if current != nil {
+		live = current.Items
+	}
+	desiredLines := sshKeyLines(desired)
+	var foreign []string
+	for _, line := range sshKeyLines(live) {
+		if !slices.Contains(managed, line) && !slices.Contains(desiredLines, line) {
+			foreign = append(foreign, line)
+		}
+	}
+	if len(foreign) == 0 || (len(desiredLines) == 0 && len(managed) == 0) {
+		// nothing to keep, or the entry is not managed by the controller
+		return desired
+	}
+
+	value := strings.Join(append(foreign, desiredLines...), "\n")
+	merged := make([]*compute.MetadataItems, 0, len(desired)+1)
+	for _, item := range desired {
+		if item.Key != metadataKeySSHKeys {
+			merged = append(merged, item)
+		}
+	}
+	return append(merged, &compute.MetadataItems{Key: metadataKeySSHKeys, Value: &value})
+}
+
+// sshKeyLines returns the non-empty lines of the ssh-keys entry of the items
+func sshKeyLines(items []*compute.MetadataItems) []string {
+	var lines []string
+	for _, item := range items {
+		if item.Key != metadataKeySSHKeys || item.Value == nil {
+			continue
+		}
+		for _, line := range strings.Split(*item.Value, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				lines = append(lines, line)
+			}
+		}
+	}
+	return lines
+}
+
+// mergeMetadata applies the desired items to the instance metadata. Keys managed by the controller and missing from
+// the desired items are removed, the other keys of the instance are kept. It returns the merged items and reports
+// whether they differ from the instance metadata.
+func mergeMetadata(current *compute.Metadata, desired []*compute.MetadataItems, managed []string) ([]*compute.MetadataItems, bool) {
+	var items []*compute.MetadataItems
+	if current != nil {
+		items = current.Items
+	}
+
+	values := make(map[string]string, len(desired))
+	for _, item := range desired {
+		values[item.Key] = *item.Value
+	}
+
+	changed := false
+	merged := make([]*compute.MetadataItems, 0, len(items)+len(desired))
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		value, ok := values[item.Key]
+		if !ok {
+			if slices.Contains(managed, item.Key) {
+				// the key was dropped from the spec
+				changed = true
+				continue
+			}
+			merged = append(merged, item)
+			continue
+		}
+		seen[item.Key] = true
+		if item.Value == nil || *item.Value != value {
+			changed = true
+		}
+		merged = append(merged, &compute.MetadataItems{Key: item.Key, Value: &value})
+	}
+	for _, item := range desired {
+		if !seen[item.Key] {
+			changed = true
+			merged = append(merged, item)
+		}
+	}
+
+	return merged, changed
+}
+
+// metadataKeys returns the sorted keys of the metadata items
+func metadataKeys(items []*compute.MetadataItems) []string {
+	keys := make([]string, 0, len(items))
+	for _, item := range items {
+		keys = append(keys, item.Key)
+	}
+	slices.Sort(keys)
+	if len(keys) == 0 {
+		return nil
+	}
+	return keys
+}
+
+// updateOSLoginBindings revokes the previously granted principals that are no longer granted the role and grants
+// the role to the principals. Members granted the roles outside the controller are kept. It reports whether the
+// policy changed.
+func updateOSLoginBindings(policy *compute.Policy, grantedRole string, granted []string, role string, principals []string) bool {
+	changed := false
+	for _, member := range granted {
+		if grantedRole == role && slices.Contains(principals, member) {
+			continue
+		}
+		if removePolicyRoleMember(policy, grantedRole, member) {
+			changed = true
+		}
+	}
+	for _, member := range principals {
+		if addPolicyRoleMember(policy, role, member) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// addPolicyRoleMember adds the member to the unconditional binding of the role. It reports whether the policy changed.
+func addPolicyRoleMember(policy *compute.Policy, role, member string) bool {
+	for _, b := range policy.Bindings {
+		if b.Role != role || b.Condition != nil {
+			continue
+		}
+		if slices.Contains(b.Members, member) {
+			return false
+		}
+		b.Members = append(b.Members, member)
+		return true
+	}
+
+	policy.Bindings = append(policy.Bindings, &compute.Binding{
+		Role:    role,
+		Members: []string{member},
+	})
+	return true
+}
+
+// removePolicyRoleMember removes the member from the unconditional binding of the role, removing the binding if it
+// has no members left. It reports whether the policy changed.
+func removePolicyRoleMember(policy *compute.Policy, role, member string) bool {
+	for i, b := range policy.Bindings {
+		if b.Role != role || b.Condition != nil || !slices.Contains(b.Members, member) {
+			continue
+		}
+		b.Members = slices.DeleteFunc(b.Members, func(m string) bool { return m == member })
+		if len(b.Members) == 0 {
+			policy.Bindings = slices.Delete(policy.Bindings, i, i+1)
+		}
+		return true
+	}
+	return false
+}
+
+// lastURLSegment returns the resource name of a GCP resource URL, e.g. the machine type of
+// https://www.googleapis.com/compute/v1/projects/p/zones/z/machineTypes/e2-medium
+func lastURLSegment(url string) string {
+	return url[strings.LastIndex(url, "/")+1:]
+}
+
+// lastURLSegments returns the names of the resources identified by the URLs
+func lastURLSegments(urls []string) []string {
+	names := make([]string, 0, len(urls))
+	for _, url := range urls {
+		names = append(names, lastURLSegment(url))
+	}
+	return names
+}
+
+// zoneRegion returns the region of the zone, e.g. us-central1 for us-central1-a
+func zoneRegion(zone string) string {
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		return zone[:i]
+	}
+	return zone
+}
@@ -0,0 +1,392 @@
+package controllers
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"github.com/muraduiurie/cloudcontroller/pkg/cloudproviders/gcp"
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"maps"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestMergeMetadata(t *testing.T) {
+	script := "#!/bin/bash"
+	other := "echo"
+	sshKeys := "jane:ssh-ed25519 AAAA jane@laptop"
+	current := &compute.Metadata{
+		Fingerprint: "test-fingerprint",
+		Items: []*compute.MetadataItems{
+			{Key: "startup-script", Value: &script},
+			{Key: "ssh-keys", Value: &sshKeys},
+		},
+	}
+
+	if _, changed := mergeMetadata(current, []*compute.MetadataItems{{Key: "startup-script", Value: &script}}, []string{"startup-script"}); changed {
+		t.Fatalf("expected metadata to be in sync")
+	}
+
+	// a changed value is overwritten, the ssh keys set by gcloud are kept
+	items, changed := mergeMetadata(current, []*compute.MetadataItems{{Key: "startup-script", Value: &other}}, []string{"startup-script"})
+	if !changed {
+		t.Fatalf("expected changed value to be out of sync")
+	}
+	if len(items) != 2 || *items[0].Value != other || items[1].Key != "ssh-keys" {
+		t.Fatalf("unexpected merged metadata %v", items)
+	}
+
+	// a managed key dropped from the spec is removed
+	items, changed = mergeMetadata(current, nil, []string{"startup-script"})
+	if !changed {
+		t.Fatalf("expected removed item to be out of sync")
+	}
+	if len(items) != 1 || items[0].Key != "ssh-keys" {
+		t.Fatalf("expected only the ssh keys to be kept, got %v", items)
+	}
+
+	// keys the controller never set are left untouched
+	if _, changed := mergeMetadata(current, nil, nil); changed {
+		t.Fatalf("expected foreign keys to be kept")
+	}
+	if _, changed := mergeMetadata(nil, nil, nil); changed {
+		t.Fatalf("expected empty metadata to be in sync")
+	}
+}
+
+func TestMergeSSHKeys(t *testing.T) {
+	live := "jane:ssh-ed25519 AAAA jane@laptop\nops:ssh-ed25519 BBBB old@ops\nops:ssh-ed25519 CCCC ops@bastion"
+	current := &compute.Metadata{Items: []*compute.MetadataItems{{Key: "ssh-keys", Value: &live}}}
+	// the controller set the two ops keys, the old one was removed from the Secret
+	managed := []string{"ops:ssh-ed25519 BBBB old@ops", "ops:ssh-ed25519 CCCC ops@bastion"}
+	value := "ops:ssh-ed25519 CCCC ops@bastion"
+	desired := []*compute.MetadataItems{{Key: "ssh-keys", Value: &value}}
+
+	items, changed := mergeMetadata(current, mergeSSHKeys(current, desired, managed), []string{"ssh-keys"})
+	if !changed {
+		t.Fatalf("expected the removed key to be out of sync")
+	}
+	expected := "jane:ssh-ed25519 AAAA jane@laptop\nops:ssh-ed25519 CCCC ops@bastion"
+	if len(items) != 1 || *items[0].Value != expected {
+		t.Fatalf("expected the gcloud key to be kept, got %q", *items[0].Value)
+	}
+	if *desired[0].Value != value {
+		t.Fatalf("expected the desired items to be left untouched")
+	}
+
+	// dropping the ssh keys from the spec keeps the gcloud key
+	current.Items[0].Value = &expected
+	items, _ = mergeMetadata(current, mergeSSHKeys(current, nil, []string{value}), []string{"ssh-keys"})
+	if len(items) != 1 || *items[0].Value != "jane:ssh-ed25519 AAAA jane@laptop" {
+		t.Fatalf("expected only the gcloud key to be kept, got %v", items)
+	}
+
+	// an entry the controller never managed is left to mergeMetadata
+	if merged := mergeSSHKeys(current, nil, nil); merged != nil {
+		t.Fatalf("expected no ssh-keys entry, got %v", merged)
+	}
+}
+
+func TestResolveMetadata(t *testing.T) {
+	gi := &benzaiten.GCPInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Spec: benzaiten.GCPInstanceSpec{
+			Metadata: []benzaiten.MetadataItem{
+				{Key: "env", Value: "production"},
+				{Key: "startup-script", ValueFrom: &benzaiten.MetadataValueSource{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "test-scripts"},
+						Key:                  "startup.sh",
+					},
+				}},
+				{Key: "api-token", ValueFrom: &benzaiten.MetadataValueSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "test-token"},
+						Key:                  "token",
+					},
+				}},
+			},
+			SSH: &benzaiten.SSHAccess{
+				Keys: []benzaiten.SSHKey{
+					{Username: "jane", SecretKeyRef: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "test-ssh"},
+						Key:                  "authorized_keys",
+					}},
+					// a missing Secret revokes the access instead of failing
+					{Username: "bob", SecretKeyRef: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "test-missing"},
+						Key:                  "authorized_keys",
+					}},
+				},
+			},
+		},
+	}
+	objs := []client.Object{
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-scripts", Namespace: "default"},
+			Data:       map[string]string{"startup.sh": "#!/bin/bash"},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-token", Namespace: "default"},
+			Data:       map[string][]byte{"token": []byte("s3cr3t")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-ssh", Namespace: "default"},
+			Data:       map[string][]byte{"authorized_keys": []byte("ssh-ed25519 AAAA jane@laptop\n\nssh-rsa BBBB jane@desktop\n")},
+		},
+	}
+	cr := &GCPInstanceReconciler{
+		Client: fake.NewClientBuilder().WithScheme(Scheme).WithObjects(objs...).Build(),
+	}
+
+	items, err := cr.resolveMetadata(context.Background(), gi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	values := map[string]string{}
+	for _, item := range items {
+		values[item.Key] = *item.Value
+	}
+	expected := map[string]string{
+		"env":            "production",
+		"startup-script": "#!/bin/bash",
+		"api-token":      "s3cr3t",
+		"ssh-keys":       "jane:ssh-ed25519 AAAA jane@laptop\njane:ssh-rsa BBBB jane@desktop",
+	}
+	if !maps.Equal(values, expected) {
+		t.Fatalf("unexpected metadata %v", values)
+	}
+	if keys := metadataKeys(items); !slices.Equal(keys, []string{"api-token", "env", "ssh-keys", "startup-script"}) {
+		t.Fatalf("unexpected metadata keys %v", keys)
+	}
+
+	// a required reference to a missing key fails the resolution
+	gi.Spec.Metadata[1].ValueFrom.ConfigMapKeyRef.Key = "missing.sh"
+	if _, err := cr.resolveMetadata(context.Background(), gi); err == nil {
+		t.Fatalf("expected missing configmap key to fail")
+	}
+}
+
+func TestUpdateOSLoginBindings(t *testing.T) {
+	policy := &compute.Policy{
+		Bindings: []*compute.Binding{
+			{Role: "roles/viewer", Members: []string{"user:bob@example.com"}},
+			{Role: roleOSLogin, Members: []string{"user:jane@example.com", "user:ops@example.com"}},
+		},
+	}
+
+	// granting jane again does not change the policy
+	if updateOSLoginBindings(policy, roleOSLogin, []string{"user:jane@example.com"}, roleOSLogin, []string{"user:jane@example.com"}) {
+		t.Fatalf("expected unchanged principals not to change the policy")
+	}
+
+	// switching to admin access moves jane, the member granted outside the controller is kept
+	if !updateOSLoginBindings(policy, roleOSLogin, []string{"user:jane@example.com"}, roleOSAdminLogin, []string{"user:jane@example.com"}) {
+		t.Fatalf("expected new role to change the policy")
+	}
+	if len(policy.Bindings) != 3 {
+		t.Fatalf("expected 3 bindings, got %d", len(policy.Bindings))
+	}
+	if !slices.Equal(policy.Bindings[1].Members, []string{"user:ops@example.com"}) {
+		t.Fatalf("expected foreign os login member to be kept, got %v", policy.Bindings[1].Members)
+	}
+	if policy.Bindings[2].Role != roleOSAdminLogin || !slices.Equal(policy.Bindings[2].Members, []string{"user:jane@example.com"}) {
+		t.Fatalf("unexpected os admin login binding %v", policy.Bindings[2])
+	}
+
+	// revoking the grants removes the binding left empty
+	if !updateOSLoginBindings(policy, roleOSAdminLogin, []string{"user:jane@example.com"}, "", nil) {
+		t.Fatalf("expected revoked principals to change the policy")
+	}
+	if len(policy.Bindings) != 2 || policy.Bindings[1].Role != roleOSLogin {
+		t.Fatalf("expected viewer and os login bindings, got %v", policy.Bindings)
+	}
+}
+
+func TestSyncOSLogin(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockInstancesInterface := gcp.NewMockInstancesInterface(mockCtrl)
+	mockGetIamPolicyInterface := gcp.NewMockGetIamPolicyInstancesInterface(mockCtrl)
+	mockSetIamPolicyInterface := gcp.NewMockSetIamPolicyInstancesInterface(mockCtrl)
+	cr := &GCPInstanceReconciler{
+		eventRecorder: record.NewFakeRecorder(10),
+		cloud: CloudProviders{
+			GCP: &gcp.API{
+				Compute: gcp.ComputeService{
+					Clients: gcp.ComputeClients{
+						Instances: mockInstancesInterface,
+					},
+				},
+				Config: gcp.Config{
+					ProjectId: "test-project",
+				},
+			},
+		},
+	}
+	gi := &benzaiten.GCPInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Spec: benzaiten.GCPInstanceSpec{
+			Name: "test-instance",
+			Zone: "us-central1-a",
+			SSH: &benzaiten.SSHAccess{
+				OSLogin: &benzaiten.OSLogin{Principals: []string{"user:jane@example.com"}},
+			},
+		},
+	}
+
+	// jane is added next to the member granted outside the controller
+	gomock.InOrder(
+		mockInstancesInterface.EXPECT().GetIamPolicy("test-project", "us-central1-a", "test-instance").Return(mockGetIamPolicyInterface),
+		mockGetIamPolicyInterface.EXPECT().Do().Return(&compute.Policy{
+			Etag:     "etag-1",
+			Bindings: []*compute.Binding{{Role: roleOSLogin, Members: []string{"user:ops@example.com"}}},
+		}, nil),
+		mockInstancesInterface.EXPECT().SetIamPolicy("test-project", "us-central1-a", "test-instance", &compute.ZoneSetPolicyRequest{
+			Policy: &compute.Policy{
+				Etag:     "etag-1",
+				Bindings: []*compute.Binding{{Role: roleOSLogin, Members: []string{"user:ops@example.com", "user:jane@example.com"}}},
+			},
+		}).Return(mockSetIamPolicyInterface),
+		mockSetIamPolicyInterface.EXPECT().Do().Return(&compute.Policy{}, nil),
+	)
+	role, principals, err := cr.syncOSLogin(gi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if role != roleOSLogin || !slices.Equal(principals, []string{"user:jane@example.com"}) {
+		t.Fatalf("unexpected granted role %s and principals %v", role, principals)
+	}
+	gi.Status.OSLoginRole, gi.Status.OSLoginPrincipals = role, principals
+
+	// removing the ssh access revokes jane only
+	gi.Spec.SSH = nil
+	gomock.InOrder(
+		mockInstancesInterface.EXPECT().GetIamPolicy("test-project", "us-central1-a", "test-instance").Return(mockGetIamPolicyInterface),
+		mockGetIamPolicyInterface.EXPECT().Do().Return(&compute.Policy{
+			Etag:     "etag-2",
+			Bindings: []*compute.Binding{{Role: roleOSLogin, Members: []string{"user:ops@example.com", "user:jane@example.com"}}},
+		}, nil),
+		mockInstancesInterface.EXPECT().SetIamPolicy("test-project", "us-central1-a", "test-instance", &compute.ZoneSetPolicyRequest{
+			Policy: &compute.Policy{
+				Etag:     "etag-2",
+				Bindings: []*compute.Binding{{Role: roleOSLogin, Members: []string{"user:ops@example.com"}}},
+			},
+		}).Return(mockSetIamPolicyInterface),
+		mockSetIamPolicyInterface.EXPECT().Do().Return(&compute.Policy{}, nil),
+	)
+	role, principals, err = cr.syncOSLogin(gi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if role != "" || principals != nil {
+		t.Fatalf("expected no granted principals, got %s %v", role, principals)
+	}
+	gi.Status.OSLoginRole, gi.Status.OSLoginPrincipals = role, principals
+
+	// without grants and ssh access the policy is not read
+	if _, _, err := cr.syncOSLogin(gi); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestLastURLSegment(t *testing.T) {
+	mt := lastURLSegment("https://www.googleapis.com/compute/v1/projects/test-project/zones/test-zone/machineTypes/e2-medium")
+	if mt != "e2-medium" {
+		t.Fatalf("expected e2-medium, got %s", mt)
+	}
+	if lastURLSegment("e2-medium") != "e2-medium" {
+		t.Fatalf("expected plain name to be returned as is")
+	}
+}
+
+func TestObserveInstance(t *testing.T) {
+	instance := &compute.Instance{
+		Id:                 1234567890123456789,
+		Zone:               "https://www.googleapis.com/compute/v1/projects/test-project/zones/test-zone",
+		MachineType:        "https://www.googleapis.com/compute/v1/projects/test-project/zones/test-zone/machineTypes/e2-medium",
+		CpuPlatform:        "Intel Broadwell",
+		CreationTimestamp:  "2025-01-04T02:00:00.123-08:00",
+		LastStartTimestamp: "2025-01-04T02:00:30.456-08:00",
+		NetworkInterfaces: []*compute.NetworkInterface{
+			{
+				NetworkIP: "10.128.0.2",
+				AccessConfigs: []*compute.AccessConfig{
+					{Name: "External NAT", NatIP: "34.1.2.3"},
+				},
+			},
+		},
+	}
+
+	status := benzaiten.GCPInstanceStatus{}
+	observeInstance(&status, instance)
+	if status.InstanceID != "1234567890123456789" {
+		t.Fatalf("unexpected instance id %s", status.InstanceID)
+	}
+	if status.Zone != "test-zone" || status.MachineType != "e2-medium" || status.CPUPlatform != "Intel Broadwell" {
+		t.Fatalf("unexpected zone, machine type or cpu platform: %s %s %s", status.Zone, status.MachineType, status.CPUPlatform)
+	}
+	if status.InternalIP != "10.128.0.2" || status.ExternalIP != "34.1.2.3" {
+		t.Fatalf("unexpected ips %s %s", status.InternalIP, status.ExternalIP)
+	}
+	if status.CreationTimestamp == nil || !status.CreationTimestamp.Equal(&metav1.Time{Time: time.Date(2025, 1, 4, 10, 0, 0, 0, time.UTC)}) {
+		t.Fatalf("unexpected creation timestamp %v", status.CreationTimestamp)
+	}
+	if status.LastStartTime == nil || status.LastStopTime != nil {
+		t.Fatalf("expected last start time and no last stop time")
+	}
+
+	// the external IP is released when the access config is removed
+	instance.NetworkInterfaces[0].AccessConfigs = nil
+	observeInstance(&status, instance)
+	if status.ExternalIP != "" {
+		t.Fatalf("expected external ip to be cleared, got %s", status.ExternalIP)
+	}
+}
+
+func TestNewComputeInstanceAddress(t *testing.T) {
+	gi := &benzaiten.GCPInstance{
+		Spec: benzaiten.GCPInstanceSpec{
+			Name:        "test-instance",
+			Zone:        "us-central1-a",
+			MachineType: "e2-medium",
+		},
+	}
+	external := &benzaiten.GCPAddress{
+		Spec:   benzaiten.GCPAddressSpec{AddressType: "EXTERNAL"},
+		Status: benzaiten.GCPAddressStatus{Address: "34.1.2.3"},
+	}
+	internal := &benzaiten.GCPAddress{
+		Spec:   benzaiten.GCPAddressSpec{AddressType: addressTypeInternal},
+		Status: benzaiten.GCPAddressStatus{Address: "10.128.0.10"},
+	}
+
+	instance := newComputeInstance(gi, networkAttachment{}, nil, external)
+	if ip := instance.NetworkInterfaces[0].AccessConfigs[0].NatIP; ip != "34.1.2.3" {
+		t.Fatalf("expected external address as nat ip, got %s", ip)
+	}
+
+	instance = newComputeInstance(gi, networkAttachment{}, nil, internal)
+	if ip := instance.NetworkInterfaces[0].NetworkIP; ip != "10.128.0.10" {
+		t.Fatalf("expected internal address as network ip, got %s", ip)
+	}
+	if ip := instance.NetworkInterfaces[0].AccessConfigs[0].NatIP; ip != "" {
+		t.Fatalf("expected ephemeral external ip, got %s", ip)
+	}
+}
+
+func TestZoneRegion(t *testing.T) {
+	if r := zoneRegion("us-central1-a"); r != "us-central1" {
+		t.Fatalf("expected region us-central1, got %s", r)
+	}
+	if r := zoneRegion("europe-west3-c"); r != "europe-west3" {
+		t.Fatalf("expected region europe-west3, got %s", r)
+	}
+}
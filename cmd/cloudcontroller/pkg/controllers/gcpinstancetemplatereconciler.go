@@ -0,0 +1,314 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/compute/v1"
+	"hash/fnv"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"slices"
+	"strings"
+	"time"
+)
+
+type GCPInstanceTemplateReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPInstanceTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpinstancetemplate", req.NamespacedName)
+
+	gt := benzaiten.GCPInstanceTemplate{}
+	err := cr.Get(ctx, req.NamespacedName, &gt)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpinstancetemplate not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gt.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gt)
+	}
+
+	if controllerutil.AddFinalizer(&gt, gcpFinalizer) {
+		err = cr.Update(ctx, &gt)
+		if err != nil {
+			logger.Error(err, "error adding gcpinstancetemplate finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// resolve metadata values referencing ConfigMaps and Secrets
+	metadata, err := resolveMetadataItems(ctx, cr.Client, gt.Namespace, gt.Spec.Metadata)
+	if err != nil {
+		logger.Error(err, "error resolving gcpinstancetemplate metadata")
+		cr.eventRecorder.Event(&gt, "Warning", "MetadataUnresolved", err.Error())
+		return ctrl.Result{}, err
+	}
+	properties := newInstanceProperties(&gt, metadata)
+	version, err := instanceTemplateVersion(gt.Spec.Name, properties)
+	if err != nil {
+		logger.Error(err, "error computing gcpinstancetemplate version")
+		return ctrl.Result{}, err
+	}
+
+	// does the template version exist in GCP?
+	template, err := cr.cloud.GCP.GetInstanceTemplate(version)
+	if err != nil && notFoundGCPResource(err) {
+		// template version does not exist in GCP
+		logger.Info("gcpinstancetemplate version not found, creating template...", "template", version)
+		op, err := cr.cloud.GCP.CreateInstanceTemplate(&compute.InstanceTemplate{
+			Name:       version,
+			Properties: properties,
+		})
+		if err != nil {
+			logger.Error(err, "error creating gcpinstancetemplate")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitGlobalOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error creating gcpinstancetemplate")
+			cr.eventRecorder.Event(&gt, "Warning", "TemplateFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		template, err = cr.cloud.GCP.GetInstanceTemplate(version)
+		if err != nil {
+			logger.Error(err, "error verifying template status")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gt, "Normal", "TemplateCreated", fmt.Sprintf("GCP Instance Template %s created", version))
+	} else if err != nil {
+		logger.Error(err, "error getting gcpinstancetemplate")
+		return ctrl.Result{}, err
+	}
+
+	previous := gt.DeepCopyObject().(*benzaiten.GCPInstanceTemplate)
+	templates := gt.Status.Templates
+	if !slices.Contains(templates, version) {
+		templates = append(templates, version)
+	}
+
+	// delete the previous versions once no instance group uses them anymore
+	templates, err = cr.deleteTemplates(ctx, &gt, templates, version)
+	if err != nil {
+		logger.Error(err, "error deleting previous gcpinstancetemplate versions")
+		return ctrl.Result{}, err
+	}
+
+	// update status
+	gt.Status.CurrentTemplate = version
+	gt.Status.SelfLink = template.SelfLink
+	gt.Status.Templates = templates
+	meta.SetStatusCondition(&gt.Status.Conditions, metav1.Condition{
+		Type:               benzaiten.InstanceTemplateConditionReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gt.Generation,
+		Reason:             "Created",
+		Message:            fmt.Sprintf("template %s matches the spec", version),
+	})
+	if !equality.Semantic.DeepEqual(previous.Status, gt.Status) {
+		err = cr.Status().Update(ctx, &gt)
+		if err != nil {
+			logger.Error(err, "error updating gcpinstancetemplate status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp instance template reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+// deleteTemplates deletes the template versions except keep. Versions still used by an instance group are kept.
+// It returns the versions which still exist.
+func (cr *GCPInstanceTemplateReconciler) deleteTemplates(ctx context.Context, gt *benzaiten.GCPInstanceTemplate, templates []string, keep string) ([]string, error) {
+	var remaining []string
+	for _, name := range templates {
+		if name == keep {
+			remaining = append(remaining, name)
+			continue
+		}
+		op, err := cr.cloud.GCP.DeleteInstanceTemplate(name)
+		if err != nil {
+			if notFoundGCPResource(err) {
+				continue
+			}
+			if inUseGCPResource(err) {
+				remaining = append(remaining, name)
+				continue
+			}
+			return nil, fmt.Errorf("unable to delete template %s: %w", name, err)
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitGlobalOperation(op.Name)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to delete template %s: %w", name, err)
+		}
+		cr.eventRecorder.Event(gt, "Normal", "TemplateDeleted", fmt.Sprintf("GCP Instance Template %s deleted", name))
+	}
+
+	return remaining, nil
+}
+
+func (cr *GCPInstanceTemplateReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gt *benzaiten.GCPInstanceTemplate) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gt, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("deleting gcpinstancetemplate versions...")
+	remaining, err := cr.deleteTemplates(ctx, gt, gt.Status.Templates, "")
+	if err != nil {
+		logger.Error(err, "error deleting gcpinstancetemplate")
+		return ctrl.Result{}, err
+	}
+	if len(remaining) > 0 {
+		// templates cannot be deleted while instance groups use them
+		cr.eventRecorder.Event(gt, "Warning", "TemplateInUse", fmt.Sprintf("GCP Instance Templates %s are in use, waiting for the instance groups to release them", strings.Join(remaining, ", ")))
+		if !slices.Equal(remaining, gt.Status.Templates) {
+			gt.Status.Templates = remaining
+			err = cr.Status().Update(ctx, gt)
+			if err != nil {
+				logger.Error(err, "error updating gcpinstancetemplate status")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+
+	controllerutil.RemoveFinalizer(gt, gcpFinalizer)
+	err = cr.Update(ctx, gt)
+	if err != nil {
+		logger.Error(err, "error removing gcpinstancetemplate finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp instance template deleted")
+	return ctrl.Result{}, nil
+}
+
+func (cr *GCPInstanceTemplateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPInstanceTemplate{}).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForMetadataSource)).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForMetadataSource)).
+		Complete(cr)
+}
+
+// requestsForMetadataSource returns the GCPInstanceTemplates whose metadata references the ConfigMap or Secret
+func (cr *GCPInstanceTemplateReconciler) requestsForMetadataSource(ctx context.Context, obj client.Object) []reconcile.Request {
+	gts := benzaiten.GCPInstanceTemplateList{}
+	err := cr.List(ctx, &gts, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		cr.Log.Error(err, "unable to list gcpinstancetemplates")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, gt := range gts.Items {
+		if metadataReferences(gt.Spec.Metadata, obj) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: gt.Name, Namespace: gt.Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
+func setupGCPInstanceTemplateController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpinstancetemplate")
+	cc := GCPInstanceTemplateReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPInstanceTemplateReconciler"),
+	}
+
+	// create GCPInstanceTemplate controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPInstanceTemplate controller: %w", err)
+	}
+
+	return nil
+}
+
+// newInstanceProperties builds the instance properties described by the GCPInstanceTemplate spec
+func newInstanceProperties(gt *benzaiten.GCPInstanceTemplate, metadata []*compute.MetadataItems) *compute.InstanceProperties {
+	network := gt.Spec.Network
+	if network == "" {
+		network = defaultInstanceNetwork
+	}
+
+	properties := &compute.InstanceProperties{
+		MachineType: gt.Spec.MachineType,
+		Disks: []*compute.AttachedDisk{
+			{
+				Boot:       true,
+				AutoDelete: true,
+				InitializeParams: &compute.AttachedDiskInitializeParams{
+					SourceImage: gt.Spec.SourceImage,
+					DiskSizeGb:  gt.Spec.DiskSizeGb,
+					DiskType:    gt.Spec.DiskType,
+				},
+			},
+		},
+		NetworkInterfaces: []*compute.NetworkInterface{
+			{
+				Network: network,
+				AccessConfigs: []*compute.AccessConfig{
+					{
+						Name: "External NAT",
+						Type: "ONE_TO_ONE_NAT",
+					},
+				},
+			},
+		},
+		Metadata: &compute.Metadata{
+			Items: metadata,
+		},
+		Labels: gt.Spec.Labels,
+	}
+	if len(gt.Spec.Tags) > 0 {
+		properties.Tags = &compute.Tags{Items: gt.Spec.Tags}
+	}
+
+	return properties
+}
+
+// instanceTemplateVersion returns the name of the template version holding the properties
+func instanceTemplateVersion(prefix string, properties *compute.InstanceProperties) (string, error) {
+	data, err := json.Marshal(properties)
+	if err != nil {
+		return "", err
+	}
+	h := fnv.New32a()
+	_, _ = h.Write(data)
+
+	return fmt.Sprintf("%s-%08x", prefix, h.Sum32()), nil
+}
@@ -7,11 +7,17 @@ import (
 	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
 	"google.golang.org/api/container/v1"
 	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"strings"
 	"time"
 )
@@ -26,9 +32,7 @@ type GCPKubernetesClusterReconciler struct {
 
 func (cr *GCPKubernetesClusterReconciler) updateStatus(ctx context.Context, cluster *benzaiten.GCPKubernetesCluster, cs benzaiten.ClusterStatus, msg, rsn, et string) error {
 	cr.eventRecorder.Event(cluster, et, rsn, msg)
-	cluster.Status = benzaiten.GCPKubernetesClusterStatus{
-		Phase: cs,
-	}
+	cluster.Status.Phase = cs
 
 	err := cr.Status().Update(ctx, cluster)
 	if err != nil {
@@ -49,15 +53,55 @@ func (cr *GCPKubernetesClusterReconciler) Reconcile(ctx context.Context, req ctr
 		}
 		return ctrl.Result{}, err
 	}
+
+	if !gkcCR.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gkcCR)
+	}
+
+	if controllerutil.AddFinalizer(&gkcCR, gcpFinalizer) {
+		err = cr.Update(ctx, &gkcCR)
+		if err != nil {
+			logger.Error(err, "error adding gcpkubernetescluster finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
 	// does cluster exist in GCP?
-	_, err = cr.cloud.GCP.GetCluster(gkcCR.Spec.Zone, gkcCR.Spec.ClusterName)
-	if err != nil && notFoundGCPResource(err) {
+	_, getErr := cr.cloud.GCP.GetCluster(gkcCR.Spec.Zone, gkcCR.Spec.ClusterName)
+	if getErr != nil && !notFoundGCPResource(getErr) {
+		logger.Error(getErr, "error getting gcpkubernetescluster")
+		return ctrl.Result{}, getErr
+	}
+	// resolve the network and subnetwork of the cluster
+	attachment, referencesCondition, err := resolveNetworkAttachment(ctx, cr.Client, &gkcCR, benzaiten.ClusterConditionReferencesResolved, gkcCR.Spec.NetworkRef, gkcCR.Spec.SubnetworkRef)
+	if err != nil {
+		logger.Error(err, "error resolving gcpkubernetescluster references")
+		return ctrl.Result{}, err
+	}
+	// allocate the ranges claimed from IPPools
+	ranges, rangesCondition, err := cr.allocateRanges(ctx, &gkcCR, getErr == nil)
+	if err != nil {
+		logger.Error(err, "error allocating gcpkubernetescluster ranges")
+		return ctrl.Result{}, err
+	}
+	referencesChanged := meta.SetStatusCondition(&gkcCR.Status.Conditions, referencesCondition)
+	rangesChanged := meta.SetStatusCondition(&gkcCR.Status.Conditions, rangesCondition)
+	if getErr != nil {
 		// cluster does not exist in GCP
+		if referencesCondition.Status == metav1.ConditionFalse || rangesCondition.Status == metav1.ConditionFalse {
+			// the cluster must be created in its network with its ranges
+			logger.Info("gcpkubernetescluster references or ranges not ready", "references", referencesCondition.Message, "ranges", rangesCondition.Message)
+			if referencesChanged || rangesChanged {
+				err = cr.Status().Update(ctx, &gkcCR)
+				if err != nil {
+					logger.Error(err, "error updating gcpkubernetescluster status")
+					return ctrl.Result{}, err
+				}
+			}
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		}
 		logger.Info("gcpkubernetescluster not found, creating cluster...")
-		_, err = cr.cloud.GCP.CreateCluster(gkcCR.Spec.Zone, &container.Cluster{
-			Name:             gkcCR.Spec.ClusterName,
-			InitialNodeCount: gkcCR.Spec.InitialNodeCount,
-		})
+		_, err = cr.cloud.GCP.CreateCluster(gkcCR.Spec.Zone, newContainerCluster(&gkcCR, attachment, ranges))
 		if err != nil {
 			logger.Error(err, "error creating gcpkubernetescluster")
 			return ctrl.Result{}, err
@@ -101,23 +145,157 @@ func (cr *GCPKubernetesClusterReconciler) Reconcile(ctx context.Context, req ctr
 				return ctrl.Result{}, ctx.Err()
 			}
 		}
-	} else if err != nil {
-		logger.Error(err, "error getting gcpkubernetescluster")
-		return ctrl.Result{}, err
 	}
 	// synchronize changes if exists
 	logger.Info("gcpkubernetescluster found, synchronizing...")
+	if referencesChanged || rangesChanged {
+		err = cr.Status().Update(ctx, &gkcCR)
+		if err != nil {
+			logger.Error(err, "error updating gcpkubernetescluster status")
+			return ctrl.Result{}, err
+		}
+	}
 
 	logger.Info("gcp kubernetes cluster reconciled")
 	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
 }
 
+// allocateRanges allocates the ranges the cluster claims from IPPools and returns them keyed by range along with the
+// RangesAllocated condition. Once the GKE cluster is created its ranges cannot change, claims added afterwards are
+// reported instead of allocated. The blocks are released once the GKE cluster is deleted.
+func (cr *GCPKubernetesClusterReconciler) allocateRanges(ctx context.Context, gkc *benzaiten.GCPKubernetesCluster, created bool) (map[string]string, metav1.Condition, error) {
+	condition := metav1.Condition{
+		Type:               benzaiten.ClusterConditionRangesAllocated,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gkc.Generation,
+		Reason:             "RangesAllocated",
+		Message:            "claimed ranges are allocated",
+	}
+
+	ranges := map[string]string{}
+	var pending, unapplied []string
+	claims := ipPoolClaims(gkc)
+	for _, rangeName := range []string{ipRangePods, ipRangeServices, ipRangeMaster} {
+		claim := claims[rangeName]
+		if claim == nil {
+			continue
+		}
+		if created {
+			cidr, err := allocatedIPRange(ctx, cr.Client, gkc, "GCPKubernetesCluster", rangeName, claim)
+			if err != nil {
+				return nil, condition, err
+			}
+			if cidr == "" {
+				unapplied = append(unapplied, rangeName)
+				continue
+			}
+			ranges[rangeName] = cidr
+			continue
+		}
+		cidr, message, err := allocateIPRange(ctx, cr.Client, gkc, "GCPKubernetesCluster", rangeName, claim)
+		if err != nil {
+			return nil, condition, err
+		}
+		if cidr == "" {
+			pending = append(pending, fmt.Sprintf("%s: %s", rangeName, message))
+			continue
+		}
+		ranges[rangeName] = cidr
+	}
+
+	if len(unapplied) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "RecreateRequired"
+		condition.Message = fmt.Sprintf("%s claimed after the cluster was created, recreate the cluster to apply the claims", strings.Join(unapplied, ", "))
+	} else if len(pending) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "RangesNotAllocated"
+		condition.Message = strings.Join(pending, ", ")
+	}
+
+	return ranges, condition, nil
+}
+
+func (cr *GCPKubernetesClusterReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gkc *benzaiten.GCPKubernetesCluster) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gkc, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("deleting gcpkubernetescluster...")
+	_, err := cr.cloud.GCP.DeleteCluster(gkc.Spec.Zone, gkc.Spec.ClusterName)
+	if err != nil && !notFoundGCPResource(err) {
+		logger.Error(err, "error deleting gcpkubernetescluster")
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		// the blocks of the cluster may only be released once GKE no longer uses them
+		err = waitOperation(ctx, func() (bool, error) {
+			_, err := cr.cloud.GCP.GetCluster(gkc.Spec.Zone, gkc.Spec.ClusterName)
+			if notFoundGCPResource(err) {
+				return true, nil
+			}
+			return false, err
+		})
+		if err != nil {
+			logger.Error(err, "error deleting gcpkubernetescluster")
+			return ctrl.Result{}, err
+		}
+	}
+
+	err = releaseIPRanges(ctx, cr.Client, gkc, "GCPKubernetesCluster")
+	if err != nil {
+		logger.Error(err, "error releasing gcpkubernetescluster ranges")
+		return ctrl.Result{}, err
+	}
+
+	controllerutil.RemoveFinalizer(gkc, gcpFinalizer)
+	err = cr.Update(ctx, gkc)
+	if err != nil {
+		logger.Error(err, "error removing gcpkubernetescluster finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp kubernetes cluster deleted")
+	return ctrl.Result{}, nil
+}
+
 func (cr *GCPKubernetesClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&benzaiten.GCPKubernetesCluster{}).
+		Watches(&benzaiten.GCPNetwork{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForReference)).
+		Watches(&benzaiten.GCPSubnetwork{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForReference)).
 		Complete(cr)
 }
 
+// requestsForReference returns the GCPKubernetesClusters referencing the GCPNetwork or GCPSubnetwork. References may
+// cross namespaces, clusters of every namespace are considered.
+func (cr *GCPKubernetesClusterReconciler) requestsForReference(ctx context.Context, obj client.Object) []reconcile.Request {
+	gkcs := benzaiten.GCPKubernetesClusterList{}
+	err := cr.List(ctx, &gkcs)
+	if err != nil {
+		cr.Log.Error(err, "unable to list gcpkubernetesclusters")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, gkc := range gkcs.Items {
+		var referenced bool
+		switch obj.(type) {
+		case *benzaiten.GCPNetwork:
+			referenced = refersTo(gkc.Spec.NetworkRef, gkc.Namespace, obj)
+		case *benzaiten.GCPSubnetwork:
+			referenced = refersTo(gkc.Spec.SubnetworkRef, gkc.Namespace, obj)
+		}
+		if referenced {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: gkc.Name, Namespace: gkc.Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
 func setupGCPKubernetesClusterController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
 	eventRecorder := mgr.GetEventRecorderFor("gcpkubernetescluster")
 	cc := GCPKubernetesClusterReconciler{
@@ -137,6 +315,49 @@ func setupGCPKubernetesClusterController(mgr manager.Manager, log logr.Logger, c
 	return nil
 }
 
+// newContainerCluster builds the GKE cluster described by the GCPKubernetesCluster spec. Resolved references and
+// ranges allocated from IPPools take the place of the names and ranges of the spec.
+func newContainerCluster(gkc *benzaiten.GCPKubernetesCluster, attachment networkAttachment, ranges map[string]string) *container.Cluster {
+	cluster := &container.Cluster{
+		Name:             gkc.Spec.ClusterName,
+		InitialNodeCount: gkc.Spec.InitialNodeCount,
+		Network:          gkc.Spec.Network,
+		Subnetwork:       gkc.Spec.Subnetwork,
+	}
+	if attachment.Network != "" {
+		cluster.Network = lastURLSegment(attachment.Network)
+	}
+	if attachment.Subnetwork != "" {
+		cluster.Subnetwork = lastURLSegment(attachment.Subnetwork)
+	}
+
+	pods, services, master := gkc.Spec.ClusterIpv4Cidr, gkc.Spec.ServicesIpv4Cidr, gkc.Spec.MasterIpv4Cidr
+	if cidr, ok := ranges[ipRangePods]; ok {
+		pods = cidr
+	}
+	if cidr, ok := ranges[ipRangeServices]; ok {
+		services = cidr
+	}
+	if cidr, ok := ranges[ipRangeMaster]; ok {
+		master = cidr
+	}
+	if pods != "" || services != "" {
+		cluster.IpAllocationPolicy = &container.IPAllocationPolicy{
+			UseIpAliases:          true,
+			ClusterIpv4CidrBlock:  pods,
+			ServicesIpv4CidrBlock: services,
+		}
+	}
+	if master != "" {
+		cluster.PrivateClusterConfig = &container.PrivateClusterConfig{
+			EnablePrivateNodes:  true,
+			MasterIpv4CidrBlock: master,
+		}
+	}
+
+	return cluster
+}
+
 func notFoundGCPResource(err error) bool {
 	return strings.Split(fmt.Sprintf("%v", err), ":")[1] == " Error 404"
 }
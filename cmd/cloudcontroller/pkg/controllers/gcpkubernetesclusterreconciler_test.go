@@ -17,6 +17,7 @@ import (
 	"path/filepath"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -232,6 +233,23 @@ func createFakeGKC(ctx context.Context, fakeClient client.Client, nodeCount int6
 	return &gk, nil
 }
 
+// deleteFakeGKC deletes the GCPKubernetesCluster, the finalizer is removed first since no controller deletes the GKE
+// cluster in the tests
+func deleteFakeGKC(ctx context.Context, fakeClient client.Client, gkc *benzaiten.GCPKubernetesCluster) error {
+	current := benzaiten.GCPKubernetesCluster{}
+	err := fakeClient.Get(ctx, client.ObjectKeyFromObject(gkc), &current)
+	if err != nil {
+		return err
+	}
+	if controllerutil.RemoveFinalizer(&current, gcpFinalizer) {
+		err = fakeClient.Update(ctx, &current)
+		if err != nil {
+			return err
+		}
+	}
+	return fakeClient.Delete(ctx, &current)
+}
+
 ////////////////////////////////////////////////////
 // TESTS
 ////////////////////////////////////////////////////
@@ -264,7 +282,7 @@ func TestGKCReconciler_GetClusterNoChanges(t *testing.T) {
 		t.Fatalf("expected no error, got %v", err)
 	}
 
-	err = rec.Client.Delete(ctx, gkc)
+	err = deleteFakeGKC(ctx, rec.Client, gkc)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -307,7 +325,7 @@ func TestGKCReconciler_CreateNewCluster(t *testing.T) {
 		t.Fatalf("expected cluster status ClusterStatusRunning, got %v", gkcCreated.Status.Phase)
 	}
 
-	err = rec.Client.Delete(ctx, gkc)
+	err = deleteFakeGKC(ctx, rec.Client, gkc)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
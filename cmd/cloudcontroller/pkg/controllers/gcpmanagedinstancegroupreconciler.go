@@ -0,0 +1,395 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"time"
+)
+
+const (
+	updatePolicyTypeProactive   = "PROACTIVE"
+	updateMinimalActionReplace  = "REPLACE"
+	updateReplacementSubstitute = "SUBSTITUTE"
+	updateReplacementRecreate   = "RECREATE"
+	defaultUpdateMaxSurge       = 1
+	defaultUpdateMaxUnavailable = 1
+)
+
+type GCPManagedInstanceGroupReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPManagedInstanceGroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpmanagedinstancegroup", req.NamespacedName)
+
+	gm := benzaiten.GCPManagedInstanceGroup{}
+	err := cr.Get(ctx, req.NamespacedName, &gm)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpmanagedinstancegroup not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gm.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gm)
+	}
+
+	if controllerutil.AddFinalizer(&gm, gcpFinalizer) {
+		err = cr.Update(ctx, &gm)
+		if err != nil {
+			logger.Error(err, "error adding gcpmanagedinstancegroup finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := gm.DeepCopyObject().(*benzaiten.GCPManagedInstanceGroup)
+
+	// the instance group is created from the current version of the referenced template
+	gt := benzaiten.GCPInstanceTemplate{}
+	err = cr.Get(ctx, types.NamespacedName{Namespace: gm.Namespace, Name: gm.Spec.TemplateRef.Name}, &gt)
+	if err != nil && !kerr.IsNotFound(err) {
+		logger.Error(err, "error getting gcpinstancetemplate")
+		return ctrl.Result{}, err
+	}
+	if err != nil || gt.Status.SelfLink == "" {
+		meta.SetStatusCondition(&gm.Status.Conditions, metav1.Condition{
+			Type:               benzaiten.ManagedInstanceGroupConditionTemplateReady,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: gm.Generation,
+			Reason:             "TemplateNotReady",
+			Message:            fmt.Sprintf("waiting for GCPInstanceTemplate %s", gm.Spec.TemplateRef.Name),
+		})
+		if !equality.Semantic.DeepEqual(previous.Status, gm.Status) {
+			err = cr.Status().Update(ctx, &gm)
+			if err != nil {
+				logger.Error(err, "error updating gcpmanagedinstancegroup status")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+	meta.SetStatusCondition(&gm.Status.Conditions, metav1.Condition{
+		Type:               benzaiten.ManagedInstanceGroupConditionTemplateReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gm.Generation,
+		Reason:             "TemplateReady",
+		Message:            fmt.Sprintf("GCPInstanceTemplate %s is ready", gm.Spec.TemplateRef.Name),
+	})
+	desired := newInstanceGroupManager(&gm, gt.Status.SelfLink)
+
+	// does the instance group exist in GCP?
+	igm, err := cr.cloud.GCP.GetInstanceGroupManager(gm.Spec.Zone, gm.Spec.Name)
+	if err != nil && notFoundGCPResource(err) {
+		// instance group does not exist in GCP
+		logger.Info("gcpmanagedinstancegroup not found, creating instance group...")
+		op, err := cr.cloud.GCP.CreateInstanceGroupManager(gm.Spec.Zone, desired)
+		if err != nil {
+			logger.Error(err, "error creating gcpmanagedinstancegroup")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitZoneOperation(gm.Spec.Zone, op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error creating gcpmanagedinstancegroup")
+			cr.eventRecorder.Event(&gm, "Warning", "InstanceGroupFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		igm, err = cr.cloud.GCP.GetInstanceGroupManager(gm.Spec.Zone, gm.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error verifying instance group status")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gm, "Normal", "InstanceGroupCreated", "GCP Managed Instance Group created")
+	} else if err != nil {
+		logger.Error(err, "error getting gcpmanagedinstancegroup")
+		return ctrl.Result{}, err
+	}
+
+	// synchronize changes, a new template is rolled out according to the update policy
+	if !instanceGroupManagerInSync(igm, desired) {
+		logger.Info("gcpmanagedinstancegroup changed, patching...", "template", lastURLSegment(desired.InstanceTemplate))
+		op, err := cr.cloud.GCP.PatchInstanceGroupManager(gm.Spec.Zone, gm.Spec.Name, &compute.InstanceGroupManager{
+			InstanceTemplate:    desired.InstanceTemplate,
+			Versions:            desired.Versions,
+			UpdatePolicy:        desired.UpdatePolicy,
+			AutoHealingPolicies: desired.AutoHealingPolicies,
+			// an empty list removes the auto healing policies
+			ForceSendFields: []string{"AutoHealingPolicies"},
+		})
+		if err != nil {
+			logger.Error(err, "error patching gcpmanagedinstancegroup")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitZoneOperation(gm.Spec.Zone, op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error patching gcpmanagedinstancegroup")
+			return ctrl.Result{}, err
+		}
+		if igm.InstanceTemplate != desired.InstanceTemplate {
+			cr.eventRecorder.Event(&gm, "Normal", "RolloutStarted", fmt.Sprintf("rolling out GCP Instance Template %s", lastURLSegment(desired.InstanceTemplate)))
+		}
+		igm, err = cr.cloud.GCP.GetInstanceGroupManager(gm.Spec.Zone, gm.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error verifying instance group status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if igm.TargetSize != gm.Spec.TargetSize {
+		logger.Info("gcpmanagedinstancegroup target size changed, resizing...", "from", igm.TargetSize, "to", gm.Spec.TargetSize)
+		op, err := cr.cloud.GCP.ResizeInstanceGroupManager(gm.Spec.Zone, gm.Spec.Name, gm.Spec.TargetSize)
+		if err != nil {
+			logger.Error(err, "error resizing gcpmanagedinstancegroup")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitZoneOperation(gm.Spec.Zone, op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error resizing gcpmanagedinstancegroup")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gm, "Normal", "InstanceGroupResized", fmt.Sprintf("GCP Managed Instance Group resized from %d to %d", igm.TargetSize, gm.Spec.TargetSize))
+		igm.TargetSize = gm.Spec.TargetSize
+	}
+
+	// update status
+	updated := igm.Status != nil && igm.Status.VersionTarget != nil && igm.Status.VersionTarget.IsReached
+	gm.Status.TargetTemplate = lastURLSegment(igm.InstanceTemplate)
+	if updated {
+		if gm.Status.CurrentTemplate != gm.Status.TargetTemplate {
+			cr.eventRecorder.Event(&gm, "Normal", "RolloutCompleted", fmt.Sprintf("all instances run GCP Instance Template %s", gm.Status.TargetTemplate))
+		}
+		gm.Status.CurrentTemplate = gm.Status.TargetTemplate
+	}
+	gm.Status.TargetSize = igm.TargetSize
+	gm.Status.Stable = igm.Status != nil && igm.Status.IsStable
+	gm.Status.InstanceGroup = igm.InstanceGroup
+	updatedCondition := metav1.Condition{
+		Type:               benzaiten.ManagedInstanceGroupConditionUpdated,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gm.Generation,
+		Reason:             "VersionTargetReached",
+		Message:            fmt.Sprintf("all instances run template %s", gm.Status.TargetTemplate),
+	}
+	if !updated {
+		updatedCondition.Status = metav1.ConditionFalse
+		updatedCondition.Reason = "RollingUpdate"
+		updatedCondition.Message = fmt.Sprintf("instances are being updated to template %s", gm.Status.TargetTemplate)
+	}
+	meta.SetStatusCondition(&gm.Status.Conditions, updatedCondition)
+	if !equality.Semantic.DeepEqual(previous.Status, gm.Status) {
+		err = cr.Status().Update(ctx, &gm)
+		if err != nil {
+			logger.Error(err, "error updating gcpmanagedinstancegroup status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if !updated || !gm.Status.Stable {
+		// follow the rollout
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+
+	logger.Info("gcp managed instance group reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+func (cr *GCPManagedInstanceGroupReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gm *benzaiten.GCPManagedInstanceGroup) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gm, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	_, err := cr.cloud.GCP.GetInstanceGroupManager(gm.Spec.Zone, gm.Spec.Name)
+	if err != nil && !notFoundGCPResource(err) {
+		logger.Error(err, "error getting gcpmanagedinstancegroup")
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		logger.Info("deleting gcpmanagedinstancegroup...")
+		op, err := cr.cloud.GCP.DeleteInstanceGroupManager(gm.Spec.Zone, gm.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error deleting gcpmanagedinstancegroup")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitZoneOperation(gm.Spec.Zone, op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error deleting gcpmanagedinstancegroup")
+			return ctrl.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(gm, gcpFinalizer)
+	err = cr.Update(ctx, gm)
+	if err != nil {
+		logger.Error(err, "error removing gcpmanagedinstancegroup finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp managed instance group deleted")
+	return ctrl.Result{}, nil
+}
+
+func (cr *GCPManagedInstanceGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPManagedInstanceGroup{}).
+		Watches(&benzaiten.GCPInstanceTemplate{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForGCPInstanceTemplate)).
+		Complete(cr)
+}
+
+// requestsForGCPInstanceTemplate returns the GCPManagedInstanceGroups referencing the GCPInstanceTemplate
+func (cr *GCPManagedInstanceGroupReconciler) requestsForGCPInstanceTemplate(ctx context.Context, obj client.Object) []reconcile.Request {
+	gms := benzaiten.GCPManagedInstanceGroupList{}
+	err := cr.List(ctx, &gms, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		cr.Log.Error(err, "unable to list gcpmanagedinstancegroups")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, gm := range gms.Items {
+		if gm.Spec.TemplateRef.Name == obj.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: gm.Name, Namespace: gm.Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
+func setupGCPManagedInstanceGroupController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpmanagedinstancegroup")
+	cc := GCPManagedInstanceGroupReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPManagedInstanceGroupReconciler"),
+	}
+
+	// create GCPManagedInstanceGroup controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPManagedInstanceGroup controller: %w", err)
+	}
+
+	return nil
+}
+
+// newInstanceGroupManager builds the instance group manager described by the GCPManagedInstanceGroup spec
+func newInstanceGroupManager(gm *benzaiten.GCPManagedInstanceGroup, template string) *compute.InstanceGroupManager {
+	baseInstanceName := gm.Spec.BaseInstanceName
+	if baseInstanceName == "" {
+		baseInstanceName = gm.Spec.Name
+	}
+
+	updatePolicy := &compute.InstanceGroupManagerUpdatePolicy{
+		Type:              updatePolicyTypeProactive,
+		MinimalAction:     updateMinimalActionReplace,
+		ReplacementMethod: updateReplacementSubstitute,
+		MaxSurge:          &compute.FixedOrPercent{Fixed: defaultUpdateMaxSurge},
+		MaxUnavailable:    &compute.FixedOrPercent{Fixed: defaultUpdateMaxUnavailable},
+	}
+	if p := gm.Spec.UpdatePolicy; p != nil {
+		if p.Type != "" {
+			updatePolicy.Type = p.Type
+		}
+		if p.MinimalAction != "" {
+			updatePolicy.MinimalAction = p.MinimalAction
+		}
+		if p.ReplacementMethod != "" {
+			updatePolicy.ReplacementMethod = p.ReplacementMethod
+		}
+		if updatePolicy.ReplacementMethod == updateReplacementRecreate {
+			// instances keeping their names cannot be surged
+			updatePolicy.MaxSurge = &compute.FixedOrPercent{Fixed: 0, ForceSendFields: []string{"Fixed"}}
+		}
+		if p.MaxSurge != nil {
+			updatePolicy.MaxSurge = &compute.FixedOrPercent{Fixed: *p.MaxSurge, ForceSendFields: []string{"Fixed"}}
+		}
+		if p.MaxUnavailable != nil {
+			updatePolicy.MaxUnavailable = &compute.FixedOrPercent{Fixed: *p.MaxUnavailable, ForceSendFields: []string{"Fixed"}}
+		}
+	}
+
+	var autoHealingPolicies []*compute.InstanceGroupManagerAutoHealingPolicy
+	if gm.Spec.AutoHealing != nil {
+		autoHealingPolicies = append(autoHealingPolicies, &compute.InstanceGroupManagerAutoHealingPolicy{
+			HealthCheck:     gm.Spec.AutoHealing.HealthCheck,
+			InitialDelaySec: gm.Spec.AutoHealing.InitialDelaySec,
+		})
+	}
+
+	return &compute.InstanceGroupManager{
+		Name:             gm.Spec.Name,
+		BaseInstanceName: baseInstanceName,
+		InstanceTemplate: template,
+		Versions: []*compute.InstanceGroupManagerVersion{
+			{InstanceTemplate: template},
+		},
+		TargetSize:          gm.Spec.TargetSize,
+		UpdatePolicy:        updatePolicy,
+		AutoHealingPolicies: autoHealingPolicies,
+	}
+}
+
+// instanceGroupManagerInSync reports whether the template, update policy and auto healing of the instance group match
+func instanceGroupManagerInSync(current, desired *compute.InstanceGroupManager) bool {
+	if current.InstanceTemplate != desired.InstanceTemplate {
+		return false
+	}
+
+	cp, dp := current.UpdatePolicy, desired.UpdatePolicy
+	if cp == nil {
+		return false
+	}
+	if cp.Type != dp.Type || cp.MinimalAction != dp.MinimalAction || cp.ReplacementMethod != dp.ReplacementMethod {
+		return false
+	}
+	if cp.MaxSurge == nil || cp.MaxSurge.Fixed != dp.MaxSurge.Fixed {
+		return false
+	}
+	if cp.MaxUnavailable == nil || cp.MaxUnavailable.Fixed != dp.MaxUnavailable.Fixed {
+		return false
+	}
+
+	if len(current.AutoHealingPolicies) != len(desired.AutoHealingPolicies) {
+		return false
+	}
+	for i := range desired.AutoHealingPolicies {
+		c, d := current.AutoHealingPolicies[i], desired.AutoHealingPolicies[i]
+		if lastURLSegment(c.HealthCheck) != lastURLSegment(d.HealthCheck) || c.InitialDelaySec != d.InitialDelaySec {
+			return false
+		}
+	}
+
+	return true
+}
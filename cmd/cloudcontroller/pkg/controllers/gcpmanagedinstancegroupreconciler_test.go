@@ -0,0 +1,98 @@
+package controllers
+
+import (
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/compute/v1"
+	"testing"
+)
+
+func TestNewInstanceGroupManager(t *testing.T) {
+	gm := &benzaiten.GCPManagedInstanceGroup{
+		Spec: benzaiten.GCPManagedInstanceGroupSpec{
+			Name:       "test-mig",
+			Zone:       "test-zone",
+			TargetSize: 3,
+			UpdatePolicy: &benzaiten.UpdatePolicy{
+				ReplacementMethod: updateReplacementRecreate,
+			},
+		},
+	}
+
+	igm := newInstanceGroupManager(gm, "test-template")
+	if igm.BaseInstanceName != "test-mig" {
+		t.Fatalf("expected base instance name to default to the group name, got %s", igm.BaseInstanceName)
+	}
+	if igm.UpdatePolicy.Type != updatePolicyTypeProactive || igm.UpdatePolicy.MinimalAction != updateMinimalActionReplace {
+		t.Fatalf("expected proactive replace update policy, got %s %s", igm.UpdatePolicy.Type, igm.UpdatePolicy.MinimalAction)
+	}
+	if igm.UpdatePolicy.MaxSurge.Fixed != 0 {
+		t.Fatalf("expected no surge when recreating instances, got %d", igm.UpdatePolicy.MaxSurge.Fixed)
+	}
+	if len(igm.Versions) != 1 || igm.Versions[0].InstanceTemplate != "test-template" {
+		t.Fatalf("expected a single version running test-template")
+	}
+}
+
+func TestInstanceGroupManagerInSync(t *testing.T) {
+	gm := &benzaiten.GCPManagedInstanceGroup{
+		Spec: benzaiten.GCPManagedInstanceGroupSpec{
+			Name: "test-mig",
+			AutoHealing: &benzaiten.AutoHealingPolicy{
+				HealthCheck:     "global/healthChecks/test-health-check",
+				InitialDelaySec: 120,
+			},
+		},
+	}
+	desired := newInstanceGroupManager(gm, "https://www.googleapis.com/compute/v1/projects/test-project/global/instanceTemplates/test-template-1")
+
+	current := newInstanceGroupManager(gm, desired.InstanceTemplate)
+	current.AutoHealingPolicies[0].HealthCheck = "https://www.googleapis.com/compute/v1/projects/test-project/global/healthChecks/test-health-check"
+	if !instanceGroupManagerInSync(current, desired) {
+		t.Fatalf("expected instance group to be in sync")
+	}
+
+	current.InstanceTemplate = "https://www.googleapis.com/compute/v1/projects/test-project/global/instanceTemplates/test-template-0"
+	if instanceGroupManagerInSync(current, desired) {
+		t.Fatalf("expected changed template to be out of sync")
+	}
+
+	current = newInstanceGroupManager(gm, desired.InstanceTemplate)
+	current.AutoHealingPolicies = nil
+	if instanceGroupManagerInSync(current, desired) {
+		t.Fatalf("expected missing auto healing to be out of sync")
+	}
+
+	current = newInstanceGroupManager(gm, desired.InstanceTemplate)
+	current.UpdatePolicy.MaxUnavailable = &compute.FixedOrPercent{Fixed: 2}
+	if instanceGroupManagerInSync(current, desired) {
+		t.Fatalf("expected changed update policy to be out of sync")
+	}
+}
+
+func TestInstanceTemplateVersion(t *testing.T) {
+	gt := &benzaiten.GCPInstanceTemplate{
+		Spec: benzaiten.GCPInstanceTemplateSpec{
+			Name:        "test-template",
+			MachineType: "e2-medium",
+			SourceImage: "projects/debian-cloud/global/images/family/debian-12",
+		},
+	}
+
+	v1, err := instanceTemplateVersion(gt.Spec.Name, newInstanceProperties(gt, nil))
+	if err != nil {
+		t.Fatalf("instanceTemplateVersion returned an error: %v", err)
+	}
+	v2, _ := instanceTemplateVersion(gt.Spec.Name, newInstanceProperties(gt, nil))
+	if v1 != v2 {
+		t.Fatalf("expected the same properties to give the same version, got %s and %s", v1, v2)
+	}
+	if len(v1) != len("test-template-")+8 {
+		t.Fatalf("unexpected version name %s", v1)
+	}
+
+	gt.Spec.MachineType = "e2-standard-4"
+	v3, _ := instanceTemplateVersion(gt.Spec.Name, newInstanceProperties(gt, nil))
+	if v3 == v1 {
+		t.Fatalf("expected changed properties to give a new version")
+	}
+}
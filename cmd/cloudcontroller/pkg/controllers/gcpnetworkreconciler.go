@@ -5,12 +5,21 @@ import (
 	"fmt"
 	"github.com/go-logr/logr"
 	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"slices"
+	"strings"
+	"time"
 )
 
 type GCPNetworkReconciler struct {
@@ -24,9 +33,9 @@ type GCPNetworkReconciler struct {
 func (cr *GCPNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := cr.Log.WithValues("gcpnetwork", req.NamespacedName)
 
-	gk := benzaiten.GCPNetwork{}
+	gn := benzaiten.GCPNetwork{}
 
-	err := cr.Get(ctx, req.NamespacedName, &gk)
+	err := cr.Get(ctx, req.NamespacedName, &gn)
 	if err != nil {
 		if kerr.IsNotFound(err) {
 			logger.Info("gcpnetwork not found")
@@ -35,14 +44,502 @@ func (cr *GCPNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
-	// TODO: Add reconciliation logic here
+	if !gn.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gn)
+	}
+
+	if controllerutil.AddFinalizer(&gn, gcpFinalizer) {
+		err = cr.Update(ctx, &gn)
+		if err != nil {
+			logger.Error(err, "error adding gcpnetwork finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// does network exist in GCP?
+	network, err := cr.cloud.GCP.GetNetwork(gn.Spec.Name)
+	if err != nil && notFoundGCPResource(err) {
+		// network does not exist in GCP
+		logger.Info("gcpnetwork not found, creating network...")
+		op, err := cr.cloud.GCP.CreateNetwork(newComputeNetwork(&gn))
+		if err != nil {
+			logger.Error(err, "error creating gcpnetwork")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitGlobalOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error creating gcpnetwork")
+			cr.eventRecorder.Event(&gn, "Warning", "NetworkFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		network, err = cr.cloud.GCP.GetNetwork(gn.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error verifying network status")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gn, "Normal", "NetworkCreated", "GCP Network created")
+	} else if err != nil {
+		logger.Error(err, "error getting gcpnetwork")
+		return ctrl.Result{}, err
+	}
+
+	// sync network
+	network, synced, err := cr.syncNetwork(ctx, &gn, network)
+	if err != nil {
+		logger.Error(err, "error syncing gcpnetwork")
+		return ctrl.Result{}, err
+	}
+
+	// sync peerings
+	network, peeringsReady, err := cr.syncPeerings(ctx, &gn, network)
+	if err != nil {
+		logger.Error(err, "error syncing gcpnetwork peerings")
+		return ctrl.Result{}, err
+	}
+
+	// update status
+	previous := gn.DeepCopyObject().(*benzaiten.GCPNetwork)
+	gn.Status.Phase = benzaiten.NetworkStatusReady
+	gn.Status.SelfLink = network.SelfLink
+	gn.Status.GatewayIPv4 = network.GatewayIPv4
+	gn.Status.MTU = network.Mtu
+	gn.Status.InternalIPv6Range = network.InternalIpv6Range
+	gn.Status.Subnetworks = network.Subnetworks
+	gn.Status.Peerings = observePeerings(&gn, network.Peerings)
+	meta.SetStatusCondition(&gn.Status.Conditions, synced)
+	meta.SetStatusCondition(&gn.Status.Conditions, peeringsReady)
+	meta.RemoveStatusCondition(&gn.Status.Conditions, benzaiten.NetworkConditionDeletionBlocked)
+	if !equality.Semantic.DeepEqual(previous.Status, gn.Status) {
+		err = cr.Status().Update(ctx, &gn)
+		if err != nil {
+			logger.Error(err, "error updating gcpnetwork status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if peeringsReady.Status == metav1.ConditionFalse {
+		// wait for the peer networks to be ready
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
 
 	logger.Info("gcp network reconciled")
-	cr.eventRecorder.Event(&gk, "Normal", "Reconciled", "GCP Network reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+// syncNetwork switches the network to custom mode and patches its mutable fields to match the spec and returns the
+// updated network along with the Synced condition
+func (cr *GCPNetworkReconciler) syncNetwork(ctx context.Context, gn *benzaiten.GCPNetwork, network *compute.Network) (*compute.Network, metav1.Condition, error) {
+	condition := metav1.Condition{
+		Type:               benzaiten.NetworkConditionSynced,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gn.Generation,
+		Reason:             "NetworkSynced",
+		Message:            "network matches the spec",
+	}
+	wait := func(op *compute.Operation) error {
+		return waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitGlobalOperation(op.Name)
+		})
+	}
+	changed := false
+
+	switch {
+	case network.AutoCreateSubnetworks && !gn.Spec.AutoCreateSubnetworks:
+		// the subnetworks created in auto mode are kept and can be managed like custom ones
+		op, err := cr.cloud.GCP.SwitchNetworkToCustomMode(gn.Spec.Name)
+		if err != nil {
+			return nil, condition, fmt.Errorf("unable to switch network to custom mode: %w", err)
+		}
+		err = wait(op)
+		if err != nil {
+			return nil, condition, fmt.Errorf("unable to switch network to custom mode: %w", err)
+		}
+		cr.eventRecorder.Event(gn, "Normal", "NetworkSwitchedToCustomMode", "GCP Network switched to custom mode")
+		changed = true
+	case !network.AutoCreateSubnetworks && gn.Spec.AutoCreateSubnetworks:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "ModeChangeNotAllowed"
+		condition.Message = "a custom mode network cannot be switched to auto mode"
+	}
+
+	desired := newComputeNetwork(gn)
+	if !networkInSync(network, desired) {
+		op, err := cr.cloud.GCP.PatchNetwork(gn.Spec.Name, networkPatch(desired))
+		if err != nil {
+			return nil, condition, fmt.Errorf("unable to patch network: %w", err)
+		}
+		err = wait(op)
+		if err != nil {
+			return nil, condition, fmt.Errorf("unable to patch network: %w", err)
+		}
+		cr.eventRecorder.Event(gn, "Normal", "NetworkUpdated", "GCP Network updated")
+		changed = true
+	}
+
+	if changed {
+		current, err := cr.cloud.GCP.GetNetwork(gn.Spec.Name)
+		if err != nil {
+			return nil, condition, err
+		}
+		network = current
+	}
+
+	return network, condition, nil
+}
+
+// syncPeerings adds, updates and removes the peerings of the network to match the spec and returns the updated
+// network along with the PeeringsReady condition. Peerings not managed by the operator are left untouched.
+func (cr *GCPNetworkReconciler) syncPeerings(ctx context.Context, gn *benzaiten.GCPNetwork, network *compute.Network) (*compute.Network, metav1.Condition, error) {
+	condition := metav1.Condition{
+		Type:               benzaiten.NetworkConditionPeeringsReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gn.Generation,
+		Reason:             "PeeringsSynced",
+		Message:            "peerings match the spec",
+	}
+	wait := func(op *compute.Operation) error {
+		return waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitGlobalOperation(op.Name)
+		})
+	}
+	add := func(peering *compute.NetworkPeering) error {
+		op, err := cr.cloud.GCP.AddNetworkPeering(gn.Spec.Name, peering)
+		if err != nil {
+			return fmt.Errorf("unable to add peering %s: %w", peering.Name, err)
+		}
+		err = wait(op)
+		if err != nil {
+			return fmt.Errorf("unable to add peering %s: %w", peering.Name, err)
+		}
+		cr.eventRecorder.Event(gn, "Normal", "PeeringAdded", fmt.Sprintf("GCP Network peering %s to %s added", peering.Name, resourcePath(peering.Network)))
+		return nil
+	}
+	remove := func(name string) error {
+		op, err := cr.cloud.GCP.RemoveNetworkPeering(gn.Spec.Name, name)
+		if err != nil {
+			return fmt.Errorf("unable to remove peering %s: %w", name, err)
+		}
+		err = wait(op)
+		if err != nil {
+			return fmt.Errorf("unable to remove peering %s: %w", name, err)
+		}
+		cr.eventRecorder.Event(gn, "Normal", "PeeringRemoved", fmt.Sprintf("GCP Network peering %s removed", name))
+		return nil
+	}
+	update := func(peering *compute.NetworkPeering) error {
+		op, err := cr.cloud.GCP.UpdateNetworkPeering(gn.Spec.Name, peering)
+		if err != nil {
+			return fmt.Errorf("unable to update peering %s: %w", peering.Name, err)
+		}
+		err = wait(op)
+		if err != nil {
+			return fmt.Errorf("unable to update peering %s: %w", peering.Name, err)
+		}
+		cr.eventRecorder.Event(gn, "Normal", "PeeringUpdated", fmt.Sprintf("GCP Network peering %s updated", peering.Name))
+		return nil
+	}
+	changed := false
+
+	desiredNames := make(map[string]bool, len(gn.Spec.Peerings))
+	for _, spec := range gn.Spec.Peerings {
+		desiredNames[spec.Name] = true
+		peerNetwork, err := cr.resolvePeerNetwork(ctx, gn, spec)
+		if err != nil {
+			return nil, condition, err
+		}
+		if peerNetwork == "" {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "PeerNetworkNotReady"
+			condition.Message = fmt.Sprintf("waiting for GCPNetwork %s of peering %s", spec.PeerNetworkRef.Name, spec.Name)
+			continue
+		}
+		desired := newNetworkPeering(spec, peerNetwork)
+
+		i := slices.IndexFunc(network.Peerings, func(p *compute.NetworkPeering) bool { return p.Name == spec.Name })
+		switch {
+		case i < 0:
+			err = add(desired)
+		case resourcePath(network.Peerings[i].Network) != resourcePath(desired.Network):
+			// the peer network of a peering cannot be changed, replace the peering
+			err = remove(spec.Name)
+			if err == nil {
+				err = add(desired)
+			}
+		case !peeringInSync(network.Peerings[i], desired):
+			err = update(desired)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, condition, err
+		}
+		changed = true
+	}
+
+	// remove the peerings previously managed which are no longer in the spec
+	for _, peering := range gn.Status.Peerings {
+		if !peering.Managed || desiredNames[peering.Name] {
+			continue
+		}
+		if !slices.ContainsFunc(network.Peerings, func(p *compute.NetworkPeering) bool { return p.Name == peering.Name }) {
+			continue
+		}
+		err := remove(peering.Name)
+		if err != nil {
+			return nil, condition, err
+		}
+		changed = true
+	}
+
+	if changed {
+		current, err := cr.cloud.GCP.GetNetwork(gn.Spec.Name)
+		if err != nil {
+			return nil, condition, err
+		}
+		network = current
+	}
+
+	return network, condition, nil
+}
+
+// resolvePeerNetwork returns the URL of the peer network, empty if the referenced GCPNetwork is not ready
+func (cr *GCPNetworkReconciler) resolvePeerNetwork(ctx context.Context, gn *benzaiten.GCPNetwork, spec benzaiten.NetworkPeering) (string, error) {
+	if spec.PeerNetworkRef == nil {
+		return spec.PeerNetwork, nil
+	}
+
+	peer := benzaiten.GCPNetwork{}
+	err := cr.Get(ctx, types.NamespacedName{Namespace: gn.Namespace, Name: spec.PeerNetworkRef.Name}, &peer)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return peer.Status.SelfLink, nil
+}
 
+func (cr *GCPNetworkReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gn *benzaiten.GCPNetwork) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gn, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	network, err := cr.cloud.GCP.GetNetwork(gn.Spec.Name)
+	if err != nil && !notFoundGCPResource(err) {
+		logger.Error(err, "error getting gcpnetwork")
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		// deleting a network still in use would break its dependents, wait for them to be deleted first
+		dependents, err := cr.networkDependents(ctx, gn, network)
+		if err != nil {
+			logger.Error(err, "error listing gcpnetwork dependents")
+			return ctrl.Result{}, err
+		}
+		if len(dependents) > 0 {
+			return cr.blockDelete(ctx, logger, gn, network, fmt.Sprintf("network is used by %s", strings.Join(dependents, ", ")))
+		}
+
+		// peerings prevent the deletion of the network
+		for _, peering := range network.Peerings {
+			logger.Info("removing gcpnetwork peering...", "peering", peering.Name)
+			op, err := cr.cloud.GCP.RemoveNetworkPeering(gn.Spec.Name, peering.Name)
+			if err != nil {
+				logger.Error(err, "error removing gcpnetwork peering")
+				return ctrl.Result{}, err
+			}
+			err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+				return cr.cloud.GCP.WaitGlobalOperation(op.Name)
+			})
+			if err != nil {
+				logger.Error(err, "error removing gcpnetwork peering")
+				return ctrl.Result{}, err
+			}
+		}
+
+		logger.Info("deleting gcpnetwork...")
+		op, err := cr.cloud.GCP.DeleteNetwork(gn.Spec.Name)
+		if err != nil {
+			if inUseGCPResource(err) {
+				// GCP refuses to delete a network with subnetworks, firewall rules or routes not known to the cluster
+				return cr.blockDelete(ctx, logger, gn, network, err.Error())
+			}
+			logger.Error(err, "error deleting gcpnetwork")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitGlobalOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error deleting gcpnetwork")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(gn, "Normal", "NetworkDeleted", "GCP Network deleted")
+	}
+
+	controllerutil.RemoveFinalizer(gn, gcpFinalizer)
+	err = cr.Update(ctx, gn)
+	if err != nil {
+		logger.Error(err, "error removing gcpnetwork finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp network deleted")
 	return ctrl.Result{}, nil
 }
 
+// blockDelete reports the deletion of the network as blocked and requeues it
+func (cr *GCPNetworkReconciler) blockDelete(ctx context.Context, logger logr.Logger, gn *benzaiten.GCPNetwork, network *compute.Network, message string) (ctrl.Result, error) {
+	condition := metav1.Condition{
+		Type:               benzaiten.NetworkConditionDeletionBlocked,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gn.Generation,
+		Reason:             "NetworkInUse",
+		Message:            message,
+	}
+	if !meta.IsStatusConditionPresentAndEqual(gn.Status.Conditions, condition.Type, condition.Status) {
+		cr.eventRecorder.Event(gn, "Warning", "NetworkInUse", fmt.Sprintf("GCP Network is in use, deletion blocked: %s", message))
+	}
+	previous := gn.DeepCopyObject().(*benzaiten.GCPNetwork)
+	meta.SetStatusCondition(&gn.Status.Conditions, condition)
+	gn.Status.Phase = benzaiten.NetworkStatusDeleting
+	gn.Status.Subnetworks = network.Subnetworks
+	if !equality.Semantic.DeepEqual(previous.Status, gn.Status) {
+		err := cr.Status().Update(ctx, gn)
+		if err != nil {
+			logger.Error(err, "error updating gcpnetwork status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+}
+
+// networkDependents returns the resources which still depend on the network
+func (cr *GCPNetworkReconciler) networkDependents(ctx context.Context, gn *benzaiten.GCPNetwork, network *compute.Network) ([]string, error) {
+	var dependents []string
+
+	// the subnetworks of an auto mode network are deleted along with it, custom ones must be deleted first
+	if !network.AutoCreateSubnetworks {
+		gss := benzaiten.GCPSubnetworkList{}
+		err := cr.List(ctx, &gss, client.InNamespace(gn.Namespace))
+		if err != nil {
+			return nil, fmt.Errorf("unable to list gcpsubnetworks: %w", err)
+		}
+		managed := make(map[string]bool, len(gss.Items))
+		for _, gs := range gss.Items {
+			if gs.Spec.NetworkRef.Name == gn.Name {
+				dependents = append(dependents, "subnetwork "+gs.Name)
+				managed[gs.Spec.Name] = true
+			}
+		}
+		// subnetworks not managed by the operator block the deletion of the network in GCP as well
+		for _, subnetwork := range network.Subnetworks {
+			if name := lastURLSegment(subnetwork); !managed[name] {
+				dependents = append(dependents, "subnetwork "+name)
+			}
+		}
+	}
+
+	gfs := benzaiten.GCPFirewallRuleList{}
+	err := cr.List(ctx, &gfs, client.InNamespace(gn.Namespace))
+	if err != nil {
+		return nil, fmt.Errorf("unable to list gcpfirewallrules: %w", err)
+	}
+	for _, gf := range gfs.Items {
+		if gf.Spec.NetworkRef.Name == gn.Name {
+			dependents = append(dependents, "firewall rule "+gf.Name)
+		}
+	}
+
+	grs := benzaiten.GCPRouterList{}
+	err = cr.List(ctx, &grs, client.InNamespace(gn.Namespace))
+	if err != nil {
+		return nil, fmt.Errorf("unable to list gcprouters: %w", err)
+	}
+	for _, gr := range grs.Items {
+		if gr.Spec.NetworkRef.Name == gn.Name {
+			dependents = append(dependents, "router "+gr.Name)
+		}
+	}
+
+	routes := benzaiten.GCPRouteList{}
+	err = cr.List(ctx, &routes, client.InNamespace(gn.Namespace))
+	if err != nil {
+		return nil, fmt.Errorf("unable to list gcproutes: %w", err)
+	}
+	for _, route := range routes.Items {
+		if route.Spec.NetworkRef.Name == gn.Name {
+			dependents = append(dependents, "route "+route.Name)
+		}
+	}
+
+	// GCP networks are global to the project, clusters of any namespace may use it
+	gkcs := benzaiten.GCPKubernetesClusterList{}
+	err = cr.List(ctx, &gkcs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list gcpkubernetesclusters: %w", err)
+	}
+	for _, gkc := range gkcs.Items {
+		if networkReferences(gkc.Spec.Network, gn.Spec.Name) || refersTo(gkc.Spec.NetworkRef, gkc.Namespace, gn) {
+			dependents = append(dependents, fmt.Sprintf("cluster %s/%s", gkc.Namespace, gkc.Name))
+		}
+	}
+
+	// instances may reference the network from any namespace
+	gis := benzaiten.GCPInstanceList{}
+	err = cr.List(ctx, &gis)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list gcpinstances: %w", err)
+	}
+	for _, gi := range gis.Items {
+		if refersTo(gi.Spec.NetworkRef, gi.Namespace, gn) {
+			dependents = append(dependents, fmt.Sprintf("instance %s/%s", gi.Namespace, gi.Name))
+		}
+	}
+
+	// redis and sql instances of any namespace may be reachable from the network
+	gris := benzaiten.GCPRedisInstanceList{}
+	err = cr.List(ctx, &gris)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list gcpredisinstances: %w", err)
+	}
+	for _, gri := range gris.Items {
+		if refersTo(gri.Spec.NetworkRef, gri.Namespace, gn) {
+			dependents = append(dependents, fmt.Sprintf("redis instance %s/%s", gri.Namespace, gri.Name))
+		}
+	}
+	gsis := benzaiten.GCPSQLInstanceList{}
+	err = cr.List(ctx, &gsis)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list gcpsqlinstances: %w", err)
+	}
+	for _, gsi := range gsis.Items {
+		if refersTo(gsi.Spec.NetworkRef, gsi.Namespace, gn) {
+			dependents = append(dependents, fmt.Sprintf("sql instance %s/%s", gsi.Namespace, gsi.Name))
+		}
+	}
+
+	// private zones of any namespace may be visible from the network
+	gzs := benzaiten.GCPDNSZoneList{}
+	err = cr.List(ctx, &gzs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list gcpdnszones: %w", err)
+	}
+	for _, gz := range gzs.Items {
+		for i := range gz.Spec.NetworkRefs {
+			if refersTo(&gz.Spec.NetworkRefs[i], gz.Namespace, gn) {
+				dependents = append(dependents, fmt.Sprintf("dns zone %s/%s", gz.Namespace, gz.Name))
+				break
+			}
+		}
+	}
+
+	return dependents, nil
+}
+
 func (cr *GCPNetworkReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&benzaiten.GCPNetwork{}).
@@ -67,3 +564,108 @@ func setupGCPNetworkController(mgr manager.Manager, log logr.Logger, cp CloudPro
 
 	return nil
 }
+
+// newComputeNetwork builds the GCP network described by the GCPNetwork spec
+func newComputeNetwork(gn *benzaiten.GCPNetwork) *compute.Network {
+	network := &compute.Network{
+		Name:                                  gn.Spec.Name,
+		AutoCreateSubnetworks:                 gn.Spec.AutoCreateSubnetworks,
+		Mtu:                                   gn.Spec.MTU,
+		Description:                           gn.Spec.Description,
+		NetworkFirewallPolicyEnforcementOrder: gn.Spec.FirewallPolicyEnforcementOrder,
+		// GCP creates a legacy network if autoCreateSubnetworks is omitted
+		ForceSendFields: []string{"AutoCreateSubnetworks"},
+	}
+	if gn.Spec.RoutingMode != "" {
+		network.RoutingConfig = &compute.NetworkRoutingConfig{RoutingMode: gn.Spec.RoutingMode}
+	}
+	if gn.Spec.InternalIPv6 != nil {
+		network.EnableUlaInternalIpv6 = true
+		network.InternalIpv6Range = gn.Spec.InternalIPv6.Range
+	}
+
+	return network
+}
+
+// networkInSync reports whether the mutable fields of the GCP network match the desired network. Unset fields but
+// the description are left to GCP.
+func networkInSync(current, desired *compute.Network) bool {
+	if desired.RoutingConfig != nil &&
+		(current.RoutingConfig == nil || current.RoutingConfig.RoutingMode != desired.RoutingConfig.RoutingMode) {
+		return false
+	}
+	if desired.Mtu != 0 && current.Mtu != desired.Mtu {
+		return false
+	}
+	if desired.NetworkFirewallPolicyEnforcementOrder != "" &&
+		current.NetworkFirewallPolicyEnforcementOrder != desired.NetworkFirewallPolicyEnforcementOrder {
+		return false
+	}
+	return current.Description == desired.Description
+}
+
+// networkPatch returns the patch applying the mutable fields of the desired network. The description is always sent,
+// an empty description clears it.
+func networkPatch(desired *compute.Network) *compute.Network {
+	return &compute.Network{
+		RoutingConfig:                         desired.RoutingConfig,
+		Mtu:                                   desired.Mtu,
+		Description:                           desired.Description,
+		NetworkFirewallPolicyEnforcementOrder: desired.NetworkFirewallPolicyEnforcementOrder,
+		ForceSendFields:                       []string{"Description"},
+	}
+}
+
+// newNetworkPeering builds the GCP network peering described by the peering spec
+func newNetworkPeering(spec benzaiten.NetworkPeering, peerNetwork string) *compute.NetworkPeering {
+	return &compute.NetworkPeering{
+		Name:                           spec.Name,
+		Network:                        peerNetwork,
+		ExchangeSubnetRoutes:           true,
+		ExportCustomRoutes:             spec.ExportCustomRoutes,
+		ImportCustomRoutes:             spec.ImportCustomRoutes,
+		ExportSubnetRoutesWithPublicIp: spec.ExportSubnetRoutesWithPublicIP,
+		ImportSubnetRoutesWithPublicIp: spec.ImportSubnetRoutesWithPublicIP,
+		ForceSendFields: []string{"ExportCustomRoutes", "ImportCustomRoutes",
+			"ExportSubnetRoutesWithPublicIp", "ImportSubnetRoutesWithPublicIp"},
+	}
+}
+
+// peeringInSync reports whether the route exchange of the GCP peering matches the desired peering
+func peeringInSync(current, desired *compute.NetworkPeering) bool {
+	return current.ExportCustomRoutes == desired.ExportCustomRoutes &&
+		current.ImportCustomRoutes == desired.ImportCustomRoutes &&
+		current.ExportSubnetRoutesWithPublicIp == desired.ExportSubnetRoutesWithPublicIp &&
+		current.ImportSubnetRoutesWithPublicIp == desired.ImportSubnetRoutesWithPublicIp
+}
+
+// observePeerings returns the status of the peerings of the GCP network
+func observePeerings(gn *benzaiten.GCPNetwork, peerings []*compute.NetworkPeering) []benzaiten.NetworkPeeringStatus {
+	var statuses []benzaiten.NetworkPeeringStatus
+	for _, peering := range peerings {
+		statuses = append(statuses, benzaiten.NetworkPeeringStatus{
+			Name:         peering.Name,
+			PeerNetwork:  peering.Network,
+			State:        peering.State,
+			StateDetails: peering.StateDetails,
+			Managed: slices.ContainsFunc(gn.Spec.Peerings, func(p benzaiten.NetworkPeering) bool {
+				return p.Name == peering.Name
+			}),
+		})
+	}
+	return statuses
+}
+
+// resourcePath returns the path of the GCP resource URL starting at the project, e.g.
+// projects/my-project/global/networks/my-network
+func resourcePath(url string) string {
+	if i := strings.Index(url, "projects/"); i >= 0 {
+		return url[i:]
+	}
+	return url
+}
+
+// networkReferences reports whether the network name or URL refers to the named network
+func networkReferences(network, name string) bool {
+	return network != "" && lastURLSegment(network) == name
+}
@@ -0,0 +1,187 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/compute/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNewComputeNetwork(t *testing.T) {
+	gn := &benzaiten.GCPNetwork{
+		Spec: benzaiten.GCPNetworkSpec{
+			Name:                  "test-network",
+			AutoCreateSubnetworks: false,
+		},
+	}
+
+	data, err := json.Marshal(newComputeNetwork(gn))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// a custom mode network must send autoCreateSubnetworks explicitly, otherwise GCP creates a legacy network
+	if !strings.Contains(string(data), `"autoCreateSubnetworks":false`) {
+		t.Fatalf("expected autoCreateSubnetworks to be sent, got %s", data)
+	}
+}
+
+func TestNetworkReferences(t *testing.T) {
+	tests := []struct {
+		network  string
+		expected bool
+	}{
+		{"test-network", true},
+		{"projects/test-project/global/networks/test-network", true},
+		{"https://www.googleapis.com/compute/v1/projects/test-project/global/networks/test-network", true},
+		{"other-network", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := networkReferences(tt.network, "test-network"); got != tt.expected {
+			t.Errorf("networkReferences(%q) = %v, expected %v", tt.network, got, tt.expected)
+		}
+	}
+}
+
+func TestPeeringInSync(t *testing.T) {
+	spec := benzaiten.NetworkPeering{
+		Name:                           "test-peering",
+		PeerNetwork:                    "projects/other-project/global/networks/other-network",
+		ExportCustomRoutes:             true,
+		ExportSubnetRoutesWithPublicIP: true,
+	}
+	desired := newNetworkPeering(spec, spec.PeerNetwork)
+
+	current := &compute.NetworkPeering{
+		Name:                           "test-peering",
+		Network:                        "https://www.googleapis.com/compute/v1/projects/other-project/global/networks/other-network",
+		ExportCustomRoutes:             true,
+		ExportSubnetRoutesWithPublicIp: true,
+	}
+	if !peeringInSync(current, desired) {
+		t.Fatalf("expected peering to be in sync")
+	}
+	if resourcePath(current.Network) != resourcePath(desired.Network) {
+		t.Fatalf("expected peer network URLs to match, got %s and %s", resourcePath(current.Network), resourcePath(desired.Network))
+	}
+
+	current.ImportCustomRoutes = true
+	if peeringInSync(current, desired) {
+		t.Fatalf("expected imported custom routes to be out of sync")
+	}
+}
+
+func TestObservePeerings(t *testing.T) {
+	gn := &benzaiten.GCPNetwork{
+		Spec: benzaiten.GCPNetworkSpec{
+			Peerings: []benzaiten.NetworkPeering{{Name: "managed-peering"}},
+		},
+	}
+	peerings := []*compute.NetworkPeering{
+		{Name: "managed-peering", State: "ACTIVE"},
+		{Name: "legacy-peering", State: "INACTIVE", StateDetails: "peer network deleted"},
+	}
+
+	statuses := observePeerings(gn, peerings)
+	if len(statuses) != 2 {
+		t.Fatalf("expected 2 peerings, got %d", len(statuses))
+	}
+	if !statuses[0].Managed || statuses[0].State != "ACTIVE" {
+		t.Fatalf("expected managed active peering, got %+v", statuses[0])
+	}
+	if statuses[1].Managed || statuses[1].State != "INACTIVE" {
+		t.Fatalf("expected unmanaged inactive peering, got %+v", statuses[1])
+	}
+}
+
+func TestNetworkInSync(t *testing.T) {
+	gn := &benzaiten.GCPNetwork{
+		Spec: benzaiten.GCPNetworkSpec{
+			Name:                           "test-network",
+			RoutingMode:                    "GLOBAL",
+			FirewallPolicyEnforcementOrder: "AFTER_CLASSIC_FIREWALL",
+		},
+	}
+	desired := newComputeNetwork(gn)
+
+	current := &compute.Network{
+		Name:                                  "test-network",
+		RoutingConfig:                         &compute.NetworkRoutingConfig{RoutingMode: "GLOBAL"},
+		Mtu:                                   1460,
+		NetworkFirewallPolicyEnforcementOrder: "AFTER_CLASSIC_FIREWALL",
+	}
+	// the MTU is left to GCP when unset
+	if !networkInSync(current, desired) {
+		t.Fatalf("expected network to be in sync")
+	}
+
+	gn.Spec.MTU = 1500
+	if networkInSync(current, newComputeNetwork(gn)) {
+		t.Fatalf("expected a different MTU to be out of sync")
+	}
+
+	gn.Spec.MTU = 0
+	current.RoutingConfig.RoutingMode = "REGIONAL"
+	if networkInSync(current, newComputeNetwork(gn)) {
+		t.Fatalf("expected a different routing mode to be out of sync")
+	}
+
+	// the description was removed from the spec
+	current.RoutingConfig.RoutingMode = "GLOBAL"
+	current.Description = "legacy network"
+	desired = newComputeNetwork(gn)
+	if networkInSync(current, desired) {
+		t.Fatalf("expected a different description to be out of sync")
+	}
+	body, err := json.Marshal(networkPatch(desired))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(body), `"description":""`) {
+		t.Fatalf("expected the empty description to be sent, got %s", body)
+	}
+}
+
+func TestNetworkDependents(t *testing.T) {
+	gn := &benzaiten.GCPNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-network", Namespace: "network"},
+		Spec:       benzaiten.GCPNetworkSpec{Name: "test-network", AutoCreateSubnetworks: true},
+	}
+	// the databases reference the network from their own namespace
+	redis := &benzaiten.GCPRedisInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "app"},
+		Spec: benzaiten.GCPRedisInstanceSpec{
+			NetworkRef: &benzaiten.NamespacedResourceRef{Name: "test-network", Namespace: "network"},
+		},
+	}
+	sql := &benzaiten.GCPSQLInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "app"},
+		Spec: benzaiten.GCPSQLInstanceSpec{
+			NetworkRef: &benzaiten.NamespacedResourceRef{Name: "test-network", Namespace: "network"},
+		},
+	}
+	// a public sql instance does not use any network
+	public := &benzaiten.GCPSQLInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "public-db", Namespace: "network"},
+	}
+	cr := &GCPNetworkReconciler{
+		Client:        fake.NewClientBuilder().WithScheme(Scheme).WithObjects(gn, redis, sql, public).Build(),
+		Scheme:        Scheme,
+		eventRecorder: record.NewFakeRecorder(10),
+	}
+
+	dependents, err := cr.networkDependents(context.Background(), gn, &compute.Network{AutoCreateSubnetworks: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(dependents, []string{"redis instance app/cache", "sql instance app/db"}) {
+		t.Fatalf("unexpected dependents %v", dependents)
+	}
+}
@@ -0,0 +1,148 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/api/artifactregistry/v1"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/dns/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/redis/v1"
+	"google.golang.org/api/sqladmin/v1"
+	"net/http"
+	"time"
+)
+
+const (
+	operationStatusDone    = "DONE"
+	dnsOperationStatusDone = "done"
+	reasonResourceInUse    = "resourceInUseByAnotherResource"
+	reasonNotEmpty         = "containerNotEmpty"
+)
+
+// waitOperation calls poll every few seconds until it reports the operation done or fails
+func waitOperation(ctx context.Context, poll func() (done bool, err error)) error {
+	ticker := time.NewTicker(3 * time.Second)
+	defer ticker.Stop()
+	for {
+		done, err := poll()
+		if err != nil || done {
+			return err
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// waitComputeOperation calls wait until the compute operation is done and returns the operation error, if any
+func waitComputeOperation(ctx context.Context, wait func() (*compute.Operation, error)) error {
+	return waitOperation(ctx, func() (bool, error) {
+		op, err := wait()
+		if err != nil || op.Status != operationStatusDone {
+			return false, err
+		}
+		if op.Error != nil && len(op.Error.Errors) > 0 {
+			return true, fmt.Errorf("operation %s failed: %s", op.Name, op.Error.Errors[0].Message)
+		}
+		return true, nil
+	})
+}
+
+// waitSQLOperation polls get until the Cloud SQL operation is done and returns the operation error, if any
+func waitSQLOperation(ctx context.Context, get func() (*sqladmin.Operation, error)) error {
+	return waitOperation(ctx, func() (bool, error) {
+		op, err := get()
+		if err != nil || op.Status != operationStatusDone {
+			return false, err
+		}
+		if op.Error != nil && len(op.Error.Errors) > 0 {
+			return true, fmt.Errorf("operation %s failed: %s", op.Name, op.Error.Errors[0].Message)
+		}
+		return true, nil
+	})
+}
+
+// waitArtifactRegistryOperation polls get until the Artifact Registry operation is done and returns the operation
+// error, if any
+func waitArtifactRegistryOperation(ctx context.Context, get func() (*artifactregistry.Operation, error)) error {
+	return waitOperation(ctx, func() (bool, error) {
+		op, err := get()
+		if err != nil || !op.Done {
+			return false, err
+		}
+		if op.Error != nil {
+			return true, fmt.Errorf("operation %s failed: %s", op.Name, op.Error.Message)
+		}
+		return true, nil
+	})
+}
+
+// waitRedisOperation polls get until the Memorystore for Redis operation is done and returns the operation error,
+// if any
+func waitRedisOperation(ctx context.Context, get func() (*redis.Operation, error)) error {
+	return waitOperation(ctx, func() (bool, error) {
+		op, err := get()
+		if err != nil || !op.Done {
+			return false, err
+		}
+		if op.Error != nil {
+			return true, fmt.Errorf("operation %s failed: %s", op.Name, op.Error.Message)
+		}
+		return true, nil
+	})
+}
+
+// waitDNSOperation polls get until the Cloud DNS operation is done
+func waitDNSOperation(ctx context.Context, get func() (*dns.Operation, error)) error {
+	return waitOperation(ctx, func() (bool, error) {
+		op, err := get()
+		if err != nil {
+			return false, err
+		}
+		return op.Status == dnsOperationStatusDone, nil
+	})
+}
+
+// inUseGCPResource reports whether the GCP resource could not be deleted because another resource still uses it
+func inUseGCPResource(err error) bool {
+	var gerr *googleapi.Error
+	if !errors.As(err, &gerr) {
+		return false
+	}
+	for _, e := range gerr.Errors {
+		if e.Reason == reasonResourceInUse {
+			return true
+		}
+	}
+	return false
+}
+
+// notEmptyGCPResource reports whether GCP refused to delete a container, e.g. a DNS zone, because it still holds resources
+func notEmptyGCPResource(err error) bool {
+	var gerr *googleapi.Error
+	if !errors.As(err, &gerr) {
+		return false
+	}
+	for _, e := range gerr.Errors {
+		if e.Reason == reasonNotEmpty {
+			return true
+		}
+	}
+	return false
+}
+
+// concurrentGCPModification reports whether GCP rejected a write because the resource changed since it was read, e.g.
+// an IAM policy whose etag no longer matches. IAM policies are written back with the etag they were read with, so a
+// binding added in between is never overwritten, the write fails and the policy is read again.
+func concurrentGCPModification(err error) bool {
+	var gerr *googleapi.Error
+	if !errors.As(err, &gerr) {
+		return false
+	}
+	return gerr.Code == http.StatusConflict || gerr.Code == http.StatusPreconditionFailed
+}
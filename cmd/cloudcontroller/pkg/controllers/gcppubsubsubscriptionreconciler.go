@@ -0,0 +1,354 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/pubsub/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"maps"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"strings"
+	"time"
+)
+
+type GCPPubSubSubscriptionReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPPubSubSubscriptionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcppubsubsubscription", req.NamespacedName)
+
+	gsub := benzaiten.GCPPubSubSubscription{}
+	err := cr.Get(ctx, req.NamespacedName, &gsub)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcppubsubsubscription not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gsub.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gsub)
+	}
+
+	if controllerutil.AddFinalizer(&gsub, gcpFinalizer) {
+		err = cr.Update(ctx, &gsub)
+		if err != nil {
+			logger.Error(err, "error adding gcppubsubsubscription finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := gsub.DeepCopyObject().(*benzaiten.GCPPubSubSubscription)
+
+	// the subscription and its dead-letter policy refer to the topics by resource name
+	topics, condition, err := resolveSubscriptionTopics(ctx, cr.Client, &gsub)
+	if err != nil {
+		logger.Error(err, "error resolving gcppubsubsubscription topics")
+		return ctrl.Result{}, err
+	}
+	meta.SetStatusCondition(&gsub.Status.Conditions, condition)
+	if condition.Status != metav1.ConditionTrue {
+		if !equality.Semantic.DeepEqual(previous.Status, gsub.Status) {
+			err = cr.Status().Update(ctx, &gsub)
+			if err != nil {
+				logger.Error(err, "error updating gcppubsubsubscription status")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+	desired := newSubscription(&gsub, topics)
+
+	// does subscription exist in GCP?
+	subscription, err := cr.cloud.GCP.GetSubscription(gsub.Spec.Name)
+	if err != nil && notFoundGCPResource(err) {
+		// subscription does not exist in GCP
+		logger.Info("gcppubsubsubscription not found, creating subscription...")
+		subscription, err = cr.cloud.GCP.CreateSubscription(gsub.Spec.Name, desired)
+		if err != nil {
+			logger.Error(err, "error creating gcppubsubsubscription")
+			cr.eventRecorder.Event(&gsub, "Warning", "SubscriptionFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gsub, "Normal", "SubscriptionCreated", "GCP Pub/Sub Subscription created")
+	} else if err != nil {
+		logger.Error(err, "error getting gcppubsubsubscription")
+		return ctrl.Result{}, err
+	} else if updateMask := subscriptionUpdateMask(subscription, desired); len(updateMask) > 0 {
+		logger.Info("gcppubsubsubscription out of sync, updating subscription...", "fields", updateMask)
+		subscription, err = cr.cloud.GCP.PatchSubscription(gsub.Spec.Name, desired, updateMask)
+		if err != nil {
+			logger.Error(err, "error updating gcppubsubsubscription")
+			cr.eventRecorder.Event(&gsub, "Warning", "SubscriptionFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gsub, "Normal", "SubscriptionUpdated", fmt.Sprintf("GCP Pub/Sub Subscription updated: %s", strings.Join(updateMask, ", ")))
+	}
+
+	// update status
+	gsub.Status.Path = subscription.Name
+	gsub.Status.State = subscription.State
+	if !equality.Semantic.DeepEqual(previous.Status, gsub.Status) {
+		err = cr.Status().Update(ctx, &gsub)
+		if err != nil {
+			logger.Error(err, "error updating gcppubsubsubscription status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp pubsub subscription reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+func (cr *GCPPubSubSubscriptionReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gsub *benzaiten.GCPPubSubSubscription) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gsub, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("deleting gcppubsubsubscription...")
+	err := cr.cloud.GCP.DeleteSubscription(gsub.Spec.Name)
+	if err != nil && !notFoundGCPResource(err) {
+		logger.Error(err, "error deleting gcppubsubsubscription")
+		return ctrl.Result{}, err
+	}
+
+	controllerutil.RemoveFinalizer(gsub, gcpFinalizer)
+	err = cr.Update(ctx, gsub)
+	if err != nil {
+		logger.Error(err, "error removing gcppubsubsubscription finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp pubsub subscription deleted")
+	return ctrl.Result{}, nil
+}
+
+func (cr *GCPPubSubSubscriptionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPPubSubSubscription{}).
+		Watches(&benzaiten.GCPPubSubTopic{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForTopic)).
+		Complete(cr)
+}
+
+// requestsForTopic returns the GCPPubSubSubscriptions referencing the GCPPubSubTopic
+func (cr *GCPPubSubSubscriptionReconciler) requestsForTopic(ctx context.Context, obj client.Object) []reconcile.Request {
+	gsubs := benzaiten.GCPPubSubSubscriptionList{}
+	err := cr.List(ctx, &gsubs, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		cr.Log.Error(err, "unable to list gcppubsubsubscriptions")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, gsub := range gsubs.Items {
+		if subscriptionReferencesTopic(&gsub, obj.GetName()) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: gsub.Name, Namespace: gsub.Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
+func setupGCPPubSubSubscriptionController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcppubsubsubscription")
+	cc := GCPPubSubSubscriptionReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPPubSubSubscriptionReconciler"),
+	}
+
+	// create GCPPubSubSubscription controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPPubSubSubscription controller: %w", err)
+	}
+
+	return nil
+}
+
+// subscriptionTopics holds the resource names of the topics referenced by a subscription
+type subscriptionTopics struct {
+	Topic           string
+	DeadLetterTopic string
+}
+
+// subscriptionReferencesTopic reports whether the subscription receives or dead-letters the messages of the topic
+func subscriptionReferencesTopic(gsub *benzaiten.GCPPubSubSubscription, topic string) bool {
+	if gsub.Spec.TopicRef.Name == topic {
+		return true
+	}
+	return gsub.Spec.DeadLetterPolicy != nil && gsub.Spec.DeadLetterPolicy.TopicRef.Name == topic
+}
+
+// resolveSubscriptionTopics resolves the topics of the subscription to their resource names. The returned condition
+// is False until every topic exists.
+func resolveSubscriptionTopics(ctx context.Context, c client.Client, gsub *benzaiten.GCPPubSubSubscription) (subscriptionTopics, metav1.Condition, error) {
+	refs := []string{gsub.Spec.TopicRef.Name}
+	if gsub.Spec.DeadLetterPolicy != nil {
+		refs = append(refs, gsub.Spec.DeadLetterPolicy.TopicRef.Name)
+	}
+
+	var paths, pending []string
+	for _, ref := range refs {
+		gt := benzaiten.GCPPubSubTopic{}
+		err := c.Get(ctx, types.NamespacedName{Namespace: gsub.Namespace, Name: ref}, &gt)
+		if err != nil && !kerr.IsNotFound(err) {
+			return subscriptionTopics{}, metav1.Condition{}, fmt.Errorf("unable to get gcppubsubtopic %s: %w", ref, err)
+		}
+		if err != nil || gt.Status.Path == "" {
+			pending = append(pending, ref)
+			continue
+		}
+		paths = append(paths, gt.Status.Path)
+	}
+	if len(pending) > 0 {
+		return subscriptionTopics{}, metav1.Condition{
+			Type:               benzaiten.SubscriptionConditionTopicsReady,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: gsub.Generation,
+			Reason:             "TopicsNotReady",
+			Message:            fmt.Sprintf("waiting for GCPPubSubTopics %s", strings.Join(pending, ", ")),
+		}, nil
+	}
+
+	topics := subscriptionTopics{Topic: paths[0]}
+	if len(paths) > 1 {
+		topics.DeadLetterTopic = paths[1]
+	}
+	return topics, metav1.Condition{
+		Type:               benzaiten.SubscriptionConditionTopicsReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gsub.Generation,
+		Reason:             "TopicsReady",
+		Message:            "topics exist",
+	}, nil
+}
+
+// newSubscription builds the Pub/Sub subscription described by the GCPPubSubSubscription spec
+func newSubscription(gsub *benzaiten.GCPPubSubSubscription, topics subscriptionTopics) *pubsub.Subscription {
+	subscription := &pubsub.Subscription{
+		Topic:                     topics.Topic,
+		AckDeadlineSeconds:        gsub.Spec.AckDeadlineSeconds,
+		MessageRetentionDuration:  gsub.Spec.MessageRetentionDuration,
+		RetainAckedMessages:       gsub.Spec.RetainAckedMessages,
+		Filter:                    gsub.Spec.Filter,
+		EnableExactlyOnceDelivery: gsub.Spec.EnableExactlyOnceDelivery,
+		EnableMessageOrdering:     gsub.Spec.EnableMessageOrdering,
+		Labels:                    gsub.Spec.Labels,
+		// an empty push config turns a push subscription back into a pull subscription
+		PushConfig: &pubsub.PushConfig{},
+	}
+	if policy := gsub.Spec.DeadLetterPolicy; policy != nil {
+		subscription.DeadLetterPolicy = &pubsub.DeadLetterPolicy{
+			DeadLetterTopic:     topics.DeadLetterTopic,
+			MaxDeliveryAttempts: policy.MaxDeliveryAttempts,
+		}
+	}
+	if policy := gsub.Spec.RetryPolicy; policy != nil {
+		subscription.RetryPolicy = &pubsub.RetryPolicy{
+			MinimumBackoff: policy.MinimumBackoff,
+			MaximumBackoff: policy.MaximumBackoff,
+		}
+	}
+	if push := gsub.Spec.PushConfig; push != nil {
+		subscription.PushConfig.PushEndpoint = push.Endpoint
+		subscription.PushConfig.Attributes = push.Attributes
+		if push.OIDCToken != nil {
+			subscription.PushConfig.OidcToken = &pubsub.OidcToken{
+				ServiceAccountEmail: push.OIDCToken.ServiceAccountEmail,
+				Audience:            push.OIDCToken.Audience,
+			}
+		}
+	}
+	return subscription
+}
+
+// subscriptionUpdateMask returns the fields of the subscription differing from the desired subscription. Only the
+// mutable fields are compared, unset optional fields keep the values picked by GCP.
+func subscriptionUpdateMask(current, desired *pubsub.Subscription) []string {
+	var updateMask []string
+	if desired.AckDeadlineSeconds != 0 && current.AckDeadlineSeconds != desired.AckDeadlineSeconds {
+		updateMask = append(updateMask, "ackDeadlineSeconds")
+	}
+	if desired.MessageRetentionDuration != "" && current.MessageRetentionDuration != desired.MessageRetentionDuration {
+		updateMask = append(updateMask, "messageRetentionDuration")
+	}
+	if current.RetainAckedMessages != desired.RetainAckedMessages {
+		updateMask = append(updateMask, "retainAckedMessages")
+	}
+	if current.EnableExactlyOnceDelivery != desired.EnableExactlyOnceDelivery {
+		updateMask = append(updateMask, "enableExactlyOnceDelivery")
+	}
+	if !deadLetterPoliciesEqual(current.DeadLetterPolicy, desired.DeadLetterPolicy) {
+		updateMask = append(updateMask, "deadLetterPolicy")
+	}
+	if !retryPoliciesEqual(current.RetryPolicy, desired.RetryPolicy) {
+		updateMask = append(updateMask, "retryPolicy")
+	}
+	if !pushConfigsEqual(current.PushConfig, desired.PushConfig) {
+		updateMask = append(updateMask, "pushConfig")
+	}
+	if !maps.Equal(current.Labels, desired.Labels) {
+		updateMask = append(updateMask, "labels")
+	}
+	return updateMask
+}
+
+func deadLetterPoliciesEqual(current, desired *pubsub.DeadLetterPolicy) bool {
+	if current == nil || desired == nil {
+		return current == nil && desired == nil
+	}
+	return current.DeadLetterTopic == desired.DeadLetterTopic && current.MaxDeliveryAttempts == desired.MaxDeliveryAttempts
+}
+
+func retryPoliciesEqual(current, desired *pubsub.RetryPolicy) bool {
+	if current == nil || desired == nil {
+		return current == nil && desired == nil
+	}
+	return current.MinimumBackoff == desired.MinimumBackoff && current.MaximumBackoff == desired.MaximumBackoff
+}
+
+// pushConfigsEqual compares the push configs. GCP adds attributes such as x-goog-version, only the desired
+// attributes are compared.
+func pushConfigsEqual(current, desired *pubsub.PushConfig) bool {
+	if current == nil {
+		current = &pubsub.PushConfig{}
+	}
+	if current.PushEndpoint != desired.PushEndpoint {
+		return false
+	}
+	for k, v := range desired.Attributes {
+		if current.Attributes[k] != v {
+			return false
+		}
+	}
+	currentToken, desiredToken := current.OidcToken, desired.OidcToken
+	if currentToken == nil || desiredToken == nil {
+		return currentToken == nil && desiredToken == nil
+	}
+	return currentToken.ServiceAccountEmail == desiredToken.ServiceAccountEmail &&
+		(desiredToken.Audience == "" || currentToken.Audience == desiredToken.Audience)
+}
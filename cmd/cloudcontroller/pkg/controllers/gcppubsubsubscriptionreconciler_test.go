@@ -0,0 +1,90 @@
+package controllers
+
+import (
+	"context"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/pubsub/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"slices"
+	"testing"
+)
+
+func TestResolveSubscriptionTopics(t *testing.T) {
+	topic := &benzaiten.GCPPubSubTopic{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-topic", Namespace: "default"},
+		Status:     benzaiten.GCPPubSubTopicStatus{Path: "projects/test-project/topics/test-topic"},
+	}
+	deadLetter := &benzaiten.GCPPubSubTopic{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dead-letter", Namespace: "default"},
+	}
+	gsub := &benzaiten.GCPPubSubSubscription{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-subscription", Namespace: "default"},
+		Spec: benzaiten.GCPPubSubSubscriptionSpec{
+			TopicRef: benzaiten.ResourceRef{Name: "test-topic"},
+			DeadLetterPolicy: &benzaiten.SubscriptionDeadLetterPolicy{
+				TopicRef:            benzaiten.ResourceRef{Name: "test-dead-letter"},
+				MaxDeliveryAttempts: 5,
+			},
+		},
+	}
+
+	// the dead-letter topic is not created yet
+	c := fake.NewClientBuilder().WithScheme(Scheme).WithObjects(topic, deadLetter).Build()
+	_, condition, err := resolveSubscriptionTopics(context.Background(), c, gsub)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if condition.Status != metav1.ConditionFalse || condition.Message != "waiting for GCPPubSubTopics test-dead-letter" {
+		t.Fatalf("expected to wait for the dead-letter topic, got %s: %s", condition.Status, condition.Message)
+	}
+
+	deadLetter.Status.Path = "projects/test-project/topics/test-dead-letter"
+	c = fake.NewClientBuilder().WithScheme(Scheme).WithObjects(topic, deadLetter).Build()
+	topics, condition, err := resolveSubscriptionTopics(context.Background(), c, gsub)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if condition.Status != metav1.ConditionTrue {
+		t.Fatalf("expected topics to be ready, got %s: %s", condition.Status, condition.Message)
+	}
+	if topics.Topic != topic.Status.Path || topics.DeadLetterTopic != deadLetter.Status.Path {
+		t.Fatalf("unexpected topics %+v", topics)
+	}
+}
+
+func TestSubscriptionUpdateMask(t *testing.T) {
+	gsub := &benzaiten.GCPPubSubSubscription{
+		Spec: benzaiten.GCPPubSubSubscriptionSpec{
+			AckDeadlineSeconds: 30,
+			PushConfig: &benzaiten.SubscriptionPushConfig{
+				Endpoint: "https://example.com/push",
+			},
+		},
+	}
+	desired := newSubscription(gsub, subscriptionTopics{Topic: "projects/test-project/topics/test-topic"})
+	current := &pubsub.Subscription{
+		Topic:                    "projects/test-project/topics/test-topic",
+		AckDeadlineSeconds:       30,
+		MessageRetentionDuration: "604800s",
+		PushConfig: &pubsub.PushConfig{
+			PushEndpoint: "https://example.com/push",
+			Attributes:   map[string]string{"x-goog-version": "v1"},
+		},
+	}
+
+	// the retention picked by GCP and the attributes added by GCP are kept
+	if mask := subscriptionUpdateMask(current, desired); len(mask) != 0 {
+		t.Fatalf("expected the subscription to be in sync, got %v", mask)
+	}
+
+	// switching back to pull, enabling a retry policy and changing the ack deadline are applied in place
+	gsub.Spec.PushConfig = nil
+	gsub.Spec.AckDeadlineSeconds = 60
+	gsub.Spec.RetryPolicy = &benzaiten.SubscriptionRetryPolicy{MinimumBackoff: "10s", MaximumBackoff: "600s"}
+	desired = newSubscription(gsub, subscriptionTopics{Topic: "projects/test-project/topics/test-topic"})
+	mask := subscriptionUpdateMask(current, desired)
+	if !slices.Equal(mask, []string{"ackDeadlineSeconds", "retryPolicy", "pushConfig"}) {
+		t.Fatalf("unexpected update mask %v", mask)
+	}
+}
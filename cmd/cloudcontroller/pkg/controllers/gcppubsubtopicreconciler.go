@@ -0,0 +1,210 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/pubsub/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"maps"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"strings"
+	"time"
+)
+
+type GCPPubSubTopicReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPPubSubTopicReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcppubsubtopic", req.NamespacedName)
+
+	gt := benzaiten.GCPPubSubTopic{}
+	err := cr.Get(ctx, req.NamespacedName, &gt)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcppubsubtopic not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gt.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gt)
+	}
+
+	if controllerutil.AddFinalizer(&gt, gcpFinalizer) {
+		err = cr.Update(ctx, &gt)
+		if err != nil {
+			logger.Error(err, "error adding gcppubsubtopic finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := gt.DeepCopyObject().(*benzaiten.GCPPubSubTopic)
+	desired := newTopic(&gt)
+
+	// does topic exist in GCP?
+	topic, err := cr.cloud.GCP.GetTopic(gt.Spec.Name)
+	if err != nil && notFoundGCPResource(err) {
+		// topic does not exist in GCP
+		logger.Info("gcppubsubtopic not found, creating topic...")
+		topic, err = cr.cloud.GCP.CreateTopic(gt.Spec.Name, desired)
+		if err != nil {
+			logger.Error(err, "error creating gcppubsubtopic")
+			cr.eventRecorder.Event(&gt, "Warning", "TopicFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gt, "Normal", "TopicCreated", "GCP Pub/Sub Topic created")
+	} else if err != nil {
+		logger.Error(err, "error getting gcppubsubtopic")
+		return ctrl.Result{}, err
+	} else if updateMask := topicUpdateMask(topic, desired); len(updateMask) > 0 {
+		logger.Info("gcppubsubtopic out of sync, updating topic...", "fields", updateMask)
+		topic, err = cr.cloud.GCP.PatchTopic(gt.Spec.Name, desired, updateMask)
+		if err != nil {
+			logger.Error(err, "error updating gcppubsubtopic")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gt, "Normal", "TopicUpdated", fmt.Sprintf("GCP Pub/Sub Topic updated: %s", strings.Join(updateMask, ", ")))
+	}
+
+	// update status
+	gt.Status.Path = topic.Name
+	meta.RemoveStatusCondition(&gt.Status.Conditions, benzaiten.TopicConditionDeletionBlocked)
+	if !equality.Semantic.DeepEqual(previous.Status, gt.Status) {
+		err = cr.Status().Update(ctx, &gt)
+		if err != nil {
+			logger.Error(err, "error updating gcppubsubtopic status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp pubsub topic reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+func (cr *GCPPubSubTopicReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gt *benzaiten.GCPPubSubTopic) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gt, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	// deleting the topic would detach the subscriptions from it, wait for their finalizers
+	gsubs := benzaiten.GCPPubSubSubscriptionList{}
+	err := cr.List(ctx, &gsubs, client.InNamespace(gt.Namespace))
+	if err != nil {
+		logger.Error(err, "error listing gcppubsubsubscriptions")
+		return ctrl.Result{}, err
+	}
+	var subscriptions []string
+	for _, gsub := range gsubs.Items {
+		if subscriptionReferencesTopic(&gsub, gt.Name) {
+			subscriptions = append(subscriptions, gsub.Name)
+		}
+	}
+	if len(subscriptions) > 0 {
+		return cr.blockDelete(ctx, logger, gt, fmt.Sprintf("topic is used by subscriptions %s", strings.Join(subscriptions, ", ")))
+	}
+
+	logger.Info("deleting gcppubsubtopic...")
+	err = cr.cloud.GCP.DeleteTopic(gt.Spec.Name)
+	if err != nil && !notFoundGCPResource(err) {
+		logger.Error(err, "error deleting gcppubsubtopic")
+		return ctrl.Result{}, err
+	}
+
+	controllerutil.RemoveFinalizer(gt, gcpFinalizer)
+	err = cr.Update(ctx, gt)
+	if err != nil {
+		logger.Error(err, "error removing gcppubsubtopic finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp pubsub topic deleted")
+	return ctrl.Result{}, nil
+}
+
+// blockDelete reports the deletion of the topic as blocked and requeues it
+func (cr *GCPPubSubTopicReconciler) blockDelete(ctx context.Context, logger logr.Logger, gt *benzaiten.GCPPubSubTopic, message string) (ctrl.Result, error) {
+	condition := metav1.Condition{
+		Type:               benzaiten.TopicConditionDeletionBlocked,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gt.Generation,
+		Reason:             "TopicInUse",
+		Message:            message,
+	}
+	if !meta.IsStatusConditionPresentAndEqual(gt.Status.Conditions, condition.Type, condition.Status) {
+		cr.eventRecorder.Event(gt, "Warning", "TopicInUse", fmt.Sprintf("GCP Pub/Sub Topic is in use, deletion blocked: %s", message))
+	}
+	previous := gt.DeepCopyObject().(*benzaiten.GCPPubSubTopic)
+	meta.SetStatusCondition(&gt.Status.Conditions, condition)
+	if !equality.Semantic.DeepEqual(previous.Status, gt.Status) {
+		err := cr.Status().Update(ctx, gt)
+		if err != nil {
+			logger.Error(err, "error updating gcppubsubtopic status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+}
+
+func (cr *GCPPubSubTopicReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPPubSubTopic{}).
+		Complete(cr)
+}
+
+func setupGCPPubSubTopicController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcppubsubtopic")
+	cc := GCPPubSubTopicReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPPubSubTopicReconciler"),
+	}
+
+	// create GCPPubSubTopic controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPPubSubTopic controller: %w", err)
+	}
+
+	return nil
+}
+
+// newTopic builds the Pub/Sub topic described by the GCPPubSubTopic spec
+func newTopic(gt *benzaiten.GCPPubSubTopic) *pubsub.Topic {
+	return &pubsub.Topic{
+		MessageRetentionDuration: gt.Spec.MessageRetentionDuration,
+		Labels:                   gt.Spec.Labels,
+		KmsKeyName:               gt.Spec.KMSKeyName,
+	}
+}
+
+// topicUpdateMask returns the fields of the topic differing from the desired topic. Only the mutable fields are
+// compared.
+func topicUpdateMask(current, desired *pubsub.Topic) []string {
+	var updateMask []string
+	if current.MessageRetentionDuration != desired.MessageRetentionDuration {
+		updateMask = append(updateMask, "messageRetentionDuration")
+	}
+	if !maps.Equal(current.Labels, desired.Labels) {
+		updateMask = append(updateMask, "labels")
+	}
+	return updateMask
+}
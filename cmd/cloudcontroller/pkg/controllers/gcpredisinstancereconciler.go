@@ -0,0 +1,372 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/redis/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"maps"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"strconv"
+	"time"
+)
+
+const (
+	redisTransitEncryptionServerAuthentication = "SERVER_AUTHENTICATION"
+	redisTransitEncryptionDisabled             = "DISABLED"
+
+	// keys of the connection Secret
+	redisSecretHost       = "host"
+	redisSecretPort       = "port"
+	redisSecretAuthString = "authString"
+	redisSecretCACert     = "caCert"
+)
+
+type GCPRedisInstanceReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPRedisInstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpredisinstance", req.NamespacedName)
+
+	gri := benzaiten.GCPRedisInstance{}
+	err := cr.Get(ctx, req.NamespacedName, &gri)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpredisinstance not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gri.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gri)
+	}
+
+	if controllerutil.AddFinalizer(&gri, gcpFinalizer) {
+		err = cr.Update(ctx, &gri)
+		if err != nil {
+			logger.Error(err, "error adding gcpredisinstance finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := gri.DeepCopyObject().(*benzaiten.GCPRedisInstance)
+
+	// the instance is reachable from the referenced network only
+	attachment, condition, err := resolveNetworkAttachment(ctx, cr.Client, &gri, benzaiten.RedisInstanceConditionReferencesResolved, gri.Spec.NetworkRef, nil)
+	if err != nil {
+		logger.Error(err, "error resolving gcpredisinstance references")
+		return ctrl.Result{}, err
+	}
+	meta.SetStatusCondition(&gri.Status.Conditions, condition)
+	if condition.Status != metav1.ConditionTrue {
+		if !equality.Semantic.DeepEqual(previous.Status, gri.Status) {
+			err = cr.Status().Update(ctx, &gri)
+			if err != nil {
+				logger.Error(err, "error updating gcpredisinstance status")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+	desired := newRedisInstance(&gri, attachment.Network)
+
+	// does instance exist in GCP?
+	instance, err := cr.cloud.GCP.GetRedisInstance(gri.Spec.Region, gri.Spec.Name)
+	if err != nil && notFoundGCPResource(err) {
+		// instance does not exist in GCP
+		logger.Info("gcpredisinstance not found, creating instance...")
+		op, err := cr.cloud.GCP.CreateRedisInstance(gri.Spec.Region, gri.Spec.Name, desired)
+		if err != nil {
+			logger.Error(err, "error creating gcpredisinstance")
+			return ctrl.Result{}, err
+		}
+		gri.Status.State = benzaiten.RedisInstanceStateCreating
+		err = cr.Status().Update(ctx, &gri)
+		if err != nil {
+			logger.Error(err, "error updating gcpredisinstance status")
+			return ctrl.Result{}, err
+		}
+		// creating an instance takes several minutes
+		err = waitRedisOperation(ctx, func() (*redis.Operation, error) {
+			return cr.cloud.GCP.GetRedisOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error creating gcpredisinstance")
+			cr.eventRecorder.Event(&gri, "Warning", "RedisInstanceFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		instance, err = cr.cloud.GCP.GetRedisInstance(gri.Spec.Region, gri.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error verifying redis instance status")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gri, "Normal", "RedisInstanceCreated", "GCP Redis Instance created")
+	} else if err != nil {
+		logger.Error(err, "error getting gcpredisinstance")
+		return ctrl.Result{}, err
+	} else if updateMask := redisUpdateMask(instance, desired); instance.State == string(benzaiten.RedisInstanceStateReady) && len(updateMask) > 0 {
+		// memory is scaled in place, the instance keeps its host and data
+		logger.Info("gcpredisinstance out of sync, updating instance...", "fields", updateMask)
+		op, err := cr.cloud.GCP.PatchRedisInstance(gri.Spec.Region, gri.Spec.Name, desired, updateMask)
+		if err != nil {
+			logger.Error(err, "error updating gcpredisinstance")
+			return ctrl.Result{}, err
+		}
+		err = waitRedisOperation(ctx, func() (*redis.Operation, error) {
+			return cr.cloud.GCP.GetRedisOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error updating gcpredisinstance")
+			cr.eventRecorder.Event(&gri, "Warning", "RedisInstanceFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		instance, err = cr.cloud.GCP.GetRedisInstance(gri.Spec.Region, gri.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error verifying redis instance status")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gri, "Normal", "RedisInstanceUpdated", "GCP Redis Instance updated")
+	}
+
+	// the connection details are published once the instance serves clients
+	if instance.State == string(benzaiten.RedisInstanceStateReady) {
+		authString := ""
+		if instance.AuthEnabled {
+			authString, err = cr.cloud.GCP.GetRedisAuthString(gri.Spec.Region, gri.Spec.Name)
+			if err != nil {
+				logger.Error(err, "error getting gcpredisinstance auth string")
+				return ctrl.Result{}, err
+			}
+		}
+		err = cr.reconcileSecret(ctx, &gri, instance, authString)
+		if err != nil {
+			logger.Error(err, "error reconciling gcpredisinstance secret")
+			return ctrl.Result{}, err
+		}
+		gri.Status.SecretName = redisSecretName(&gri)
+	}
+
+	// update status
+	gri.Status.State = benzaiten.RedisInstanceState(instance.State)
+	gri.Status.Host = instance.Host
+	gri.Status.Port = instance.Port
+	if !equality.Semantic.DeepEqual(previous.Status, gri.Status) {
+		err = cr.Status().Update(ctx, &gri)
+		if err != nil {
+			logger.Error(err, "error updating gcpredisinstance status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp redis instance reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+func (cr *GCPRedisInstanceReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gri *benzaiten.GCPRedisInstance) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gri, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("deleting gcpredisinstance...")
+	op, err := cr.cloud.GCP.DeleteRedisInstance(gri.Spec.Region, gri.Spec.Name)
+	if err != nil && !notFoundGCPResource(err) {
+		logger.Error(err, "error deleting gcpredisinstance")
+		cr.eventRecorder.Event(gri, "Warning", "RedisInstanceFailedState", err.Error())
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		err = waitRedisOperation(ctx, func() (*redis.Operation, error) {
+			return cr.cloud.GCP.GetRedisOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error deleting gcpredisinstance")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// the Secret is garbage collected along with the instance
+	controllerutil.RemoveFinalizer(gri, gcpFinalizer)
+	err = cr.Update(ctx, gri)
+	if err != nil {
+		logger.Error(err, "error removing gcpredisinstance finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp redis instance deleted")
+	return ctrl.Result{}, nil
+}
+
+// reconcileSecret creates or updates the Secret holding the connection details of the instance
+func (cr *GCPRedisInstanceReconciler) reconcileSecret(ctx context.Context, gri *benzaiten.GCPRedisInstance, instance *redis.Instance, authString string) error {
+	secret := corev1.Secret{}
+	err := cr.Get(ctx, types.NamespacedName{Namespace: gri.Namespace, Name: redisSecretName(gri)}, &secret)
+	if err != nil && !kerr.IsNotFound(err) {
+		return fmt.Errorf("unable to get secret %s: %w", redisSecretName(gri), err)
+	}
+	create := kerr.IsNotFound(err)
+	if create {
+		secret = corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      redisSecretName(gri),
+				Namespace: gri.Namespace,
+			},
+			Type: corev1.SecretTypeOpaque,
+		}
+		// the Secret is garbage collected along with the instance
+		err = controllerutil.SetControllerReference(gri, &secret, cr.Scheme)
+		if err != nil {
+			return fmt.Errorf("unable to set secret owner: %w", err)
+		}
+	}
+
+	previous := secret.DeepCopy()
+	secret.Data = redisSecretData(instance, authString)
+
+	if create {
+		err = cr.Create(ctx, &secret)
+		if err != nil {
+			return fmt.Errorf("unable to create secret %s: %w", secret.Name, err)
+		}
+		cr.eventRecorder.Event(gri, "Normal", "RedisInstanceSecretCreated", fmt.Sprintf("Connection details stored in Secret %s", secret.Name))
+	} else if !equality.Semantic.DeepEqual(previous.Data, secret.Data) {
+		err = cr.Update(ctx, &secret)
+		if err != nil {
+			return fmt.Errorf("unable to update secret %s: %w", secret.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (cr *GCPRedisInstanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPRedisInstance{}).
+		Owns(&corev1.Secret{}).
+		Watches(&benzaiten.GCPNetwork{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForNetwork)).
+		Complete(cr)
+}
+
+// requestsForNetwork returns the GCPRedisInstances referencing the GCPNetwork
+func (cr *GCPRedisInstanceReconciler) requestsForNetwork(ctx context.Context, obj client.Object) []reconcile.Request {
+	// GCP networks are global to the project, instances of any namespace may reference it
+	gris := benzaiten.GCPRedisInstanceList{}
+	err := cr.List(ctx, &gris)
+	if err != nil {
+		cr.Log.Error(err, "unable to list gcpredisinstances")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, gri := range gris.Items {
+		if refersTo(gri.Spec.NetworkRef, gri.Namespace, obj) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: gri.Name, Namespace: gri.Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
+func setupGCPRedisInstanceController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpredisinstance")
+	cc := GCPRedisInstanceReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPRedisInstanceReconciler"),
+	}
+
+	// create GCPRedisInstance controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPRedisInstance controller: %w", err)
+	}
+
+	return nil
+}
+
+// newRedisInstance builds the Memorystore for Redis instance described by the GCPRedisInstance spec
+func newRedisInstance(gri *benzaiten.GCPRedisInstance, network string) *redis.Instance {
+	instance := &redis.Instance{
+		Tier:                  gri.Spec.Tier,
+		MemorySizeGb:          gri.Spec.MemorySizeGb,
+		RedisVersion:          gri.Spec.RedisVersion,
+		AuthorizedNetwork:     resourcePath(network),
+		ConnectMode:           gri.Spec.ConnectMode,
+		AuthEnabled:           gri.Spec.AuthEnabled,
+		TransitEncryptionMode: redisTransitEncryptionDisabled,
+		Labels:                gri.Spec.Labels,
+		// auth is disabled by a patch sending false
+		ForceSendFields: []string{"AuthEnabled"},
+	}
+	if gri.Spec.TLS {
+		instance.TransitEncryptionMode = redisTransitEncryptionServerAuthentication
+	}
+	return instance
+}
+
+// redisUpdateMask returns the fields of the instance to patch for it to match the desired instance. The other
+// fields cannot be changed in place.
+func redisUpdateMask(current, desired *redis.Instance) []string {
+	var updateMask []string
+	if current.MemorySizeGb != desired.MemorySizeGb {
+		updateMask = append(updateMask, "memorySizeGb")
+	}
+	if current.AuthEnabled != desired.AuthEnabled {
+		updateMask = append(updateMask, "authEnabled")
+	}
+	if !maps.Equal(current.Labels, desired.Labels) {
+		updateMask = append(updateMask, "labels")
+	}
+	return updateMask
+}
+
+// redisSecretData returns the connection details of the instance. The auth string and the CA certificate are only
+// set if auth and TLS are enabled.
+func redisSecretData(instance *redis.Instance, authString string) map[string][]byte {
+	data := map[string][]byte{
+		redisSecretHost: []byte(instance.Host),
+		redisSecretPort: []byte(strconv.FormatInt(instance.Port, 10)),
+	}
+	if authString != "" {
+		data[redisSecretAuthString] = []byte(authString)
+	}
+	if len(instance.ServerCaCerts) > 0 {
+		var caCert []byte
+		for _, cert := range instance.ServerCaCerts {
+			caCert = append(caCert, cert.Cert...)
+		}
+		data[redisSecretCACert] = caCert
+	}
+	return data
+}
+
+// redisSecretName returns the name of the connection Secret of the instance
+func redisSecretName(gri *benzaiten.GCPRedisInstance) string {
+	if gri.Spec.SecretName != "" {
+		return gri.Spec.SecretName
+	}
+	return gri.Name + "-redis"
+}
@@ -0,0 +1,111 @@
+package controllers
+
+import (
+	"context"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/redis/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"slices"
+	"testing"
+)
+
+func TestRedisUpdateMask(t *testing.T) {
+	gri := &benzaiten.GCPRedisInstance{
+		Spec: benzaiten.GCPRedisInstanceSpec{
+			Name:         "test-cache",
+			Region:       "us-central1",
+			Tier:         "STANDARD_HA",
+			MemorySizeGb: 5,
+			NetworkRef:   &benzaiten.NamespacedResourceRef{Name: "test-network"},
+			TLS:          true,
+			Labels:       map[string]string{"team": "platform"},
+		},
+	}
+	desired := newRedisInstance(gri, "https://www.googleapis.com/compute/v1/projects/test-project/global/networks/test-network")
+	if desired.AuthorizedNetwork != "projects/test-project/global/networks/test-network" {
+		t.Fatalf("unexpected authorized network %s", desired.AuthorizedNetwork)
+	}
+	if desired.TransitEncryptionMode != redisTransitEncryptionServerAuthentication {
+		t.Fatalf("unexpected transit encryption mode %s", desired.TransitEncryptionMode)
+	}
+
+	// the instance as returned by GCP
+	current := &redis.Instance{
+		Name:                  "projects/test-project/locations/us-central1/instances/test-cache",
+		Tier:                  "STANDARD_HA",
+		MemorySizeGb:          5,
+		AuthorizedNetwork:     "projects/test-project/global/networks/test-network",
+		TransitEncryptionMode: redisTransitEncryptionServerAuthentication,
+		Labels:                map[string]string{"team": "platform"},
+		State:                 "READY",
+	}
+	if updateMask := redisUpdateMask(current, desired); len(updateMask) > 0 {
+		t.Fatalf("expected instance to be in sync, got %v", updateMask)
+	}
+
+	// scaling the memory and enabling auth
+	gri.Spec.MemorySizeGb = 10
+	gri.Spec.AuthEnabled = true
+	updateMask := redisUpdateMask(current, newRedisInstance(gri, current.AuthorizedNetwork))
+	if !slices.Equal(updateMask, []string{"memorySizeGb", "authEnabled"}) {
+		t.Fatalf("unexpected update mask %v", updateMask)
+	}
+}
+
+func TestReconcileRedisSecret(t *testing.T) {
+	gri := &benzaiten.GCPRedisInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cache", Namespace: "default", UID: "test-uid"},
+		Spec: benzaiten.GCPRedisInstanceSpec{
+			Name:         "test-cache",
+			Region:       "us-central1",
+			MemorySizeGb: 1,
+			NetworkRef:   &benzaiten.NamespacedResourceRef{Name: "test-network"},
+		},
+	}
+	cr := &GCPRedisInstanceReconciler{
+		Client:        fake.NewClientBuilder().WithScheme(Scheme).WithObjects(gri).Build(),
+		Scheme:        Scheme,
+		eventRecorder: record.NewFakeRecorder(10),
+	}
+	instance := &redis.Instance{
+		Host:          "10.0.0.3",
+		Port:          6378,
+		AuthEnabled:   true,
+		ServerCaCerts: []*redis.TlsCertificate{{Cert: "-----BEGIN CERTIFICATE-----\n"}},
+	}
+
+	err := cr.reconcileSecret(context.Background(), gri, instance, "test-auth")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	secret := corev1.Secret{}
+	err = cr.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "test-cache-redis"}, &secret)
+	if err != nil {
+		t.Fatalf("unexpected error getting secret: %v", err)
+	}
+	if string(secret.Data[redisSecretHost]) != "10.0.0.3" || string(secret.Data[redisSecretPort]) != "6378" ||
+		string(secret.Data[redisSecretAuthString]) != "test-auth" || len(secret.Data[redisSecretCACert]) == 0 {
+		t.Fatalf("unexpected secret data %v", secret.Data)
+	}
+	if len(secret.OwnerReferences) != 1 || secret.OwnerReferences[0].UID != gri.UID {
+		t.Fatalf("expected secret to be owned by the instance, got %v", secret.OwnerReferences)
+	}
+
+	// disabling auth removes the auth string
+	instance.AuthEnabled = false
+	err = cr.reconcileSecret(context.Background(), gri, instance, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = cr.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "test-cache-redis"}, &secret)
+	if err != nil {
+		t.Fatalf("unexpected error getting secret: %v", err)
+	}
+	if _, ok := secret.Data[redisSecretAuthString]; ok {
+		t.Fatalf("expected auth string to be removed, got %v", secret.Data)
+	}
+}
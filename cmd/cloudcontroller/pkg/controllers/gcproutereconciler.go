@@ -0,0 +1,338 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"strings"
+	"time"
+)
+
+type GCPRouteReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcproute", req.NamespacedName)
+
+	gr := benzaiten.GCPRoute{}
+	err := cr.Get(ctx, req.NamespacedName, &gr)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcproute not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gr.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gr)
+	}
+
+	if controllerutil.AddFinalizer(&gr, gcpFinalizer) {
+		err = cr.Update(ctx, &gr)
+		if err != nil {
+			logger.Error(err, "error adding gcproute finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := gr.DeepCopyObject().(*benzaiten.GCPRoute)
+
+	// the route is created in the referenced network
+	gn := benzaiten.GCPNetwork{}
+	err = cr.Get(ctx, types.NamespacedName{Namespace: gr.Namespace, Name: gr.Spec.NetworkRef.Name}, &gn)
+	if err != nil && !kerr.IsNotFound(err) {
+		logger.Error(err, "error getting gcpnetwork")
+		return ctrl.Result{}, err
+	}
+	if err != nil || gn.Status.SelfLink == "" {
+		meta.SetStatusCondition(&gr.Status.Conditions, metav1.Condition{
+			Type:               benzaiten.RouteConditionNetworkReady,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: gr.Generation,
+			Reason:             "NetworkNotReady",
+			Message:            fmt.Sprintf("waiting for GCPNetwork %s", gr.Spec.NetworkRef.Name),
+		})
+		if !equality.Semantic.DeepEqual(previous.Status, gr.Status) {
+			err = cr.Status().Update(ctx, &gr)
+			if err != nil {
+				logger.Error(err, "error updating gcproute status")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+	meta.SetStatusCondition(&gr.Status.Conditions, metav1.Condition{
+		Type:               benzaiten.RouteConditionNetworkReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gr.Generation,
+		Reason:             "NetworkReady",
+		Message:            fmt.Sprintf("GCPNetwork %s is ready", gr.Spec.NetworkRef.Name),
+	})
+
+	// the next hop instance is referenced by its URL
+	var nextHopInstance string
+	if ref := gr.Spec.NextHop.InstanceRef; ref != nil {
+		gi := benzaiten.GCPInstance{}
+		err = cr.Get(ctx, types.NamespacedName{Namespace: gr.Namespace, Name: ref.Name}, &gi)
+		if err != nil && !kerr.IsNotFound(err) {
+			logger.Error(err, "error getting gcpinstance")
+			return ctrl.Result{}, err
+		}
+		if err != nil || gi.Status.InstanceID == "" {
+			meta.SetStatusCondition(&gr.Status.Conditions, metav1.Condition{
+				Type:               benzaiten.RouteConditionNextHopReady,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: gr.Generation,
+				Reason:             "InstanceNotReady",
+				Message:            fmt.Sprintf("waiting for GCPInstance %s", ref.Name),
+			})
+			if !equality.Semantic.DeepEqual(previous.Status, gr.Status) {
+				err = cr.Status().Update(ctx, &gr)
+				if err != nil {
+					logger.Error(err, "error updating gcproute status")
+					return ctrl.Result{}, err
+				}
+			}
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		}
+		nextHopInstance = fmt.Sprintf("zones/%s/instances/%s", gi.Spec.Zone, gi.Spec.Name)
+	}
+	meta.SetStatusCondition(&gr.Status.Conditions, metav1.Condition{
+		Type:               benzaiten.RouteConditionNextHopReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gr.Generation,
+		Reason:             "NextHopReady",
+		Message:            "next hop is ready",
+	})
+	desired := newComputeRoute(&gr, gn.Status.SelfLink, nextHopInstance)
+
+	// does route exist in GCP?
+	route, err := cr.cloud.GCP.GetRoute(gr.Spec.Name)
+	if err != nil && notFoundGCPResource(err) {
+		// route does not exist in GCP
+		logger.Info("gcproute not found, creating route...")
+		route, err = cr.createRoute(ctx, &gr, desired)
+		if err != nil {
+			logger.Error(err, "error creating gcproute")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gr, "Normal", "RouteCreated", "GCP Route created")
+	} else if err != nil {
+		logger.Error(err, "error getting gcproute")
+		return ctrl.Result{}, err
+	} else if !routeInSync(route, desired) {
+		// routes cannot be updated, replace the route
+		logger.Info("gcproute out of sync, replacing route...")
+		op, err := cr.cloud.GCP.DeleteRoute(gr.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error deleting gcproute")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitGlobalOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error deleting gcproute")
+			return ctrl.Result{}, err
+		}
+		route, err = cr.createRoute(ctx, &gr, desired)
+		if err != nil {
+			logger.Error(err, "error creating gcproute")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gr, "Normal", "RouteReplaced", "GCP Route replaced")
+	}
+
+	// update status
+	gr.Status.SelfLink = route.SelfLink
+	gr.Status.NextHop = routeNextHop(route)
+	gr.Status.Warnings = nil
+	for _, warning := range route.Warnings {
+		gr.Status.Warnings = append(gr.Status.Warnings, fmt.Sprintf("%s: %s", warning.Code, warning.Message))
+	}
+	if !equality.Semantic.DeepEqual(previous.Status, gr.Status) {
+		err = cr.Status().Update(ctx, &gr)
+		if err != nil {
+			logger.Error(err, "error updating gcproute status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp route reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+// createRoute creates the route and returns it once the operation is done
+func (cr *GCPRouteReconciler) createRoute(ctx context.Context, gr *benzaiten.GCPRoute, route *compute.Route) (*compute.Route, error) {
+	op, err := cr.cloud.GCP.CreateRoute(route)
+	if err != nil {
+		return nil, err
+	}
+	err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+		return cr.cloud.GCP.WaitGlobalOperation(op.Name)
+	})
+	if err != nil {
+		cr.eventRecorder.Event(gr, "Warning", "RouteFailedState", err.Error())
+		return nil, err
+	}
+	return cr.cloud.GCP.GetRoute(route.Name)
+}
+
+func (cr *GCPRouteReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gr *benzaiten.GCPRoute) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gr, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("deleting gcproute...")
+	op, err := cr.cloud.GCP.DeleteRoute(gr.Spec.Name)
+	if err != nil && !notFoundGCPResource(err) {
+		logger.Error(err, "error deleting gcproute")
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitGlobalOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error deleting gcproute")
+			return ctrl.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(gr, gcpFinalizer)
+	err = cr.Update(ctx, gr)
+	if err != nil {
+		logger.Error(err, "error removing gcproute finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp route deleted")
+	return ctrl.Result{}, nil
+}
+
+func (cr *GCPRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPRoute{}).
+		Watches(&benzaiten.GCPNetwork{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForReference)).
+		Watches(&benzaiten.GCPInstance{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForReference)).
+		Complete(cr)
+}
+
+// requestsForReference returns the GCPRoutes referencing the GCPNetwork or GCPInstance
+func (cr *GCPRouteReconciler) requestsForReference(ctx context.Context, obj client.Object) []reconcile.Request {
+	grs := benzaiten.GCPRouteList{}
+	err := cr.List(ctx, &grs, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		cr.Log.Error(err, "unable to list gcproutes")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, gr := range grs.Items {
+		var referenced bool
+		switch obj.(type) {
+		case *benzaiten.GCPNetwork:
+			referenced = gr.Spec.NetworkRef.Name == obj.GetName()
+		case *benzaiten.GCPInstance:
+			referenced = gr.Spec.NextHop.InstanceRef != nil && gr.Spec.NextHop.InstanceRef.Name == obj.GetName()
+		}
+		if referenced {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: gr.Name, Namespace: gr.Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
+func setupGCPRouteController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcproute")
+	cc := GCPRouteReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPRouteReconciler"),
+	}
+
+	// create GCPRoute controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPRoute controller: %w", err)
+	}
+
+	return nil
+}
+
+// newComputeRoute builds the GCP route described by the GCPRoute spec
+func newComputeRoute(gr *benzaiten.GCPRoute, network, nextHopInstance string) *compute.Route {
+	route := &compute.Route{
+		Name:            gr.Spec.Name,
+		Network:         network,
+		DestRange:       gr.Spec.DestRange,
+		Priority:        gr.Spec.Priority,
+		Tags:            gr.Spec.Tags,
+		NextHopInstance: nextHopInstance,
+		NextHopIp:       gr.Spec.NextHop.IP,
+		NextHopIlb:      gr.Spec.NextHop.ILB,
+		Description:     gr.Spec.Description,
+		ForceSendFields: []string{"Priority"},
+	}
+	if gr.Spec.NextHop.Gateway != "" {
+		route.NextHopGateway = "global/gateways/" + gr.Spec.NextHop.Gateway
+	}
+
+	return route
+}
+
+// routeInSync reports whether the GCP route matches the desired route
+func routeInSync(current, desired *compute.Route) bool {
+	return urlMatches(current.Network, desired.Network) &&
+		current.DestRange == desired.DestRange &&
+		current.Priority == desired.Priority &&
+		current.Description == desired.Description &&
+		stringSetsEqual(current.Tags, desired.Tags) &&
+		urlMatches(current.NextHopGateway, desired.NextHopGateway) &&
+		urlMatches(current.NextHopInstance, desired.NextHopInstance) &&
+		current.NextHopIp == desired.NextHopIp &&
+		urlMatches(current.NextHopIlb, desired.NextHopIlb)
+}
+
+// routeNextHop returns the name or IP of the next hop of the route
+func routeNextHop(route *compute.Route) string {
+	for _, hop := range []string{route.NextHopGateway, route.NextHopInstance, route.NextHopIp, route.NextHopIlb} {
+		if hop != "" {
+			return lastURLSegment(hop)
+		}
+	}
+	return ""
+}
+
+// urlMatches reports whether the URL returned by GCP identifies the same resource as the desired, possibly partial,
+// URL, e.g. https://www.googleapis.com/compute/v1/projects/my-project/global/gateways/default-internet-gateway and
+// global/gateways/default-internet-gateway
+func urlMatches(current, desired string) bool {
+	return current == desired || (desired != "" && strings.HasSuffix(current, "/"+desired))
+}
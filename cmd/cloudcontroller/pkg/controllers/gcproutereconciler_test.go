@@ -0,0 +1,48 @@
+package controllers
+
+import (
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/compute/v1"
+	"testing"
+)
+
+func TestRouteInSync(t *testing.T) {
+	gr := &benzaiten.GCPRoute{
+		Spec: benzaiten.GCPRouteSpec{
+			Name:      "test-route",
+			DestRange: "0.0.0.0/0",
+			Priority:  1000,
+			Tags:      []string{"a", "b"},
+			NextHop:   benzaiten.RouteNextHop{Gateway: "default-internet-gateway"},
+		},
+	}
+	network := "https://www.googleapis.com/compute/v1/projects/test-project/global/networks/test-network"
+	desired := newComputeRoute(gr, network, "")
+
+	current := &compute.Route{
+		Name:           "test-route",
+		Network:        network,
+		DestRange:      "0.0.0.0/0",
+		Priority:       1000,
+		Tags:           []string{"b", "a"},
+		NextHopGateway: "https://www.googleapis.com/compute/v1/projects/test-project/global/gateways/default-internet-gateway",
+	}
+	if !routeInSync(current, desired) {
+		t.Fatalf("expected route to be in sync")
+	}
+	if hop := routeNextHop(current); hop != "default-internet-gateway" {
+		t.Fatalf("expected next hop default-internet-gateway, got %s", hop)
+	}
+
+	desired = newComputeRoute(gr, network, "zones/us-central1-a/instances/test-appliance")
+	desired.NextHopGateway = ""
+	if routeInSync(current, desired) {
+		t.Fatalf("expected a different next hop to be out of sync")
+	}
+
+	current.NextHopGateway = ""
+	current.NextHopInstance = "https://www.googleapis.com/compute/v1/projects/test-project/zones/us-central1-a/instances/test-appliance"
+	if !routeInSync(current, desired) {
+		t.Fatalf("expected partial instance URL to match")
+	}
+}
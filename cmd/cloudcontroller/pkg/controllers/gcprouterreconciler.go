@@ -0,0 +1,459 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"slices"
+	"time"
+)
+
+const (
+	natIPAllocationAuto      = "AUTO_ONLY"
+	natAllSubnetworks        = "ALL_SUBNETWORKS_ALL_IP_RANGES"
+	natSubnetworkAllIPRanges = "ALL_IP_RANGES"
+	natLogFilterAll          = "ALL"
+)
+
+type GCPRouterReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPRouterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcprouter", req.NamespacedName)
+
+	gr := benzaiten.GCPRouter{}
+	err := cr.Get(ctx, req.NamespacedName, &gr)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcprouter not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gr.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gr)
+	}
+
+	if controllerutil.AddFinalizer(&gr, gcpFinalizer) {
+		err = cr.Update(ctx, &gr)
+		if err != nil {
+			logger.Error(err, "error adding gcprouter finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := gr.DeepCopyObject().(*benzaiten.GCPRouter)
+
+	// the router is created in the referenced network
+	gn := benzaiten.GCPNetwork{}
+	err = cr.Get(ctx, types.NamespacedName{Namespace: gr.Namespace, Name: gr.Spec.NetworkRef.Name}, &gn)
+	if err != nil && !kerr.IsNotFound(err) {
+		logger.Error(err, "error getting gcpnetwork")
+		return ctrl.Result{}, err
+	}
+	if err != nil || gn.Status.SelfLink == "" {
+		meta.SetStatusCondition(&gr.Status.Conditions, metav1.Condition{
+			Type:               benzaiten.RouterConditionNetworkReady,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: gr.Generation,
+			Reason:             "NetworkNotReady",
+			Message:            fmt.Sprintf("waiting for GCPNetwork %s", gr.Spec.NetworkRef.Name),
+		})
+		if !equality.Semantic.DeepEqual(previous.Status, gr.Status) {
+			err = cr.Status().Update(ctx, &gr)
+			if err != nil {
+				logger.Error(err, "error updating gcprouter status")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+	meta.SetStatusCondition(&gr.Status.Conditions, metav1.Condition{
+		Type:               benzaiten.RouterConditionNetworkReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gr.Generation,
+		Reason:             "NetworkReady",
+		Message:            fmt.Sprintf("GCPNetwork %s is ready", gr.Spec.NetworkRef.Name),
+	})
+
+	// the NATs reference the GCPAddresses and GCPSubnetworks by their URL
+	nats, reason, err := cr.resolveNATs(ctx, &gr)
+	if err != nil {
+		logger.Error(err, "error resolving gcprouter nats")
+		return ctrl.Result{}, err
+	}
+	if reason != "" {
+		meta.SetStatusCondition(&gr.Status.Conditions, metav1.Condition{
+			Type:               benzaiten.RouterConditionNATReady,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: gr.Generation,
+			Reason:             "ReferencesNotReady",
+			Message:            reason,
+		})
+		logger.Info("gcprouter nat references not ready", "reason", reason)
+		if !equality.Semantic.DeepEqual(previous.Status, gr.Status) {
+			err = cr.Status().Update(ctx, &gr)
+			if err != nil {
+				logger.Error(err, "error updating gcprouter status")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+	meta.SetStatusCondition(&gr.Status.Conditions, metav1.Condition{
+		Type:               benzaiten.RouterConditionNATReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gr.Generation,
+		Reason:             "ReferencesReady",
+		Message:            "the addresses and subnetworks of the NATs are ready",
+	})
+	desired := newComputeRouter(&gr, gn.Status.SelfLink, nats)
+
+	// does router exist in GCP?
+	router, err := cr.cloud.GCP.GetRouter(gr.Spec.Region, gr.Spec.Name)
+	if err != nil && notFoundGCPResource(err) {
+		// router does not exist in GCP
+		logger.Info("gcprouter not found, creating router...")
+		op, err := cr.cloud.GCP.CreateRouter(gr.Spec.Region, desired)
+		if err != nil {
+			logger.Error(err, "error creating gcprouter")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitRegionOperation(gr.Spec.Region, op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error creating gcprouter")
+			cr.eventRecorder.Event(&gr, "Warning", "RouterFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		router, err = cr.cloud.GCP.GetRouter(gr.Spec.Region, gr.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error verifying router status")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gr, "Normal", "RouterCreated", "GCP Cloud Router created")
+	} else if err != nil {
+		logger.Error(err, "error getting gcprouter")
+		return ctrl.Result{}, err
+	} else if !routerInSync(router, desired) {
+		// the BGP and NAT configuration is patched in place
+		logger.Info("gcprouter out of sync, patching router...")
+		op, err := cr.cloud.GCP.PatchRouter(gr.Spec.Region, gr.Spec.Name, &compute.Router{
+			Bgp:             desired.Bgp,
+			Nats:            desired.Nats,
+			Description:     desired.Description,
+			ForceSendFields: []string{"Nats", "Description"},
+		})
+		if err != nil {
+			logger.Error(err, "error patching gcprouter")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitRegionOperation(gr.Spec.Region, op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error patching gcprouter")
+			cr.eventRecorder.Event(&gr, "Warning", "RouterFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		router, err = cr.cloud.GCP.GetRouter(gr.Spec.Region, gr.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error verifying router status")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gr, "Normal", "RouterUpdated", "GCP Cloud Router updated")
+	}
+
+	// update status
+	gr.Status.SelfLink = router.SelfLink
+	gr.Status.NATs = nil
+	for _, nat := range router.Nats {
+		gr.Status.NATs = append(gr.Status.NATs, nat.Name)
+	}
+	if !equality.Semantic.DeepEqual(previous.Status, gr.Status) {
+		err = cr.Status().Update(ctx, &gr)
+		if err != nil {
+			logger.Error(err, "error updating gcprouter status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp router reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+// resolveNATs builds the NATs of the router with the URLs of the referenced GCPAddresses and GCPSubnetworks.
+// It returns the reason why a reference is not ready yet, if any.
+func (cr *GCPRouterReconciler) resolveNATs(ctx context.Context, gr *benzaiten.GCPRouter) ([]*compute.RouterNat, string, error) {
+	var nats []*compute.RouterNat
+	for _, spec := range gr.Spec.NATs {
+		var natIPs []string
+		for _, ref := range spec.AddressRefs {
+			ga := benzaiten.GCPAddress{}
+			err := cr.Get(ctx, types.NamespacedName{Namespace: gr.Namespace, Name: ref.Name}, &ga)
+			if err != nil {
+				if kerr.IsNotFound(err) {
+					return nil, fmt.Sprintf("GCPAddress %s of NAT %s not found", ref.Name, spec.Name), nil
+				}
+				return nil, "", err
+			}
+			if ga.Spec.Region != gr.Spec.Region || ga.Spec.AddressType != addressTypeExternal {
+				return nil, fmt.Sprintf("GCPAddress %s of NAT %s is not an external address of region %s", ref.Name, spec.Name, gr.Spec.Region), nil
+			}
+			if ga.Status.SelfLink == "" {
+				return nil, fmt.Sprintf("waiting for GCPAddress %s of NAT %s", ref.Name, spec.Name), nil
+			}
+			natIPs = append(natIPs, ga.Status.SelfLink)
+		}
+
+		var subnetworks []*compute.RouterNatSubnetworkToNat
+		for _, subnetwork := range spec.Subnetworks {
+			gs := benzaiten.GCPSubnetwork{}
+			err := cr.Get(ctx, types.NamespacedName{Namespace: gr.Namespace, Name: subnetwork.SubnetworkRef.Name}, &gs)
+			if err != nil {
+				if kerr.IsNotFound(err) {
+					return nil, fmt.Sprintf("GCPSubnetwork %s of NAT %s not found", subnetwork.SubnetworkRef.Name, spec.Name), nil
+				}
+				return nil, "", err
+			}
+			if gs.Status.SelfLink == "" {
+				return nil, fmt.Sprintf("waiting for GCPSubnetwork %s of NAT %s", subnetwork.SubnetworkRef.Name, spec.Name), nil
+			}
+			sourceIPRanges := subnetwork.SourceIPRanges
+			if sourceIPRanges == "" {
+				sourceIPRanges = natSubnetworkAllIPRanges
+			}
+			subnetworks = append(subnetworks, &compute.RouterNatSubnetworkToNat{
+				Name:                gs.Status.SelfLink,
+				SourceIpRangesToNat: []string{sourceIPRanges},
+			})
+		}
+
+		nats = append(nats, newRouterNat(spec, natIPs, subnetworks))
+	}
+
+	return nats, "", nil
+}
+
+func (cr *GCPRouterReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gr *benzaiten.GCPRouter) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gr, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("deleting gcprouter...")
+	op, err := cr.cloud.GCP.DeleteRouter(gr.Spec.Region, gr.Spec.Name)
+	if err != nil && !notFoundGCPResource(err) {
+		logger.Error(err, "error deleting gcprouter")
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitRegionOperation(gr.Spec.Region, op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error deleting gcprouter")
+			return ctrl.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(gr, gcpFinalizer)
+	err = cr.Update(ctx, gr)
+	if err != nil {
+		logger.Error(err, "error removing gcprouter finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp router deleted")
+	return ctrl.Result{}, nil
+}
+
+func (cr *GCPRouterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPRouter{}).
+		Watches(&benzaiten.GCPNetwork{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForReference)).
+		Watches(&benzaiten.GCPAddress{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForReference)).
+		Watches(&benzaiten.GCPSubnetwork{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForReference)).
+		Complete(cr)
+}
+
+// requestsForReference returns the GCPRouters referencing the GCPNetwork, GCPAddress or GCPSubnetwork
+func (cr *GCPRouterReconciler) requestsForReference(ctx context.Context, obj client.Object) []reconcile.Request {
+	grs := benzaiten.GCPRouterList{}
+	err := cr.List(ctx, &grs, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		cr.Log.Error(err, "unable to list gcprouters")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, gr := range grs.Items {
+		if routerReferences(&gr, obj) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: gr.Name, Namespace: gr.Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
+// routerReferences reports whether the router references the object
+func routerReferences(gr *benzaiten.GCPRouter, obj client.Object) bool {
+	switch obj.(type) {
+	case *benzaiten.GCPNetwork:
+		return gr.Spec.NetworkRef.Name == obj.GetName()
+	case *benzaiten.GCPAddress:
+		for _, nat := range gr.Spec.NATs {
+			if slices.Contains(nat.AddressRefs, benzaiten.ResourceRef{Name: obj.GetName()}) {
+				return true
+			}
+		}
+	case *benzaiten.GCPSubnetwork:
+		for _, nat := range gr.Spec.NATs {
+			for _, subnetwork := range nat.Subnetworks {
+				if subnetwork.SubnetworkRef.Name == obj.GetName() {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func setupGCPRouterController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcprouter")
+	cc := GCPRouterReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPRouterReconciler"),
+	}
+
+	// create GCPRouter controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPRouter controller: %w", err)
+	}
+
+	return nil
+}
+
+// newComputeRouter builds the GCP Cloud Router described by the GCPRouter spec
+func newComputeRouter(gr *benzaiten.GCPRouter, network string, nats []*compute.RouterNat) *compute.Router {
+	router := &compute.Router{
+		Name:        gr.Spec.Name,
+		Network:     network,
+		Nats:        nats,
+		Description: gr.Spec.Description,
+	}
+	if gr.Spec.BGP != nil {
+		router.Bgp = &compute.RouterBgp{Asn: gr.Spec.BGP.ASN}
+	}
+
+	return router
+}
+
+// newRouterNat builds the GCP NAT gateway described by the NAT spec
+func newRouterNat(spec benzaiten.RouterNAT, natIPs []string, subnetworks []*compute.RouterNatSubnetworkToNat) *compute.RouterNat {
+	nat := &compute.RouterNat{
+		Name:                          spec.Name,
+		NatIpAllocateOption:           spec.IPAllocation,
+		NatIps:                        natIPs,
+		SourceSubnetworkIpRangesToNat: spec.SubnetworkSelection,
+		Subnetworks:                   subnetworks,
+		MinPortsPerVm:                 spec.MinPortsPerVM,
+		MaxPortsPerVm:                 spec.MaxPortsPerVM,
+		EnableDynamicPortAllocation:   spec.DynamicPortAllocation,
+		LogConfig: &compute.RouterNatLogConfig{
+			Enable:          spec.Logging != nil,
+			ForceSendFields: []string{"Enable"},
+		},
+		ForceSendFields: []string{"EnableDynamicPortAllocation"},
+	}
+	if nat.NatIpAllocateOption == "" {
+		nat.NatIpAllocateOption = natIPAllocationAuto
+	}
+	if nat.SourceSubnetworkIpRangesToNat == "" {
+		nat.SourceSubnetworkIpRangesToNat = natAllSubnetworks
+	}
+	if spec.Logging != nil {
+		nat.LogConfig.Filter = spec.Logging.Filter
+		if nat.LogConfig.Filter == "" {
+			nat.LogConfig.Filter = natLogFilterAll
+		}
+	}
+
+	return nat
+}
+
+// routerInSync reports whether the BGP and NAT configuration of the GCP router matches the desired router.
+// Port allocation settings left unset are defaulted by GCP and not compared, neither is the BGP speaker of a router
+// without BGP in the spec since a patch cannot remove it.
+func routerInSync(current, desired *compute.Router) bool {
+	if current.Description != desired.Description {
+		return false
+	}
+	if desired.Bgp != nil && (current.Bgp == nil || current.Bgp.Asn != desired.Bgp.Asn) {
+		return false
+	}
+	if len(current.Nats) != len(desired.Nats) {
+		return false
+	}
+
+	for _, d := range desired.Nats {
+		i := slices.IndexFunc(current.Nats, func(c *compute.RouterNat) bool { return c.Name == d.Name })
+		if i < 0 {
+			return false
+		}
+		c := current.Nats[i]
+		if c.NatIpAllocateOption != d.NatIpAllocateOption ||
+			c.SourceSubnetworkIpRangesToNat != d.SourceSubnetworkIpRangesToNat ||
+			c.EnableDynamicPortAllocation != d.EnableDynamicPortAllocation ||
+			(d.MinPortsPerVm != 0 && c.MinPortsPerVm != d.MinPortsPerVm) ||
+			(d.MaxPortsPerVm != 0 && c.MaxPortsPerVm != d.MaxPortsPerVm) {
+			return false
+		}
+		if !stringSetsEqual(lastURLSegments(c.NatIps), lastURLSegments(d.NatIps)) {
+			return false
+		}
+		currentLogging := c.LogConfig != nil && c.LogConfig.Enable
+		if currentLogging != d.LogConfig.Enable || (d.LogConfig.Enable && c.LogConfig.Filter != d.LogConfig.Filter) {
+			return false
+		}
+		var currentSubnetworks, desiredSubnetworks []string
+		for _, s := range c.Subnetworks {
+			currentSubnetworks = append(currentSubnetworks, lastURLSegment(s.Name)+":"+fmt.Sprint(s.SourceIpRangesToNat))
+		}
+		for _, s := range d.Subnetworks {
+			desiredSubnetworks = append(desiredSubnetworks, lastURLSegment(s.Name)+":"+fmt.Sprint(s.SourceIpRangesToNat))
+		}
+		if !stringSetsEqual(currentSubnetworks, desiredSubnetworks) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/compute/v1"
+	"testing"
+)
+
+func TestNewRouterNat(t *testing.T) {
+	nat := newRouterNat(benzaiten.RouterNAT{Name: "test-nat"}, nil, nil)
+	if nat.NatIpAllocateOption != natIPAllocationAuto || nat.SourceSubnetworkIpRangesToNat != natAllSubnetworks {
+		t.Fatalf("expected auto allocation for all subnetworks, got %s %s", nat.NatIpAllocateOption, nat.SourceSubnetworkIpRangesToNat)
+	}
+	if nat.LogConfig.Enable {
+		t.Fatalf("expected logging to be disabled")
+	}
+
+	nat = newRouterNat(benzaiten.RouterNAT{Name: "test-nat", Logging: &benzaiten.NATLogging{}}, nil, nil)
+	if !nat.LogConfig.Enable || nat.LogConfig.Filter != natLogFilterAll {
+		t.Fatalf("expected logging of all connections, got %v %s", nat.LogConfig.Enable, nat.LogConfig.Filter)
+	}
+}
+
+func TestRouterInSync(t *testing.T) {
+	gr := &benzaiten.GCPRouter{
+		Spec: benzaiten.GCPRouterSpec{
+			Name: "test-router",
+			BGP:  &benzaiten.RouterBGP{ASN: 64512},
+		},
+	}
+	nats := []*compute.RouterNat{
+		newRouterNat(benzaiten.RouterNAT{Name: "test-nat", IPAllocation: "MANUAL_ONLY"},
+			[]string{"https://www.googleapis.com/compute/v1/projects/test-project/regions/us-central1/addresses/test-address"}, nil),
+	}
+	desired := newComputeRouter(gr, "test-network", nats)
+
+	current := &compute.Router{
+		Bgp: &compute.RouterBgp{Asn: 64512},
+		Nats: []*compute.RouterNat{
+			{
+				Name:                          "test-nat",
+				NatIpAllocateOption:           "MANUAL_ONLY",
+				NatIps:                        []string{"projects/test-project/regions/us-central1/addresses/test-address"},
+				SourceSubnetworkIpRangesToNat: natAllSubnetworks,
+				MinPortsPerVm:                 64,
+				LogConfig:                     &compute.RouterNatLogConfig{Enable: false},
+			},
+		},
+	}
+	if !routerInSync(current, desired) {
+		t.Fatalf("expected router with defaulted ports to be in sync")
+	}
+
+	current.Bgp.Asn = 64513
+	if routerInSync(current, desired) {
+		t.Fatalf("expected a different ASN to be out of sync")
+	}
+
+	current.Bgp.Asn = 64512
+	current.Nats[0].NatIps = []string{"projects/test-project/regions/us-central1/addresses/other-address"}
+	if routerInSync(current, desired) {
+		t.Fatalf("expected different NAT IPs to be out of sync")
+	}
+
+	// the BGP speaker GCP keeps after bgp is removed from the spec does not trigger a patch
+	current.Nats[0].NatIps = []string{"projects/test-project/regions/us-central1/addresses/test-address"}
+	gr.Spec.BGP = nil
+	if !routerInSync(current, newComputeRouter(gr, "test-network", nats)) {
+		t.Fatalf("expected router without bgp in the spec to be in sync")
+	}
+}
@@ -0,0 +1,407 @@
+package controllers
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"github.com/robfig/cron"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"time"
+)
+
+type GCPServiceAccountKeyReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPServiceAccountKeyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpserviceaccountkey", req.NamespacedName)
+
+	gsak := benzaiten.GCPServiceAccountKey{}
+	err := cr.Get(ctx, req.NamespacedName, &gsak)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpserviceaccountkey not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gsak.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gsak)
+	}
+
+	if controllerutil.AddFinalizer(&gsak, gcpFinalizer) {
+		err = cr.Update(ctx, &gsak)
+		if err != nil {
+			logger.Error(err, "error adding gcpserviceaccountkey finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := gsak.DeepCopyObject().(*benzaiten.GCPServiceAccountKey)
+
+	var schedule cron.Schedule
+	if gsak.Spec.RotationSchedule != "" {
+		schedule, err = cron.ParseStandard(gsak.Spec.RotationSchedule)
+		if err != nil {
+			condition := metav1.Condition{
+				Type:               benzaiten.ServiceAccountKeyConditionScheduleValid,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: gsak.Generation,
+				Reason:             "InvalidSchedule",
+				Message:            err.Error(),
+			}
+			if !meta.IsStatusConditionPresentAndEqual(gsak.Status.Conditions, condition.Type, condition.Status) {
+				cr.eventRecorder.Event(&gsak, "Warning", "InvalidSchedule", fmt.Sprintf("unable to parse rotation schedule %q: %v", gsak.Spec.RotationSchedule, err))
+			}
+			meta.SetStatusCondition(&gsak.Status.Conditions, condition)
+			gsak.Status.NextRotationTime = nil
+			if !equality.Semantic.DeepEqual(previous.Status, gsak.Status) {
+				err = cr.Status().Update(ctx, &gsak)
+				if err != nil {
+					logger.Error(err, "error updating gcpserviceaccountkey status")
+					return ctrl.Result{}, err
+				}
+			}
+			// wait for the schedule to be fixed
+			return ctrl.Result{}, nil
+		}
+	}
+	meta.RemoveStatusCondition(&gsak.Status.Conditions, benzaiten.ServiceAccountKeyConditionScheduleValid)
+
+	// the key is created for the referenced service account
+	gsa, condition, err := resolveServiceAccount(ctx, cr.Client, &gsak)
+	if err != nil {
+		logger.Error(err, "error resolving gcpserviceaccountkey service account")
+		return ctrl.Result{}, err
+	}
+	meta.SetStatusCondition(&gsak.Status.Conditions, condition)
+	if condition.Status != metav1.ConditionTrue {
+		if !equality.Semantic.DeepEqual(previous.Status, gsak.Status) {
+			err = cr.Status().Update(ctx, &gsak)
+			if err != nil {
+				logger.Error(err, "error updating gcpserviceaccountkey status")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+
+	secret := corev1.Secret{}
+	err = cr.Get(ctx, types.NamespacedName{Namespace: gsak.Namespace, Name: serviceAccountKeySecretName(&gsak)}, &secret)
+	if err != nil && !kerr.IsNotFound(err) {
+		logger.Error(err, "error getting gcpserviceaccountkey secret")
+		return ctrl.Result{}, err
+	}
+
+	// a new key is created if the stored key is lost, deleted or due for rotation
+	now := time.Now()
+	reason, err := cr.keyRotationReason(&gsak, gsa, &secret, schedule, now)
+	if err != nil {
+		logger.Error(err, "error getting gcpserviceaccountkey")
+		return ctrl.Result{}, err
+	}
+	if reason != "" {
+		logger.Info("creating gcpserviceaccountkey...", "reason", reason)
+		key, err := cr.cloud.GCP.CreateServiceAccountKey(gsa.Spec.AccountID)
+		if err != nil {
+			logger.Error(err, "error creating gcpserviceaccountkey")
+			cr.eventRecorder.Event(&gsak, "Warning", "ServiceAccountKeyFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		credentials, err := base64.StdEncoding.DecodeString(key.PrivateKeyData)
+		if err != nil {
+			logger.Error(err, "error decoding gcpserviceaccountkey")
+			return ctrl.Result{}, err
+		}
+
+		// record the key before storing it, so it is deleted even if storing it fails
+		retireServiceAccountKey(&gsak, now)
+		gsak.Status.KeyID = lastURLSegment(key.Name)
+		gsak.Status.KeyCreationTime = &metav1.Time{Time: now}
+		gsak.Status.SecretName = serviceAccountKeySecretName(&gsak)
+		err = cr.Status().Update(ctx, &gsak)
+		if err != nil {
+			logger.Error(err, "error updating gcpserviceaccountkey status")
+			return ctrl.Result{}, err
+		}
+
+		err = cr.storeKey(ctx, &gsak, &secret, credentials)
+		if err != nil {
+			logger.Error(err, "error storing gcpserviceaccountkey")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gsak, "Normal", "ServiceAccountKeyCreated", fmt.Sprintf("GCP Service Account Key %s stored in Secret %s: %s", gsak.Status.KeyID, gsak.Status.SecretName, reason))
+	}
+
+	// delete the retired keys whose grace period ended
+	var retained []benzaiten.RetiredServiceAccountKey
+	for _, retired := range gsak.Status.RetiredKeys {
+		if now.Before(retired.RetiredTime.Add(gsak.Spec.GracePeriod.Duration)) {
+			retained = append(retained, retired)
+			continue
+		}
+		err = cr.cloud.GCP.DeleteServiceAccountKey(gsa.Spec.AccountID, retired.KeyID)
+		if err != nil && !notFoundGCPResource(err) {
+			logger.Error(err, "error deleting retired gcpserviceaccountkey", "key", retired.KeyID)
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gsak, "Normal", "ServiceAccountKeyDeleted", fmt.Sprintf("Retired GCP Service Account Key %s deleted", retired.KeyID))
+	}
+	gsak.Status.RetiredKeys = retained
+
+	// update status
+	gsak.Status.NextRotationTime = nil
+	if schedule != nil {
+		gsak.Status.NextRotationTime = &metav1.Time{Time: schedule.Next(now)}
+	}
+	if !equality.Semantic.DeepEqual(previous.Status, gsak.Status) {
+		err = cr.Status().Update(ctx, &gsak)
+		if err != nil {
+			logger.Error(err, "error updating gcpserviceaccountkey status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp service account key reconciled")
+	return ctrl.Result{RequeueAfter: serviceAccountKeyRequeue(&gsak, now)}, nil
+}
+
+// keyRotationReason returns why a new key must be created, empty if the stored key is kept
+func (cr *GCPServiceAccountKeyReconciler) keyRotationReason(gsak *benzaiten.GCPServiceAccountKey, gsa *benzaiten.GCPServiceAccount, secret *corev1.Secret, schedule cron.Schedule, now time.Time) (string, error) {
+	if gsak.Status.KeyID == "" {
+		return "no key", nil
+	}
+	if len(secret.Data[serviceAccountKeySecretKey(gsak)]) == 0 {
+		return "key missing from the secret", nil
+	}
+	_, err := cr.cloud.GCP.GetServiceAccountKey(gsa.Spec.AccountID, gsak.Status.KeyID)
+	if err != nil {
+		if notFoundGCPResource(err) {
+			return "key deleted", nil
+		}
+		return "", err
+	}
+	if schedule != nil && gsak.Status.KeyCreationTime != nil && mostRecentScheduleTime(schedule, gsak.Status.KeyCreationTime.Time, now) != nil {
+		return "scheduled rotation", nil
+	}
+	return "", nil
+}
+
+// storeKey writes the JSON credentials file of the key to the Secret, creating the Secret if missing
+func (cr *GCPServiceAccountKeyReconciler) storeKey(ctx context.Context, gsak *benzaiten.GCPServiceAccountKey, secret *corev1.Secret, credentials []byte) error {
+	if secret.CreationTimestamp.IsZero() {
+		*secret = corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      serviceAccountKeySecretName(gsak),
+				Namespace: gsak.Namespace,
+			},
+			Type: corev1.SecretTypeOpaque,
+		}
+		// the Secret is garbage collected along with the key
+		err := controllerutil.SetControllerReference(gsak, secret, cr.Scheme)
+		if err != nil {
+			return fmt.Errorf("unable to set secret owner: %w", err)
+		}
+		secret.Data = map[string][]byte{serviceAccountKeySecretKey(gsak): credentials}
+		err = cr.Create(ctx, secret)
+		if err != nil {
+			return fmt.Errorf("unable to create secret %s: %w", secret.Name, err)
+		}
+		return nil
+	}
+
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[serviceAccountKeySecretKey(gsak)] = credentials
+	err := cr.Update(ctx, secret)
+	if err != nil {
+		return fmt.Errorf("unable to update secret %s: %w", secret.Name, err)
+	}
+	return nil
+}
+
+func (cr *GCPServiceAccountKeyReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gsak *benzaiten.GCPServiceAccountKey) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gsak, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	// the service account waits for its keys, without it there is nothing left to delete
+	gsa := benzaiten.GCPServiceAccount{}
+	err := cr.Get(ctx, types.NamespacedName{Namespace: gsak.Namespace, Name: gsak.Spec.ServiceAccountRef.Name}, &gsa)
+	if err != nil && !kerr.IsNotFound(err) {
+		logger.Error(err, "error getting gcpserviceaccount")
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		keyIDs := []string{gsak.Status.KeyID}
+		for _, retired := range gsak.Status.RetiredKeys {
+			keyIDs = append(keyIDs, retired.KeyID)
+		}
+		for _, keyID := range keyIDs {
+			if keyID == "" {
+				continue
+			}
+			logger.Info("deleting gcpserviceaccountkey...", "key", keyID)
+			err = cr.cloud.GCP.DeleteServiceAccountKey(gsa.Spec.AccountID, keyID)
+			if err != nil && !notFoundGCPResource(err) {
+				logger.Error(err, "error deleting gcpserviceaccountkey", "key", keyID)
+				return ctrl.Result{}, err
+			}
+		}
+	}
+
+	controllerutil.RemoveFinalizer(gsak, gcpFinalizer)
+	err = cr.Update(ctx, gsak)
+	if err != nil {
+		logger.Error(err, "error removing gcpserviceaccountkey finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp service account key deleted")
+	return ctrl.Result{}, nil
+}
+
+func (cr *GCPServiceAccountKeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPServiceAccountKey{}).
+		Owns(&corev1.Secret{}).
+		Watches(&benzaiten.GCPServiceAccount{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForServiceAccount)).
+		Complete(cr)
+}
+
+// requestsForServiceAccount returns the GCPServiceAccountKeys referencing the GCPServiceAccount
+func (cr *GCPServiceAccountKeyReconciler) requestsForServiceAccount(ctx context.Context, obj client.Object) []reconcile.Request {
+	gsaks := benzaiten.GCPServiceAccountKeyList{}
+	err := cr.List(ctx, &gsaks, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		cr.Log.Error(err, "unable to list gcpserviceaccountkeys")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, gsak := range gsaks.Items {
+		if gsak.Spec.ServiceAccountRef.Name == obj.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: gsak.Name, Namespace: gsak.Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
+func setupGCPServiceAccountKeyController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpserviceaccountkey")
+	cc := GCPServiceAccountKeyReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPServiceAccountKeyReconciler"),
+	}
+
+	// create GCPServiceAccountKey controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPServiceAccountKey controller: %w", err)
+	}
+
+	return nil
+}
+
+// resolveServiceAccount returns the referenced GCPServiceAccount. The returned condition is False until the service
+// account exists in GCP.
+func resolveServiceAccount(ctx context.Context, c client.Client, gsak *benzaiten.GCPServiceAccountKey) (*benzaiten.GCPServiceAccount, metav1.Condition, error) {
+	ref := gsak.Spec.ServiceAccountRef.Name
+	gsa := benzaiten.GCPServiceAccount{}
+	err := c.Get(ctx, types.NamespacedName{Namespace: gsak.Namespace, Name: ref}, &gsa)
+	if err != nil && !kerr.IsNotFound(err) {
+		return nil, metav1.Condition{}, fmt.Errorf("unable to get gcpserviceaccount %s: %w", ref, err)
+	}
+	if err != nil || gsa.Status.Email == "" {
+		return nil, metav1.Condition{
+			Type:               benzaiten.ServiceAccountKeyConditionServiceAccountReady,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: gsak.Generation,
+			Reason:             "ServiceAccountNotReady",
+			Message:            fmt.Sprintf("waiting for GCPServiceAccount %s", ref),
+		}, nil
+	}
+
+	return &gsa, metav1.Condition{
+		Type:               benzaiten.ServiceAccountKeyConditionServiceAccountReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gsak.Generation,
+		Reason:             "ServiceAccountReady",
+		Message:            fmt.Sprintf("GCPServiceAccount %s is ready", ref),
+	}, nil
+}
+
+// retireServiceAccountKey moves the current key to the retired keys, it is deleted once its grace period ends
+func retireServiceAccountKey(gsak *benzaiten.GCPServiceAccountKey, now time.Time) {
+	if gsak.Status.KeyID == "" {
+		return
+	}
+	gsak.Status.RetiredKeys = append(gsak.Status.RetiredKeys, benzaiten.RetiredServiceAccountKey{
+		KeyID:       gsak.Status.KeyID,
+		RetiredTime: metav1.Time{Time: now},
+	})
+	gsak.Status.KeyID = ""
+	gsak.Status.KeyCreationTime = nil
+}
+
+// serviceAccountKeyRequeue returns the time until the next rotation or the end of the next grace period, at most a
+// minute
+func serviceAccountKeyRequeue(gsak *benzaiten.GCPServiceAccountKey, now time.Time) time.Duration {
+	requeue := time.Second * 60
+	deadlines := []time.Time{}
+	if gsak.Status.NextRotationTime != nil {
+		deadlines = append(deadlines, gsak.Status.NextRotationTime.Time)
+	}
+	for _, retired := range gsak.Status.RetiredKeys {
+		deadlines = append(deadlines, retired.RetiredTime.Add(gsak.Spec.GracePeriod.Duration))
+	}
+	for _, deadline := range deadlines {
+		if wait := deadline.Sub(now) + time.Second; wait < requeue {
+			requeue = wait
+		}
+	}
+	return requeue
+}
+
+// serviceAccountKeySecretName returns the name of the Secret holding the key
+func serviceAccountKeySecretName(gsak *benzaiten.GCPServiceAccountKey) string {
+	if gsak.Spec.SecretName != "" {
+		return gsak.Spec.SecretName
+	}
+	return gsak.Name + "-key"
+}
+
+// serviceAccountKeySecretKey returns the data key of the Secret the key is stored under
+func serviceAccountKeySecretKey(gsak *benzaiten.GCPServiceAccountKey) string {
+	if gsak.Spec.SecretKey != "" {
+		return gsak.Spec.SecretKey
+	}
+	return "key.json"
+}
@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"testing"
+	"time"
+)
+
+func TestRetireServiceAccountKey(t *testing.T) {
+	now := time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC)
+	gsak := &benzaiten.GCPServiceAccountKey{}
+
+	// nothing to retire before the first key is created
+	retireServiceAccountKey(gsak, now)
+	if len(gsak.Status.RetiredKeys) != 0 {
+		t.Fatalf("expected no retired keys, got %+v", gsak.Status.RetiredKeys)
+	}
+
+	gsak.Status.KeyID = "old-key"
+	gsak.Status.KeyCreationTime = &metav1.Time{Time: now.Add(-time.Hour)}
+	retireServiceAccountKey(gsak, now)
+	if gsak.Status.KeyID != "" || gsak.Status.KeyCreationTime != nil {
+		t.Fatalf("expected the current key to be cleared, got %s", gsak.Status.KeyID)
+	}
+	if len(gsak.Status.RetiredKeys) != 1 || gsak.Status.RetiredKeys[0].KeyID != "old-key" || !gsak.Status.RetiredKeys[0].RetiredTime.Time.Equal(now) {
+		t.Fatalf("expected old-key to be retired, got %+v", gsak.Status.RetiredKeys)
+	}
+}
+
+func TestServiceAccountKeyRequeue(t *testing.T) {
+	now := time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC)
+	gsak := &benzaiten.GCPServiceAccountKey{
+		Spec: benzaiten.GCPServiceAccountKeySpec{
+			GracePeriod: metav1.Duration{Duration: time.Hour},
+		},
+	}
+
+	if requeue := serviceAccountKeyRequeue(gsak, now); requeue != time.Minute {
+		t.Fatalf("expected to requeue after a minute, got %s", requeue)
+	}
+
+	// the grace period of the retired key ends in 10 seconds
+	gsak.Status.RetiredKeys = []benzaiten.RetiredServiceAccountKey{
+		{KeyID: "old-key", RetiredTime: metav1.Time{Time: now.Add(-time.Hour + 10*time.Second)}},
+	}
+	gsak.Status.NextRotationTime = &metav1.Time{Time: now.Add(30 * time.Second)}
+	if requeue := serviceAccountKeyRequeue(gsak, now); requeue != 11*time.Second {
+		t.Fatalf("expected to requeue at the end of the grace period, got %s", requeue)
+	}
+}
@@ -0,0 +1,203 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/iam/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"strings"
+	"time"
+)
+
+type GCPServiceAccountReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPServiceAccountReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpserviceaccount", req.NamespacedName)
+
+	gsa := benzaiten.GCPServiceAccount{}
+	err := cr.Get(ctx, req.NamespacedName, &gsa)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpserviceaccount not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gsa.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gsa)
+	}
+
+	if controllerutil.AddFinalizer(&gsa, gcpFinalizer) {
+		err = cr.Update(ctx, &gsa)
+		if err != nil {
+			logger.Error(err, "error adding gcpserviceaccount finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := gsa.DeepCopyObject().(*benzaiten.GCPServiceAccount)
+	desired := &iam.ServiceAccount{
+		DisplayName: gsa.Spec.DisplayName,
+		Description: gsa.Spec.Description,
+	}
+
+	// does service account exist in GCP?
+	sa, err := cr.cloud.GCP.GetServiceAccount(gsa.Spec.AccountID)
+	if err != nil && notFoundGCPResource(err) {
+		// service account does not exist in GCP
+		logger.Info("gcpserviceaccount not found, creating service account...")
+		sa, err = cr.cloud.GCP.CreateServiceAccount(gsa.Spec.AccountID, desired)
+		if err != nil {
+			logger.Error(err, "error creating gcpserviceaccount")
+			cr.eventRecorder.Event(&gsa, "Warning", "ServiceAccountFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gsa, "Normal", "ServiceAccountCreated", fmt.Sprintf("GCP Service Account created: %s", sa.Email))
+	} else if err != nil {
+		logger.Error(err, "error getting gcpserviceaccount")
+		return ctrl.Result{}, err
+	} else if updateMask := serviceAccountUpdateMask(sa, desired); len(updateMask) > 0 {
+		logger.Info("gcpserviceaccount out of sync, updating service account...", "fields", updateMask)
+		sa, err = cr.cloud.GCP.PatchServiceAccount(gsa.Spec.AccountID, desired, updateMask)
+		if err != nil {
+			logger.Error(err, "error updating gcpserviceaccount")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gsa, "Normal", "ServiceAccountUpdated", fmt.Sprintf("GCP Service Account updated: %s", strings.Join(updateMask, ", ")))
+	}
+
+	// update status
+	gsa.Status.Email = sa.Email
+	gsa.Status.UniqueID = sa.UniqueId
+	meta.RemoveStatusCondition(&gsa.Status.Conditions, benzaiten.ServiceAccountConditionDeletionBlocked)
+	if !equality.Semantic.DeepEqual(previous.Status, gsa.Status) {
+		err = cr.Status().Update(ctx, &gsa)
+		if err != nil {
+			logger.Error(err, "error updating gcpserviceaccount status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp service account reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+func (cr *GCPServiceAccountReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gsa *benzaiten.GCPServiceAccount) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gsa, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	// the keys delete their Secrets and retired keys first, wait for their finalizers
+	gsaks := benzaiten.GCPServiceAccountKeyList{}
+	err := cr.List(ctx, &gsaks, client.InNamespace(gsa.Namespace))
+	if err != nil {
+		logger.Error(err, "error listing gcpserviceaccountkeys")
+		return ctrl.Result{}, err
+	}
+	var keys []string
+	for _, gsak := range gsaks.Items {
+		if gsak.Spec.ServiceAccountRef.Name == gsa.Name {
+			keys = append(keys, gsak.Name)
+		}
+	}
+	if len(keys) > 0 {
+		return cr.blockDelete(ctx, logger, gsa, fmt.Sprintf("service account is used by keys %s", strings.Join(keys, ", ")))
+	}
+
+	logger.Info("deleting gcpserviceaccount...")
+	err = cr.cloud.GCP.DeleteServiceAccount(gsa.Spec.AccountID)
+	if err != nil && !notFoundGCPResource(err) {
+		logger.Error(err, "error deleting gcpserviceaccount")
+		return ctrl.Result{}, err
+	}
+
+	controllerutil.RemoveFinalizer(gsa, gcpFinalizer)
+	err = cr.Update(ctx, gsa)
+	if err != nil {
+		logger.Error(err, "error removing gcpserviceaccount finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp service account deleted")
+	return ctrl.Result{}, nil
+}
+
+// blockDelete reports the deletion of the service account as blocked and requeues it
+func (cr *GCPServiceAccountReconciler) blockDelete(ctx context.Context, logger logr.Logger, gsa *benzaiten.GCPServiceAccount, message string) (ctrl.Result, error) {
+	condition := metav1.Condition{
+		Type:               benzaiten.ServiceAccountConditionDeletionBlocked,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gsa.Generation,
+		Reason:             "ServiceAccountInUse",
+		Message:            message,
+	}
+	if !meta.IsStatusConditionPresentAndEqual(gsa.Status.Conditions, condition.Type, condition.Status) {
+		cr.eventRecorder.Event(gsa, "Warning", "ServiceAccountInUse", fmt.Sprintf("GCP Service Account is in use, deletion blocked: %s", message))
+	}
+	previous := gsa.DeepCopyObject().(*benzaiten.GCPServiceAccount)
+	meta.SetStatusCondition(&gsa.Status.Conditions, condition)
+	if !equality.Semantic.DeepEqual(previous.Status, gsa.Status) {
+		err := cr.Status().Update(ctx, gsa)
+		if err != nil {
+			logger.Error(err, "error updating gcpserviceaccount status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+}
+
+func (cr *GCPServiceAccountReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPServiceAccount{}).
+		Complete(cr)
+}
+
+func setupGCPServiceAccountController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpserviceaccount")
+	cc := GCPServiceAccountReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPServiceAccountReconciler"),
+	}
+
+	// create GCPServiceAccount controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPServiceAccount controller: %w", err)
+	}
+
+	return nil
+}
+
+// serviceAccountUpdateMask returns the fields of the service account differing from the desired service account
+func serviceAccountUpdateMask(current, desired *iam.ServiceAccount) []string {
+	var updateMask []string
+	if current.DisplayName != desired.DisplayName {
+		updateMask = append(updateMask, "displayName")
+	}
+	if current.Description != desired.Description {
+		updateMask = append(updateMask, "description")
+	}
+	return updateMask
+}
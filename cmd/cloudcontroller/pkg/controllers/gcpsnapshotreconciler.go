@@ -0,0 +1,257 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"time"
+)
+
+type GCPSnapshotReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPSnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpsnapshot", req.NamespacedName)
+
+	gs := benzaiten.GCPSnapshot{}
+	err := cr.Get(ctx, req.NamespacedName, &gs)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpsnapshot not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gs.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gs)
+	}
+
+	if controllerutil.AddFinalizer(&gs, gcpFinalizer) {
+		err = cr.Update(ctx, &gs)
+		if err != nil {
+			logger.Error(err, "error adding gcpsnapshot finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := gs.DeepCopyObject().(*benzaiten.GCPSnapshot)
+
+	// does snapshot exist in GCP?
+	snapshot, err := cr.cloud.GCP.GetSnapshot(gs.Spec.Name)
+	if err != nil && notFoundGCPResource(err) {
+		// snapshot does not exist in GCP, resolve the disk to take it from
+		sourceDisk, reason, err := cr.resolveSourceDisk(ctx, &gs)
+		if err != nil {
+			logger.Error(err, "error resolving gcpsnapshot source disk")
+			return ctrl.Result{}, err
+		}
+		if sourceDisk == "" {
+			meta.SetStatusCondition(&gs.Status.Conditions, metav1.Condition{
+				Type:               benzaiten.SnapshotConditionReady,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: gs.Generation,
+				Reason:             "SourceNotReady",
+				Message:            reason,
+			})
+			if !equality.Semantic.DeepEqual(previous.Status, gs.Status) {
+				err = cr.Status().Update(ctx, &gs)
+				if err != nil {
+					logger.Error(err, "error updating gcpsnapshot status")
+					return ctrl.Result{}, err
+				}
+			}
+			logger.Info("gcpsnapshot source not ready", "reason", reason)
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		}
+
+		logger.Info("gcpsnapshot not found, creating snapshot...")
+		op, err := cr.cloud.GCP.CreateSnapshot(&compute.Snapshot{
+			Name:             gs.Spec.Name,
+			SourceDisk:       sourceDisk,
+			StorageLocations: gs.Spec.StorageLocations,
+			Labels:           gs.Spec.Labels,
+		})
+		if err != nil {
+			logger.Error(err, "error creating gcpsnapshot")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gs, "Normal", "SnapshotCreating", fmt.Sprintf("GCP Snapshot of %s creating", lastURLSegment(sourceDisk)))
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitGlobalOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error creating gcpsnapshot")
+			cr.eventRecorder.Event(&gs, "Warning", "SnapshotFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		snapshot, err = cr.cloud.GCP.GetSnapshot(gs.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error verifying snapshot status")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gs, "Normal", "SnapshotCreated", "GCP Snapshot created")
+	} else if err != nil {
+		logger.Error(err, "error getting gcpsnapshot")
+		return ctrl.Result{}, err
+	}
+
+	// update status
+	readyCondition := metav1.Condition{
+		Type:               benzaiten.SnapshotConditionReady,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: gs.Generation,
+		Reason:             snapshot.Status,
+		Message:            fmt.Sprintf("snapshot is %s", snapshot.Status),
+	}
+	if benzaiten.SnapshotStatus(snapshot.Status) == benzaiten.SnapshotStatusReady {
+		readyCondition.Status = metav1.ConditionTrue
+		readyCondition.Reason = "Ready"
+		readyCondition.Message = "snapshot is ready to be restored"
+	}
+	gs.Status.Phase = benzaiten.SnapshotStatus(snapshot.Status)
+	gs.Status.SelfLink = snapshot.SelfLink
+	gs.Status.SourceDisk = snapshot.SourceDisk
+	gs.Status.DiskSizeGb = snapshot.DiskSizeGb
+	gs.Status.StorageBytes = snapshot.StorageBytes
+	meta.SetStatusCondition(&gs.Status.Conditions, readyCondition)
+	if !equality.Semantic.DeepEqual(previous.Status, gs.Status) {
+		err = cr.Status().Update(ctx, &gs)
+		if err != nil {
+			logger.Error(err, "error updating gcpsnapshot status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if readyCondition.Status != metav1.ConditionTrue {
+		// snapshots are uploaded after their creation, follow the upload
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+
+	logger.Info("gcp snapshot reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+// resolveSourceDisk returns the URL of the disk to snapshot, or the reason why it is not available yet
+func (cr *GCPSnapshotReconciler) resolveSourceDisk(ctx context.Context, gs *benzaiten.GCPSnapshot) (string, string, error) {
+	source := gs.Spec.Source
+	switch {
+	case source.DiskRef != nil:
+		gd := benzaiten.GCPDisk{}
+		err := cr.Get(ctx, types.NamespacedName{Namespace: gs.Namespace, Name: source.DiskRef.Name}, &gd)
+		if err != nil {
+			if kerr.IsNotFound(err) {
+				return "", fmt.Sprintf("GCPDisk %s not found", source.DiskRef.Name), nil
+			}
+			return "", "", err
+		}
+		if gd.Status.SelfLink == "" || gd.Status.Phase != benzaiten.DiskStatusReady {
+			return "", fmt.Sprintf("GCPDisk %s is not ready", source.DiskRef.Name), nil
+		}
+		return gd.Status.SelfLink, "", nil
+	case source.InstanceRef != nil:
+		gi := benzaiten.GCPInstance{}
+		err := cr.Get(ctx, types.NamespacedName{Namespace: gs.Namespace, Name: source.InstanceRef.Name}, &gi)
+		if err != nil {
+			if kerr.IsNotFound(err) {
+				return "", fmt.Sprintf("GCPInstance %s not found", source.InstanceRef.Name), nil
+			}
+			return "", "", err
+		}
+		instance, err := cr.cloud.GCP.GetInstance(gi.Spec.Zone, gi.Spec.Name)
+		if err != nil {
+			if notFoundGCPResource(err) {
+				return "", fmt.Sprintf("GCPInstance %s does not exist in GCP", source.InstanceRef.Name), nil
+			}
+			return "", "", err
+		}
+		for _, d := range instance.Disks {
+			if d.Boot {
+				return d.Source, "", nil
+			}
+		}
+		return "", fmt.Sprintf("GCPInstance %s has no boot disk", source.InstanceRef.Name), nil
+	}
+
+	return "", "no source disk referenced", nil
+}
+
+func (cr *GCPSnapshotReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gs *benzaiten.GCPSnapshot) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gs, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	_, err := cr.cloud.GCP.GetSnapshot(gs.Spec.Name)
+	if err != nil && !notFoundGCPResource(err) {
+		logger.Error(err, "error getting gcpsnapshot")
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		logger.Info("deleting gcpsnapshot...")
+		op, err := cr.cloud.GCP.DeleteSnapshot(gs.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error deleting gcpsnapshot")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitGlobalOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error deleting gcpsnapshot")
+			return ctrl.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(gs, gcpFinalizer)
+	err = cr.Update(ctx, gs)
+	if err != nil {
+		logger.Error(err, "error removing gcpsnapshot finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp snapshot deleted")
+	return ctrl.Result{}, nil
+}
+
+func (cr *GCPSnapshotReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPSnapshot{}).
+		Complete(cr)
+}
+
+func setupGCPSnapshotController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpsnapshot")
+	cc := GCPSnapshotReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPSnapshotReconciler"),
+	}
+
+	// create GCPSnapshot controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPSnapshot controller: %w", err)
+	}
+
+	return nil
+}
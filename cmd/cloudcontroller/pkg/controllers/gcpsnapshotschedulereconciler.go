@@ -0,0 +1,295 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"github.com/robfig/cron"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxSnapshotNamePrefix keeps the generated snapshot names within the 63 characters allowed by GCP
+const maxSnapshotNamePrefix = 47
+
+type GCPSnapshotScheduleReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	Log           logr.Logger
+}
+
+func (cr *GCPSnapshotScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpsnapshotschedule", req.NamespacedName)
+
+	gss := benzaiten.GCPSnapshotSchedule{}
+	err := cr.Get(ctx, req.NamespacedName, &gss)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpsnapshotschedule not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	if !gss.DeletionTimestamp.IsZero() {
+		// the snapshots taken by the schedule are backups, they outlive the schedule
+		return ctrl.Result{}, nil
+	}
+
+	previous := gss.DeepCopyObject().(*benzaiten.GCPSnapshotSchedule)
+
+	schedule, err := cron.ParseStandard(gss.Spec.Schedule)
+	if err != nil {
+		condition := metav1.Condition{
+			Type:               benzaiten.SnapshotScheduleConditionValid,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: gss.Generation,
+			Reason:             "InvalidSchedule",
+			Message:            err.Error(),
+		}
+		if !meta.IsStatusConditionPresentAndEqual(gss.Status.Conditions, condition.Type, condition.Status) {
+			cr.eventRecorder.Event(&gss, "Warning", "InvalidSchedule", fmt.Sprintf("unable to parse schedule %q: %v", gss.Spec.Schedule, err))
+		}
+		meta.SetStatusCondition(&gss.Status.Conditions, condition)
+		gss.Status.NextScheduleTime = nil
+		if !equality.Semantic.DeepEqual(previous.Status, gss.Status) {
+			err = cr.Status().Update(ctx, &gss)
+			if err != nil {
+				logger.Error(err, "error updating gcpsnapshotschedule status")
+				return ctrl.Result{}, err
+			}
+		}
+		// wait for the schedule to be fixed
+		return ctrl.Result{}, nil
+	}
+
+	snapshots := benzaiten.GCPSnapshotList{}
+	err = cr.List(ctx, &snapshots, client.InNamespace(gss.Namespace), client.MatchingLabels{benzaiten.SnapshotScheduleLabel: gss.Name})
+	if err != nil {
+		logger.Error(err, "error listing gcpsnapshots")
+		return ctrl.Result{}, err
+	}
+	var retained []benzaiten.GCPSnapshot
+	for _, s := range snapshots.Items {
+		if s.DeletionTimestamp.IsZero() {
+			retained = append(retained, s)
+		}
+	}
+
+	// take the snapshot of the most recent missed schedule, older missed schedules are skipped
+	now := time.Now()
+	earliest := gss.CreationTimestamp.Time
+	if gss.Status.LastScheduleTime != nil {
+		earliest = gss.Status.LastScheduleTime.Time
+	}
+	scheduled := mostRecentScheduleTime(schedule, earliest, now)
+	if scheduled != nil && !gss.Spec.Suspend {
+		gs := newScheduledSnapshot(&gss, *scheduled)
+		err = cr.Create(ctx, gs)
+		if err != nil && !kerr.IsAlreadyExists(err) {
+			logger.Error(err, "error creating gcpsnapshot")
+			cr.eventRecorder.Event(&gss, "Warning", "SnapshotFailed", fmt.Sprintf("unable to create GCPSnapshot %s: %v", gs.Name, err))
+			return ctrl.Result{}, err
+		}
+		if err == nil {
+			retained = append(retained, *gs)
+			cr.eventRecorder.Event(&gss, "Normal", "SnapshotScheduled", fmt.Sprintf("GCPSnapshot %s created", gs.Name))
+		}
+		gss.Status.LastScheduleTime = &metav1.Time{Time: *scheduled}
+	}
+
+	// prune the oldest ready snapshots exceeding the retention count and the older failed snapshots
+	retained, pruned := pruneSnapshots(retained, int(gss.Spec.RetentionCount))
+	for i := range pruned {
+		err = cr.Delete(ctx, &pruned[i])
+		if err != nil && !kerr.IsNotFound(err) {
+			logger.Error(err, "error pruning gcpsnapshot", "snapshot", pruned[i].Name)
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gss, "Normal", "SnapshotPruned", fmt.Sprintf("GCPSnapshot %s deleted", pruned[i].Name))
+	}
+
+	// update status
+	next := schedule.Next(now)
+	gss.Status.NextScheduleTime = &metav1.Time{Time: next}
+	if gss.Spec.Suspend {
+		gss.Status.NextScheduleTime = nil
+	}
+	gss.Status.Snapshots = nil
+	for _, s := range retained {
+		gss.Status.Snapshots = append(gss.Status.Snapshots, s.Name)
+	}
+	meta.SetStatusCondition(&gss.Status.Conditions, metav1.Condition{
+		Type:               benzaiten.SnapshotScheduleConditionValid,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gss.Generation,
+		Reason:             "Valid",
+		Message:            fmt.Sprintf("schedule %q is valid", gss.Spec.Schedule),
+	})
+	if !equality.Semantic.DeepEqual(previous.Status, gss.Status) {
+		err = cr.Status().Update(ctx, &gss)
+		if err != nil {
+			logger.Error(err, "error updating gcpsnapshotschedule status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp snapshot schedule reconciled", "next", next)
+	if gss.Spec.Suspend {
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{RequeueAfter: next.Sub(now) + time.Second}, nil
+}
+
+func (cr *GCPSnapshotScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPSnapshotSchedule{}).
+		Watches(&benzaiten.GCPSnapshot{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForGCPSnapshot)).
+		Complete(cr)
+}
+
+// requestsForGCPSnapshot returns the GCPSnapshotSchedule which created the GCPSnapshot
+func (cr *GCPSnapshotScheduleReconciler) requestsForGCPSnapshot(ctx context.Context, obj client.Object) []reconcile.Request {
+	name, ok := obj.GetLabels()[benzaiten.SnapshotScheduleLabel]
+	if !ok {
+		return nil
+	}
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{Name: name, Namespace: obj.GetNamespace()},
+	}}
+}
+
+func setupGCPSnapshotScheduleController(mgr manager.Manager, log logr.Logger) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpsnapshotschedule")
+	cc := GCPSnapshotScheduleReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		Log:           log.WithName("GCPSnapshotScheduleReconciler"),
+	}
+
+	// create GCPSnapshotSchedule controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPSnapshotSchedule controller: %w", err)
+	}
+
+	return nil
+}
+
+// newScheduledSnapshot builds the GCPSnapshot taken by the schedule at the given time
+func newScheduledSnapshot(gss *benzaiten.GCPSnapshotSchedule, scheduled time.Time) *benzaiten.GCPSnapshot {
+	labels := map[string]string{}
+	for k, v := range gss.Spec.Template.Labels {
+		labels[k] = v
+	}
+
+	gs := &benzaiten.GCPSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      scheduledSnapshotName(gss.Name, scheduled),
+			Namespace: gss.Namespace,
+			Labels: map[string]string{
+				benzaiten.SnapshotScheduleLabel: gss.Name,
+			},
+		},
+		Spec: benzaiten.GCPSnapshotSpec{
+			// snapshot names are global to the project, include the namespace to avoid collisions
+			Name:   scheduledSnapshotName(gss.Namespace+"-"+gss.Name, scheduled),
+			Labels: labels,
+		},
+	}
+	gss.Spec.Template.Source.DeepCopyInto(&gs.Spec.Source)
+	if gss.Spec.Template.StorageLocations != nil {
+		gs.Spec.StorageLocations = append([]string{}, gss.Spec.Template.StorageLocations...)
+	}
+
+	return gs
+}
+
+// scheduledSnapshotName returns a valid GCP snapshot name made of the prefix and the scheduled time
+func scheduledSnapshotName(prefix string, scheduled time.Time) string {
+	if len(prefix) > maxSnapshotNamePrefix {
+		prefix = prefix[:maxSnapshotNamePrefix]
+	}
+	prefix = strings.TrimRight(strings.ToLower(prefix), "-.")
+	prefix = strings.ReplaceAll(prefix, ".", "-")
+	return fmt.Sprintf("%s-%s", prefix, scheduled.UTC().Format("20060102-150405"))
+}
+
+// mostRecentScheduleTime returns the latest time after earliest and not after now at which the schedule fires, if any
+func mostRecentScheduleTime(schedule cron.Schedule, earliest, now time.Time) *time.Time {
+	t := schedule.Next(earliest)
+	if t.IsZero() || t.After(now) {
+		return nil
+	}
+	for {
+		next := schedule.Next(t)
+		if next.IsZero() || next.After(now) {
+			return &t
+		}
+		t = next
+	}
+}
+
+// pruneSnapshots sorts the snapshots oldest first and splits off the ones to delete. Only ready snapshots count
+// towards the retention count, so failing runs never displace good backups. Failed snapshots are deleted but the most
+// recent one, kept for inspection. Snapshots in progress are kept up to the retention count as well.
+func pruneSnapshots(snapshots []benzaiten.GCPSnapshot, retention int) ([]benzaiten.GCPSnapshot, []benzaiten.GCPSnapshot) {
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		ti, tj := snapshots[i].CreationTimestamp, snapshots[j].CreationTimestamp
+		if ti.Equal(&tj) {
+			return snapshots[i].Name < snapshots[j].Name
+		}
+		return ti.Before(&tj)
+	})
+
+	var ready, failed, pending int
+	for _, s := range snapshots {
+		switch s.Status.Phase {
+		case benzaiten.SnapshotStatusReady:
+			ready++
+		case benzaiten.SnapshotStatusFailed:
+			failed++
+		default:
+			pending++
+		}
+	}
+
+	var retained, pruned []benzaiten.GCPSnapshot
+	for _, s := range snapshots {
+		// walking oldest first, a snapshot is pruned while more of its kind remain than are kept
+		prune := false
+		switch s.Status.Phase {
+		case benzaiten.SnapshotStatusReady:
+			prune = ready > retention
+			ready--
+		case benzaiten.SnapshotStatusFailed:
+			prune = failed > 1
+			failed--
+		default:
+			prune = pending > retention
+			pending--
+		}
+		if prune {
+			pruned = append(pruned, s)
+		} else {
+			retained = append(retained, s)
+		}
+	}
+
+	return retained, pruned
+}
@@ -0,0 +1,106 @@
+package controllers
+
+import (
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"github.com/robfig/cron"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestMostRecentScheduleTime(t *testing.T) {
+	schedule, err := cron.ParseStandard("0 2 * * *")
+	if err != nil {
+		t.Fatalf("unable to parse schedule: %v", err)
+	}
+	earliest := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	if s := mostRecentScheduleTime(schedule, earliest, earliest.Add(time.Hour)); s != nil {
+		t.Fatalf("expected no schedule before the first run, got %v", s)
+	}
+
+	// the controller was down for three nights, only the last run is taken
+	s := mostRecentScheduleTime(schedule, earliest, time.Date(2025, 1, 4, 3, 0, 0, 0, time.UTC))
+	if s == nil {
+		t.Fatalf("expected a missed schedule")
+	}
+	if expected := time.Date(2025, 1, 4, 2, 0, 0, 0, time.UTC); !s.Equal(expected) {
+		t.Fatalf("expected schedule %v, got %v", expected, s)
+	}
+}
+
+func TestScheduledSnapshotName(t *testing.T) {
+	scheduled := time.Date(2025, 1, 4, 2, 0, 0, 0, time.UTC)
+
+	if name := scheduledSnapshotName("db-nightly", scheduled); name != "db-nightly-20250104-020000" {
+		t.Fatalf("unexpected snapshot name %s", name)
+	}
+
+	long := "a-very-long-namespace-name-for-the-database-team-nightly"
+	name := scheduledSnapshotName(long, scheduled)
+	if len(name) > 63 {
+		t.Fatalf("expected snapshot name of at most 63 characters, got %d", len(name))
+	}
+	if name != "a-very-long-namespace-name-for-the-database-tea-20250104-020000" {
+		t.Fatalf("unexpected snapshot name %s", name)
+	}
+}
+
+func TestPruneSnapshots(t *testing.T) {
+	snapshot := func(name string, created time.Time, phase benzaiten.SnapshotStatus) benzaiten.GCPSnapshot {
+		return benzaiten.GCPSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)},
+			Status:     benzaiten.GCPSnapshotStatus{Phase: phase},
+		}
+	}
+	now := time.Now()
+	snapshots := []benzaiten.GCPSnapshot{
+		snapshot("newest", now, benzaiten.SnapshotStatusReady),
+		snapshot("oldest", now.Add(-48*time.Hour), benzaiten.SnapshotStatusReady),
+		snapshot("middle", now.Add(-24*time.Hour), benzaiten.SnapshotStatusReady),
+	}
+
+	retained, pruned := pruneSnapshots(snapshots, 2)
+	if len(pruned) != 1 || pruned[0].Name != "oldest" {
+		t.Fatalf("expected oldest snapshot to be pruned, got %v", pruned)
+	}
+	if len(retained) != 2 || retained[0].Name != "middle" || retained[1].Name != "newest" {
+		t.Fatalf("expected middle and newest snapshots to be retained, got %v", retained)
+	}
+
+	retained, pruned = pruneSnapshots(retained, 5)
+	if len(pruned) != 0 || len(retained) != 2 {
+		t.Fatalf("expected nothing to be pruned within the retention count")
+	}
+}
+
+func TestPruneSnapshotsFailed(t *testing.T) {
+	snapshot := func(name string, age time.Duration, phase benzaiten.SnapshotStatus) benzaiten.GCPSnapshot {
+		return benzaiten.GCPSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(time.Now().Add(-age))},
+			Status:     benzaiten.GCPSnapshotStatus{Phase: phase},
+		}
+	}
+	// the source disk broke after two good runs
+	snapshots := []benzaiten.GCPSnapshot{
+		snapshot("ready-1", 120*time.Hour, benzaiten.SnapshotStatusReady),
+		snapshot("ready-2", 96*time.Hour, benzaiten.SnapshotStatusReady),
+		snapshot("failed-1", 72*time.Hour, benzaiten.SnapshotStatusFailed),
+		snapshot("failed-2", 48*time.Hour, benzaiten.SnapshotStatusFailed),
+		snapshot("failed-3", 24*time.Hour, benzaiten.SnapshotStatusFailed),
+		snapshot("pending", 0, ""),
+	}
+
+	retained, pruned := pruneSnapshots(snapshots, 2)
+	var names []string
+	for _, s := range retained {
+		names = append(names, s.Name)
+	}
+	if !slices.Equal(names, []string{"ready-1", "ready-2", "failed-3", "pending"}) {
+		t.Fatalf("expected good backups, the latest failure and the pending snapshot to be retained, got %v", names)
+	}
+	if len(pruned) != 2 || pruned[0].Name != "failed-1" || pruned[1].Name != "failed-2" {
+		t.Fatalf("expected older failed snapshots to be pruned, got %v", pruned)
+	}
+}
@@ -0,0 +1,212 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/sqladmin/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"time"
+)
+
+type GCPSQLDatabaseReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPSQLDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpsqldatabase", req.NamespacedName)
+
+	gsd := benzaiten.GCPSQLDatabase{}
+	err := cr.Get(ctx, req.NamespacedName, &gsd)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpsqldatabase not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gsd.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gsd)
+	}
+
+	if controllerutil.AddFinalizer(&gsd, gcpFinalizer) {
+		err = cr.Update(ctx, &gsd)
+		if err != nil {
+			logger.Error(err, "error adding gcpsqldatabase finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := gsd.DeepCopyObject().(*benzaiten.GCPSQLDatabase)
+
+	// the database is created in the referenced instance once it runs
+	gsi, condition, err := resolveSQLInstance(ctx, cr.Client, &gsd, benzaiten.SQLDatabaseConditionInstanceReady, gsd.Spec.InstanceRef)
+	if err != nil {
+		logger.Error(err, "error resolving gcpsqldatabase instance")
+		return ctrl.Result{}, err
+	}
+	meta.SetStatusCondition(&gsd.Status.Conditions, condition)
+	if condition.Status != metav1.ConditionTrue {
+		if !equality.Semantic.DeepEqual(previous.Status, gsd.Status) {
+			err = cr.Status().Update(ctx, &gsd)
+			if err != nil {
+				logger.Error(err, "error updating gcpsqldatabase status")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+
+	// does database exist in GCP?
+	database, err := cr.cloud.GCP.GetSQLDatabase(gsi.Spec.Name, gsd.Spec.Name)
+	if err != nil && notFoundGCPResource(err) {
+		// database does not exist in GCP
+		logger.Info("gcpsqldatabase not found, creating database...")
+		op, err := cr.cloud.GCP.CreateSQLDatabase(gsi.Spec.Name, &sqladmin.Database{
+			Name:      gsd.Spec.Name,
+			Charset:   gsd.Spec.Charset,
+			Collation: gsd.Spec.Collation,
+		})
+		if err != nil {
+			logger.Error(err, "error creating gcpsqldatabase")
+			cr.eventRecorder.Event(&gsd, "Warning", "SQLDatabaseFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		err = waitSQLOperation(ctx, func() (*sqladmin.Operation, error) {
+			return cr.cloud.GCP.GetSQLOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error creating gcpsqldatabase")
+			cr.eventRecorder.Event(&gsd, "Warning", "SQLDatabaseFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		database, err = cr.cloud.GCP.GetSQLDatabase(gsi.Spec.Name, gsd.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error verifying sql database status")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gsd, "Normal", "SQLDatabaseCreated", "GCP SQL Database created")
+	} else if err != nil {
+		logger.Error(err, "error getting gcpsqldatabase")
+		return ctrl.Result{}, err
+	}
+
+	// update status
+	gsd.Status.SelfLink = database.SelfLink
+	if !equality.Semantic.DeepEqual(previous.Status, gsd.Status) {
+		err = cr.Status().Update(ctx, &gsd)
+		if err != nil {
+			logger.Error(err, "error updating gcpsqldatabase status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp sql database reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+func (cr *GCPSQLDatabaseReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gsd *benzaiten.GCPSQLDatabase) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gsd, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	// the databases are deleted along with the instance, without a running instance there is nothing left to delete
+	gsi := benzaiten.GCPSQLInstance{}
+	err := cr.Get(ctx, types.NamespacedName{Namespace: gsd.Namespace, Name: gsd.Spec.InstanceRef.Name}, &gsi)
+	if err != nil && !kerr.IsNotFound(err) {
+		logger.Error(err, "error getting gcpsqlinstance")
+		return ctrl.Result{}, err
+	}
+	if err == nil && gsi.DeletionTimestamp.IsZero() && gsi.Status.Phase == benzaiten.SQLInstanceStatusRunnable {
+		logger.Info("deleting gcpsqldatabase...")
+		op, err := cr.cloud.GCP.DeleteSQLDatabase(gsi.Spec.Name, gsd.Spec.Name)
+		if err != nil && !notFoundGCPResource(err) {
+			logger.Error(err, "error deleting gcpsqldatabase")
+			return ctrl.Result{}, err
+		}
+		if err == nil {
+			err = waitSQLOperation(ctx, func() (*sqladmin.Operation, error) {
+				return cr.cloud.GCP.GetSQLOperation(op.Name)
+			})
+			if err != nil {
+				logger.Error(err, "error deleting gcpsqldatabase")
+				return ctrl.Result{}, err
+			}
+		}
+	}
+
+	controllerutil.RemoveFinalizer(gsd, gcpFinalizer)
+	err = cr.Update(ctx, gsd)
+	if err != nil {
+		logger.Error(err, "error removing gcpsqldatabase finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp sql database deleted")
+	return ctrl.Result{}, nil
+}
+
+func (cr *GCPSQLDatabaseReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPSQLDatabase{}).
+		Watches(&benzaiten.GCPSQLInstance{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForInstance)).
+		Complete(cr)
+}
+
+// requestsForInstance returns the GCPSQLDatabases referencing the GCPSQLInstance
+func (cr *GCPSQLDatabaseReconciler) requestsForInstance(ctx context.Context, obj client.Object) []reconcile.Request {
+	gsds := benzaiten.GCPSQLDatabaseList{}
+	err := cr.List(ctx, &gsds, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		cr.Log.Error(err, "unable to list gcpsqldatabases")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, gsd := range gsds.Items {
+		if gsd.Spec.InstanceRef.Name == obj.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: gsd.Name, Namespace: gsd.Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
+func setupGCPSQLDatabaseController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpsqldatabase")
+	cc := GCPSQLDatabaseReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPSQLDatabaseReconciler"),
+	}
+
+	// create GCPSQLDatabase controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPSQLDatabase controller: %w", err)
+	}
+
+	return nil
+}
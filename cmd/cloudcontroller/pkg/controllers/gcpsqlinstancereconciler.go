@@ -0,0 +1,384 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/sqladmin/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"strings"
+	"time"
+)
+
+const (
+	sqlIPTypePrimary = "PRIMARY"
+	sqlIPTypePrivate = "PRIVATE"
+)
+
+type GCPSQLInstanceReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPSQLInstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpsqlinstance", req.NamespacedName)
+
+	gsi := benzaiten.GCPSQLInstance{}
+	err := cr.Get(ctx, req.NamespacedName, &gsi)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpsqlinstance not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gsi.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gsi)
+	}
+
+	if controllerutil.AddFinalizer(&gsi, gcpFinalizer) {
+		err = cr.Update(ctx, &gsi)
+		if err != nil {
+			logger.Error(err, "error adding gcpsqlinstance finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := gsi.DeepCopyObject().(*benzaiten.GCPSQLInstance)
+
+	// the private IP of the instance is allocated in the referenced network
+	attachment, condition, err := resolveNetworkAttachment(ctx, cr.Client, &gsi, benzaiten.SQLInstanceConditionReferencesResolved, gsi.Spec.NetworkRef, nil)
+	if err != nil {
+		logger.Error(err, "error resolving gcpsqlinstance references")
+		return ctrl.Result{}, err
+	}
+	meta.SetStatusCondition(&gsi.Status.Conditions, condition)
+	if condition.Status != metav1.ConditionTrue {
+		if !equality.Semantic.DeepEqual(previous.Status, gsi.Status) {
+			err = cr.Status().Update(ctx, &gsi)
+			if err != nil {
+				logger.Error(err, "error updating gcpsqlinstance status")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+	desired := newSQLInstance(&gsi, attachment.Network)
+
+	// does instance exist in GCP?
+	instance, err := cr.cloud.GCP.GetSQLInstance(gsi.Spec.Name)
+	if err != nil && notFoundGCPResource(err) {
+		// instance does not exist in GCP
+		logger.Info("gcpsqlinstance not found, creating instance...")
+		op, err := cr.cloud.GCP.CreateSQLInstance(desired)
+		if err != nil {
+			logger.Error(err, "error creating gcpsqlinstance")
+			return ctrl.Result{}, err
+		}
+		gsi.Status.Phase = benzaiten.SQLInstanceStatusPendingCreate
+		err = cr.Status().Update(ctx, &gsi)
+		if err != nil {
+			logger.Error(err, "error updating gcpsqlinstance status")
+			return ctrl.Result{}, err
+		}
+		// creating an instance takes several minutes
+		err = waitSQLOperation(ctx, func() (*sqladmin.Operation, error) {
+			return cr.cloud.GCP.GetSQLOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error creating gcpsqlinstance")
+			cr.eventRecorder.Event(&gsi, "Warning", "SQLInstanceFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		instance, err = cr.cloud.GCP.GetSQLInstance(gsi.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error verifying sql instance status")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gsi, "Normal", "SQLInstanceCreated", "GCP SQL Instance created")
+	} else if err != nil {
+		logger.Error(err, "error getting gcpsqlinstance")
+		return ctrl.Result{}, err
+	} else if instance.State == string(benzaiten.SQLInstanceStatusRunnable) && !sqlInstanceInSync(instance.Settings, desired.Settings) {
+		logger.Info("gcpsqlinstance out of sync, updating instance...")
+		op, err := cr.cloud.GCP.PatchSQLInstance(gsi.Spec.Name, &sqladmin.DatabaseInstance{Settings: sqlInstancePatch(instance.Settings, desired.Settings)})
+		if err != nil {
+			logger.Error(err, "error updating gcpsqlinstance")
+			return ctrl.Result{}, err
+		}
+		err = waitSQLOperation(ctx, func() (*sqladmin.Operation, error) {
+			return cr.cloud.GCP.GetSQLOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error updating gcpsqlinstance")
+			cr.eventRecorder.Event(&gsi, "Warning", "SQLInstanceFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		instance, err = cr.cloud.GCP.GetSQLInstance(gsi.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error verifying sql instance status")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gsi, "Normal", "SQLInstanceUpdated", "GCP SQL Instance updated")
+	}
+
+	// update status
+	gsi.Status.Phase = benzaiten.SQLInstanceStatus(instance.State)
+	gsi.Status.SelfLink = instance.SelfLink
+	gsi.Status.ConnectionName = instance.ConnectionName
+	gsi.Status.PrivateIP = sqlInstanceIP(instance, sqlIPTypePrivate)
+	gsi.Status.PublicIP = sqlInstanceIP(instance, sqlIPTypePrimary)
+	if !equality.Semantic.DeepEqual(previous.Status, gsi.Status) {
+		err = cr.Status().Update(ctx, &gsi)
+		if err != nil {
+			logger.Error(err, "error updating gcpsqlinstance status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp sql instance reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+func (cr *GCPSQLInstanceReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gsi *benzaiten.GCPSQLInstance) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gsi, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("deleting gcpsqlinstance...")
+	op, err := cr.cloud.GCP.DeleteSQLInstance(gsi.Spec.Name)
+	if err != nil && !notFoundGCPResource(err) {
+		// deletion protection makes GCP refuse the deletion
+		logger.Error(err, "error deleting gcpsqlinstance")
+		cr.eventRecorder.Event(gsi, "Warning", "SQLInstanceFailedState", err.Error())
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		err = waitSQLOperation(ctx, func() (*sqladmin.Operation, error) {
+			return cr.cloud.GCP.GetSQLOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error deleting gcpsqlinstance")
+			return ctrl.Result{}, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(gsi, gcpFinalizer)
+	err = cr.Update(ctx, gsi)
+	if err != nil {
+		logger.Error(err, "error removing gcpsqlinstance finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp sql instance deleted")
+	return ctrl.Result{}, nil
+}
+
+func (cr *GCPSQLInstanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPSQLInstance{}).
+		Watches(&benzaiten.GCPNetwork{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForNetwork)).
+		Complete(cr)
+}
+
+// requestsForNetwork returns the GCPSQLInstances referencing the GCPNetwork
+func (cr *GCPSQLInstanceReconciler) requestsForNetwork(ctx context.Context, obj client.Object) []reconcile.Request {
+	// GCP networks are global to the project, instances of any namespace may reference it
+	gsis := benzaiten.GCPSQLInstanceList{}
+	err := cr.List(ctx, &gsis)
+	if err != nil {
+		cr.Log.Error(err, "unable to list gcpsqlinstances")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, gsi := range gsis.Items {
+		if refersTo(gsi.Spec.NetworkRef, gsi.Namespace, obj) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: gsi.Name, Namespace: gsi.Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
+func setupGCPSQLInstanceController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpsqlinstance")
+	cc := GCPSQLInstanceReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPSQLInstanceReconciler"),
+	}
+
+	// create GCPSQLInstance controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPSQLInstance controller: %w", err)
+	}
+
+	return nil
+}
+
+// newSQLInstance builds the Cloud SQL instance described by the GCPSQLInstance spec
+func newSQLInstance(gsi *benzaiten.GCPSQLInstance, network string) *sqladmin.DatabaseInstance {
+	settings := &sqladmin.Settings{
+		Tier:                      gsi.Spec.Tier,
+		AvailabilityType:          gsi.Spec.AvailabilityType,
+		DataDiskSizeGb:            gsi.Spec.DiskSizeGb,
+		DeletionProtectionEnabled: gsi.Spec.DeletionProtection,
+		BackupConfiguration: &sqladmin.BackupConfiguration{
+			ForceSendFields: []string{"Enabled"},
+		},
+		IpConfiguration: &sqladmin.IpConfiguration{
+			Ipv4Enabled:     gsi.Spec.PublicIP,
+			ForceSendFields: []string{"Ipv4Enabled"},
+		},
+		// the flags replace the flags of the instance, an empty list removes them
+		ForceSendFields: []string{"DatabaseFlags", "DeletionProtectionEnabled"},
+	}
+	if network != "" {
+		settings.IpConfiguration.PrivateNetwork = resourcePath(network)
+	}
+	if backup := gsi.Spec.Backup; backup != nil {
+		settings.BackupConfiguration.Enabled = backup.Enabled
+		settings.BackupConfiguration.StartTime = backup.StartTime
+		// point in time recovery relies on the binary log for MySQL and on the write-ahead log for other engines
+		if strings.HasPrefix(gsi.Spec.DatabaseVersion, "MYSQL") {
+			settings.BackupConfiguration.BinaryLogEnabled = backup.PointInTimeRecovery
+		} else {
+			settings.BackupConfiguration.PointInTimeRecoveryEnabled = backup.PointInTimeRecovery
+		}
+		if backup.RetainedBackups > 0 {
+			settings.BackupConfiguration.BackupRetentionSettings = &sqladmin.BackupRetentionSettings{
+				RetainedBackups: backup.RetainedBackups,
+				RetentionUnit:   "COUNT",
+			}
+		}
+	}
+	settings.DatabaseFlags = []*sqladmin.DatabaseFlags{}
+	for _, flag := range gsi.Spec.Flags {
+		settings.DatabaseFlags = append(settings.DatabaseFlags, &sqladmin.DatabaseFlags{
+			Name:  flag.Name,
+			Value: flag.Value,
+		})
+	}
+
+	return &sqladmin.DatabaseInstance{
+		Name:            gsi.Spec.Name,
+		DatabaseVersion: gsi.Spec.DatabaseVersion,
+		Region:          gsi.Spec.Region,
+		Settings:        settings,
+	}
+}
+
+// sqlInstanceInSync reports whether the settings of the instance match the desired settings. Unset optional
+// settings keep the values picked by GCP.
+func sqlInstanceInSync(current, desired *sqladmin.Settings) bool {
+	if current == nil {
+		return false
+	}
+	if current.Tier != desired.Tier || current.AvailabilityType != desired.AvailabilityType ||
+		current.DeletionProtectionEnabled != desired.DeletionProtectionEnabled {
+		return false
+	}
+	if desired.DataDiskSizeGb > current.DataDiskSizeGb {
+		return false
+	}
+
+	currentIP, desiredIP := current.IpConfiguration, desired.IpConfiguration
+	if currentIP == nil || currentIP.Ipv4Enabled != desiredIP.Ipv4Enabled ||
+		resourcePath(currentIP.PrivateNetwork) != resourcePath(desiredIP.PrivateNetwork) {
+		return false
+	}
+
+	currentBackup, desiredBackup := current.BackupConfiguration, desired.BackupConfiguration
+	if currentBackup == nil {
+		currentBackup = &sqladmin.BackupConfiguration{}
+	}
+	if currentBackup.Enabled != desiredBackup.Enabled ||
+		currentBackup.BinaryLogEnabled != desiredBackup.BinaryLogEnabled ||
+		currentBackup.PointInTimeRecoveryEnabled != desiredBackup.PointInTimeRecoveryEnabled ||
+		(desiredBackup.StartTime != "" && currentBackup.StartTime != desiredBackup.StartTime) {
+		return false
+	}
+	if retention := desiredBackup.BackupRetentionSettings; retention != nil &&
+		(currentBackup.BackupRetentionSettings == nil || currentBackup.BackupRetentionSettings.RetainedBackups != retention.RetainedBackups) {
+		return false
+	}
+
+	currentFlags := make([]string, 0, len(current.DatabaseFlags))
+	for _, flag := range current.DatabaseFlags {
+		currentFlags = append(currentFlags, flag.Name+"="+flag.Value)
+	}
+	desiredFlags := make([]string, 0, len(desired.DatabaseFlags))
+	for _, flag := range desired.DatabaseFlags {
+		desiredFlags = append(desiredFlags, flag.Name+"="+flag.Value)
+	}
+	return stringSetsEqual(currentFlags, desiredFlags)
+}
+
+// sqlInstancePatch returns the desired settings to patch the instance with. The disk size is left out unless it grows
+// the disk, the disk may have grown automatically past the desired size and Cloud SQL rejects shrinking it.
+func sqlInstancePatch(current, desired *sqladmin.Settings) *sqladmin.Settings {
+	patch := *desired
+	if current != nil && desired.DataDiskSizeGb <= current.DataDiskSizeGb {
+		patch.DataDiskSizeGb = 0
+	}
+	return &patch
+}
+
+// sqlInstanceIP returns the IP of the given type of the instance
+func sqlInstanceIP(instance *sqladmin.DatabaseInstance, ipType string) string {
+	for _, ip := range instance.IpAddresses {
+		if ip.Type == ipType {
+			return ip.IpAddress
+		}
+	}
+	return ""
+}
+
+// resolveSQLInstance returns the referenced GCPSQLInstance. The returned condition is False until the instance runs.
+func resolveSQLInstance(ctx context.Context, c client.Client, obj client.Object, condType string, ref benzaiten.ResourceRef) (*benzaiten.GCPSQLInstance, metav1.Condition, error) {
+	gsi := benzaiten.GCPSQLInstance{}
+	err := c.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: ref.Name}, &gsi)
+	if err != nil && !kerr.IsNotFound(err) {
+		return nil, metav1.Condition{}, fmt.Errorf("unable to get gcpsqlinstance %s: %w", ref.Name, err)
+	}
+	if err != nil || gsi.Status.Phase != benzaiten.SQLInstanceStatusRunnable {
+		return nil, metav1.Condition{
+			Type:               condType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: obj.GetGeneration(),
+			Reason:             "InstanceNotReady",
+			Message:            fmt.Sprintf("waiting for GCPSQLInstance %s", ref.Name),
+		}, nil
+	}
+
+	return &gsi, metav1.Condition{
+		Type:               condType,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: obj.GetGeneration(),
+		Reason:             "InstanceReady",
+		Message:            fmt.Sprintf("GCPSQLInstance %s is running", ref.Name),
+	}, nil
+}
@@ -0,0 +1,108 @@
+package controllers
+
+import (
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/sqladmin/v1"
+	"testing"
+)
+
+func TestNewSQLInstance(t *testing.T) {
+	gsi := &benzaiten.GCPSQLInstance{
+		Spec: benzaiten.GCPSQLInstanceSpec{
+			Name:            "test-instance",
+			DatabaseVersion: "MYSQL_8_0",
+			Region:          "us-central1",
+			Tier:            "db-f1-micro",
+			Backup: &benzaiten.SQLInstanceBackup{
+				Enabled:             true,
+				PointInTimeRecovery: true,
+				RetainedBackups:     7,
+			},
+		},
+	}
+
+	instance := newSQLInstance(gsi, "https://www.googleapis.com/compute/v1/projects/test-project/global/networks/test-network")
+	ipConfig := instance.Settings.IpConfiguration
+	if ipConfig.PrivateNetwork != "projects/test-project/global/networks/test-network" {
+		t.Fatalf("expected the network path, got %s", ipConfig.PrivateNetwork)
+	}
+	// MySQL relies on the binary log for point in time recovery
+	backup := instance.Settings.BackupConfiguration
+	if !backup.BinaryLogEnabled || backup.PointInTimeRecoveryEnabled {
+		t.Fatalf("expected the binary log to be enabled, got %+v", backup)
+	}
+	if backup.BackupRetentionSettings == nil || backup.BackupRetentionSettings.RetainedBackups != 7 {
+		t.Fatalf("expected 7 retained backups, got %+v", backup.BackupRetentionSettings)
+	}
+
+	gsi.Spec.DatabaseVersion = "POSTGRES_16"
+	backup = newSQLInstance(gsi, "").Settings.BackupConfiguration
+	if backup.BinaryLogEnabled || !backup.PointInTimeRecoveryEnabled {
+		t.Fatalf("expected point in time recovery to be enabled, got %+v", backup)
+	}
+}
+
+func TestSQLInstanceInSync(t *testing.T) {
+	gsi := &benzaiten.GCPSQLInstance{
+		Spec: benzaiten.GCPSQLInstanceSpec{
+			Name:            "test-instance",
+			DatabaseVersion: "POSTGRES_16",
+			Tier:            "db-custom-2-7680",
+			DiskSizeGb:      20,
+			Flags:           []benzaiten.SQLInstanceFlag{{Name: "max_connections", Value: "200"}},
+		},
+	}
+	desired := newSQLInstance(gsi, "projects/test-project/global/networks/test-network").Settings
+	current := &sqladmin.Settings{
+		Tier:             "db-custom-2-7680",
+		AvailabilityType: "",
+		DataDiskSizeGb:   25,
+		IpConfiguration: &sqladmin.IpConfiguration{
+			PrivateNetwork: "projects/test-project/global/networks/test-network",
+		},
+		BackupConfiguration: &sqladmin.BackupConfiguration{StartTime: "04:00"},
+		DatabaseFlags:       []*sqladmin.DatabaseFlags{{Name: "max_connections", Value: "200"}},
+	}
+
+	// the disk grew automatically and the backup window was picked by GCP
+	if !sqlInstanceInSync(current, desired) {
+		t.Fatalf("expected the instance to be in sync")
+	}
+
+	current.DatabaseFlags = nil
+	if sqlInstanceInSync(current, desired) {
+		t.Fatalf("expected missing flags to be out of sync")
+	}
+
+	current.DatabaseFlags = []*sqladmin.DatabaseFlags{{Name: "max_connections", Value: "200"}}
+	current.DataDiskSizeGb = 10
+	if sqlInstanceInSync(current, desired) {
+		t.Fatalf("expected a smaller disk to be out of sync")
+	}
+}
+
+func TestSQLInstancePatch(t *testing.T) {
+	gsi := &benzaiten.GCPSQLInstance{
+		Spec: benzaiten.GCPSQLInstanceSpec{
+			Name:            "test-instance",
+			DatabaseVersion: "POSTGRES_16",
+			Tier:            "db-custom-4-15360",
+			DiskSizeGb:      20,
+		},
+	}
+	desired := newSQLInstance(gsi, "").Settings
+
+	// the disk grew automatically past the desired size, patching the tier must not shrink it
+	patch := sqlInstancePatch(&sqladmin.Settings{Tier: "db-custom-2-7680", DataDiskSizeGb: 25}, desired)
+	if patch.DataDiskSizeGb != 0 || patch.Tier != "db-custom-4-15360" {
+		t.Fatalf("expected the tier without the disk size, got %+v", patch)
+	}
+	if desired.DataDiskSizeGb != 20 {
+		t.Fatalf("expected the desired settings to be left untouched")
+	}
+
+	patch = sqlInstancePatch(&sqladmin.Settings{DataDiskSizeGb: 10}, desired)
+	if patch.DataDiskSizeGb != 20 {
+		t.Fatalf("expected the disk to grow to 20GB, got %d", patch.DataDiskSizeGb)
+	}
+}
@@ -0,0 +1,332 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/sqladmin/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"time"
+)
+
+const (
+	// keys of the credentials Secret
+	sqlUserSecretUsername       = "username"
+	sqlUserSecretPassword       = "password"
+	sqlUserSecretConnectionName = "connectionName"
+	sqlUserSecretHost           = "host"
+
+	sqlUserPasswordLength  = 32
+	sqlUserPasswordCharset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+type GCPSQLUserReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPSQLUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpsqluser", req.NamespacedName)
+
+	gsu := benzaiten.GCPSQLUser{}
+	err := cr.Get(ctx, req.NamespacedName, &gsu)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpsqluser not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gsu.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gsu)
+	}
+
+	if controllerutil.AddFinalizer(&gsu, gcpFinalizer) {
+		err = cr.Update(ctx, &gsu)
+		if err != nil {
+			logger.Error(err, "error adding gcpsqluser finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := gsu.DeepCopyObject().(*benzaiten.GCPSQLUser)
+
+	// the user is created in the referenced instance once it runs
+	gsi, condition, err := resolveSQLInstance(ctx, cr.Client, &gsu, benzaiten.SQLUserConditionInstanceReady, gsu.Spec.InstanceRef)
+	if err != nil {
+		logger.Error(err, "error resolving gcpsqluser instance")
+		return ctrl.Result{}, err
+	}
+	meta.SetStatusCondition(&gsu.Status.Conditions, condition)
+	if condition.Status != metav1.ConditionTrue {
+		if !equality.Semantic.DeepEqual(previous.Status, gsu.Status) {
+			err = cr.Status().Update(ctx, &gsu)
+			if err != nil {
+				logger.Error(err, "error updating gcpsqluser status")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+
+	// the password is stored before being set in GCP, so it is never lost
+	password, secretVersion, err := cr.reconcileSecret(ctx, &gsu, gsi)
+	if err != nil {
+		logger.Error(err, "error reconciling gcpsqluser secret")
+		return ctrl.Result{}, err
+	}
+	user := &sqladmin.User{
+		Name:     gsu.Spec.Name,
+		Host:     gsu.Spec.Host,
+		Password: password,
+	}
+
+	// does user exist in GCP?
+	_, err = cr.cloud.GCP.GetSQLUser(gsi.Spec.Name, gsu.Spec.Name, gsu.Spec.Host)
+	if err != nil && notFoundGCPResource(err) {
+		// user does not exist in GCP
+		logger.Info("gcpsqluser not found, creating user...")
+		op, err := cr.cloud.GCP.CreateSQLUser(gsi.Spec.Name, user)
+		if err != nil {
+			logger.Error(err, "error creating gcpsqluser")
+			cr.eventRecorder.Event(&gsu, "Warning", "SQLUserFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		err = waitSQLOperation(ctx, func() (*sqladmin.Operation, error) {
+			return cr.cloud.GCP.GetSQLOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error creating gcpsqluser")
+			cr.eventRecorder.Event(&gsu, "Warning", "SQLUserFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gsu, "Normal", "SQLUserCreated", "GCP SQL User created")
+	} else if err != nil {
+		logger.Error(err, "error getting gcpsqluser")
+		return ctrl.Result{}, err
+	} else if gsu.Status.SecretResourceVersion != secretVersion {
+		// the Secret changed since the password was set in GCP, e.g. the Secret was deleted to rotate the password
+		logger.Info("gcpsqluser password changed, updating user...")
+		op, err := cr.cloud.GCP.UpdateSQLUser(gsi.Spec.Name, user)
+		if err != nil {
+			logger.Error(err, "error updating gcpsqluser")
+			return ctrl.Result{}, err
+		}
+		err = waitSQLOperation(ctx, func() (*sqladmin.Operation, error) {
+			return cr.cloud.GCP.GetSQLOperation(op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error updating gcpsqluser")
+			cr.eventRecorder.Event(&gsu, "Warning", "SQLUserFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gsu, "Normal", "SQLUserUpdated", "GCP SQL User password updated")
+	}
+
+	// update status
+	gsu.Status.SecretName = sqlUserSecretName(&gsu)
+	gsu.Status.SecretResourceVersion = secretVersion
+	if !equality.Semantic.DeepEqual(previous.Status, gsu.Status) {
+		err = cr.Status().Update(ctx, &gsu)
+		if err != nil {
+			logger.Error(err, "error updating gcpsqluser status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp sql user reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+// reconcileSecret creates or updates the credentials Secret of the user and returns the password it holds along with
+// the resource version of the Secret. A password is generated if the Secret has none.
+func (cr *GCPSQLUserReconciler) reconcileSecret(ctx context.Context, gsu *benzaiten.GCPSQLUser, gsi *benzaiten.GCPSQLInstance) (string, string, error) {
+	secret := corev1.Secret{}
+	err := cr.Get(ctx, types.NamespacedName{Namespace: gsu.Namespace, Name: sqlUserSecretName(gsu)}, &secret)
+	if err != nil && !kerr.IsNotFound(err) {
+		return "", "", fmt.Errorf("unable to get secret %s: %w", sqlUserSecretName(gsu), err)
+	}
+	create := kerr.IsNotFound(err)
+	if create {
+		secret = corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      sqlUserSecretName(gsu),
+				Namespace: gsu.Namespace,
+			},
+			Type: corev1.SecretTypeOpaque,
+		}
+		// the Secret is garbage collected along with the user
+		err = controllerutil.SetControllerReference(gsu, &secret, cr.Scheme)
+		if err != nil {
+			return "", "", fmt.Errorf("unable to set secret owner: %w", err)
+		}
+	}
+
+	previous := secret.DeepCopy()
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	if len(secret.Data[sqlUserSecretPassword]) == 0 {
+		password, err := generateSQLUserPassword()
+		if err != nil {
+			return "", "", err
+		}
+		secret.Data[sqlUserSecretPassword] = []byte(password)
+	}
+	secret.Data[sqlUserSecretUsername] = []byte(gsu.Spec.Name)
+	secret.Data[sqlUserSecretConnectionName] = []byte(gsi.Status.ConnectionName)
+	secret.Data[sqlUserSecretHost] = []byte(sqlInstanceHost(gsi))
+
+	if create {
+		err = cr.Create(ctx, &secret)
+		if err != nil {
+			return "", "", fmt.Errorf("unable to create secret %s: %w", secret.Name, err)
+		}
+		cr.eventRecorder.Event(gsu, "Normal", "SQLUserSecretCreated", fmt.Sprintf("Credentials stored in Secret %s", secret.Name))
+	} else if !equality.Semantic.DeepEqual(previous.Data, secret.Data) {
+		err = cr.Update(ctx, &secret)
+		if err != nil {
+			return "", "", fmt.Errorf("unable to update secret %s: %w", secret.Name, err)
+		}
+	}
+
+	return string(secret.Data[sqlUserSecretPassword]), secret.ResourceVersion, nil
+}
+
+func (cr *GCPSQLUserReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gsu *benzaiten.GCPSQLUser) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gsu, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	// the users are deleted along with the instance, without a running instance there is nothing left to delete
+	gsi := benzaiten.GCPSQLInstance{}
+	err := cr.Get(ctx, types.NamespacedName{Namespace: gsu.Namespace, Name: gsu.Spec.InstanceRef.Name}, &gsi)
+	if err != nil && !kerr.IsNotFound(err) {
+		logger.Error(err, "error getting gcpsqlinstance")
+		return ctrl.Result{}, err
+	}
+	if err == nil && gsi.DeletionTimestamp.IsZero() && gsi.Status.Phase == benzaiten.SQLInstanceStatusRunnable {
+		logger.Info("deleting gcpsqluser...")
+		op, err := cr.cloud.GCP.DeleteSQLUser(gsi.Spec.Name, gsu.Spec.Name, gsu.Spec.Host)
+		if err != nil && !notFoundGCPResource(err) {
+			logger.Error(err, "error deleting gcpsqluser")
+			return ctrl.Result{}, err
+		}
+		if err == nil {
+			err = waitSQLOperation(ctx, func() (*sqladmin.Operation, error) {
+				return cr.cloud.GCP.GetSQLOperation(op.Name)
+			})
+			if err != nil {
+				logger.Error(err, "error deleting gcpsqluser")
+				return ctrl.Result{}, err
+			}
+		}
+	}
+
+	controllerutil.RemoveFinalizer(gsu, gcpFinalizer)
+	err = cr.Update(ctx, gsu)
+	if err != nil {
+		logger.Error(err, "error removing gcpsqluser finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp sql user deleted")
+	return ctrl.Result{}, nil
+}
+
+func (cr *GCPSQLUserReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPSQLUser{}).
+		Owns(&corev1.Secret{}).
+		Watches(&benzaiten.GCPSQLInstance{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForInstance)).
+		Complete(cr)
+}
+
+// requestsForInstance returns the GCPSQLUsers referencing the GCPSQLInstance
+func (cr *GCPSQLUserReconciler) requestsForInstance(ctx context.Context, obj client.Object) []reconcile.Request {
+	gsus := benzaiten.GCPSQLUserList{}
+	err := cr.List(ctx, &gsus, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		cr.Log.Error(err, "unable to list gcpsqlusers")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, gsu := range gsus.Items {
+		if gsu.Spec.InstanceRef.Name == obj.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: gsu.Name, Namespace: gsu.Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
+func setupGCPSQLUserController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpsqluser")
+	cc := GCPSQLUserReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPSQLUserReconciler"),
+	}
+
+	// create GCPSQLUser controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPSQLUser controller: %w", err)
+	}
+
+	return nil
+}
+
+// sqlUserSecretName returns the name of the credentials Secret of the user
+func sqlUserSecretName(gsu *benzaiten.GCPSQLUser) string {
+	if gsu.Spec.SecretName != "" {
+		return gsu.Spec.SecretName
+	}
+	return gsu.Name + "-credentials"
+}
+
+// sqlInstanceHost returns the IP clients connect to, the private IP if the instance has one
+func sqlInstanceHost(gsi *benzaiten.GCPSQLInstance) string {
+	if gsi.Status.PrivateIP != "" {
+		return gsi.Status.PrivateIP
+	}
+	return gsi.Status.PublicIP
+}
+
+// generateSQLUserPassword returns a random alphanumeric password
+func generateSQLUserPassword() (string, error) {
+	b := make([]byte, sqlUserPasswordLength)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", fmt.Errorf("unable to generate password: %w", err)
+	}
+	// 256 is not a multiple of the charset length, the bias is negligible for a password this long
+	for i := range b {
+		b[i] = sqlUserPasswordCharset[int(b[i])%len(sqlUserPasswordCharset)]
+	}
+	return string(b), nil
+}
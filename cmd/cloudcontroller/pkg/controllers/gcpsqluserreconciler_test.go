@@ -0,0 +1,79 @@
+package controllers
+
+import (
+	"context"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"testing"
+)
+
+func TestReconcileSQLUserSecret(t *testing.T) {
+	gsu := &benzaiten.GCPSQLUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-user", Namespace: "default", UID: "test-uid"},
+		Spec: benzaiten.GCPSQLUserSpec{
+			InstanceRef: benzaiten.ResourceRef{Name: "test-instance"},
+			Name:        "app",
+		},
+	}
+	gsi := &benzaiten.GCPSQLInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "default"},
+		Status: benzaiten.GCPSQLInstanceStatus{
+			ConnectionName: "test-project:us-central1:test-instance",
+			PrivateIP:      "10.0.0.5",
+			PublicIP:       "34.1.2.3",
+		},
+	}
+	cr := &GCPSQLUserReconciler{
+		Client:        fake.NewClientBuilder().WithScheme(Scheme).WithObjects(gsu).Build(),
+		Scheme:        Scheme,
+		eventRecorder: record.NewFakeRecorder(10),
+	}
+
+	// the password is generated along with the Secret
+	password, version, err := cr.reconcileSecret(context.Background(), gsu, gsi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(password) != sqlUserPasswordLength {
+		t.Fatalf("expected a %d characters password, got %q", sqlUserPasswordLength, password)
+	}
+	secret := corev1.Secret{}
+	err = cr.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "test-user-credentials"}, &secret)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(secret.Data[sqlUserSecretUsername]) != "app" || string(secret.Data[sqlUserSecretHost]) != "10.0.0.5" ||
+		string(secret.Data[sqlUserSecretConnectionName]) != "test-project:us-central1:test-instance" {
+		t.Fatalf("unexpected secret data %v", secret.Data)
+	}
+	if len(secret.OwnerReferences) != 1 || secret.OwnerReferences[0].Name != "test-user" {
+		t.Fatalf("expected the secret to be owned by the user, got %v", secret.OwnerReferences)
+	}
+
+	// the stored password is kept
+	again, againVersion, err := cr.reconcileSecret(context.Background(), gsu, gsi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if again != password || againVersion != version {
+		t.Fatalf("expected the password and secret to be kept, got %q in version %s", again, againVersion)
+	}
+
+	// a password written to the Secret is used as is
+	secret.Data[sqlUserSecretPassword] = []byte("rotated")
+	err = cr.Update(context.Background(), &secret)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rotated, rotatedVersion, err := cr.reconcileSecret(context.Background(), gsu, gsi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rotated != "rotated" || rotatedVersion == version {
+		t.Fatalf("expected the rotated password in a new version, got %q in version %s", rotated, rotatedVersion)
+	}
+}
@@ -0,0 +1,446 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"maps"
+	"reflect"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"slices"
+	"time"
+)
+
+type GCPStorageBucketReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPStorageBucketReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpstoragebucket", req.NamespacedName)
+
+	gb := benzaiten.GCPStorageBucket{}
+	err := cr.Get(ctx, req.NamespacedName, &gb)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpstoragebucket not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gb.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gb)
+	}
+
+	if controllerutil.AddFinalizer(&gb, gcpFinalizer) {
+		err = cr.Update(ctx, &gb)
+		if err != nil {
+			logger.Error(err, "error adding gcpstoragebucket finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	desired := newStorageBucket(&gb)
+
+	// does bucket exist in GCP?
+	bucket, err := cr.cloud.GCP.GetBucket(gb.Spec.Name)
+	if err != nil && notFoundGCPResource(err) {
+		// bucket does not exist in GCP
+		logger.Info("gcpstoragebucket not found, creating bucket...")
+		bucket, err = cr.cloud.GCP.CreateBucket(desired)
+		if err != nil {
+			logger.Error(err, "error creating gcpstoragebucket")
+			cr.eventRecorder.Event(&gb, "Warning", "BucketFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gb, "Normal", "BucketCreated", "GCP Storage Bucket created")
+	} else if err != nil {
+		logger.Error(err, "error getting gcpstoragebucket")
+		return ctrl.Result{}, err
+	} else if !bucketInSync(bucket, desired) {
+		logger.Info("gcpstoragebucket out of sync, updating bucket...")
+		bucket, err = cr.cloud.GCP.PatchBucket(gb.Spec.Name, bucketPatch(bucket, desired))
+		if err != nil {
+			logger.Error(err, "error updating gcpstoragebucket")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gb, "Normal", "BucketUpdated", "GCP Storage Bucket updated")
+	}
+
+	// the members granted by the operator follow the spec
+	policy, err := cr.cloud.GCP.GetBucketIamPolicy(gb.Spec.Name)
+	if err != nil {
+		logger.Error(err, "error getting gcpstoragebucket iam policy")
+		return ctrl.Result{}, err
+	}
+	if applyBucketIAMBindings(policy, gb.Spec.IAMBindings, gb.Status.IAMBindings) {
+		logger.Info("gcpstoragebucket iam policy out of sync, updating policy...")
+		_, err = cr.cloud.GCP.SetBucketIamPolicy(gb.Spec.Name, policy)
+		if err != nil {
+			logger.Error(err, "error updating gcpstoragebucket iam policy")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gb, "Normal", "BucketIAMUpdated", "GCP Storage Bucket IAM policy updated")
+	}
+
+	// update status
+	previous := gb.DeepCopyObject().(*benzaiten.GCPStorageBucket)
+	gb.Status.SelfLink = bucket.SelfLink
+	gb.Status.URL = "gs://" + bucket.Name
+	gb.Status.IAMBindings = nil
+	for _, binding := range gb.Spec.IAMBindings {
+		gb.Status.IAMBindings = append(gb.Status.IAMBindings, benzaiten.BucketIAMBinding{
+			Role:    binding.Role,
+			Members: slices.Clone(binding.Members),
+		})
+	}
+	if !equality.Semantic.DeepEqual(previous.Status, gb.Status) {
+		err = cr.Status().Update(ctx, &gb)
+		if err != nil {
+			logger.Error(err, "error updating gcpstoragebucket status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp storage bucket reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+func (cr *GCPStorageBucketReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gb *benzaiten.GCPStorageBucket) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gb, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	_, err := cr.cloud.GCP.GetBucket(gb.Spec.Name)
+	if err != nil && !notFoundGCPResource(err) {
+		logger.Error(err, "error getting gcpstoragebucket")
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		objects, err := cr.cloud.GCP.ListObjects(gb.Spec.Name, "")
+		if err != nil {
+			logger.Error(err, "error listing gcpstoragebucket objects")
+			return ctrl.Result{}, err
+		}
+		if len(objects.Items) > 0 {
+			// deleting the objects of a bucket is irreversible, it must be requested explicitly
+			if !gb.Spec.ForceDestroy {
+				return cr.blockDelete(ctx, logger, gb)
+			}
+			logger.Info("deleting gcpstoragebucket objects...")
+			err = cr.deleteObjects(gb, objects)
+			if err != nil {
+				logger.Error(err, "error deleting gcpstoragebucket objects")
+				return ctrl.Result{}, err
+			}
+		}
+
+		logger.Info("deleting gcpstoragebucket...")
+		err = cr.cloud.GCP.DeleteBucket(gb.Spec.Name)
+		if err != nil && !notFoundGCPResource(err) {
+			logger.Error(err, "error deleting gcpstoragebucket")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(gb, "Normal", "BucketDeleted", "GCP Storage Bucket deleted")
+	}
+
+	controllerutil.RemoveFinalizer(gb, gcpFinalizer)
+	err = cr.Update(ctx, gb)
+	if err != nil {
+		logger.Error(err, "error removing gcpstoragebucket finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp storage bucket deleted")
+	return ctrl.Result{}, nil
+}
+
+// deleteObjects deletes every version of the objects of the bucket, starting with the listed page
+func (cr *GCPStorageBucketReconciler) deleteObjects(gb *benzaiten.GCPStorageBucket, objects *storage.Objects) error {
+	for {
+		for _, object := range objects.Items {
+			err := cr.cloud.GCP.DeleteObject(gb.Spec.Name, object.Name, object.Generation)
+			if err != nil && !notFoundGCPResource(err) {
+				return fmt.Errorf("unable to delete object %s: %w", object.Name, err)
+			}
+		}
+		if objects.NextPageToken == "" {
+			return nil
+		}
+		var err error
+		objects, err = cr.cloud.GCP.ListObjects(gb.Spec.Name, objects.NextPageToken)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// blockDelete reports the deletion of the non-empty bucket as blocked and requeues it
+func (cr *GCPStorageBucketReconciler) blockDelete(ctx context.Context, logger logr.Logger, gb *benzaiten.GCPStorageBucket) (ctrl.Result, error) {
+	condition := metav1.Condition{
+		Type:               benzaiten.BucketConditionDeletionBlocked,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gb.Generation,
+		Reason:             "BucketNotEmpty",
+		Message:            "bucket is not empty, set forceDestroy to delete its objects",
+	}
+	if !meta.IsStatusConditionPresentAndEqual(gb.Status.Conditions, condition.Type, condition.Status) {
+		cr.eventRecorder.Event(gb, "Warning", "BucketNotEmpty", "GCP Storage Bucket is not empty, deletion blocked")
+	}
+	previous := gb.DeepCopyObject().(*benzaiten.GCPStorageBucket)
+	meta.SetStatusCondition(&gb.Status.Conditions, condition)
+	if !equality.Semantic.DeepEqual(previous.Status, gb.Status) {
+		err := cr.Status().Update(ctx, gb)
+		if err != nil {
+			logger.Error(err, "error updating gcpstoragebucket status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+}
+
+func (cr *GCPStorageBucketReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPStorageBucket{}).
+		Complete(cr)
+}
+
+func setupGCPStorageBucketController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpstoragebucket")
+	cc := GCPStorageBucketReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPStorageBucketReconciler"),
+	}
+
+	// create GCPStorageBucket controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPStorageBucket controller: %w", err)
+	}
+
+	return nil
+}
+
+// newStorageBucket builds the GCS bucket described by the GCPStorageBucket spec
+func newStorageBucket(gb *benzaiten.GCPStorageBucket) *storage.Bucket {
+	bucket := &storage.Bucket{
+		Name:         gb.Spec.Name,
+		Location:     gb.Spec.Location,
+		StorageClass: gb.Spec.StorageClass,
+		Versioning: &storage.BucketVersioning{
+			Enabled:         gb.Spec.Versioning,
+			ForceSendFields: []string{"Enabled"},
+		},
+		Lifecycle: &storage.BucketLifecycle{
+			// an empty list removes the rules
+			ForceSendFields: []string{"Rule"},
+		},
+		IamConfiguration: &storage.BucketIamConfiguration{
+			UniformBucketLevelAccess: &storage.BucketIamConfigurationUniformBucketLevelAccess{
+				Enabled:         gb.Spec.UniformBucketLevelAccess,
+				ForceSendFields: []string{"Enabled"},
+			},
+		},
+		Labels: gb.Spec.Labels,
+	}
+	for _, rule := range gb.Spec.LifecycleRules {
+		bucket.Lifecycle.Rule = append(bucket.Lifecycle.Rule, &storage.BucketLifecycleRule{
+			Action: &storage.BucketLifecycleRuleAction{
+				Type:         rule.Action.Type,
+				StorageClass: rule.Action.StorageClass,
+			},
+			Condition: &storage.BucketLifecycleRuleCondition{
+				Age:                     rule.Condition.Age,
+				CreatedBefore:           rule.Condition.CreatedBefore,
+				IsLive:                  rule.Condition.IsLive,
+				NumNewerVersions:        rule.Condition.NumNewerVersions,
+				DaysSinceNoncurrentTime: rule.Condition.DaysSinceNoncurrentTime,
+				MatchesStorageClass:     rule.Condition.MatchesStorageClass,
+				MatchesPrefix:           rule.Condition.MatchesPrefix,
+				MatchesSuffix:           rule.Condition.MatchesSuffix,
+			},
+		})
+	}
+	if gb.Spec.RetentionPolicy != nil {
+		bucket.RetentionPolicy = &storage.BucketRetentionPolicy{
+			RetentionPeriod: gb.Spec.RetentionPolicy.RetentionPeriod,
+		}
+	}
+	if gb.Spec.KMSKeyName != "" {
+		bucket.Encryption = &storage.BucketEncryption{
+			DefaultKmsKeyName: gb.Spec.KMSKeyName,
+		}
+	}
+
+	return bucket
+}
+
+// bucketInSync reports whether the mutable fields of the bucket match the desired bucket
+func bucketInSync(current, desired *storage.Bucket) bool {
+	return current.StorageClass == desired.StorageClass &&
+		bucketVersioning(current) == desired.Versioning.Enabled &&
+		lifecycleRulesEqual(current.Lifecycle, desired.Lifecycle) &&
+		bucketUniformAccess(current) == desired.IamConfiguration.UniformBucketLevelAccess.Enabled &&
+		bucketRetentionPeriod(current) == bucketRetentionPeriod(desired) &&
+		maps.Equal(current.Labels, desired.Labels) &&
+		bucketKMSKeyName(current) == bucketKMSKeyName(desired)
+}
+
+// bucketPatch returns the patch turning the current bucket into the desired one. Removed labels, retention policy
+// and encryption are cleared explicitly.
+func bucketPatch(current, desired *storage.Bucket) *storage.Bucket {
+	patch := &storage.Bucket{
+		StorageClass:     desired.StorageClass,
+		Versioning:       desired.Versioning,
+		Lifecycle:        desired.Lifecycle,
+		IamConfiguration: desired.IamConfiguration,
+		Labels:           desired.Labels,
+		RetentionPolicy:  desired.RetentionPolicy,
+		Encryption:       desired.Encryption,
+	}
+	for key := range current.Labels {
+		if _, ok := desired.Labels[key]; !ok {
+			patch.ForceSendFields = append(patch.ForceSendFields, "Labels")
+			patch.NullFields = append(patch.NullFields, "Labels."+key)
+		}
+	}
+	if desired.RetentionPolicy == nil && current.RetentionPolicy != nil {
+		patch.NullFields = append(patch.NullFields, "RetentionPolicy")
+	}
+	if desired.Encryption == nil && current.Encryption != nil {
+		patch.NullFields = append(patch.NullFields, "Encryption")
+	}
+
+	return patch
+}
+
+// applyBucketIAMBindings grants the members of the bindings on the policy and revokes the previously granted members
+// no longer listed. Members granted outside of the bucket, e.g. by a GCPIAMPolicyMember, and conditional bindings are
+// left untouched. It reports whether the policy changed.
+func applyBucketIAMBindings(policy *storage.Policy, bindings, granted []benzaiten.BucketIAMBinding) bool {
+	changed := false
+	for _, binding := range granted {
+		for _, member := range binding.Members {
+			if !bucketIAMBindingsContain(bindings, binding.Role, member) && removeBucketPolicyMember(policy, binding.Role, member) {
+				changed = true
+			}
+		}
+	}
+	for _, binding := range bindings {
+		for _, member := range binding.Members {
+			if addBucketPolicyMember(policy, binding.Role, member) {
+				changed = true
+			}
+		}
+	}
+
+	return changed
+}
+
+// addBucketPolicyMember adds the member to the unconditional binding of the role and reports whether it was missing
+func addBucketPolicyMember(policy *storage.Policy, role, member string) bool {
+	for _, binding := range policy.Bindings {
+		if binding.Role == role && binding.Condition == nil {
+			if slices.Contains(binding.Members, member) {
+				return false
+			}
+			binding.Members = append(binding.Members, member)
+			return true
+		}
+	}
+
+	policy.Bindings = append(policy.Bindings, &storage.PolicyBindings{Role: role, Members: []string{member}})
+	return true
+}
+
+// removeBucketPolicyMember removes the member from the unconditional binding of the role, dropping the binding once
+// empty, and reports whether it was present
+func removeBucketPolicyMember(policy *storage.Policy, role, member string) bool {
+	for i, binding := range policy.Bindings {
+		if binding.Role != role || binding.Condition != nil {
+			continue
+		}
+		j := slices.Index(binding.Members, member)
+		if j < 0 {
+			return false
+		}
+		binding.Members = slices.Delete(binding.Members, j, j+1)
+		if len(binding.Members) == 0 {
+			policy.Bindings = slices.Delete(policy.Bindings, i, i+1)
+		}
+		return true
+	}
+	return false
+}
+
+// bucketIAMBindingsContain reports whether the bindings grant the role to the member
+func bucketIAMBindingsContain(bindings []benzaiten.BucketIAMBinding, role, member string) bool {
+	for _, binding := range bindings {
+		if binding.Role == role && slices.Contains(binding.Members, member) {
+			return true
+		}
+	}
+	return false
+}
+
+// lifecycleRulesEqual reports whether both lifecycles have the same rules in the same order
+func lifecycleRulesEqual(a, b *storage.BucketLifecycle) bool {
+	var ra, rb []*storage.BucketLifecycleRule
+	if a != nil {
+		ra = a.Rule
+	}
+	if b != nil {
+		rb = b.Rule
+	}
+	if len(ra) != len(rb) {
+		return false
+	}
+	for i := range ra {
+		if !reflect.DeepEqual(ra[i].Action, rb[i].Action) || !reflect.DeepEqual(ra[i].Condition, rb[i].Condition) {
+			return false
+		}
+	}
+	return true
+}
+
+func bucketVersioning(bucket *storage.Bucket) bool {
+	return bucket.Versioning != nil && bucket.Versioning.Enabled
+}
+
+func bucketUniformAccess(bucket *storage.Bucket) bool {
+	return bucket.IamConfiguration != nil && bucket.IamConfiguration.UniformBucketLevelAccess != nil &&
+		bucket.IamConfiguration.UniformBucketLevelAccess.Enabled
+}
+
+func bucketRetentionPeriod(bucket *storage.Bucket) int64 {
+	if bucket.RetentionPolicy == nil {
+		return 0
+	}
+	return bucket.RetentionPolicy.RetentionPeriod
+}
+
+func bucketKMSKeyName(bucket *storage.Bucket) string {
+	if bucket.Encryption == nil {
+		return ""
+	}
+	return bucket.Encryption.DefaultKmsKeyName
+}
@@ -0,0 +1,146 @@
+package controllers
+
+import (
+	"encoding/json"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/storage/v1"
+	"strings"
+	"testing"
+)
+
+func TestBucketInSync(t *testing.T) {
+	age := int64(30)
+	gb := &benzaiten.GCPStorageBucket{
+		Spec: benzaiten.GCPStorageBucketSpec{
+			Name:                     "test-bucket",
+			Location:                 "us-central1",
+			StorageClass:             "STANDARD",
+			Versioning:               true,
+			UniformBucketLevelAccess: true,
+			LifecycleRules: []benzaiten.BucketLifecycleRule{
+				{
+					Action:    benzaiten.BucketLifecycleAction{Type: "Delete"},
+					Condition: benzaiten.BucketLifecycleCondition{Age: &age},
+				},
+			},
+			Labels: map[string]string{"team": "platform"},
+		},
+	}
+	desired := newStorageBucket(gb)
+
+	current := &storage.Bucket{
+		Name:         "test-bucket",
+		Location:     "US-CENTRAL1",
+		StorageClass: "STANDARD",
+		Versioning:   &storage.BucketVersioning{Enabled: true},
+		Lifecycle: &storage.BucketLifecycle{
+			Rule: []*storage.BucketLifecycleRule{
+				{
+					Action:    &storage.BucketLifecycleRuleAction{Type: "Delete"},
+					Condition: &storage.BucketLifecycleRuleCondition{Age: &age},
+				},
+			},
+		},
+		IamConfiguration: &storage.BucketIamConfiguration{
+			UniformBucketLevelAccess: &storage.BucketIamConfigurationUniformBucketLevelAccess{Enabled: true},
+		},
+		Labels: map[string]string{"team": "platform"},
+	}
+	if !bucketInSync(current, desired) {
+		t.Fatalf("expected bucket to be in sync")
+	}
+
+	// a retention policy set outside of the operator is drift
+	current.RetentionPolicy = &storage.BucketRetentionPolicy{RetentionPeriod: 3600}
+	if bucketInSync(current, desired) {
+		t.Fatalf("expected bucket with a retention policy to be out of sync")
+	}
+}
+
+func TestBucketPatch(t *testing.T) {
+	current := &storage.Bucket{
+		Labels:          map[string]string{"team": "platform", "env": "dev"},
+		RetentionPolicy: &storage.BucketRetentionPolicy{RetentionPeriod: 3600},
+	}
+	desired := newStorageBucket(&benzaiten.GCPStorageBucket{
+		Spec: benzaiten.GCPStorageBucketSpec{
+			Name:   "test-bucket",
+			Labels: map[string]string{"team": "platform"},
+		},
+	})
+
+	body, err := json.Marshal(bucketPatch(current, desired))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	patch := string(body)
+	for _, expected := range []string{`"env":null`, `"team":"platform"`, `"retentionPolicy":null`, `"rule":[]`, `"enabled":false`} {
+		if !strings.Contains(patch, expected) {
+			t.Errorf("expected patch %s to contain %s", patch, expected)
+		}
+	}
+	if strings.Contains(patch, "encryption") {
+		t.Errorf("expected patch %s to leave the encryption untouched", patch)
+	}
+}
+
+func TestApplyBucketIAMBindings(t *testing.T) {
+	policy := &storage.Policy{
+		Etag: "test-etag",
+		Bindings: []*storage.PolicyBindings{
+			{Role: "roles/storage.legacyBucketOwner", Members: []string{"projectOwner:test-project"}},
+			// jane was granted by the bucket, the service account by a GCPIAMPolicyMember
+			{Role: "roles/storage.objectViewer", Members: []string{"user:jane@example.com", "serviceAccount:ci@test-project.iam.gserviceaccount.com"}},
+			{Role: "roles/storage.objectAdmin", Members: []string{"user:john@example.com"}},
+		},
+	}
+	bindings := []benzaiten.BucketIAMBinding{
+		{Role: "roles/storage.objectViewer", Members: []string{"group:team@example.com"}},
+		{Role: "roles/storage.objectCreator", Members: []string{"serviceAccount:app@test-project.iam.gserviceaccount.com"}},
+	}
+
+	// objectAdmin was granted before and removed from the spec
+	granted := []benzaiten.BucketIAMBinding{
+		{Role: "roles/storage.objectViewer", Members: []string{"user:jane@example.com"}},
+		{Role: "roles/storage.objectAdmin", Members: []string{"user:john@example.com"}},
+	}
+	if !applyBucketIAMBindings(policy, bindings, granted) {
+		t.Fatalf("expected policy to change")
+	}
+	roles := make(map[string][]string)
+	for _, binding := range policy.Bindings {
+		roles[binding.Role] = binding.Members
+	}
+	if len(roles) != 3 || roles["roles/storage.legacyBucketOwner"] == nil {
+		t.Fatalf("expected unmanaged roles to be kept and objectAdmin removed, got %v", roles)
+	}
+	expected := []string{"serviceAccount:ci@test-project.iam.gserviceaccount.com", "group:team@example.com"}
+	if !stringSetsEqual(roles["roles/storage.objectViewer"], expected) {
+		t.Errorf("expected objectViewer members %v, got %v", expected, roles["roles/storage.objectViewer"])
+	}
+	if roles["roles/storage.objectCreator"] == nil {
+		t.Errorf("expected objectCreator to be granted")
+	}
+	if policy.Etag != "test-etag" {
+		t.Errorf("expected etag to be kept")
+	}
+
+	if applyBucketIAMBindings(policy, bindings, bindings) {
+		t.Fatalf("expected policy in sync to be unchanged")
+	}
+
+	// dropping all bindings revokes only the granted members
+	if !applyBucketIAMBindings(policy, nil, bindings) {
+		t.Fatalf("expected policy to change")
+	}
+	roles = make(map[string][]string)
+	for _, binding := range policy.Bindings {
+		roles[binding.Role] = binding.Members
+	}
+	if len(roles) != 2 || roles["roles/storage.objectCreator"] != nil {
+		t.Fatalf("expected objectCreator to be removed, got %v", roles)
+	}
+	if !stringSetsEqual(roles["roles/storage.objectViewer"], expected[:1]) {
+		t.Errorf("expected the foreign objectViewer member to be kept, got %v", roles["roles/storage.objectViewer"])
+	}
+}
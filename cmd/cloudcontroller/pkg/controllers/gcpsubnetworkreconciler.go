@@ -0,0 +1,477 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"net/netip"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"strconv"
+	"time"
+)
+
+type GCPSubnetworkReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	cloud         CloudProviders
+	Log           logr.Logger
+}
+
+func (cr *GCPSubnetworkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("gcpsubnetwork", req.NamespacedName)
+
+	gs := benzaiten.GCPSubnetwork{}
+	err := cr.Get(ctx, req.NamespacedName, &gs)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("gcpsubnetwork not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !gs.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &gs)
+	}
+
+	if controllerutil.AddFinalizer(&gs, gcpFinalizer) {
+		err = cr.Update(ctx, &gs)
+		if err != nil {
+			logger.Error(err, "error adding gcpsubnetwork finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := gs.DeepCopyObject().(*benzaiten.GCPSubnetwork)
+
+	// the subnetwork is created in the referenced network
+	gn := benzaiten.GCPNetwork{}
+	err = cr.Get(ctx, types.NamespacedName{Namespace: gs.Namespace, Name: gs.Spec.NetworkRef.Name}, &gn)
+	if err != nil && !kerr.IsNotFound(err) {
+		logger.Error(err, "error getting gcpnetwork")
+		return ctrl.Result{}, err
+	}
+	if err != nil || gn.Status.SelfLink == "" {
+		meta.SetStatusCondition(&gs.Status.Conditions, metav1.Condition{
+			Type:               benzaiten.SubnetworkConditionNetworkReady,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: gs.Generation,
+			Reason:             "NetworkNotReady",
+			Message:            fmt.Sprintf("waiting for GCPNetwork %s", gs.Spec.NetworkRef.Name),
+		})
+		if !equality.Semantic.DeepEqual(previous.Status, gs.Status) {
+			err = cr.Status().Update(ctx, &gs)
+			if err != nil {
+				logger.Error(err, "error updating gcpsubnetwork status")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+	meta.SetStatusCondition(&gs.Status.Conditions, metav1.Condition{
+		Type:               benzaiten.SubnetworkConditionNetworkReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gs.Generation,
+		Reason:             "NetworkReady",
+		Message:            fmt.Sprintf("GCPNetwork %s is ready", gs.Spec.NetworkRef.Name),
+	})
+
+	// the primary range is either set or allocated from an IPPool
+	ipCidrRange := gs.Spec.IPCidrRange
+	if claim := gs.Spec.IPCidrRangeFrom; claim != nil {
+		cidr, pending, err := allocateIPRange(ctx, cr.Client, &gs, "GCPSubnetwork", ipRangePrimary, claim)
+		if err != nil {
+			logger.Error(err, "error allocating gcpsubnetwork range")
+			return ctrl.Result{}, err
+		}
+		if cidr == "" {
+			meta.SetStatusCondition(&gs.Status.Conditions, metav1.Condition{
+				Type:               benzaiten.SubnetworkConditionRangeAllocated,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: gs.Generation,
+				Reason:             "RangeNotAllocated",
+				Message:            pending,
+			})
+			if !equality.Semantic.DeepEqual(previous.Status, gs.Status) {
+				err = cr.Status().Update(ctx, &gs)
+				if err != nil {
+					logger.Error(err, "error updating gcpsubnetwork status")
+					return ctrl.Result{}, err
+				}
+			}
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		}
+		meta.SetStatusCondition(&gs.Status.Conditions, metav1.Condition{
+			Type:               benzaiten.SubnetworkConditionRangeAllocated,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: gs.Generation,
+			Reason:             "RangeAllocated",
+			Message:            fmt.Sprintf("%s allocated from IPPool %s", cidr, claim.PoolRef.NamespacedName(gs.Namespace)),
+		})
+		ipCidrRange = cidr
+	}
+
+	// does subnetwork exist in GCP?
+	subnetwork, err := cr.cloud.GCP.GetSubnetwork(gs.Spec.Region, gs.Spec.Name)
+	if err != nil && notFoundGCPResource(err) {
+		// subnetwork does not exist in GCP
+		logger.Info("gcpsubnetwork not found, creating subnetwork...")
+		op, err := cr.cloud.GCP.CreateSubnetwork(gs.Spec.Region, newComputeSubnetwork(&gs, gn.Status.SelfLink, ipCidrRange))
+		if err != nil {
+			logger.Error(err, "error creating gcpsubnetwork")
+			return ctrl.Result{}, err
+		}
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitRegionOperation(gs.Spec.Region, op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error creating gcpsubnetwork")
+			cr.eventRecorder.Event(&gs, "Warning", "SubnetworkFailedState", err.Error())
+			return ctrl.Result{}, err
+		}
+		subnetwork, err = cr.cloud.GCP.GetSubnetwork(gs.Spec.Region, gs.Spec.Name)
+		if err != nil {
+			logger.Error(err, "error verifying subnetwork status")
+			return ctrl.Result{}, err
+		}
+		cr.eventRecorder.Event(&gs, "Normal", "SubnetworkCreated", "GCP Subnetwork created")
+	} else if err != nil {
+		logger.Error(err, "error getting gcpsubnetwork")
+		return ctrl.Result{}, err
+	}
+
+	// sync subnetwork
+	subnetwork, synced, err := cr.syncSubnetwork(ctx, &gs, subnetwork, ipCidrRange)
+	if err != nil {
+		logger.Error(err, "error syncing gcpsubnetwork")
+		return ctrl.Result{}, err
+	}
+	if synced.Status == metav1.ConditionFalse && !meta.IsStatusConditionPresentAndEqual(gs.Status.Conditions, synced.Type, synced.Status) {
+		cr.eventRecorder.Event(&gs, "Warning", synced.Reason, synced.Message)
+	}
+	meta.SetStatusCondition(&gs.Status.Conditions, synced)
+
+	// update status
+	gs.Status.Phase = subnetworkPhase(subnetwork)
+	gs.Status.SelfLink = subnetwork.SelfLink
+	gs.Status.GatewayAddress = subnetwork.GatewayAddress
+	gs.Status.IPCidrRange = subnetwork.IpCidrRange
+	gs.Status.SecondaryRanges = nil
+	for _, sr := range subnetwork.SecondaryIpRanges {
+		gs.Status.SecondaryRanges = append(gs.Status.SecondaryRanges, benzaiten.SubnetworkSecondaryRange{
+			RangeName:   sr.RangeName,
+			IPCidrRange: sr.IpCidrRange,
+		})
+	}
+	if !equality.Semantic.DeepEqual(previous.Status, gs.Status) {
+		err = cr.Status().Update(ctx, &gs)
+		if err != nil {
+			logger.Error(err, "error updating gcpsubnetwork status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("gcp subnetwork reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+// subnetworkPhase returns the phase of the existing subnetwork. GCP only reports a state for subnetworks of load
+// balancer purpose, the other subnetworks are ready as soon as they exist.
+func subnetworkPhase(subnetwork *compute.Subnetwork) benzaiten.SubnetworkStatus {
+	if subnetwork.State == "" {
+		return benzaiten.SubnetworkStatusReady
+	}
+	return benzaiten.SubnetworkStatus(subnetwork.State)
+}
+
+// syncSubnetwork applies the changes of the spec the GCP subnetwork supports in place and returns the updated
+// subnetwork along with the Synced condition
+func (cr *GCPSubnetworkReconciler) syncSubnetwork(ctx context.Context, gs *benzaiten.GCPSubnetwork, subnetwork *compute.Subnetwork, ipCidrRange string) (*compute.Subnetwork, metav1.Condition, error) {
+	condition := metav1.Condition{
+		Type:               benzaiten.SubnetworkConditionSynced,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gs.Generation,
+		Reason:             "Synced",
+		Message:            "subnetwork matches the spec",
+	}
+	region := gs.Spec.Region
+	wait := func(op *compute.Operation) error {
+		return waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitRegionOperation(region, op.Name)
+		})
+	}
+	changed := false
+
+	// the primary range can only be expanded
+	if subnetwork.IpCidrRange != ipCidrRange {
+		expands, err := cidrExpands(subnetwork.IpCidrRange, ipCidrRange)
+		if err != nil {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "InvalidRange"
+			condition.Message = err.Error()
+		} else if expands {
+			op, err := cr.cloud.GCP.ExpandSubnetworkIpCidrRange(region, gs.Spec.Name, ipCidrRange)
+			if err != nil {
+				return nil, condition, fmt.Errorf("unable to expand the subnetwork range: %w", err)
+			}
+			err = wait(op)
+			if err != nil {
+				return nil, condition, fmt.Errorf("unable to expand the subnetwork range: %w", err)
+			}
+			cr.eventRecorder.Event(gs, "Normal", "RangeExpanded", fmt.Sprintf("GCP Subnetwork range expanded from %s to %s", subnetwork.IpCidrRange, ipCidrRange))
+			changed = true
+		} else {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "RangeChangeNotAllowed"
+			condition.Message = fmt.Sprintf("range %s does not contain the current range %s, subnetwork ranges can only be expanded", ipCidrRange, subnetwork.IpCidrRange)
+		}
+	}
+
+	if subnetwork.PrivateIpGoogleAccess != gs.Spec.PrivateIPGoogleAccess {
+		op, err := cr.cloud.GCP.SetSubnetworkPrivateIpGoogleAccess(region, gs.Spec.Name, gs.Spec.PrivateIPGoogleAccess)
+		if err != nil {
+			return nil, condition, fmt.Errorf("unable to set private Google access: %w", err)
+		}
+		err = wait(op)
+		if err != nil {
+			return nil, condition, fmt.Errorf("unable to set private Google access: %w", err)
+		}
+		changed = true
+	}
+
+	// secondary ranges and flow logs are patched in place
+	if !secondaryRangesInSync(subnetwork.SecondaryIpRanges, gs.Spec.SecondaryRanges) || !logConfigInSync(subnetwork.LogConfig, gs.Spec.FlowLogs) {
+		if changed {
+			// the fingerprint changed with the previous updates
+			current, err := cr.cloud.GCP.GetSubnetwork(region, gs.Spec.Name)
+			if err != nil {
+				return nil, condition, err
+			}
+			subnetwork = current
+		}
+		op, err := cr.cloud.GCP.PatchSubnetwork(region, gs.Spec.Name, &compute.Subnetwork{
+			Fingerprint:       subnetwork.Fingerprint,
+			SecondaryIpRanges: newSecondaryIpRanges(gs.Spec.SecondaryRanges),
+			LogConfig:         newSubnetworkLogConfig(gs.Spec.FlowLogs),
+			ForceSendFields:   []string{"SecondaryIpRanges"},
+		})
+		if err != nil {
+			return nil, condition, fmt.Errorf("unable to patch the subnetwork: %w", err)
+		}
+		err = wait(op)
+		if err != nil {
+			return nil, condition, fmt.Errorf("unable to patch the subnetwork: %w", err)
+		}
+		cr.eventRecorder.Event(gs, "Normal", "SubnetworkUpdated", "GCP Subnetwork updated")
+		changed = true
+	}
+
+	if changed {
+		current, err := cr.cloud.GCP.GetSubnetwork(region, gs.Spec.Name)
+		if err != nil {
+			return nil, condition, err
+		}
+		subnetwork = current
+	}
+
+	return subnetwork, condition, nil
+}
+
+func (cr *GCPSubnetworkReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, gs *benzaiten.GCPSubnetwork) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(gs, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("deleting gcpsubnetwork...")
+	op, err := cr.cloud.GCP.DeleteSubnetwork(gs.Spec.Region, gs.Spec.Name)
+	if err != nil && !notFoundGCPResource(err) {
+		if inUseGCPResource(err) {
+			// instances or clusters still use the subnetwork
+			cr.eventRecorder.Event(gs, "Warning", "SubnetworkInUse", fmt.Sprintf("GCP Subnetwork is in use, deletion blocked: %s", err.Error()))
+			return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+		}
+		logger.Error(err, "error deleting gcpsubnetwork")
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		err = waitComputeOperation(ctx, func() (*compute.Operation, error) {
+			return cr.cloud.GCP.WaitRegionOperation(gs.Spec.Region, op.Name)
+		})
+		if err != nil {
+			logger.Error(err, "error deleting gcpsubnetwork")
+			return ctrl.Result{}, err
+		}
+	}
+
+	err = releaseIPRanges(ctx, cr.Client, gs, "GCPSubnetwork")
+	if err != nil {
+		logger.Error(err, "error releasing gcpsubnetwork range")
+		return ctrl.Result{}, err
+	}
+
+	controllerutil.RemoveFinalizer(gs, gcpFinalizer)
+	err = cr.Update(ctx, gs)
+	if err != nil {
+		logger.Error(err, "error removing gcpsubnetwork finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("gcp subnetwork deleted")
+	return ctrl.Result{}, nil
+}
+
+func (cr *GCPSubnetworkReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.GCPSubnetwork{}).
+		Watches(&benzaiten.GCPNetwork{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForGCPNetwork)).
+		Complete(cr)
+}
+
+// requestsForGCPNetwork returns the GCPSubnetworks referencing the GCPNetwork
+func (cr *GCPSubnetworkReconciler) requestsForGCPNetwork(ctx context.Context, obj client.Object) []reconcile.Request {
+	gss := benzaiten.GCPSubnetworkList{}
+	err := cr.List(ctx, &gss, client.InNamespace(obj.GetNamespace()))
+	if err != nil {
+		cr.Log.Error(err, "unable to list gcpsubnetworks")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, gs := range gss.Items {
+		if gs.Spec.NetworkRef.Name == obj.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: gs.Name, Namespace: gs.Namespace},
+			})
+		}
+	}
+
+	return requests
+}
+
+func setupGCPSubnetworkController(mgr manager.Manager, log logr.Logger, cp CloudProviders) error {
+	eventRecorder := mgr.GetEventRecorderFor("gcpsubnetwork")
+	cc := GCPSubnetworkReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		cloud:         cp,
+		Log:           log.WithName("GCPSubnetworkReconciler"),
+	}
+
+	// create GCPSubnetwork controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create GCPSubnetwork controller: %w", err)
+	}
+
+	return nil
+}
+
+// newComputeSubnetwork builds the GCP subnetwork described by the GCPSubnetwork spec
+func newComputeSubnetwork(gs *benzaiten.GCPSubnetwork, network, ipCidrRange string) *compute.Subnetwork {
+	subnetwork := &compute.Subnetwork{
+		Name:                  gs.Spec.Name,
+		Network:               network,
+		IpCidrRange:           ipCidrRange,
+		SecondaryIpRanges:     newSecondaryIpRanges(gs.Spec.SecondaryRanges),
+		PrivateIpGoogleAccess: gs.Spec.PrivateIPGoogleAccess,
+		Purpose:               gs.Spec.Purpose,
+		Role:                  gs.Spec.Role,
+		Description:           gs.Spec.Description,
+	}
+	if gs.Spec.FlowLogs != nil {
+		subnetwork.LogConfig = newSubnetworkLogConfig(gs.Spec.FlowLogs)
+	}
+
+	return subnetwork
+}
+
+// newSecondaryIpRanges converts the secondary ranges of the spec
+func newSecondaryIpRanges(ranges []benzaiten.SubnetworkSecondaryRange) []*compute.SubnetworkSecondaryRange {
+	var secondaryRanges []*compute.SubnetworkSecondaryRange
+	for _, sr := range ranges {
+		secondaryRanges = append(secondaryRanges, &compute.SubnetworkSecondaryRange{
+			RangeName:   sr.RangeName,
+			IpCidrRange: sr.IPCidrRange,
+		})
+	}
+	return secondaryRanges
+}
+
+// newSubnetworkLogConfig converts the flow logs of the spec, no flow logs disable them
+func newSubnetworkLogConfig(flowLogs *benzaiten.SubnetworkFlowLogs) *compute.SubnetworkLogConfig {
+	if flowLogs == nil {
+		return &compute.SubnetworkLogConfig{
+			Enable:          false,
+			ForceSendFields: []string{"Enable"},
+		}
+	}
+	// the pattern of the CRD guarantees a valid sampling
+	flowSampling, _ := strconv.ParseFloat(flowLogs.FlowSampling, 64)
+	return &compute.SubnetworkLogConfig{
+		Enable:              true,
+		AggregationInterval: flowLogs.AggregationInterval,
+		FlowSampling:        flowSampling,
+		Metadata:            flowLogs.Metadata,
+		ForceSendFields:     []string{"FlowSampling"},
+	}
+}
+
+// secondaryRangesInSync reports whether the secondary ranges of the subnetwork match the spec, regardless of their order
+func secondaryRangesInSync(current []*compute.SubnetworkSecondaryRange, desired []benzaiten.SubnetworkSecondaryRange) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+	ranges := make(map[string]string, len(current))
+	for _, sr := range current {
+		ranges[sr.RangeName] = sr.IpCidrRange
+	}
+	for _, sr := range desired {
+		if r, ok := ranges[sr.RangeName]; !ok || r != sr.IPCidrRange {
+			return false
+		}
+	}
+	return true
+}
+
+// logConfigInSync reports whether the flow logs of the subnetwork match the spec
+func logConfigInSync(current *compute.SubnetworkLogConfig, desired *benzaiten.SubnetworkFlowLogs) bool {
+	if desired == nil {
+		return current == nil || !current.Enable
+	}
+	if current == nil || !current.Enable {
+		return false
+	}
+	wanted := newSubnetworkLogConfig(desired)
+	return current.AggregationInterval == wanted.AggregationInterval &&
+		current.FlowSampling == wanted.FlowSampling &&
+		current.Metadata == wanted.Metadata
+}
+
+// cidrExpands reports whether the desired range is an expansion of the current range
+func cidrExpands(current, desired string) (bool, error) {
+	c, err := netip.ParsePrefix(current)
+	if err != nil {
+		return false, fmt.Errorf("invalid range %s: %w", current, err)
+	}
+	d, err := netip.ParsePrefix(desired)
+	if err != nil {
+		return false, fmt.Errorf("invalid range %s: %w", desired, err)
+	}
+	return d.Bits() < c.Bits() && d.Masked().Contains(c.Addr()), nil
+}
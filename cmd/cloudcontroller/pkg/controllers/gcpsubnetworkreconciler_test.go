@@ -0,0 +1,163 @@
+package controllers
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"github.com/muraduiurie/cloudcontroller/pkg/cloudproviders/gcp"
+	"google.golang.org/api/compute/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"testing"
+)
+
+func TestCidrExpands(t *testing.T) {
+	tests := []struct {
+		current  string
+		desired  string
+		expected bool
+	}{
+		{"10.0.0.0/24", "10.0.0.0/20", true},
+		{"10.0.1.0/24", "10.0.0.0/20", true},
+		{"10.0.0.0/24", "10.0.0.0/24", false},
+		{"10.0.0.0/20", "10.0.0.0/24", false},
+		{"10.0.0.0/24", "10.1.0.0/20", false},
+	}
+
+	for _, tt := range tests {
+		expands, err := cidrExpands(tt.current, tt.desired)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if expands != tt.expected {
+			t.Errorf("cidrExpands(%s, %s) = %v, expected %v", tt.current, tt.desired, expands, tt.expected)
+		}
+	}
+
+	if _, err := cidrExpands("10.0.0.0/24", "not-a-range"); err == nil {
+		t.Fatalf("expected an error for an invalid range")
+	}
+}
+
+func TestSecondaryRangesInSync(t *testing.T) {
+	current := []*compute.SubnetworkSecondaryRange{
+		{RangeName: "pods", IpCidrRange: "10.4.0.0/14"},
+		{RangeName: "services", IpCidrRange: "10.8.0.0/20"},
+	}
+
+	desired := []benzaiten.SubnetworkSecondaryRange{
+		{RangeName: "services", IPCidrRange: "10.8.0.0/20"},
+		{RangeName: "pods", IPCidrRange: "10.4.0.0/14"},
+	}
+	if !secondaryRangesInSync(current, desired) {
+		t.Fatalf("expected ranges in a different order to be in sync")
+	}
+
+	desired = append(desired, benzaiten.SubnetworkSecondaryRange{RangeName: "pods-2", IPCidrRange: "10.12.0.0/14"})
+	if secondaryRangesInSync(current, desired) {
+		t.Fatalf("expected an added range to be out of sync")
+	}
+
+	desired = []benzaiten.SubnetworkSecondaryRange{
+		{RangeName: "pods", IPCidrRange: "10.4.0.0/14"},
+		{RangeName: "services", IPCidrRange: "10.9.0.0/20"},
+	}
+	if secondaryRangesInSync(current, desired) {
+		t.Fatalf("expected a changed range to be out of sync")
+	}
+}
+
+func TestLogConfigInSync(t *testing.T) {
+	flowLogs := &benzaiten.SubnetworkFlowLogs{
+		AggregationInterval: "INTERVAL_5_SEC",
+		FlowSampling:        "0.5",
+		Metadata:            "INCLUDE_ALL_METADATA",
+	}
+
+	if !logConfigInSync(nil, nil) || !logConfigInSync(&compute.SubnetworkLogConfig{Enable: false}, nil) {
+		t.Fatalf("expected disabled flow logs to be in sync")
+	}
+	if logConfigInSync(nil, flowLogs) {
+		t.Fatalf("expected flow logs to be enabled")
+	}
+	if !logConfigInSync(newSubnetworkLogConfig(flowLogs), flowLogs) {
+		t.Fatalf("expected matching flow logs to be in sync")
+	}
+	if logConfigInSync(&compute.SubnetworkLogConfig{Enable: true, AggregationInterval: "INTERVAL_5_SEC", FlowSampling: 1, Metadata: "INCLUDE_ALL_METADATA"}, flowLogs) {
+		t.Fatalf("expected a different sampling to be out of sync")
+	}
+}
+
+func TestGCPSubnetworkReconcilerEmptyState(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockSubnetworksInterface := gcp.NewMockSubnetworksInterface(mockCtrl)
+	mockGetSubnetworksInterface := gcp.NewMockGetSubnetworksInterface(mockCtrl)
+
+	// GCP leaves the state of regular subnetworks empty
+	mockSubnetworksInterface.EXPECT().Get("test-project", "us-central1", "test-subnetwork").Return(mockGetSubnetworksInterface)
+	mockGetSubnetworksInterface.EXPECT().Do().Return(&compute.Subnetwork{
+		Name:        "test-subnetwork",
+		SelfLink:    "https://www.googleapis.com/compute/v1/projects/test-project/regions/us-central1/subnetworks/test-subnetwork",
+		IpCidrRange: "10.0.0.0/24",
+	}, nil)
+
+	gn := &benzaiten.GCPNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-network", Namespace: "default"},
+		Status: benzaiten.GCPNetworkStatus{
+			SelfLink: "https://www.googleapis.com/compute/v1/projects/test-project/global/networks/test-network",
+		},
+	}
+	gs := &benzaiten.GCPSubnetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-subnetwork", Namespace: "default"},
+		Spec: benzaiten.GCPSubnetworkSpec{
+			Name:        "test-subnetwork",
+			Region:      "us-central1",
+			NetworkRef:  benzaiten.ResourceRef{Name: "test-network"},
+			IPCidrRange: "10.0.0.0/24",
+		},
+	}
+	cr := &GCPSubnetworkReconciler{
+		Client:        fake.NewClientBuilder().WithScheme(Scheme).WithObjects(gn, gs).WithStatusSubresource(gs).Build(),
+		Scheme:        Scheme,
+		eventRecorder: record.NewFakeRecorder(10),
+		cloud: CloudProviders{
+			GCP: &gcp.API{
+				Compute: gcp.ComputeService{
+					Clients: gcp.ComputeClients{
+						Subnetworks: mockSubnetworksInterface,
+					},
+				},
+				Config: gcp.Config{
+					ProjectId: "test-project",
+				},
+			},
+		},
+		Log: logr.Discard(),
+	}
+
+	key := types.NamespacedName{Namespace: "default", Name: "test-subnetwork"}
+	_, err := cr.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	reconciled := benzaiten.GCPSubnetwork{}
+	err = cr.Get(context.Background(), key, &reconciled)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reconciled.Status.Phase != benzaiten.SubnetworkStatusReady {
+		t.Fatalf("expected existing subnetwork to be ready, got phase %q", reconciled.Status.Phase)
+	}
+
+	// the draining state of load balancer subnetworks is kept
+	if phase := subnetworkPhase(&compute.Subnetwork{State: "DRAINING"}); phase != benzaiten.SubnetworkStatusDraining {
+		t.Fatalf("expected draining phase, got %q", phase)
+	}
+}
@@ -0,0 +1,369 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-logr/logr"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"github.com/muraduiurie/cloudcontroller/pkg/ipam"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"net/netip"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"time"
+)
+
+const (
+	// ranges claimed from IPPools
+	ipRangePrimary  = "primary"
+	ipRangePods     = "pods"
+	ipRangeServices = "services"
+	ipRangeMaster   = "master"
+)
+
+type IPPoolReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	eventRecorder record.EventRecorder
+	Log           logr.Logger
+}
+
+func (cr *IPPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := cr.Log.WithValues("ippool", req.NamespacedName)
+
+	pool := benzaiten.IPPool{}
+	err := cr.Get(ctx, req.NamespacedName, &pool)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			logger.Info("ippool not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if pool.DeletionTimestamp.IsZero() && controllerutil.AddFinalizer(&pool, gcpFinalizer) {
+		err = cr.Update(ctx, &pool)
+		if err != nil {
+			logger.Error(err, "error adding ippool finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	previous := pool.DeepCopyObject().(*benzaiten.IPPool)
+
+	// release the blocks of the objects which were deleted or no longer claim them, e.g. while the controller was down
+	var allocations []benzaiten.IPPoolAllocation
+	for _, allocation := range pool.Status.Allocations {
+		claimed, err := cr.allocationClaimed(ctx, &pool, allocation)
+		if err != nil {
+			logger.Error(err, "error getting ippool allocation owner")
+			return ctrl.Result{}, err
+		}
+		if claimed {
+			allocations = append(allocations, allocation)
+			continue
+		}
+		logger.Info("releasing ippool allocation", "cidr", allocation.CIDR, "owner", allocationOwner(allocation))
+		cr.eventRecorder.Event(&pool, "Normal", "BlockReleased", fmt.Sprintf("%s released by %s", allocation.CIDR, allocationOwner(allocation)))
+	}
+	pool.Status.Allocations = allocations
+	meta.SetStatusCondition(&pool.Status.Conditions, ipPoolReady(&pool))
+	if !equality.Semantic.DeepEqual(previous.Status, pool.Status) {
+		// a conflict means an allocation raced the release, the pool is requeued and the release retried
+		err = cr.Status().Update(ctx, &pool)
+		if err != nil {
+			logger.Error(err, "error updating ippool status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if !pool.DeletionTimestamp.IsZero() {
+		return cr.reconcileDelete(ctx, logger, &pool)
+	}
+
+	logger.Info("ip pool reconciled")
+	return ctrl.Result{RequeueAfter: time.Second * 60}, nil
+}
+
+func (cr *IPPoolReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, pool *benzaiten.IPPool) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(pool, gcpFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	// deleting a pool still in use would allow its blocks to be allocated twice
+	if len(pool.Status.Allocations) > 0 {
+		logger.Info("ippool in use, waiting for its allocations to be released", "allocations", len(pool.Status.Allocations))
+		cr.eventRecorder.Event(pool, "Warning", "PoolInUse", fmt.Sprintf("IP Pool has %d allocations, deletion blocked", len(pool.Status.Allocations)))
+		return ctrl.Result{RequeueAfter: time.Second * 30}, nil
+	}
+
+	controllerutil.RemoveFinalizer(pool, gcpFinalizer)
+	err := cr.Update(ctx, pool)
+	if err != nil {
+		logger.Error(err, "error removing ippool finalizer")
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("ip pool deleted")
+	return ctrl.Result{}, nil
+}
+
+// allocationClaimed reports whether the owner of the allocation exists and still claims the range from the pool. A
+// GCPKubernetesCluster keeps its blocks until it is deleted, the ranges of a GKE cluster cannot change.
+func (cr *IPPoolReconciler) allocationClaimed(ctx context.Context, pool *benzaiten.IPPool, allocation benzaiten.IPPoolAllocation) (bool, error) {
+	var owner client.Object
+	switch allocation.Kind {
+	case "GCPSubnetwork":
+		owner = &benzaiten.GCPSubnetwork{}
+	case "GCPKubernetesCluster":
+		owner = &benzaiten.GCPKubernetesCluster{}
+	default:
+		// keep the blocks of unknown owners
+		return true, nil
+	}
+
+	err := cr.Get(ctx, types.NamespacedName{Namespace: allocation.Namespace, Name: allocation.Name}, owner)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if allocation.Kind == "GCPKubernetesCluster" {
+		return true, nil
+	}
+	claim := ipPoolClaims(owner)[allocation.Range]
+	return claim != nil && claim.PoolRef.NamespacedName(owner.GetNamespace()) == client.ObjectKeyFromObject(pool), nil
+}
+
+func (cr *IPPoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&benzaiten.IPPool{}).
+		Watches(&benzaiten.GCPSubnetwork{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForClaims)).
+		Watches(&benzaiten.GCPKubernetesCluster{}, handler.EnqueueRequestsFromMapFunc(cr.requestsForClaims)).
+		Complete(cr)
+}
+
+// requestsForClaims returns the IPPools the object claims ranges from, so that the blocks are released once the
+// object is deleted
+func (cr *IPPoolReconciler) requestsForClaims(ctx context.Context, obj client.Object) []reconcile.Request {
+	var requests []reconcile.Request
+	for _, claim := range ipPoolClaims(obj) {
+		if claim != nil {
+			requests = append(requests, reconcile.Request{NamespacedName: claim.PoolRef.NamespacedName(obj.GetNamespace())})
+		}
+	}
+
+	return requests
+}
+
+func setupIPPoolController(mgr manager.Manager, log logr.Logger) error {
+	eventRecorder := mgr.GetEventRecorderFor("ippool")
+	cc := IPPoolReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		eventRecorder: eventRecorder,
+		Log:           log.WithName("IPPoolReconciler"),
+	}
+
+	// create IPPool controller
+	err := cc.SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create IPPool controller: %w", err)
+	}
+
+	return nil
+}
+
+// ipPoolClaims returns the ranges the object claims from IPPools, keyed by range
+func ipPoolClaims(obj client.Object) map[string]*benzaiten.IPPoolClaim {
+	switch o := obj.(type) {
+	case *benzaiten.GCPSubnetwork:
+		return map[string]*benzaiten.IPPoolClaim{
+			ipRangePrimary: o.Spec.IPCidrRangeFrom,
+		}
+	case *benzaiten.GCPKubernetesCluster:
+		return map[string]*benzaiten.IPPoolClaim{
+			ipRangePods:     o.Spec.ClusterIpv4CidrFrom,
+			ipRangeServices: o.Spec.ServicesIpv4CidrFrom,
+			ipRangeMaster:   o.Spec.MasterIpv4CidrFrom,
+		}
+	}
+	return nil
+}
+
+// allocateIPRange returns the block allocated to the range of the owner, allocating it from the claimed IPPool first
+// if needed. The allocation is recorded in the pool status, a concurrent allocation makes the update fail with a
+// conflict instead of allocating the same block twice. If no block can be allocated, the returned message explains
+// why.
+func allocateIPRange(ctx context.Context, c client.Client, owner client.Object, kind, rangeName string, claim *benzaiten.IPPoolClaim) (string, string, error) {
+	key := claim.PoolRef.NamespacedName(owner.GetNamespace())
+	pool := benzaiten.IPPool{}
+	err := c.Get(ctx, key, &pool)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			return "", fmt.Sprintf("IPPool %s not found", key), nil
+		}
+		return "", "", fmt.Errorf("unable to get ippool %s: %w", key, err)
+	}
+
+	if cidr := poolAllocation(&pool, owner, kind, rangeName); cidr != "" {
+		return cidr, "", nil
+	}
+	if !pool.DeletionTimestamp.IsZero() {
+		return "", fmt.Sprintf("IPPool %s is being deleted", key), nil
+	}
+
+	parents, used, err := ipPoolPrefixes(&pool)
+	if err != nil {
+		return "", fmt.Sprintf("IPPool %s is invalid: %v", key, err), nil
+	}
+	block, err := ipam.Allocate(parents, used, claim.PrefixLength)
+	if errors.Is(err, ipam.ErrExhausted) {
+		return "", fmt.Sprintf("IPPool %s has no free /%d block", key, claim.PrefixLength), nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	pool.Status.Allocations = append(pool.Status.Allocations, benzaiten.IPPoolAllocation{
+		CIDR:      block.String(),
+		Kind:      kind,
+		Namespace: owner.GetNamespace(),
+		Name:      owner.GetName(),
+		Range:     rangeName,
+	})
+	err = c.Status().Update(ctx, &pool)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to record allocation in ippool %s: %w", key, err)
+	}
+
+	return block.String(), "", nil
+}
+
+// allocatedIPRange returns the block allocated to the range of the owner from the claimed IPPool, empty if none was
+// allocated
+func allocatedIPRange(ctx context.Context, c client.Client, owner client.Object, kind, rangeName string, claim *benzaiten.IPPoolClaim) (string, error) {
+	key := claim.PoolRef.NamespacedName(owner.GetNamespace())
+	pool := benzaiten.IPPool{}
+	err := c.Get(ctx, key, &pool)
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("unable to get ippool %s: %w", key, err)
+	}
+
+	return poolAllocation(&pool, owner, kind, rangeName), nil
+}
+
+// poolAllocation returns the block of the pool allocated to the range of the owner, empty if none
+func poolAllocation(pool *benzaiten.IPPool, owner client.Object, kind, rangeName string) string {
+	for _, allocation := range pool.Status.Allocations {
+		if allocation.Kind == kind && allocation.Namespace == owner.GetNamespace() && allocation.Name == owner.GetName() && allocation.Range == rangeName {
+			return allocation.CIDR
+		}
+	}
+	return ""
+}
+
+// releaseIPRanges removes the blocks allocated to the owner from the claimed IPPools
+func releaseIPRanges(ctx context.Context, c client.Client, owner client.Object, kind string) error {
+	for _, claim := range ipPoolClaims(owner) {
+		if claim == nil {
+			continue
+		}
+		key := claim.PoolRef.NamespacedName(owner.GetNamespace())
+		pool := benzaiten.IPPool{}
+		err := c.Get(ctx, key, &pool)
+		if err != nil {
+			if kerr.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("unable to get ippool %s: %w", key, err)
+		}
+
+		var allocations []benzaiten.IPPoolAllocation
+		for _, allocation := range pool.Status.Allocations {
+			if allocation.Kind != kind || allocation.Namespace != owner.GetNamespace() || allocation.Name != owner.GetName() {
+				allocations = append(allocations, allocation)
+			}
+		}
+		if len(allocations) == len(pool.Status.Allocations) {
+			continue
+		}
+		pool.Status.Allocations = allocations
+		err = c.Status().Update(ctx, &pool)
+		if err != nil {
+			return fmt.Errorf("unable to release allocation in ippool %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// ipPoolPrefixes returns the parent ranges of the pool and the blocks which may not be allocated
+func ipPoolPrefixes(pool *benzaiten.IPPool) ([]netip.Prefix, []netip.Prefix, error) {
+	parents, err := ipam.ParsePrefixes(pool.Spec.CIDRs)
+	if err != nil {
+		return nil, nil, err
+	}
+	used, err := ipam.ParsePrefixes(pool.Spec.Reserved)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, allocation := range pool.Status.Allocations {
+		block, err := netip.ParsePrefix(allocation.CIDR)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid allocation %s: %w", allocation.CIDR, err)
+		}
+		used = append(used, block)
+	}
+	return parents, used, nil
+}
+
+// ipPoolReady returns the Ready condition of the pool
+func ipPoolReady(pool *benzaiten.IPPool) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               benzaiten.IPPoolConditionReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: pool.Generation,
+		Reason:             "PoolReady",
+		Message:            fmt.Sprintf("%d blocks allocated", len(pool.Status.Allocations)),
+	}
+
+	parents, _, err := ipPoolPrefixes(pool)
+	if err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "InvalidCIDR"
+		condition.Message = err.Error()
+		return condition
+	}
+	// removing a parent range does not release the blocks allocated from it
+	for _, allocation := range pool.Status.Allocations {
+		if !ipam.Within(parents, netip.MustParsePrefix(allocation.CIDR)) {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "AllocationOutOfRange"
+			condition.Message = fmt.Sprintf("%s allocated to %s is outside of the pool", allocation.CIDR, allocationOwner(allocation))
+			return condition
+		}
+	}
+
+	return condition
+}
+
+// allocationOwner describes the owner of the allocation, e.g. GCPSubnetwork default/my-subnetwork
+func allocationOwner(allocation benzaiten.IPPoolAllocation) string {
+	return fmt.Sprintf("%s %s/%s", allocation.Kind, allocation.Namespace, allocation.Name)
+}
@@ -0,0 +1,206 @@
+package controllers
+
+import (
+	"context"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"testing"
+)
+
+func newTestIPPool() *benzaiten.IPPool {
+	return &benzaiten.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-pool", Namespace: "network"},
+		Spec: benzaiten.IPPoolSpec{
+			CIDRs:    []string{"10.0.0.0/16"},
+			Reserved: []string{"10.0.0.0/20"},
+		},
+	}
+}
+
+func TestAllocateIPRange(t *testing.T) {
+	ctx := context.Background()
+	pool := newTestIPPool()
+	c := fake.NewClientBuilder().WithScheme(Scheme).WithObjects(pool).WithStatusSubresource(pool).Build()
+	claim := &benzaiten.IPPoolClaim{
+		PoolRef:      benzaiten.NamespacedResourceRef{Name: "test-pool", Namespace: "network"},
+		PrefixLength: 20,
+	}
+	first := &benzaiten.GCPSubnetwork{ObjectMeta: metav1.ObjectMeta{Name: "first", Namespace: "app"}}
+	second := &benzaiten.GCPSubnetwork{ObjectMeta: metav1.ObjectMeta{Name: "second", Namespace: "app"}}
+
+	cidr, pending, err := allocateIPRange(ctx, c, first, "GCPSubnetwork", ipRangePrimary, claim)
+	if err != nil || pending != "" {
+		t.Fatalf("unexpected error: %v %s", err, pending)
+	}
+	// the reserved range is skipped
+	if cidr != "10.0.16.0/20" {
+		t.Fatalf("expected 10.0.16.0/20, got %s", cidr)
+	}
+
+	// the allocation recorded in the pool is returned again, e.g. after a restart of the controller
+	again, _, err := allocateIPRange(ctx, c, first, "GCPSubnetwork", ipRangePrimary, claim)
+	if err != nil || again != cidr {
+		t.Fatalf("expected the same block %s, got %s: %v", cidr, again, err)
+	}
+
+	cidr, _, err = allocateIPRange(ctx, c, second, "GCPSubnetwork", ipRangePrimary, claim)
+	if err != nil || cidr != "10.0.32.0/20" {
+		t.Fatalf("expected 10.0.32.0/20, got %s: %v", cidr, err)
+	}
+
+	current := benzaiten.IPPool{}
+	err = c.Get(ctx, client.ObjectKeyFromObject(pool), &current)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(current.Status.Allocations) != 2 {
+		t.Fatalf("expected 2 allocations, got %v", current.Status.Allocations)
+	}
+
+	// a stale pool cannot overwrite the allocations
+	err = c.Status().Update(ctx, pool)
+	if !kerr.IsConflict(err) {
+		t.Fatalf("expected a conflict, got %v", err)
+	}
+
+	err = releaseIPRanges(ctx, c, &benzaiten.GCPSubnetwork{
+		ObjectMeta: first.ObjectMeta,
+		Spec:       benzaiten.GCPSubnetworkSpec{IPCidrRangeFrom: claim},
+	}, "GCPSubnetwork")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = c.Get(ctx, client.ObjectKeyFromObject(pool), &current)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(current.Status.Allocations) != 1 || current.Status.Allocations[0].Name != "second" {
+		t.Fatalf("expected the allocation of second only, got %v", current.Status.Allocations)
+	}
+
+	// the released block is allocated again
+	third := &benzaiten.GCPSubnetwork{ObjectMeta: metav1.ObjectMeta{Name: "third", Namespace: "app"}}
+	cidr, _, err = allocateIPRange(ctx, c, third, "GCPSubnetwork", ipRangePrimary, claim)
+	if err != nil || cidr != "10.0.16.0/20" {
+		t.Fatalf("expected 10.0.16.0/20, got %s: %v", cidr, err)
+	}
+}
+
+func TestAllocateIPRangeExhausted(t *testing.T) {
+	ctx := context.Background()
+	pool := newTestIPPool()
+	c := fake.NewClientBuilder().WithScheme(Scheme).WithObjects(pool).WithStatusSubresource(pool).Build()
+	gs := &benzaiten.GCPSubnetwork{ObjectMeta: metav1.ObjectMeta{Name: "test-subnetwork", Namespace: "network"}}
+
+	cidr, pending, err := allocateIPRange(ctx, c, gs, "GCPSubnetwork", ipRangePrimary, &benzaiten.IPPoolClaim{
+		PoolRef:      benzaiten.NamespacedResourceRef{Name: "test-pool"},
+		PrefixLength: 15,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cidr != "" || pending != "IPPool network/test-pool has no free /15 block" {
+		t.Fatalf("expected the pool to be exhausted, got %s: %s", cidr, pending)
+	}
+
+	_, pending, err = allocateIPRange(ctx, c, gs, "GCPSubnetwork", ipRangePrimary, &benzaiten.IPPoolClaim{
+		PoolRef:      benzaiten.NamespacedResourceRef{Name: "other-pool"},
+		PrefixLength: 24,
+	})
+	if err != nil || pending != "IPPool network/other-pool not found" {
+		t.Fatalf("expected the pool not to be found, got %s: %v", pending, err)
+	}
+}
+
+func TestAllocationClaimed(t *testing.T) {
+	ctx := context.Background()
+	pool := newTestIPPool()
+	// both claims were removed from the specs after the blocks were allocated
+	gs := &benzaiten.GCPSubnetwork{ObjectMeta: metav1.ObjectMeta{Name: "test-subnetwork", Namespace: "network"}}
+	gkc := &benzaiten.GCPKubernetesCluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "network"}}
+	c := fake.NewClientBuilder().WithScheme(Scheme).WithObjects(pool, gs, gkc).Build()
+	cr := IPPoolReconciler{Client: c}
+
+	tests := []struct {
+		name       string
+		allocation benzaiten.IPPoolAllocation
+		expected   bool
+	}{
+		{"unclaimed subnetwork range", benzaiten.IPPoolAllocation{Kind: "GCPSubnetwork", Namespace: "network", Name: "test-subnetwork", Range: ipRangePrimary}, false},
+		{"existing cluster", benzaiten.IPPoolAllocation{Kind: "GCPKubernetesCluster", Namespace: "network", Name: "test-cluster", Range: ipRangePods}, true},
+		{"deleted cluster", benzaiten.IPPoolAllocation{Kind: "GCPKubernetesCluster", Namespace: "network", Name: "other-cluster", Range: ipRangePods}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claimed, err := cr.allocationClaimed(ctx, pool, tt.allocation)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if claimed != tt.expected {
+				t.Errorf("expected claimed %v, got %v", tt.expected, claimed)
+			}
+		})
+	}
+}
+
+func TestAllocateRangesCreatedCluster(t *testing.T) {
+	ctx := context.Background()
+	pool := newTestIPPool()
+	pool.Status.Allocations = []benzaiten.IPPoolAllocation{
+		{CIDR: "10.0.16.0/20", Kind: "GCPKubernetesCluster", Namespace: "network", Name: "test-cluster", Range: ipRangePods},
+	}
+	c := fake.NewClientBuilder().WithScheme(Scheme).WithObjects(pool).WithStatusSubresource(pool).Build()
+	claim := &benzaiten.IPPoolClaim{PoolRef: benzaiten.NamespacedResourceRef{Name: "test-pool"}, PrefixLength: 20}
+	// the services claim was added after the cluster was created
+	gkc := &benzaiten.GCPKubernetesCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "network"},
+		Spec: benzaiten.GCPKubernetesClusterSpec{
+			ClusterIpv4CidrFrom:  claim,
+			ServicesIpv4CidrFrom: claim,
+		},
+	}
+	cr := GCPKubernetesClusterReconciler{Client: c}
+
+	ranges, condition, err := cr.allocateRanges(ctx, gkc, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ranges[ipRangePods] != "10.0.16.0/20" || len(ranges) != 1 {
+		t.Errorf("expected the allocated pods range only, got %v", ranges)
+	}
+	if condition.Status != metav1.ConditionFalse || condition.Reason != "RecreateRequired" {
+		t.Errorf("expected the services claim to require a recreate, got %s: %s", condition.Reason, condition.Message)
+	}
+
+	current := benzaiten.IPPool{}
+	err = c.Get(ctx, client.ObjectKeyFromObject(pool), &current)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(current.Status.Allocations) != 1 {
+		t.Errorf("expected no block to be allocated, got %v", current.Status.Allocations)
+	}
+}
+
+func TestIPPoolReady(t *testing.T) {
+	pool := newTestIPPool()
+	pool.Status.Allocations = []benzaiten.IPPoolAllocation{
+		{CIDR: "10.0.16.0/20", Kind: "GCPSubnetwork", Namespace: "app", Name: "first", Range: ipRangePrimary},
+	}
+	if condition := ipPoolReady(pool); condition.Status != metav1.ConditionTrue {
+		t.Fatalf("expected pool to be ready, got %s", condition.Message)
+	}
+
+	pool.Spec.CIDRs = []string{"10.1.0.0/16"}
+	if condition := ipPoolReady(pool); condition.Reason != "AllocationOutOfRange" {
+		t.Fatalf("expected AllocationOutOfRange, got %s", condition.Reason)
+	}
+
+	pool.Spec.CIDRs = []string{"10.1.0.0"}
+	if condition := ipPoolReady(pool); condition.Reason != "InvalidCIDR" {
+		t.Fatalf("expected InvalidCIDR, got %s", condition.Reason)
+	}
+}
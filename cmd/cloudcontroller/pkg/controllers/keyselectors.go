@@ -0,0 +1,114 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// getConfigMapKey returns the value of the selected ConfigMap key. The returned bool is false if an optional key is missing.
+func getConfigMapKey(ctx context.Context, c client.Reader, namespace string, ref *corev1.ConfigMapKeySelector) (string, bool, error) {
+	optional := ref.Optional != nil && *ref.Optional
+
+	cm := corev1.ConfigMap{}
+	err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, &cm)
+	if err != nil {
+		if kerr.IsNotFound(err) && optional {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("unable to get configmap %s: %w", ref.Name, err)
+	}
+
+	if v, ok := cm.Data[ref.Key]; ok {
+		return v, true, nil
+	}
+	if v, ok := cm.BinaryData[ref.Key]; ok {
+		return string(v), true, nil
+	}
+	if optional {
+		return "", false, nil
+	}
+
+	return "", false, fmt.Errorf("key %s not found in configmap %s", ref.Key, ref.Name)
+}
+
+// getSecretKey returns the value of the selected Secret key. The returned bool is false if an optional key is missing.
+func getSecretKey(ctx context.Context, c client.Reader, namespace string, ref *corev1.SecretKeySelector) (string, bool, error) {
+	optional := ref.Optional != nil && *ref.Optional
+
+	secret := corev1.Secret{}
+	err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, &secret)
+	if err != nil {
+		if kerr.IsNotFound(err) && optional {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("unable to get secret %s: %w", ref.Name, err)
+	}
+
+	if v, ok := secret.Data[ref.Key]; ok {
+		return string(v), true, nil
+	}
+	if optional {
+		return "", false, nil
+	}
+
+	return "", false, fmt.Errorf("key %s not found in secret %s", ref.Key, ref.Name)
+}
+
+// resolveMetadataItems builds compute metadata items, reading referenced ConfigMap and Secret keys
+func resolveMetadataItems(ctx context.Context, c client.Reader, namespace string, metadata []benzaiten.MetadataItem) ([]*compute.MetadataItems, error) {
+	items := make([]*compute.MetadataItems, 0, len(metadata))
+	for _, m := range metadata {
+		value := m.Value
+		if m.ValueFrom != nil {
+			var found bool
+			var err error
+			switch {
+			case m.ValueFrom.ConfigMapKeyRef != nil:
+				value, found, err = getConfigMapKey(ctx, c, namespace, m.ValueFrom.ConfigMapKeyRef)
+			case m.ValueFrom.SecretKeyRef != nil:
+				value, found, err = getSecretKey(ctx, c, namespace, m.ValueFrom.SecretKeyRef)
+			default:
+				err = fmt.Errorf("no value source set")
+			}
+			if err != nil {
+				return nil, fmt.Errorf("unable to resolve metadata %s: %w", m.Key, err)
+			}
+			if !found {
+				// optional reference is missing, skip the entry
+				continue
+			}
+		}
+		items = append(items, &compute.MetadataItems{
+			Key:   m.Key,
+			Value: &value,
+		})
+	}
+
+	return items, nil
+}
+
+// metadataReferences reports whether the metadata items reference the ConfigMap or Secret
+func metadataReferences(metadata []benzaiten.MetadataItem, obj client.Object) bool {
+	for _, m := range metadata {
+		if m.ValueFrom == nil {
+			continue
+		}
+		switch obj.(type) {
+		case *corev1.ConfigMap:
+			if m.ValueFrom.ConfigMapKeyRef != nil && m.ValueFrom.ConfigMapKeyRef.Name == obj.GetName() {
+				return true
+			}
+		case *corev1.Secret:
+			if m.ValueFrom.SecretKeyRef != nil && m.ValueFrom.SecretKeyRef.Name == obj.GetName() {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -8,6 +8,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
+// gcpFinalizer guards the deletion of the GCP resources backing benzaiten.io objects
+const gcpFinalizer = "benzaiten.io/gcp-resource"
+
 type CloudProviders struct {
 	GCP *gcp.API
 }
@@ -41,6 +44,126 @@ func RunControllers(ctx context.Context, log logr.Logger, mgr manager.Manager, a
 		if err != nil {
 			return fmt.Errorf("unable to setup GKENetwork controller: %w", err)
 		}
+
+		err = setupGCPDiskController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPDisk controller: %w", err)
+		}
+
+		err = setupGCPSnapshotController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPSnapshot controller: %w", err)
+		}
+
+		err = setupGCPSnapshotScheduleController(mgr, log)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPSnapshotSchedule controller: %w", err)
+		}
+
+		err = setupGCPInstanceTemplateController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPInstanceTemplate controller: %w", err)
+		}
+
+		err = setupGCPManagedInstanceGroupController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPManagedInstanceGroup controller: %w", err)
+		}
+
+		err = setupGCPAddressController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPAddress controller: %w", err)
+		}
+
+		err = setupGCPSubnetworkController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPSubnetwork controller: %w", err)
+		}
+
+		err = setupGCPFirewallRuleController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPFirewallRule controller: %w", err)
+		}
+
+		err = setupGCPRouterController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPRouter controller: %w", err)
+		}
+
+		err = setupGCPRouteController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPRoute controller: %w", err)
+		}
+
+		err = setupIPPoolController(mgr, log)
+		if err != nil {
+			return fmt.Errorf("unable to setup IPPool controller: %w", err)
+		}
+
+		err = setupGCPDNSZoneController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPDNSZone controller: %w", err)
+		}
+
+		err = setupGCPDNSRecordSetController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPDNSRecordSet controller: %w", err)
+		}
+
+		err = setupGCPStorageBucketController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPStorageBucket controller: %w", err)
+		}
+
+		err = setupGCPSQLInstanceController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPSQLInstance controller: %w", err)
+		}
+
+		err = setupGCPSQLDatabaseController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPSQLDatabase controller: %w", err)
+		}
+
+		err = setupGCPSQLUserController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPSQLUser controller: %w", err)
+		}
+
+		err = setupGCPPubSubTopicController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPPubSubTopic controller: %w", err)
+		}
+
+		err = setupGCPPubSubSubscriptionController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPPubSubSubscription controller: %w", err)
+		}
+
+		err = setupGCPServiceAccountController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPServiceAccount controller: %w", err)
+		}
+
+		err = setupGCPServiceAccountKeyController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPServiceAccountKey controller: %w", err)
+		}
+
+		err = setupGCPIAMPolicyMemberController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPIAMPolicyMember controller: %w", err)
+		}
+
+		err = setupGCPArtifactRepositoryController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPArtifactRepository controller: %w", err)
+		}
+
+		err = setupGCPRedisInstanceController(mgr, log, cp)
+		if err != nil {
+			return fmt.Errorf("unable to setup GCPRedisInstance controller: %w", err)
+		}
 	}
 
 	// start manager
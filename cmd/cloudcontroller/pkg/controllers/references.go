@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"strings"
+)
+
+// networkAttachment holds the self links of the GCPNetwork and GCPSubnetwork referenced by an object
+type networkAttachment struct {
+	Network    string
+	Subnetwork string
+}
+
+// resolveNetworkAttachment resolves the GCPNetwork and GCPSubnetwork references of obj to their self links. The
+// returned condition of the given type is False until every referenced object is ready.
+func resolveNetworkAttachment(ctx context.Context, c client.Client, obj client.Object, conditionType string, networkRef, subnetworkRef *benzaiten.NamespacedResourceRef) (networkAttachment, metav1.Condition, error) {
+	attachment := networkAttachment{}
+	condition := metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: obj.GetGeneration(),
+		Reason:             "ReferencesResolved",
+		Message:            "referenced objects are ready",
+	}
+	var pending []string
+
+	if networkRef != nil {
+		key := networkRef.NamespacedName(obj.GetNamespace())
+		gn := benzaiten.GCPNetwork{}
+		err := c.Get(ctx, key, &gn)
+		if err != nil && !kerr.IsNotFound(err) {
+			return attachment, condition, fmt.Errorf("unable to get gcpnetwork %s: %w", key, err)
+		}
+		if err != nil || gn.Status.SelfLink == "" || gn.Status.Phase != benzaiten.NetworkStatusReady {
+			pending = append(pending, "GCPNetwork "+key.String())
+		} else {
+			attachment.Network = gn.Status.SelfLink
+		}
+	}
+
+	if subnetworkRef != nil {
+		key := subnetworkRef.NamespacedName(obj.GetNamespace())
+		gs := benzaiten.GCPSubnetwork{}
+		err := c.Get(ctx, key, &gs)
+		if err != nil && !kerr.IsNotFound(err) {
+			return attachment, condition, fmt.Errorf("unable to get gcpsubnetwork %s: %w", key, err)
+		}
+		if err != nil || gs.Status.SelfLink == "" || gs.Status.Phase != benzaiten.SubnetworkStatusReady {
+			pending = append(pending, "GCPSubnetwork "+key.String())
+		} else {
+			attachment.Subnetwork = gs.Status.SelfLink
+		}
+	}
+
+	if len(pending) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "ReferencesNotReady"
+		condition.Message = fmt.Sprintf("waiting for %s", strings.Join(pending, ", "))
+	}
+
+	return attachment, condition, nil
+}
+
+// refersTo reports whether the reference held by an object of the namespace points at obj
+func refersTo(ref *benzaiten.NamespacedResourceRef, namespace string, obj client.Object) bool {
+	return ref != nil && ref.NamespacedName(namespace) == client.ObjectKeyFromObject(obj)
+}
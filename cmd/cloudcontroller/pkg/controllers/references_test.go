@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"context"
+	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"testing"
+)
+
+func TestResolveNetworkAttachment(t *testing.T) {
+	network := &benzaiten.GCPNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared-network", Namespace: "network"},
+		Status: benzaiten.GCPNetworkStatus{
+			Phase:    benzaiten.NetworkStatusReady,
+			SelfLink: "https://www.googleapis.com/compute/v1/projects/test-project/global/networks/shared-network",
+		},
+	}
+	subnetwork := &benzaiten.GCPSubnetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-subnetwork", Namespace: "app"},
+	}
+	gi := &benzaiten.GCPInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-instance", Namespace: "app"},
+		Spec: benzaiten.GCPInstanceSpec{
+			NetworkRef:    &benzaiten.NamespacedResourceRef{Name: "shared-network", Namespace: "network"},
+			SubnetworkRef: &benzaiten.NamespacedResourceRef{Name: "app-subnetwork"},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(Scheme).WithObjects(network, subnetwork).Build()
+
+	// the subnetwork is not created in GCP yet
+	attachment, condition, err := resolveNetworkAttachment(context.Background(), c, gi, benzaiten.InstanceConditionReferencesResolved, gi.Spec.NetworkRef, gi.Spec.SubnetworkRef)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if condition.Status != metav1.ConditionFalse || condition.Message != "waiting for GCPSubnetwork app/app-subnetwork" {
+		t.Fatalf("expected to wait for the subnetwork, got %s: %s", condition.Status, condition.Message)
+	}
+	if attachment.Network != network.Status.SelfLink {
+		t.Fatalf("expected network %s, got %s", network.Status.SelfLink, attachment.Network)
+	}
+
+	subnetwork.Status = benzaiten.GCPSubnetworkStatus{
+		Phase:    benzaiten.SubnetworkStatusReady,
+		SelfLink: "https://www.googleapis.com/compute/v1/projects/test-project/regions/us-central1/subnetworks/app-subnetwork",
+	}
+	c = fake.NewClientBuilder().WithScheme(Scheme).WithObjects(network, subnetwork).Build()
+	attachment, condition, err = resolveNetworkAttachment(context.Background(), c, gi, benzaiten.InstanceConditionReferencesResolved, gi.Spec.NetworkRef, gi.Spec.SubnetworkRef)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if condition.Status != metav1.ConditionTrue {
+		t.Fatalf("expected references to be resolved, got %s: %s", condition.Status, condition.Message)
+	}
+	if attachment.Subnetwork != subnetwork.Status.SelfLink {
+		t.Fatalf("expected subnetwork %s, got %s", subnetwork.Status.SelfLink, attachment.Subnetwork)
+	}
+
+	instance := newComputeInstance(gi, attachment, nil, nil)
+	if nic := instance.NetworkInterfaces[0]; nic.Network != network.Status.SelfLink || nic.Subnetwork != subnetwork.Status.SelfLink {
+		t.Fatalf("expected instance to use the resolved network and subnetwork, got %s and %s", nic.Network, nic.Subnetwork)
+	}
+}
+
+func TestRefersTo(t *testing.T) {
+	network := &benzaiten.GCPNetwork{ObjectMeta: metav1.ObjectMeta{Name: "test-network", Namespace: "network"}}
+
+	tests := []struct {
+		ref       *benzaiten.NamespacedResourceRef
+		namespace string
+		expected  bool
+	}{
+		{&benzaiten.NamespacedResourceRef{Name: "test-network"}, "network", true},
+		{&benzaiten.NamespacedResourceRef{Name: "test-network", Namespace: "network"}, "app", true},
+		{&benzaiten.NamespacedResourceRef{Name: "test-network"}, "app", false},
+		{&benzaiten.NamespacedResourceRef{Name: "other-network", Namespace: "network"}, "app", false},
+		{nil, "network", false},
+	}
+
+	for _, tt := range tests {
+		if got := refersTo(tt.ref, tt.namespace, network); got != tt.expected {
+			t.Errorf("refersTo(%v, %q) = %v, expected %v", tt.ref, tt.namespace, got, tt.expected)
+		}
+	}
+}
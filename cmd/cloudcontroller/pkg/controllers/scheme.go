@@ -4,6 +4,7 @@ import (
 	benzaiten "github.com/muraduiurie/cloudcontroller/api/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 )
 
 var (
@@ -12,5 +13,6 @@ var (
 
 // initiate the program by creating the scheme
 func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(Scheme))
 	utilruntime.Must(benzaiten.AddToScheme(Scheme))
 }
@@ -0,0 +1,82 @@
+// Package ipam allocates non-overlapping CIDR blocks from parent ranges.
+package ipam
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+)
+
+// ErrExhausted is returned when the parent ranges have no free block of the requested size
+var ErrExhausted = errors.New("no free block of the requested size")
+
+// ParsePrefixes parses the CIDRs and masks their host bits, e.g. 10.0.0.1/8 becomes 10.0.0.0/8
+func ParsePrefixes(cidrs []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		p, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %s: %w", cidr, err)
+		}
+		prefixes = append(prefixes, p.Masked())
+	}
+	return prefixes, nil
+}
+
+// Allocate returns the first block of the given prefix length inside the parents which overlaps none of the used
+// blocks. Parents are searched in order and the lowest free block of a parent is returned.
+func Allocate(parents, used []netip.Prefix, bits int) (netip.Prefix, error) {
+	for _, parent := range parents {
+		if bits < parent.Bits() || bits > parent.Addr().BitLen() {
+			continue
+		}
+
+		addr := parent.Addr()
+		for addr.IsValid() && parent.Contains(addr) {
+			candidate := netip.PrefixFrom(addr, bits)
+			conflict, ok := firstOverlap(candidate, used)
+			if !ok {
+				return candidate, nil
+			}
+			// skip the used block, or the candidate if the used block is smaller. Both ends are aligned to the
+			// requested prefix length.
+			if conflict.Bits() <= bits {
+				addr = lastAddr(conflict).Next()
+			} else {
+				addr = lastAddr(candidate).Next()
+			}
+		}
+	}
+
+	return netip.Prefix{}, ErrExhausted
+}
+
+// Within reports whether the prefix is entirely contained in one of the parents
+func Within(parents []netip.Prefix, p netip.Prefix) bool {
+	for _, parent := range parents {
+		if parent.Bits() <= p.Bits() && parent.Contains(p.Addr()) {
+			return true
+		}
+	}
+	return false
+}
+
+// firstOverlap returns the first used block overlapping the prefix
+func firstOverlap(p netip.Prefix, used []netip.Prefix) (netip.Prefix, bool) {
+	for _, u := range used {
+		if u.Overlaps(p) {
+			return u, true
+		}
+	}
+	return netip.Prefix{}, false
+}
+
+// lastAddr returns the last address of the prefix
+func lastAddr(p netip.Prefix) netip.Addr {
+	b := p.Masked().Addr().AsSlice()
+	for i := p.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 0x80 >> (i % 8)
+	}
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
@@ -0,0 +1,103 @@
+package ipam
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+)
+
+func mustParse(t *testing.T, cidrs ...string) []netip.Prefix {
+	t.Helper()
+	prefixes, err := ParsePrefixes(cidrs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return prefixes
+}
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		name     string
+		parents  []string
+		used     []string
+		bits     int
+		expected string
+	}{
+		{"empty pool", []string{"10.0.0.0/16"}, nil, 20, "10.0.0.0/20"},
+		{"after used block", []string{"10.0.0.0/16"}, []string{"10.0.0.0/20"}, 20, "10.0.16.0/20"},
+		{"skip larger used block", []string{"10.0.0.0/16"}, []string{"10.0.0.0/18"}, 20, "10.0.64.0/20"},
+		{"skip block partially used", []string{"10.0.0.0/16"}, []string{"10.0.4.0/24"}, 20, "10.0.16.0/20"},
+		{"fill the gap", []string{"10.0.0.0/16"}, []string{"10.0.0.0/24", "10.0.2.0/24"}, 24, "10.0.1.0/24"},
+		{"next parent", []string{"10.0.0.0/24", "10.1.0.0/16"}, []string{"10.0.0.0/24"}, 24, "10.1.0.0/24"},
+		{"parent too small", []string{"10.0.0.0/24", "10.1.0.0/16"}, nil, 20, "10.1.0.0/20"},
+		{"unaligned used block", []string{"192.168.0.0/16"}, []string{"192.168.0.0/23", "192.168.4.0/22"}, 22, "192.168.8.0/22"},
+		{"ipv6", []string{"fd20::/48"}, []string{"fd20::/64"}, 64, "fd20:0:0:1::/64"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Allocate(mustParse(t, tt.parents...), mustParse(t, tt.used...), tt.bits)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.String() != tt.expected {
+				t.Fatalf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestAllocateExhausted(t *testing.T) {
+	parents := mustParse(t, "10.0.0.0/23")
+	used := mustParse(t, "10.0.0.0/24")
+
+	block, err := Allocate(parents, used, 24)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	used = append(used, block)
+
+	_, err = Allocate(parents, used, 24)
+	if !errors.Is(err, ErrExhausted) {
+		t.Fatalf("expected ErrExhausted, got %v", err)
+	}
+
+	// the end of the address space must not wrap around
+	_, err = Allocate(mustParse(t, "255.255.255.0/24"), mustParse(t, "255.255.255.0/25"), 24)
+	if !errors.Is(err, ErrExhausted) {
+		t.Fatalf("expected ErrExhausted, got %v", err)
+	}
+}
+
+func TestAllocateNoOverlap(t *testing.T) {
+	parents := mustParse(t, "10.0.0.0/16")
+	var used []netip.Prefix
+	for _, bits := range []int{24, 20, 22, 24, 18, 28, 20} {
+		block, err := Allocate(parents, used, bits)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, u := range used {
+			if u.Overlaps(block) {
+				t.Fatalf("block %s overlaps %s", block, u)
+			}
+		}
+		if !Within(parents, block) {
+			t.Fatalf("block %s is not within the parents", block)
+		}
+		used = append(used, block)
+	}
+}
+
+func TestWithin(t *testing.T) {
+	parents := mustParse(t, "10.0.0.0/16")
+	if !Within(parents, netip.MustParsePrefix("10.0.4.0/24")) {
+		t.Fatalf("expected 10.0.4.0/24 to be within 10.0.0.0/16")
+	}
+	if Within(parents, netip.MustParsePrefix("10.0.0.0/8")) {
+		t.Fatalf("expected 10.0.0.0/8 not to be within 10.0.0.0/16")
+	}
+	if Within(parents, netip.MustParsePrefix("10.1.0.0/24")) {
+		t.Fatalf("expected 10.1.0.0/24 not to be within 10.0.0.0/16")
+	}
+}